@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/config/flag"
+	"github.com/rss3-network/node/internal/database/dialer"
+	"github.com/rss3-network/node/internal/engine/protocol"
+	"github.com/rss3-network/node/internal/node/admin"
+	"github.com/rss3-network/node/provider/redis"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var checkpointCommand = cobra.Command{
+	Use:   "checkpoint",
+	Short: "Inspect and move the checkpoints of workers",
+}
+
+var checkpointListCommand = cobra.Command{
+	Use:   "list",
+	Short: "List the checkpoints with the decoded states",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		service, err := newAdminService(cmd)
+		if err != nil {
+			return err
+		}
+
+		var value network.Network
+
+		if networkName := lo.Must(cmd.Flags().GetString(flag.KeyCheckpointNetwork)); networkName != "" {
+			if value, err = network.NetworkString(networkName); err != nil {
+				return fmt.Errorf("invalid network %s: %w", networkName, err)
+			}
+		}
+
+		checkpoints, err := service.ListCheckpoints(cmd.Context(), lo.Must(cmd.Flags().GetString(flag.KeyCheckpointID)), value, lo.Must(cmd.Flags().GetString(flag.KeyCheckpointWorker)))
+		if err != nil {
+			return err
+		}
+
+		return printJSON(checkpoints)
+	},
+}
+
+var checkpointMoveCommand = cobra.Command{
+	Use:   "move",
+	Short: "Rewind or fast-forward the checkpoint of a worker to a block number, event id or timestamp",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		service, err := newAdminService(cmd)
+		if err != nil {
+			return err
+		}
+
+		request := admin.MoveRequest{
+			ID: lo.Must(cmd.Flags().GetString(flag.KeyCheckpointID)),
+			Position: protocol.Position{
+				BlockNumber: optionalUint64(cmd.Flags(), flag.KeyCheckpointBlockNumber),
+				EventID:     optionalUint64(cmd.Flags(), flag.KeyCheckpointEventID),
+				Timestamp:   optionalUint64(cmd.Flags(), flag.KeyCheckpointTimestamp),
+			},
+			DeleteActivitiesSince: optionalUint64(cmd.Flags(), flag.KeyCheckpointDeleteActivitiesSince),
+		}
+
+		checkpoint, err := service.MoveCheckpoint(cmd.Context(), request)
+		if err != nil {
+			return err
+		}
+
+		return printJSON(checkpoint)
+	},
+}
+
+// newAdminService connects to the database and Redis of the config file.
+func newAdminService(cmd *cobra.Command) (*admin.Service, error) {
+	configFile, err := config.Setup(lo.Must(cmd.Flags().GetString(flag.KeyConfig)))
+	if err != nil {
+		return nil, fmt.Errorf("setup config file: %w", err)
+	}
+
+	databaseClient, err := dialer.Dial(cmd.Context(), configFile.Database)
+	if err != nil {
+		return nil, fmt.Errorf("dial database: %w", err)
+	}
+
+	if configFile.Redis == nil {
+		return admin.NewService(databaseClient, nil), nil
+	}
+
+	redisClient, err := redis.NewClient(*configFile.Redis)
+	if err != nil {
+		return nil, fmt.Errorf("new redis client: %w", err)
+	}
+
+	return admin.NewService(databaseClient, redisClient), nil
+}
+
+// optionalUint64 returns the value of the flag if it is set.
+func optionalUint64(flags *pflag.FlagSet, name string) *uint64 {
+	if !flags.Changed(name) {
+		return nil
+	}
+
+	return lo.ToPtr(lo.Must(flags.GetUint64(name)))
+}
+
+func printJSON(value any) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(value)
+}
+
+func init() {
+	checkpointListCommand.Flags().String(flag.KeyCheckpointID, "", "worker id")
+	checkpointListCommand.Flags().String(flag.KeyCheckpointNetwork, "", "network name")
+	checkpointListCommand.Flags().String(flag.KeyCheckpointWorker, "", "worker name")
+
+	checkpointMoveCommand.Flags().String(flag.KeyCheckpointID, "", "worker id")
+	checkpointMoveCommand.Flags().Uint64(flag.KeyCheckpointBlockNumber, 0, "the next block number or height to index")
+	checkpointMoveCommand.Flags().Uint64(flag.KeyCheckpointEventID, 0, "the event id, cursor or offset to resume from")
+	checkpointMoveCommand.Flags().Uint64(flag.KeyCheckpointTimestamp, 0, "the timestamp in seconds recorded by the state")
+	checkpointMoveCommand.Flags().Uint64(flag.KeyCheckpointDeleteActivitiesSince, 0, "delete the activities of the worker since the timestamp in seconds")
+	lo.Must0(checkpointMoveCommand.MarkFlagRequired(flag.KeyCheckpointID))
+
+	checkpointCommand.AddCommand(&checkpointListCommand, &checkpointMoveCommand)
+	command.AddCommand(&checkpointCommand)
+}
@@ -340,6 +340,15 @@ func runWorker(ctx context.Context, configFile *config.File, databaseClient data
 
 	zap.L().Info("starting worker", zap.String("workerID", workerID))
 
+	// Shut down gracefully on signals, the worker commits its in-flight batch before it exits.
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Multiple worker ids separated by commas share one data source.
+	if workerIDs := strings.Split(workerID, ","); len(workerIDs) > 1 {
+		return runSharedWorkers(ctx, configFile, workerIDs, databaseClient, streamClient, redisClient)
+	}
+
 	module, err := findModuleByID(configFile, workerID)
 	if err != nil {
 		return fmt.Errorf("find module by id: %w", err)
@@ -356,6 +365,28 @@ func runWorker(ctx context.Context, configFile *config.File, databaseClient data
 	return server.Run(ctx)
 }
 
+func runSharedWorkers(ctx context.Context, configFile *config.File, workerIDs []string, databaseClient database.Client, streamClient stream.Client, redisClient rueidis.Client) error {
+	modules := make([]*config.Module, 0, len(workerIDs))
+
+	for _, workerID := range workerIDs {
+		module, err := findModuleByID(configFile, strings.TrimSpace(workerID))
+		if err != nil {
+			return fmt.Errorf("find module by id: %w", err)
+		}
+
+		modules = append(modules, module)
+	}
+
+	server, err := indexer.NewSharedServer(ctx, modules, databaseClient, streamClient, redisClient)
+	if err != nil {
+		return fmt.Errorf("new shared indexer server: %w", err)
+	}
+
+	zap.L().Info("shared workers initialized successfully", zap.Strings("workerIDs", workerIDs))
+
+	return server.Run(ctx)
+}
+
 func runBroadcaster(ctx context.Context, config *config.File) error {
 	zap.L().Info("initializing broadcaster")
 
@@ -461,7 +492,7 @@ func init() {
 
 	command.PersistentFlags().String(flag.KeyConfig, "config.yaml", "config file name")
 	command.PersistentFlags().String(flag.KeyModule, WorkerArg, "module name")
-	command.PersistentFlags().String(flag.KeyWorkerID, "", "worker id")
+	command.PersistentFlags().String(flag.KeyWorkerID, "", "worker id, multiple ids separated by commas share one data source")
 	zap.L().Debug("command flags initialized")
 }
 
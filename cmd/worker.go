@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/rss3-network/node/config/flag"
+	"github.com/rss3-network/node/internal/node/admin"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+var workerCommand = cobra.Command{
+	Use:   "worker",
+	Short: "Pause, resume and drain running workers by --worker.id",
+}
+
+var workerControlCommand = cobra.Command{
+	Use:   "control",
+	Short: "Show whether the worker is paused, drained or running",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		service, id, err := newWorkerControl(cmd)
+		if err != nil {
+			return err
+		}
+
+		control, err := service.Control(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		return printJSON(map[string]any{
+			"id":      id,
+			"control": control,
+		})
+	},
+}
+
+// newWorkerControlCommand creates a command to set the control of the worker.
+func newWorkerControlCommand(control admin.Control, short string) *cobra.Command {
+	return &cobra.Command{
+		Use:   control.String(),
+		Short: short,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			service, id, err := newWorkerControl(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := service.SetControl(cmd.Context(), id, control); err != nil {
+				return err
+			}
+
+			return printJSON(map[string]any{
+				"id":      id,
+				"control": control,
+			})
+		},
+	}
+}
+
+func newWorkerControl(cmd *cobra.Command) (*admin.Service, string, error) {
+	id := lo.Must(cmd.Flags().GetString(flag.KeyWorkerID))
+	if id == "" {
+		return nil, "", fmt.Errorf("--%s is required", flag.KeyWorkerID)
+	}
+
+	service, err := newAdminService(cmd)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return service, id, nil
+}
+
+func init() {
+	workerCommand.AddCommand(
+		&workerControlCommand,
+		newWorkerControlCommand(admin.ControlPause, "Stop the data source of the worker after its in-flight batch"),
+		newWorkerControlCommand(admin.ControlResume, "Restart the data source of a paused or drained worker from its checkpoint"),
+		newWorkerControlCommand(admin.ControlDrain, "Commit the in-flight batch of the worker and exit its process"),
+	)
+	command.AddCommand(&workerCommand)
+}
@@ -15,6 +15,7 @@ const (
 	ErrorCodeValidationFailed
 	ErrorCodeBadParams
 	ErrorCodeInternalError
+	ErrorCodeNotFound
 )
 
 type ErrorResponse struct {
@@ -36,6 +37,13 @@ func ValidationFailedError(c echo.Context, err error) error {
 	})
 }
 
+func NotFoundError(c echo.Context, err error) error {
+	return c.JSON(http.StatusNotFound, &ErrorResponse{
+		Code:    ErrorCodeNotFound,
+		Message: fmt.Sprintf("The requested resource was not found, %s", err),
+	})
+}
+
 // InternalError should not return the details of the error to the client for safety reasons.
 func InternalError(c echo.Context) error {
 	return c.JSON(http.StatusInternalServerError, &ErrorResponse{
@@ -8,11 +8,11 @@ import (
 	"strings"
 )
 
-const _ErrorCodeName = "bad_requestvalidation_failedbad_paramsinternal_error"
+const _ErrorCodeName = "bad_requestvalidation_failedbad_paramsinternal_errornot_found"
 
-var _ErrorCodeIndex = [...]uint8{0, 11, 28, 38, 52}
+var _ErrorCodeIndex = [...]uint8{0, 11, 28, 38, 52, 61}
 
-const _ErrorCodeLowerName = "bad_requestvalidation_failedbad_paramsinternal_error"
+const _ErrorCodeLowerName = "bad_requestvalidation_failedbad_paramsinternal_errornot_found"
 
 func (i ErrorCode) String() string {
 	i -= 1
@@ -34,9 +34,10 @@ func _ErrorCodeNoOp() {
 	_ = x[ErrorCodeValidationFailed-(2)]
 	_ = x[ErrorCodeBadParams-(3)]
 	_ = x[ErrorCodeInternalError-(4)]
+	_ = x[ErrorCodeNotFound-(5)]
 }
 
-var _ErrorCodeValues = []ErrorCode{ErrorCodeBadRequest, ErrorCodeValidationFailed, ErrorCodeBadParams, ErrorCodeInternalError}
+var _ErrorCodeValues = []ErrorCode{ErrorCodeBadRequest, ErrorCodeValidationFailed, ErrorCodeBadParams, ErrorCodeInternalError, ErrorCodeNotFound}
 
 var _ErrorCodeNameToValueMap = map[string]ErrorCode{
 	_ErrorCodeName[0:11]:       ErrorCodeBadRequest,
@@ -47,6 +48,8 @@ var _ErrorCodeNameToValueMap = map[string]ErrorCode{
 	_ErrorCodeLowerName[28:38]: ErrorCodeBadParams,
 	_ErrorCodeName[38:52]:      ErrorCodeInternalError,
 	_ErrorCodeLowerName[38:52]: ErrorCodeInternalError,
+	_ErrorCodeName[52:61]:      ErrorCodeNotFound,
+	_ErrorCodeLowerName[52:61]: ErrorCodeNotFound,
 }
 
 var _ErrorCodeNames = []string{
@@ -54,6 +57,7 @@ var _ErrorCodeNames = []string{
 	_ErrorCodeName[11:28],
 	_ErrorCodeName[28:38],
 	_ErrorCodeName[38:52],
+	_ErrorCodeName[52:61],
 }
 
 // ErrorCodeString retrieves an enum value from the enum constants string name.
@@ -7,6 +7,7 @@ import (
 	"path"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/creasty/defaults"
 	"github.com/ethereum/go-ethereum/common"
@@ -42,6 +43,7 @@ type File struct {
 	Stream        *Stream             `mapstructure:"stream"`
 	Redis         *Redis              `mapstructure:"redis"`
 	Observability *Telemetry          `mapstructure:"observability"`
+	Alert         *Alert              `mapstructure:"alert"`
 }
 
 // LoadModulesEndpoint loads the endpoint url and headers for each module.
@@ -169,6 +171,45 @@ type RedisTLS struct {
 	InsecureSkipVerify bool   `mapstructure:"insecure_skip_verify" default:"false"`
 }
 
+type Alert struct {
+	// RepeatInterval is the interval to resend an alert that is still firing.
+	RepeatInterval time.Duration    `mapstructure:"repeat_interval" default:"6h"`
+	Notifiers      []*AlertNotifier `mapstructure:"notifiers" validate:"dive"`
+	Rules          []*AlertRule     `mapstructure:"rules" validate:"dive"`
+}
+
+type AlertNotifier struct {
+	ID          string            `mapstructure:"id" validate:"required"`
+	Type        string            `mapstructure:"type" validate:"required,oneof=webhook slack pagerduty email"`
+	URL         string            `mapstructure:"url" validate:"required_if=Type webhook,required_if=Type slack,omitempty,url"`
+	HTTPHeaders map[string]string `mapstructure:"http_headers"`
+	// RoutingKey is the integration key of the PagerDuty Events API v2.
+	RoutingKey string     `mapstructure:"routing_key" validate:"required_if=Type pagerduty"`
+	SMTP       *AlertSMTP `mapstructure:"smtp" validate:"required_if=Type email"`
+}
+
+type AlertSMTP struct {
+	Host     string   `mapstructure:"host" validate:"required"`
+	Port     int      `mapstructure:"port" default:"587"`
+	Username string   `mapstructure:"username"`
+	Password string   `mapstructure:"password"`
+	From     string   `mapstructure:"from" validate:"required"`
+	To       []string `mapstructure:"to" validate:"required,min=1"`
+}
+
+type AlertRule struct {
+	// Workers is the list of worker ids the rule applies to, all workers if empty.
+	Workers []string `mapstructure:"workers"`
+	// Notifiers is the list of notifier ids to send alerts to, all notifiers if empty.
+	Notifiers []string `mapstructure:"notifiers"`
+	// Statuses is the list of worker statuses to alert on, defaults to Unhealthy.
+	Statuses []string `mapstructure:"statuses"`
+	// CheckpointStall alerts when the checkpoint of the worker has not been updated for the duration.
+	CheckpointStall time.Duration `mapstructure:"checkpoint_stall"`
+	// MinIndexCount alerts when the worker indexes fewer activities than the count in a monitoring cycle.
+	MinIndexCount int64 `mapstructure:"min_index_count"`
+}
+
 // var _ fmt.Stringer = (*Parameters)(nil)
 
 type Parameters map[string]any
@@ -253,6 +294,7 @@ func _Setup(configName, configType string, v *viper.Viper) (*File, error) {
 		network.HookFunc(),
 		worker.HookFunc(),
 		EvmAddressHookFunc(),
+		mapstructure.StringToTimeDurationHookFunc(),
 	))); err != nil {
 		return nil, fmt.Errorf("unmarshal config file: %w", err)
 	}
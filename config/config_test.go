@@ -607,6 +607,87 @@ func TestConfigFileType(t *testing.T) {
 	}
 }
 
+func TestConfigAlertNotifier(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		notifier  string
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name: "Webhook",
+			notifier: `
+    - id: ops
+      type: webhook
+      url: https://example.com/alert`,
+			wantError: require.NoError,
+		},
+		{
+			name: "Webhook without a URL",
+			notifier: `
+    - id: ops
+      type: webhook`,
+			wantError: require.Error,
+		},
+		{
+			name: "Webhook with an invalid URL",
+			notifier: `
+    - id: ops
+      type: webhook
+      url: example`,
+			wantError: require.Error,
+		},
+		{
+			name: "Slack without a URL",
+			notifier: `
+    - id: ops
+      type: slack`,
+			wantError: require.Error,
+		},
+		{
+			name: "PagerDuty without a routing key",
+			notifier: `
+    - id: ops
+      type: pagerduty`,
+			wantError: require.Error,
+		},
+		{
+			name: "Email without SMTP",
+			notifier: `
+    - id: ops
+      type: email`,
+			wantError: require.Error,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			configDir := "/etc/rss3/node"
+			fs := afero.NewMemMapFs()
+
+			err := fs.Mkdir(configDir, 0o777)
+			require.NoError(t, err)
+
+			file, err := fs.Create(path.Join(configDir, configName))
+			require.NoError(t, err)
+
+			_, err = file.WriteString(configExampleYaml + "alert:\n  notifiers:" + testcase.notifier + "\n")
+			require.NoError(t, err)
+
+			v := viper.New()
+			v.SetFs(fs)
+
+			_, err = _Setup(configName, "yaml", v)
+			testcase.wantError(t, err)
+		})
+	}
+}
+
 func AssertConfig(t *testing.T, expect, got *File) {
 	t.Run("environment", func(t *testing.T) {
 		assert.Equal(t, expect.Environment, got.Environment)
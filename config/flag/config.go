@@ -5,3 +5,13 @@ var (
 	KeyModule   = "module"
 	KeyWorkerID = "worker.id"
 )
+
+var (
+	KeyCheckpointID                    = "id"
+	KeyCheckpointNetwork               = "network"
+	KeyCheckpointWorker                = "worker"
+	KeyCheckpointBlockNumber           = "block.number"
+	KeyCheckpointEventID               = "event.id"
+	KeyCheckpointTimestamp             = "timestamp"
+	KeyCheckpointDeleteActivitiesSince = "delete.activities.since"
+)
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 
+	"github.com/rss3-network/node/schema/worker/rss"
 	"github.com/samber/lo"
 )
 
@@ -15,9 +16,10 @@ func HasOneWorker(config *File) error {
 	return nil
 }
 
-// IsRSSComponentOnly Check if the configuration contains an RSS component only
+// IsRSSComponentOnly Check if the configuration contains an RSS component only,
+// the feed worker is excluded as it indexes activities into the database.
 func IsRSSComponentOnly(config *File) bool {
-	return len(config.Component.Decentralized) == 0 && config.Component.RSS != nil && len(config.Component.Federated) == 0
+	return len(config.Component.Decentralized) == 0 && config.Component.RSS != nil && config.Component.RSS.Worker != rss.Feed && len(config.Component.Federated) == 0
 }
 
 // CalculateWorkerCount returns the number of workers deployed
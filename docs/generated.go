@@ -783,178 +783,184 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9aW/jOBLoXxG0D5hdwHHk2w4w2MnRvdN43T15cWYGu72BQUu0zY0kakgqjqeR//7A",
-	"S6Ik2rEc231Muj/EtijWwWKxWKwqfnZ9HCU4hjGj7tlnNwEERJBBUvo2SQBbTIDv4zRmkwD6MGYEhOhP",
-	"GPCGAaQ+QQlDOHbP3BvICIIP0AE+Qw+IIUidGcGRwxbQoQn00QzBwFG9Nd2GCx9BlITQPXO9x2AYgP50",
-	"1u6P+l0wGwUDCIMR9Dqw1+m2ekFnAMCo73V7bsNFHBrHzG24MYj4+6pTt+ES+EeKCMePkRQ2XOovYAQ4",
-	"tv+HwJl75v7tNKf9VD7N/k6uTBLPVadPT421PJnBABLA9soPMQjv03tA//wZExxA/x7xnx5AmHJifyo9",
-	"+ynBYZNiH4HQfWq4FAJ/EYBoUXgn+/Wnlsf/N5NQEHZAZr7VvNnMSMGc1QRtYmEAGUAhdWaYVNgn33fe",
-	"XZUkatj1YLcd9AYdOIWt2aw9ACPYHbW6vUFn2oVerzWc+UF/2PMHs1Gn0wo6rRGYwmGvOxsMPdixSxoK",
-	"NvKFrRLeijKC4rmV4BiyJSb3O8uLer84fyBbQALTyI6zBvnSAb0mmGEfhx9VfzbykhCwGSbRnrSF7q5p",
-	"p0w/3u/Ev9a9biTw5VP/WMRlE3EjYYTSnWbgzXjsKMRzgQRTfw1R8tuOM+iPFJKV0Bk4noQoQqyK8lhg",
-	"thJYxmk0hcTBM0e+Q50lYgsUi4eZ6mDYIYrO4rRqeZoIAdhUkQYGdYfjXLz8Xrz71HAfTzBI0ImPAziH",
-	"8Ql8ZAScMDBXozEDacg0LhKNCvwHEKIAMI5ZhOIfW40IPP7Y9jZyLwFzuJl5CZijGPAHYtQVB00GtTZy",
-	"R0DYjTnX/NUavKmyRgEvc8bOEj8lFJPN3JBtnJTCQLAj505zjZCoXuty4FK+9hz1ml4FxUpXgAj0JS3b",
-	"qKgp4MTh2MneW0da3vGua8hV1sO2hOYwrbTW1gaK6LWTv71u8u826+vPd3PCb5zpvKmVJ3uyNf5O/7FO",
-	"EHLToh43lAlBtx792G5zSDoPZHRsoNtYm3PCEYMRfaHF0dALICAEcICPJ3N8wn87ofcoOcGCFhCeJBjF",
-	"DBK5gG7LxWSNAVBi4/5Mm4Oy0GLXfEn2URT7cMJQBCkDUbId7ygDhKF4rpmIqJP1sI5zZUB1J99t9ua2",
-	"hJch2ulPfR9uNCJta416izOCpXQtyarvuqSO1XtPShJUd2MBzN2Wet2JjWoG5lvOkorlzMB8w/zgHdce",
-	"WY75toPKAdhJElNoR5pWCdxEFO+6NlWrBG5P1omdqDRmKKw7O9OE2wfbzcoygMPPyjLEJ04431lByi5w",
-	"gKQnKf9hNbkAzF/8CzKbn4ueZ3Tz13wcMxgLiwokSYh8Yeue/o9KazKnLSE4gUS/ppxH9GXrYeYx2lmf",
-	"l/eIO2zM8l1BPbO9YHXvbBPXwjzD2bD46pph2eK2hzWfT5EvaQxZluLaU9BYzmquNGpJqKOwtcrNuAbC",
-	"8JeZe/apngRxTek+NT7njDJcKXe789OiPGvyM4eNp/+DPpPKqixnQlM5UxysxNIy5epKb8+4kVRaeqI0",
-	"ZCgJoZNpnbJnqaAQ1+i/D5CBADDwqv/2o/9201yRGoXdhV+Po/t0Vxa2ZxZUY1edic9TUZvuhlLmKd8X",
-	"RqaSfpFi/So05I5Kjtut+2KoVrwv0bc5LkrV7ojLV6pl8xVez9GG9gw5IBbbGPl3lUAHUSejaUtlXD4n",
-	"/MKGaPXY8tUI/UsYoft0J70aoK8GqMRdKD2a4Jjq7bj6MrkAgQJYS81tQ/cbQsSZUIXC2wV0lA7mqjoC",
-	"IRd0GDiYOBwDgGLqoFisUE7uQ2m6JhllW1Wz5EY1qEWODT/ZjUMZSX2WEijPH50QUVY8vWm6jZLa1yZk",
-	"tVv72y+x0eXxcUVLKGN220652XqZH+Ll4vNJEnO3heCaw7oEVDtVZ2m4xcitdho3O9tfxMW6XLvFDITX",
-	"YA7pdtN7ay4ZJsBfRrbLNH9bcl3B/sgybeXeVyPP7/haGoNQLgtHW2zOYwcpyA6F5AESB/K2Dvb9lBAY",
-	"OMsFCqGTEMzx5usry0kq0aCsxEscz9B8p9GtYYtKKFfCmfACvn/EAXwXz/Dh0FUA9oApe4vTODiuJZKb",
-	"7QRSnBIfCixjzJwZx6aE5M14vJNCzmI69Jz+9NnV0Uz8M99GuGfucrlsgqnfjCFrgtQtuaRStsBEtlcH",
-	"Pm+iCDi/r+JYugCK5P039byOH6AH8QHKr4u2/PYudugCE3Ymfz7Vv8uvifzyEfkLGCJhdvopZTiCxLlE",
-	"cbBybpC/ACSgOHYoWFGHLsTGGz9AslzAkNtzS8QWjo8pow0HxX6YBrwfAmPWcCJM2BzModi1c61FnSCF",
-	"8qQLOiGO504AQ94xH9gQivPpBSTOAqcUNhXSiQXn8QI6C0AdMQow4F0CebzLX6dpAgmI41QGleHYEVQs",
-	"UOLMCR9v6kxTKagEcqUHAyswza7fF4D9QJ0YPrJ/buLk7+fOJY4iyOXrA4oRZZA44xQ6b8IQkhVnHYod",
-	"BsJ7GR8o471wlIB45YApTpmQz/BBayjBIs0bHGtuRby9HirqAL64Ax/FcysVp7lwxHypT6cT5RRqe+3u",
-	"iTc48dq3Xves1TnrDJue5/3HbbgMMRGcJOVATukkwVTyesotAYElih3gxHDpLHAEnenKifGy6byLKYMg",
-	"4PLyA1W4OVMQ35M0Yf6quAF3b8bjn9Op2NGEfGmbpCTkE8BdMJbQs9PT4oQ5jeGSnua4n8a5AJ9wNE6m",
-	"KQq5GJ5Ifp34IKXioZKC05bX9bqDYcsTxgHfxLpEHPozbJufaoGcQRjIGZj7PFycMrexdmajwD07BhkN",
-	"F8UBfHTPPMMrwmlaCMbiZcz3uFX8sq3/DIQUlniRb4Jbg9ZoOOq1h6O1LOImxSRTdq0y054az5o/B7E2",
-	"c1X+bdmZv2NyD8nOa/ruBualfsRh78E8zAZeYHIzHndOlO2T+XJO/oUnBM7oZAFBQCcRQPEEJzAGCZp8",
-	"gAGyOV2DgCiXVcn303AjFMEstKQa5V0ip7ELTvlyjWO4hfeqNghtEUwucRhyXTMN4XmSEPwgcm+OAS3V",
-	"ztkjALtIyZEgfUDC0X4ESLcEBPBooGI6g+SA0N48+gsQz+F79EeKAqlNDw4Lg/gIYMYM3EvVcXBIS5Ac",
-	"EMy/uF0eg9iH1wQnmB5UVeTAfsPskHLOte0DJPTQSiKDc2AVkcE5tIIwAR1aPdyMxwfsfSxyTsXW6qBD",
-	"I+FcwRAeVKIlmPfo/vBADizLEsg1pkcAQvAMhfAYcB5XB4dyAx8QhUcAswQkODiY8QKQQxIjFJjcWx7B",
-	"CDagXRAUzI9E2YGXNwPSgZWCASlfee72sfG6xfcwrrcZDKCPIhCaD1HM4Fyshq6sBTDDJAJMPul3c3+P",
-	"0VB6Yi39J4BQGExQBOZwkpLQ2ogyEAd8Ip7tmecwTqPJWPfOIa2iKbYjkRJUIJZ/b1SbqUIOW3BlL3vp",
-	"21phcbW75whm5vf+ZV50b8j7gSCMdc2NQ3Ru7CMPBOHX+D7Gy0MxJzNzD9T/zXicBRvtv/d8G2UJ79xl",
-	"QgmVYPEanWdBeryFe/bJBeIJFH73B3wP3cPAl36kKnifQMA49KkodTJDsQjUcBuuz9kRug03TQLZRAUK",
-	"8S8HQVJsxaooTtOV23ApDDkyeMYXMv6VcRw19hne+0Psygyp1LigmOMgDhsoDGeuNRY4D4XTNScem1dG",
-	"Cn0eBYeiBBPhSDZqKYgX3IasnXHmzhFbpNOmj6NTQmnnRB0snCaakDlW0n8KMh/7zlRXPEwWiQ24pEwx",
-	"IXjJx0AOoBDgSEtyIsL9aJokIf+wRGwRELDc4/CY7qkNYq1RIZCLCJ/gDTcUtMnnFO5XakrurCpmlIF7",
-	"DjiN9Sc/BCjaIwpFn1AVg5moUgHmAMWUDxuYUgZQvEcMis6VdTM6RJTpib0/2B/zaGQNLlUrX8MFZIoY",
-	"EYWTAFlCIKQVPAA+3aaASj0oWHdCI0DYib8AYspPEfOx+OQTTOlUKiOjDtMMEB9QJnTTPMYUUUFhDIHb",
-	"cCNAGQ7E3I8h4E1wwlCEKH8zweFqLp5lB4UUPPAnDzQU2iIEK9HvNEwhvV89p3R0yaiPWbEGm8Kpr13M",
-	"mgw72simD2HjpI3hUsyRJQGJuQSJ7/sTFsPZYF2ZMYoNxbZHuMZWRIP7NZPSNzeXJ6IcCf/Q6vfUp0G7",
-	"pX9r9fSPrVF/4Dbcj2+uT1rd1h5RvJVB5dU55BuWasOF2qpvuPPciJJHutIebKiDbFWyruEyw1K/K5Q1",
-	"y/vaJN8MzJu3YL43uRaJ8dvHpu/Izqrz5Ki2YMWdUoUewARTUXZmv8v1xlPUw206pZuC7xfKmULb5Mns",
-	"2+Ivxyrk8l/avd/tjcVpRujBOXxYThb2LtUB9TFlex9ORdvT4cZHeBqPMTiHIkA4ML9lAuTR3zc/P0wj",
-	"+7uZHZnP+psTsGqMxtkxl6B1G3g+Vpzg7TNkd2ZoKb7PvvJl6OzlTMIetfJlGJ87JJ4aLohEUvHBWM2t",
-	"ccAgkabUgSa5dfgMyIcZQh0R9EVGsei/eWq4CSQIB1VkYFw8veIb1ROGImg71hHl37ZtXmK8fLchANoY",
-	"zvSx3DGFQAI90PgvQVLlt4zxPpjmwsdbK1/CI0uQWYVTUxysrGeQMA4m0xD799an8jS28nOIYnt7uVEu",
-	"LiuVRuWIbyHMa5EoSRsK9ixhpai5oyoYq3dWKHK1TlSPvQkEKrS8yteDTPqNsYzHVAXFqMNvzhQsBjN+",
-	"u+gfb6N0vHloPaMQ8/Db2S9VY0u/ORm7GY8t+ldnXxpLSrHFmkgg2yJbXnoKWTO2WKIsM29bq05l6R0m",
-	"zWVN+O3hBnpdWOleh70Q4/td0CICib8LSg68WB2Rkmuly23aZW2Y4FqzWaX9TVQIX+X5AsRBaI9PjHT+",
-	"3GEcLzI9z6LrEnnUOllj0yfpVGcvrmtCZOzywTZcNI0iQOwM1wX0tt9WMEDmkB3OHi6IrIK2VpAK5au2",
-	"iG495jKi4/iPuvWxHf8/NTYGDk8Rtm9hHxMkpWa7JXrD3NRBw/ZJfQ/tsrk+FHnzlMsCe48zyI+rLzTE",
-	"j4bfOcmF7Ti5KRzi42qyXrAOwW2VTPJdLJw3mdb/9mmRaTHfOCm23JujTuz18St8ERfFfCZbFgJ9WVxf",
-	"vu4eDdyBHet71ETVpKkvJSSFIKNXEfk6ReSbdKqWU+i+ZQK+Ob9dJVepEr0oQjynXLIabiTDZ5lwH6vA",
-	"T5m+AdJy/NsLAgyL6WUGRmEWgiFOnO5lLCldipjiEIN9wjdSiAwMEn1s0nAf8L7yZkoJV4XA+o183x90",
-	"nY5lphVAGOwRgsq2M8PDleuz4QbSb5gRmmCRSaD3Gcr+l2G0SIYgS4O24VJhDTbcEN3vczTM7MM1U0Ku",
-	"x5a5se+x0YHkluhtGxBbJXrzasqW19jnlbuFC2W9hhuBRxRxLNueYIr80qo4SOrdnLvpilzL3bMFeveE",
-	"xrrraDPwKVvAmCn/m/VGA0jpRDkgtisaJsqYiqub7WZD1hKEob3g2w1MCKS8e1kWEYShw1s6gIoAfqaL",
-	"TYI4G95q6eJZGsu7EBaALuxV5fgTLjYcim4uwMGgafPgqBRv3dTeqWyU9xeDCFp7I2C5pmIzWAosOM1N",
-	"a0zOeqYWaraVCpEWbyr99FlWCpyoU/9Wa9BVxQNhMKFMyEzb67b6Q29o1hI0Mo6M0o2/jd+rLBUGS2+P",
-	"xMrHUirGFgRcK0ph/VROxFiKYnfumco7kl+FHysDe+JjVWKigH671Rl2e4MKBb3RaNDtDDsmBXm6k0HA",
-	"ZUpU2oNJgnx91N5AgpkysoYaX/Vt0qOQOJHPKvT0R4PhsF8hp9Ud9DrDnklNnkVmUPMBiSq4ZXLk6/0N",
-	"1KgUGRP7SPdloq+gnqiHT3cN17jDVQtXjnihHKWRjGbgbPxaxNqriW/ej4mx/lkJ0J1MCjrbjKnMGzKQ",
-	"zFOktseRd2IiqJLsnp4q5dY3XybMlUOhiSO7NG8yrVMSMy8taTvI2HAlL0cke3xQJAjdqaPNOtJYoqoV",
-	"Mp85mkd0koceZc+nGIdQ1Khb661fd7SSX4RSx1FfiE0zUGro+07lu0Wz6W4TV150Ib27waizXjNnFSlR",
-	"nFYvyIWVHTAGCW/mPX7yTkbgZHZ+8vbuc9d7qgF53bX0mbUBYgfFAXpAQQpCZUxqW3KzrbFFQOhzt+6Z",
-	"pb93u4Nuj3e1Fesvl1l2ntXj/fXmPXVUY13NG+T3+Gs98FypmeoZ6rwuG27BfLvg2e1uP9zlDqJNSsd+",
-	"A0nRQLMUiPceg2Fw3r942+6P+l3wdnQ1gG+CEfQ6b3qdbqt31RmA81Hf6/bKxeP1uZPrPQ5G/e5F/3wI",
-	"3vTAoNX1RnDwpjUAvWm73x+NvM55ezAaDc591yyS1Bpm1wxfh2B1hYWBk9cvyjOEdaUh9/r9+b8zzcM3",
-	"QdZ/VfHKy3sLG4/6IG4ifMoeT73HYdeD3XbQG3TgFLZms/YAjGB31Or2Bp1pF3q91nDmB/1hzx/MRp1O",
-	"K+i0RmAKh73ubDD0YCcv623aZqp2tfcIZ5e92WDg986velcXw97Ubw8Hb4ZeMPQ7F8Oh35m1B/1++zIX",
-	"WmYWsvKNPUxpv+F6j12v3Z4OerB0OZouFA7loKvkDLfT6vVHo1Gv12l7o76XDwYfi6dGLhEX5xfdoDVr",
-	"D6bn54NBvw26wbDdvhh6l/3u4O2g1Ru1291Re6aLju+Bifai4sZGQJcVryWvWclxRlK4ZpgKlceH/eFo",
-	"0B5ko1dDsMtVyT3LgDasxxT0+Yrka1wOL7/sCcc27WhK3VZ7ct1+b5f0Kdnd7poaIf/7WCHRGks0jdEf",
-	"KXRQAGOGZgiS7JJ4cySqlpwUaVuP4lHZEHHU8PKRb9prw9W7hbByZWs2j2oZR1U3xd5ubM2vAyzi8y4O",
-	"kC9u8VguhBYoMqpc+r1hMZNfsMS/6IrDPRgIRVViGyvRZDu/pF2SjmCEXBtSUq3/0UKxv3Ab7vn5b2/4",
-	"H/AA55j5C26+necVbC5kwZoLGAdX57+4DfdS1GO6NArUaL/Km49jt+G+NWrU/IzmixDNF3w1fPf/fkf3",
-	"vPP/i5ZIlCZxG+57GPPd93sUYPFHVrJ5jz6+Ob/hHzC+pzfSl/5B7BB468zz8RECMtYFPz7iVPT1SwLj",
-	"sejml7z8zTUgYE5AslCf1enMNQ5XESD3oszXzXjM18EbgOKpoHF8/tsH/ocBMpeFYX6NkXpTOsP+A4n1",
-	"fKlYSqSwoW9mo7JTZZEYB1CXFJG78tOiS+FpnTT8rnwTxrmBqkxkjrxRu0iXKoJxEAAscjHFQPiyIJch",
-	"ANr5JQdzYQw6+mMpB/0eLRFVgx7KdqEcdF2+SPwl4sgM31MiBz3KBj1zUUU4wvdAFTnKyr3EavS1VOME",
-	"xlT0axZBMqQgyaUgMaWAZMPP+Z6XSaK5FKSZFEgf5p87SIEajQPJQI07ZfWVWnEgHknXiV3nQdXGUcl4",
-	"4qonX7zgBMLZQvXqJdGqbqP5NqA9CRAF0xAGux05NEQv4mAKErprHyqedr8nHtldeJU76+QddfrOlypj",
-	"fBzANVwXb/Ln9oUkgpRmp0v2l1WTmicNlTur6xktecWlnygE/iIA0eKnlsf/N5Nwk1OncO/h1+DQsd3e",
-	"XdeZk/XxEm+O9Tbpr8OT84Jr9xuGj0R7++W9zvjl96sfskbvlrMndwV94d1neWL9BXeeNkF52a7zufly",
-	"0E3obrMu346aMy87VBNzL9un7srVvei2F19XfxC1dKTtqVWpfSVb0xcrzlLphVyq9YxR81owTA5ndvJV",
-	"ZELDzT9peTEH6W4bLW3e87abzOTreuXe1vMgQNL+drTZsH4hNo8LjBzCzIOemVCnKYWEnmbGlauzCt0x",
-	"jqDD4CPfz+icpHVWWDk7r9Xq9Hpeq9dt91ter9Xqevkp90/v03tA//wZExxA/x79lOCwmR/P53lxVsTz",
-	"xgrzUm/P4b8BuJ2M7qjf8TqD3tAbjQYGGX9LfLfh/g1P0wAv5V2OphO60+63R8PesJR7tzUdp/KYHNLT",
-	"ChJdtwqrMxj2WltZEpv9OR/ymIiL7Ur4Zuf7+/dJ5JEFO+xFM4KrLgsj8GNal8g9b7lfSCLcuK2ZQW4d",
-	"SNOC72jEff6btjNGFlp1RVAPua5ZLpAv7yIWIKiTAGQPhctrilk6FM+ywDpoD3/LTrfWRLnwh05CoI+o",
-	"uLo5603139zyIhLNVFtUaY2wUnnr7Loo0rYZRSr63U8Y6do4Uv7IdOYZV9JWGKrXHjNmQ16ALq+Ytfgb",
-	"1vR0mweBaGM3ho/MoVAMN4E0DRmt6Ung6N3yNfsazC0xODn6SJ466Cuxy5ZMwl+v0sI2dH27rhsHPAAU",
-	"gmkI64rZBznuN+p2+eq+zk/SifCVmrvpWYgByyFJbOSuid5PaAJ8OEHxZD61V2daF3MUwQiTVf7m8/Dq",
-	"JIBrkpWht8k5qELESu7A6cqRXKqOWiUSrtZWtoBR7tXbGOF2CAAqeq1SrU8o/onkxtYwS07Y9WXSVNBj",
-	"ze7lAqg7t9z2vK0EXClD2Qw9zuK8yyHINmZU3cAlJc0NQP0idSKwcmLM5LX0hDk/395etxtCJ7EFEiqb",
-	"r6/8r+pTNhEOEjOaT998np9V5tEscRqGFt9yETHeraOeqsvx+YItg9XVvdgSH46XpuAZPCKQfJJje5eZ",
-	"MEWklLO6Out+vXmv1821wFTsRclG0jE82qpV0b7NGEpJMEOA3arEffpsdZtIEfuBOihoOFFKGeeQ9KM0",
-	"xIKiRMb54ZMyre5OPsm+736oh3g5PLnhomRGJ3PA4BKsNnpC312/HTu6IR+tGWT+QmYg8E0nRz1kiOOZ",
-	"teIyiCJROV9u2OTygULEVlmfYhZsokLqjfLwGg6d6hCrh85yAQk0DlccrnHECb0wh+tzTuU7gAgyJes+",
-	"jv2UEBirookTLdFrMMtW1PxF5+b6sjQRoHMuAWpmNp0raQCdOa3NMyOV6UsK8dZTZnRNiLH65uut1+zZ",
-	"FtRueZ30mr2npzxcu0zcRxDB4klWPQZrkXy6qwbNv6rDA6nDf+OUOHoT7KCYMhD7MFNCMtEJcWY8IOAA",
-	"R3oMuAZtqF0RfEwwhdRZ8Z5C7IMw7wZLx5ngt5L7FU4dCkPos6bzb5w6PoidlHIOkAfkQ+qE6B468Zzg",
-	"e+fvWs2Kr3x/+Y8d9XSAI4DipuwHJEmurKtJEaa21sz/yzEud2s3vvSSVcpPObriN1lR1Pw7qdVW26ZX",
-	"G672qVgy5wwpYAvABFUaqzy+QMq4s0RhKNZsGDs4bjq3XInQBU7DQAhmnO/hMmYJ2DXWFKVRCAzBapKS",
-	"cCLuC6sg/15ZDqKdPEnlZgMOQ7wUYRAE+hA9QH3UTh3lvN6AiM0WOOCiJGVOLklq1/K6Fh1yLboZj39O",
-	"p7km/PXmfa0R0wIhlHt2a1t91b4XPL647pQMyDTncwqrbdVYXlVhtXvH18M6O7CshUElWbuYnl3az4hn",
-	"zj0Ume+ypVyC5Yhvlmy7tB1D/1iSImsEHBRdUTV8FmuyAb+Ar2aH5MkcSuXWk+fIrw/SErVeWrLqHnd8",
-	"xAG05Kv7+AGSLHat5A5UK4qq/67CUic6ZjX7bt61qX4TknYnLj81+xABppM8vlNdeTnR4Ztld2HhZW2k",
-	"qM5zz1+hlZzYuo24toD3h2XiTG8WzIJhqwOCtgdG3hR4o0E7CDqeB/yRN2j57VF/Ouy1ROaxj0kgAISA",
-	"Mr7qETaFgMlDw8Gw1+8PRDO+Wc+36Z/cYijo6fbpOv+UR9CTEEWI/dj7b+p57X4W0vMjTpn8ST7v9NxG",
-	"GRaQYQq0+sR7fNMfTkFw9abduxp2hiMAu9NRf9Rvn1/22v2rVqsz61yAy9HVP2X3bU8CU7r3R52IJH9l",
-	"YP5jMYuoAq87anmd6cjrX8367fOLwcDrdIdXl63OdNga9PujQeei3/dbrQweF5iMnUsgmM/X1gT7C/es",
-	"0x3oweQ8ylq4bW9oSYCT4Q2QsoniyJkrLthU5YGM9z0ZZ6Kh9O1QOt3edlBaXncdmLuGS0NAFzCY6Cua",
-	"BHQ3TUQ907NWrz3odxvuAyRULT8+jsSRmjtodQc+9F2dw/XQarabniW/3ZzSz/j7i4+NeVSnFm9hjm//",
-	"ok1vFib/HpCzwbAeF+ypdxu8XP9YY3fVUwc+RNkhsVri42LAsVmrOVNN2ywmN6q5IWjrz+hkCxMFB8UO",
-	"hT6OA2oPVjKkdRt0flPNN6+carnS5xwvsFb0wrcR3nXBCiwd7Cdo9+o8DYtJuU0vpapBTw1X+oOngPmL",
-	"CUV/wl3x0X5lH6KE0b11mF15tXsX21X1XtfHRtf5Lh3ukUVV58Z+sx7MuKR6sXPr8hJ3jhi9M9GqRBcf",
-	"rv6hKNVphDHb8KgfYrhDif4st+BzIQpKhx02sxb7utc6y4N4emHc43rxKm1HDjyK2a7nrv4+x4wfPjCa",
-	"IkT5JSiqIN9DFQQVqaolKZS9NW9lFO9e5E+KXV0m3IzHL0xq+uQul8smmPrNGLImSDcWPRTgvo5UJoPy",
-	"HZKYbsbjl6Qv3YzH32/iEjfta2YtFQfjcGH3uo7qxlVcCelXkqqUT5m/YJJSUS5e05NC02leOzmpyM0X",
-	"6qyvJiEpVzdHykYqKatvOg/JVIhfRyKSubIe4RaRm/F473lK0mGqKoq9iSLg/L6KY2iB89/U8zp+gB7E",
-	"Byi/Ltry27vYoQtM2Jn8+VT/Lr8m8stH5C9giB5QPHf8lDIcQeJcojhYOTfIXwASUBw7FKyoQxcizwE/",
-	"QLJcwDDSpYV9TBltOCj2wzTg/fDNe8OJMGFzMIfyVF8c7wXy/FpoPRzPnYDvp6nDBySEIlh9AYmzwCmF",
-	"TYV0YsF5LOsROyBJQiQ5qg7t+Os0TSABcZzKhA8cO4KKBUqcOcFpHFBnmjJReIdALj0wsALT7Pp9AdgP",
-	"VATv/3MTJ38/d8Rtj8SHzgcUI8ogccYpdN6EISQrzjo+WUF4L2euLBOJowTEKwdMccocAikOH3TQvmCR",
-	"5g2ONbci3l4PFXUAgc4M+CieW6k4zYUjds3bMt221+6eeIMTr33rdc9anbPOsOl53n/cLLLdlXIgSxQl",
-	"CaaS11O+ZgksuT3txHDpLHAEnenKifGy6byLKYMg4PLyA1W4OVMQ35M0Yf7KfcZ22pycpU5kn8lVIpTu",
-	"PxWLyOWn9hYpd/Na6lfLh1yrCydtnj5T3Z2Uz6+sFiSgzMna6G7tq0LlzGsNgjBmediH0WNmhtreEs31",
-	"ZJekJai5zW6ifHa0ESvRxorUdi51cc2A7cLz0gGPDQnVxpFtLLzeJiEkv7rLRiV/ZnZcOnGXh13WciX8",
-	"kYyz4n3YBUCfWmzmdXa2YXC70qt5qlE8SdskHrLNMx1WzuCsYyEbbYXjpuEYryvjdqMy2cwEtyngGpEv",
-	"j/ItVda5WsNNKQ21oo9lKwPqbZbO9hxIvTGhIvlOLH5g/nf6j7qSX64T97KohFvTcrbYr/oxH5blApZu",
-	"m8C+cPjbhLSo1VN5GacJeJXA3cMylGvtpdT/lh+cVWmfI+aokzWRh7p+Nusj4nW9yOcb1Ey2M7K/z8B8",
-	"Rx2VZw+DeLXroYTqpPaRhH7vroJQnkRnD0Ta9ZQH7VxOq5I3s0sntoC8bfopZ1PWL7JZxqQYU7fVzMrf",
-	"KATA7feYzKheX9jhle6cf3GF0boejoJ7Q11wUCc2LV8YdH7zVl4I3rY2t7PpaN77sCEd9d2VTWlsO5sl",
-	"tGuC5yK3vrqRVWs4Sy2F756Rg6zXiiIoXBKy3i/n6xT8CkjDVipdfLG2NxgIOuBz/RVvxLAbSbzFNr09",
-	"P1fGmTSuHeD8Sorqpusdp0zusvR9Hb/GCwhCtni2bIRCe6xNo73sxbbfh3FWQD8liK3GvAcpGVMICCTn",
-	"KRPms+ha2Gvi55zDC8YSIeUuUsqmZKGNxx3nI9/cnF+/a8i0xz9SvtnPMnASgh9QII2bEPkwljfAKVPw",
-	"w7tbV0W+Z3lJouCmTPXGZH6qXqKnvG2ede5qsK4RueO2ml7TU6Z9DBLknrmdptfsyHshFoL2dbGGfByw",
-	"LWUjt0MdEUJRKvNQvmkqy/jMbVQNQ7u8ixez0BVlMCpmMD0AgnBKFTfzhUgki6CQQSLcSrpCg+hVlVQw",
-	"PVEiJpHq1ClrlQrejfI2NnJLlTZKRn3Dyc47GuKdCBNhR2VRhu8C98y9xpTZiiHTPMbwQpTmsevNvAmC",
-	"5rfV5IJz/l/Q3vl5Ro5ULapYBYfS9rz1wFS7/JP17gUE6Y1qwLvv1uvxAgQ3kg7+cq/ey+/4TI5B+Eal",
-	"geaTWSz45jT+JPJxaBpFgKzcM1cwzPkXZI7mkmOwKa9hVKBYxhqXZoh5tnuoGRLlhTv+ajPECKTZ/wzR",
-	"nb/OkM0zJBvsi5VjDMj2k0Sv1Z/VhydOhAoDXDtZzPNu7eAgOCp5OIpzQfWvkhizTEcQhngpsnbMCWD4",
-	"albZjDHmCk39hQOonAWGcFdle71Al0XuY3bqZe6f1myZ8ybGxwlfq7PLsNfZ2PZXhTqQqQc7vWnmLuzU",
-	"gaqHtMurFMU+nDDjVLV+H2nMUPjCPmh21lz/3cAIbqj/NlOn07Xf00FidV/UW9xJqe753detJLtet87L",
-	"HzF7i9M4OK5+5ZpVKYNdTQ89PKef9aeD6VUN4OtVrMaB3ss06zqhf1W0r4r2UIo2NoKhXzXrHjSr1ga7",
-	"qlb2ePoZBVuoU12Br3r0lp1gSefoZu11+/ju6sWaS0OcoODLKB3VQQLm8CCivPrrCbKmnK+kQkS2l+HP",
-	"yrG2XoqLa7kux0rNA1ibhwJUnXffv2dijXdtD5NW9PNqbfxlrY2XbOVft4Tfnb6X+nRruyVLp/8iZzX5",
-	"3fXfmxe6fCvI3s5oKh3vz/tcvgzp+z6byaitzIQDuptzgf8aXc0ZS17dzK/2yLfnZjbu+rj7ejXh9+Fe",
-	"Xq89D+pWzvXnV+lSrta62Js72bzI5lWZvirTr9yV/KpBn3Ujr1ehR3AfZ8BfXcfPie6r23gbmf0S7uLv",
-	"031g2eTvzU38akW8uohft3OvruHnbBElMfTUz/LgNlsixYvlZL6EVIzGC9Sq7HQFbJVy97KhLlQT/yq9",
-	"l5WBsbLOGBuRiyaHRadL0+cHRKTFq6wnZSbauY8D+IvqVkCy89/HMYMy1YnBR8Z3+SjO8luASrThZOuV",
-	"HolaaXkWi6rDH3Cbwnv85J2MwMns/OTt3eeu92TP/yrXtreR9FUPrcBYc9dR7N00rqeZJZ1llm0xzIUs",
-	"q42jrQ27S2VVPDPUogiKLC97+j+KywNeSjFemwpnM5oKxWla7W7bG7X73tCWziZqU6RJoKs+r6lMIYoS",
-	"F5K/pcJbAuqotwultvgvJ6LW8RYJy1VpPC9C0fZsoI2+WRqGq29BPjNCtFBsFFCdrLY/7bNB62y/qspu",
-	"vg2FL7ie5Sxu5Lac2XSSZ/zuVR3IBE6a4fKSMcgTp7+hUZBIbxwMQunpZ76JquOPqXqub8Zjh/diHYeb",
-	"8fjFWzyicp9fMoZ5dcm/pInMx8h0fVwDtjCEgo+SvBiHQvKgh6l0BZm4Vy+ADzDESQRj5si2hUzcs9NT",
-	"cf/eAlN2NpS3Pigga0VLhsdJ8cIzgWpxMVVZv6JmXGPbbooBd9YOryqRZdt1nTtnrN2+NXZ3T/8/AAD/",
-	"/5e+BJ6WJgEA",
+	"H4sIAAAAAAAC/+x9e3fbNvLoV8HR3rPdPceWqbeU03Zrx0mbe5M013Lbu5ufjwqRkIQ1CbAAaFvN8Xe/",
+	"By8SpEi9pTipkz8siSDmgcFgMJgZfKr5NIopQUTw2gvwqRZDBiMkECt+HcVQzEbQ92lCxChAPiKCwRD/",
+	"iQLVMkDcZzgWmJLaC1C7QoJhdIcA9AW+wwIjDiaMRkDMEOAx8vEEowCY/urgPI4RCcDvdcb57yfg9zoU",
+	"NPodUAZ+r/+XU/I7EFS9a96QX5mFIWY5OJADSMDVcAiade8EnAsayY7+9/Dn9+A1QkG9dgJq6AFGcYgk",
+	"rt5D0A9gdzxpdgfdNpwMgh5CwQB5LdRptRudoNWDcND12h35Ilb0SW7IbwRGqg+DlvyJoT8SzBRbBEvQ",
+	"Cahxf4YiqNj0vxiayPZ/O8sYf6afp39Hly53z03Pj48noHI8JihADIoveyy0yL1NbiH/8yfKaID8W6x+",
+	"u4Nhotj8Q+HpDzEN65z6GIY1yR+OoD8LYDQrvJb+/kPDk//rcagZeoTRfG3HZsVIKp7NR3j5GAZIQBxy",
+	"MKFsYfx0D+DN5YKA99seajeDTq+FxqgxmTR7cIDag0a702uN28jrNPoTP+j2O35vMmi1GkGrMYBj1O+0",
+	"J72+h1rVgo+DlVwS81g15YJhMi0nnyBxT9ntDuJreihObiRmiKEkqqbAgt7LYH9gVFCfhu9Np6XExiEU",
+	"E8qivalR22G9mkrb5AAa6oPtejmx+9BRRyc0nbzLiWScbzlrpWq0JDhiC8f+EhLN911m3R8JYnOldSgZ",
+	"hTjCooyAocJzrnAmSTRGDNAJ0G9xcI/FDBNX5c/dtaA4FRteRpICn1e5DiZbDNS5ev+tel2S+nBKYYxP",
+	"fRqgKSKn6EEweCrg1I7TBCahyLDS+JTgcQdDHEChcIww+a5xEsGH75recobGcIpW8TOGU0ygfKLEwjA1",
+	"z7PGSoYpSFvz64N8ezN2lXHLIrHArAou+QnjlK1ikG4FEo4CxaGMYfUlgmT63oIlL/Wbq9mRkm9glRMZ",
+	"YIZ8Q9da+m4MJaWUgPTNZXRm3e+wTF2mnWxAdQa5nPAttInhwBLl0VymPLbWGlvpi7zCWKEpZPNyNu3N",
+	"5PkH/+cyQXHsm03ZY6wYvol0kArLRxN9MNNnBRNcq8DhAhYo4jubPScgXW0hY1ABfjid0lP56ym/xfEp",
+	"VXTB8DSmmAjEzJK9AV/jKgOkwNh9mllHYWqJifUUGMox8dFI4AhxAaN4XW5yAZnAZGrZijlI+1jGyyK4",
+	"LWbrdfryBlwowq1gRuL7iPN1mZCuZOY9yRWR8KX0Gwhb0D00rz5aKbF9DhXU2gbMsD2VMkHA6dpzasHO",
+	"F3C6YjbJ7rcZdUnFBgMuwVTQp6fctgTOY7SKQglgGxLnMdqIxtMKChMicLj5nE5i7fFady4XwRxrLhfh",
+	"Pj4+ml0i4uKCBti42LJf5qMLKPzZj0iU+Rz5ecoD9Z5PiUBEm3YwjkPsK2v8TDoF1Y8OlTGjMWLpq8aj",
+	"xndedVMn2m5rxMLGd6tNprOP2XSHkd8d7GC0b0hChrxrf25uDaar516sDDWZnoAtVrbobzFf3eVy4yXM",
+	"LjObaf9UeWdshGH4s+zh44byJdWt7PNTxjjXkXSzI4/LlPCmPHZRoOP/Il8YZbcgiUrTgTEN5mrNGkt1",
+	"ZzeZ0kwrrGlREgoch+nZBi/xs+WVaoUKfYcEDKCAzyr0YCp0a80XmbHZaZbYAa493iwK48o123UWpOL1",
+	"WFTL2+KWnj7sF7Wczt9RST8lTbu1ooTTfbM4VeO7KW8XKaO3d0DqSWvszJ6w0/rEOsYAJGrjpf/OYwQw",
+	"Bylp6yv24gnuE7GLFw+Wn23iv7ZNvHdX2rM9/GwPr7aHtd7kMSU89TCYb6MLGBigG6vKtZjwijF1AldC",
+	"7PUMAaPOpeKPYCinBAoAZUDiATHhABO17oHMV1Sv5egp2s6WPVemwcZklWKp+wJcsMQXCUOK+RCEmIv8",
+	"GZnyOxUWkdSWLem6oofd9g/6rL9EuVjrev2epRn90jlGdWTroybsZk3Zdof7HnLre54k4TojOt96PKsG",
+	"Y0fGbs7GaypgKE/0+foKYX2mOdbGX3IKFOn/csV/gZLPI/rlDH1qYv9GrtYEhnqdOfYSdk4ANggAjtgd",
+	"YgDJ1oD6fsIYCsD9DIcIxIxK/OUCLjLSirQYY/UlJRM83XrQN7GLNahL5SbZbRze0wC9IRN6YLQNlP1g",
+	"LF7ThASfxe7JdhQMcZowHylsCRVgIpEqIns1HG6r17MgnVQDfPxUs+Fs6ovc6EjM7u/v63Ds1wkSdZjU",
+	"FlxxiZhRZt6xh2mvogiC3+aEGLdGgdhvA3z3/bez5vdvCOAzysSLb89mze+/jb9/j/0ZCrGyav2ECxoh",
+	"Bl5iEszBFfZnkAWcEsDhnAM+U64BeofY/QyF0kaUsY3Ap1zwE4CJHyaB7IchIk5ARJmYwilSfgUGBeIg",
+	"SJCNig8pmYIAhbJjOYAhUvEAM8TAjCYc1b89iyV+wxkCM8iBYioK5OtQn5bLpjyJEYOEJIrbgBKgMJ7h",
+	"GEyZHEEOxokWQYakokOB7njW/P63GRTfcEDQg/iX5cZv5+AljSIkJeEdJpgLxMAwQeBVGCI2l+RjAgQM",
+	"b3VcpyJFog/JHMAxTYSSpPDO6hhFpqWPEktxJNtbdnMA5ToOfUymGrszOVz/o2LW4mQ8sv6mptdsn3q9",
+	"U6957bVfNFovWv2653n/ke0EFkq6anrs9KSLY8o1z8ZygVdYYQIgIOgezGiEwHgOCL2vgzeECwQDOcbf",
+	"cIMLGENyy5JY+PPixr12NRz+lIz1NieUy9MoYaGSydpMiJi/ODvLi/EZQff8LKPgjGSCdypROR0nOJTi",
+	"c6p5dOrDhKuHZkTPGl7ba/f6Da92k254a0xHQwhaPnPsMjdB0k13U3Cf1Gii/JDVM0/lGRyDJAmLBOih",
+	"9gJ4OReLpHCmWU3vCWLleGa+gwkMOVrkj7NxbvQag/6g0+wPljFO2gujTD81Fpn5eALWMXMOZmtm2vjL",
+	"tTJ/o+wWsZ2W7J3My5f2kURhT5ZhJhcao6vhsHVqTJ3UXXT6Ix0xNOGjGYIBH0UQkxGNEYExHr1DAS6l",
+	"DQYBsx6yooNJDjeOUBafUxbaX6TtBGyDnbMYU4LW8pZtDMUu+aOXNAylwhqH6DyOGb3TSVxHgZekzuJj",
+	"gLtI2NFgvcNEHAvWNYMBOiIwwieIHRTeqwd/BskUvcV/JDgw+vfw0CgkRwE0FPDW6JTDw7qH8UEB/Sjt",
+	"dQKJjz4wGlMOwyOB+5WKw0q9VMR3iPHDK44U0sHVRgrp8ErDBXV4lXE1HB60/6FKb1abNyKOAOkShUig",
+	"IwB6i2+PAebgkq3BfKD8KGAYneAQHQfSw/wIcK7QHeboKIDuIQuOAGg4g+ywBCnFprewRzGdHXgXDAfT",
+	"o1F38AXQgXVwReHAylammz1t3q7pLSJbbC0D5OMIhvnnmAg01etmzRSkkP4xKMzDbtt1Qbmtjdu2FFQM",
+	"GUfBCEdwiqRXraodF5AEcqq+2PtYIJJEo6HtXwGbR2NaiUrCcIF6+csJKGtry42sx6t9bdqvN40Y3BiC",
+	"xDK16g8xQRQAZ3IcDMYwrRRzkO6dzerBYPxCbgm9PxyLUtv5YBCuhsMsouoA/Wf7tNI42K0mmVIbJf6q",
+	"8yxcUTZR/lSoniF9gnBHb1HtYFhoL1YZEj5DUCgcxrpCzwQTFWQiP/uSOaH8lMSBaWbioKA4HLpq41eG",
+	"7DhRIXMchQopOpELo/pBnWFktKRE7BXFy1zYaYoVVqdU5hyFo3BSq4qqdoICbR2Uh/qlW58hbXOKo5gy",
+	"7f52K3mod2onprbLC1CbYjFLxnWfRmeM89apOTY5iy1VU2rmyRlMjwq258GC36tUsgMlSmPKGL2Xn3w9",
+	"uFrUo1ToYx0CKU/pQvVJHigGDN7vd9xc79nyOZCixNAEa9WgooQlraYJR3uXq4LTrQxFLuCtAp+Q9KMf",
+	"QhztF5O866oMkYkumQKnEBOuBhSOuYCY7BeRvBNoiS6Q52ipTtgrCu+dEO8UamJWVUk3G2PBdMEwyO4R",
+	"1FIN76CanzK1Xf3VcnTKI8jEqT+DWl2MsfCp/ugzyvnY6DS3DNkEMh9yoXXclFCOuSaZICg/RJALGmjF",
+	"QRBUzWgscIS5ej+m4XyqH2cnqBzeqYd3PNQKJ4RzDWEcJojfztfRXraG2vusZEip5tpCSeXKgmxptrsO",
+	"kFUTnqB7Pa/uGYzzS536Za8y5ThMKiwCiomrJvcL3d0upUB/yUT61dXL06ZnPzW6Hfux12ykvzY66c+N",
+	"QbcnP79/9eG00W7sF9trE85fNvd8x4BW5X/MnkPOk8yqM8ff2ko9SQMBTBlIiauzlbgplAN0ulwxGQSc",
+	"1q/hdJ+TQNVe2Cg5YEseL7qHPoOluuA0KsMhQDHlWBzESlh60nzQLbN2xjyelHhj1kxw2vcWZTHuw5kg",
+	"RT/Ezf7YnWT0HoPbB+ZpbsNVNrw+5eIAg2sofDzoWClf67EG6nBkKDful0+GPib9OuaNa+1/bbMm9eR/",
+	"oSK3GPHy4tirVpXzQQ2cJHyj9OjteVuMs6xaMjO09nV4Ux4R9PkGIvOoSHJgpNLLD8l5aflDgRgMDwdm",
+	"yXA64A82pDb26rONat4PJYmKEcM0KMMIkeKpn9w9nwocoYrTLy4gE5u8szAcuge5XyNB1TCI9JTz2CKi",
+	"IR9OOmS0XMlAmAD+A+o8etyVdydulYT8lfBMZrdXHegiEozGIfVvqxrgoOpJiEnlW3oLX1ynShouhvIr",
+	"sV+C04JM4qB2czjGquDGo+uoUg+1Xhjs0lMWbcAQtHkDZbw+kLJYGoF6fBWSjxb9Qs3QfCDql07EcTdv",
+	"R52ppUc4eqZ+YZu4xTDhL1TsZARymcZO02ndNanQqDJeq2LtXly/8glU5YFfWarnJialzfw8XMZTRZD1",
+	"QeWgMm54z1KRi+b+iihSYeNfET2HX/COS88Huw6UqqNlcZ/L7HaTNzqyAZklTWaQBGFl8GmUpl4eyo+k",
+	"kzvLlWSsT61H1buLOBnbZNglrZiOYT/khpAnUQRZ5UCk9SI33OYIyKZIHNIaz0u3AbhM3vI119YMcD76",
+	"omQzPo6+JyuLt5AErQgoH2Na9Qg9xJjNC9xeZQUsn9Y2mLxSK9yiSlFeGqu+csJm4d7HE4SH+WcUgwfX",
+	"Lx87UnmshCcJ9GE+WiZ/B2K9yVH6ilbpq2wh+Uoo0llXXwVBZeldR5/31UFEjyraKmE+Gq1dMHe3YE1n",
+	"PT8mxMMfNuxVVy1m6X1OocnFfD2LzNMXmS/Ye1zM4vzyyfhCfZILeXAlAac6SHcsxU2VNtKR0UK5zPUH",
+	"TbncaiSLQYq7RITmUxlzuIVp/Is+k7s1McH8XsePhxTuGQ8nUS2HSWzPkuQmgwq0X6hZgl8h72LVcOwV",
+	"C5sEmMNBVV/bKxiT8JmD4hsnr3JdK+eoQ3ZMdd6J3d7YTYeJkcYm4lwbz1I6lNGpDodv9zxQbkJs9SzS",
+	"63z5fDrAuNmsgtII/gpIZTdF5C/VbXgnYP3Lgte4erxwi7bsPoIPOFIINz3NJvO1UeLo2fwC8eX3hJfc",
+	"ul3gwH5RWnIZd4pKImaICONyrLibBHE+sg6UNavuqYK/6rr7SnMlbQzDsKqY4hWKGeIShhpkH4YhkG0B",
+	"5CrRQ9garZCkElBWGHySEMXz0QzyWVXVRvlMSpeEZF9QIFFQr/BKmVoGtnVVz7pZ1imBEarqksH7qm4Y",
+	"vFf4SA7Uq4KplnE6VwxxoWpv4S7mj590xc6RDb5oNHptW8YTBSMutFg1vXaj2/f6+aqebqqbW1j11+Fb",
+	"mwElULGPgV50RcL14MNAqVwj3B8XUnruVXXJ2os04U3/oN11KQqnPrWFWPIENRutfrvTK6GpMxj02q1+",
+	"K0+Tk3LnkvQyYXeohCjdyaC5gqhcMlIlgb4FkiPRYHSqn5aQ2B30+v1uCYWNdq/T6nfyBDqZji6B7zBj",
+	"lJVQqDvpriDQpGTl6YnSPnMEGQxOzWOVuu/eZf3x0wIlhXKybt6kS4T7e4EMbysCnP5yJNgHRuxuTG7a",
+	"C7ASdZPC5mLt5O9tiLTsLI+xSRN9fCy5OGHVPexS/eQaAd1v7k7nzcrbZqVhK86Klt5hLhFKGxwBGca3",
+	"7G2lTnaWyrJyt6vjKjAfOaFmWZMxpSGCZNX5R/VRlnuB0sZHH/lgRQfFk+wKaN1Dweq7WcGw7GaxJbai",
+	"vn8MJGrZpQzEcIoJTEPwqs3T0jsyK+RP1au2FkPe/IBCIOVIqXkPH73TATydnJ++vvnU9h43Q6DKrHAs",
+	"I0gAJgG+w0ECQ2MbW9N4lV20VlzxyvtDc/X7t7w/c883SxbKti/w7zwt0v3L1VsOTHNbvV8zsaBI1qn+",
+	"VHbiPd2cLddwunZY9rq3u25139kK7VV+cVHRsiy9BcJ7CPrBeffidbM76Lbh68FlD70KBshrveq02o3O",
+	"ZasHzwddr91ZvCEiPfqreQ+9Qbd90T3vw1cd2Gu0vQHqvWr0YGfc7HYHA6913uwNBr1zv5avctboO1e1",
+	"fwjh/JJqE8wpO+akzKf1wWof3p7/u+Zov1rDK/1Xe1x2eYCyTbkPSR3TM/Fw5j302x5qN4NOr4XGqDGZ",
+	"NHtwgNqDRrvTa43byOs0+hM/6PY7fm8yaLUaQasxgGPU77Qnvb6HWu6lAa4ZaaWo5j2gycvOpNfzO+eX",
+	"ncuLfmfsN/u9V30v6Puti37fb02avW63+dKVbZErUee7e7XipqrmPbS9ZnPc66CFixzTmwiQEQmbTFRr",
+	"NTrdwWDQ6bSa3qDrOcOkRunRub7Ae7g4v2gHjUmzNz4/7/W6TdgO+s3mRd972W33XvcanUGz2R40J9nV",
+	"BntgbPXVBe4mJ728YDPBzi42ECxB1UOYv9+g3+0Pes2eM7abTIOFuw+80gFfXC6yV1Zee1DhntnLtXOU",
+	"VOjanHiu56mwb+z15lEr5WvegIVqjpTvqu1xpbmcEPxHggAOEBF4ghFTltHC6JRZmEb8S7tVD4tWEDDj",
+	"LkWiXlklctN7Vhdutnbm3YYWWgmVe73Y2rnrtIDXGxJgHwrEwf1MKZA844rXTZyAUoN+N7Ni16tc92OX",
+	"FNRQ6QiqRuu5fivlbBcDKPWuj6xirERVtwNpO4nu/Qz7s8LM0L5Mcx+XmYH6jHob7agP2N0a52W7V22H",
+	"VGH+5pIXJglGpTaxJOBEiucMQE0GVRLMcYDU6BSZUKBotcW8icH5wZ2wZSWPGpj4M/nh/PzXV+ovvENT",
+	"KvyZst/PEaMBozrm8Nyp+HVhyntdIBJcnv+sPoaJ8la91DXvXrplvVIH4KvzofqDp4i8tZW3Xr1XP752",
+	"6339+O7/qT/q7E+7wX7C01mIpzNlJr35v7/hW4Xj/8H3WJV2kl/eIqI8RG9xQPVfUyzsLX7/6vxKfaL0",
+	"ll+Z46h3ahuqXsl8eO8RZMO0StJ7mug+f44RGerefnbKjH2AxIe3OjFVfWVwymA8s1/swegHGs4jyG51",
+	"sUZ5rKT+QkzGmmNDOFE4Dc9/faf+SpE3tbh+Idj28ysKszExzuL/IFZx/Fus1pRzUdVT+di2eBOhAbJV",
+	"m+6VT+csB6H2WCmbv1m3m3tkZ6vI5aTQLTWX1pVDJAggNYXb1LApb6L6e79QVy51DiOoxhJJAUxLvxmh",
+	"yRecix6yelpaLmauAOI/7o0A3uJ7zK0Ahqav0AhgWq1OfVDdhFIAmRHAKBPAzOEb0YjeQlvXLivYRawo",
+	"ptOWxohwDSBX+s4VwtgRwjgnhCwTPjmq8i83Qmjr5HFHCJNMCM1pwp9byp0Z+8NJ3Wa3t9u7IkmgHmqP",
+	"Y9VSgEwroHvk6nZDX70CAuWlTJcKjVuZW0nucpujAHM4DlGw7bnhib6YTp1C22V3u25s9P/+Dy+zC2EX",
+	"72zVd7Tau9DK+CTPcCsHQr0tW1TaNRHiHE5X9GAabXNe6NzNu6Uf1Km59wNH0J8FMJr90PDk/3ocrnCF",
+	"5u4Gfjpu0AW2bOcCTbvZ0Qea9vO0/Z/rRq9V7Flcj6I9kHu82XQvUjp0620Pdqs1v/5Mc3yoT8L7UpyE",
+	"f3nPS6kM7ex1WWdyHcENs+0sdTwyuZmanpLruZo5a7Zn9b40pOuiWauboXnhOFrtmC6acrX4tNwzu+vf",
+	"YvkcR/TTyWW1gWagGWjnxDrPlBNQcz5agcqN3s2aij936+q2UpVZEiV3pJ8HAdabBGCNlSXLfu78zs3Z",
+	"To+wUivuLOGI8bPUvqtlSdy1IY0QEOhB1NwczkpjcCH9udFodTpeo9Nudhtep9Foe26Myw9vk1vI//yJ",
+	"Mhog/xb/ENOw7kTrONnG5TRk7Q0RhQ7XIWUJDlUUtQfdltfqdfreYNDLUfS3WJ3Q/I2Ok4DemxuZc2c/",
+	"rWa3Oeh3+gvJzWsTdabDZBA/W8ClXSuD1+r1O411LZiVTrl3TuDUhalKL3/GnMsoFelLChGjkdr2f8AP",
+	"KJwg4/2KIv0cIXadjNE6O/M0Rugg3qC09y335CnXytxFboTZBuX7M4r374fYnV60Yhc3QdKU0daQ3MBJ",
+	"Y2nF7s3NBC5ZosxjIKjjjVdgOIghroztdepdlvWqnqbhwqgynjc7zK4KqpOPQcyQj7mkOOvSAKlvcnOY",
+	"5XR5gP1GEfbmGKAyoL6ZD6jXve8zon5JSL18mHO+Opfbl3DaLoxuLBgKUt8ML3XOVPZ2nQWYWVueoAcB",
+	"OFICwRBPQsG38bhIPK+liSGTA/hSSrA+RsRkqhAoGmKx7KCMLLG0++uqrgC8gziU/rytxPGdFosrpI+9",
+	"yra3fpyMlJM772uYhBQKF6RGzGwb+e2Ix9BHI0xG03FlhcAl4Y8RiiibO++vB3rjAiCWE8aEXe6FNfGs",
+	"Bb/reG5ODcvGdTGEd8ONfg4xx3m6KjD3YGBsyO1iUVq1wIw0dzYAXfCAL6/zqYdgcyB60bUgyoRhEwG5",
+	"tFuCXKZGli2zkLJRyp0Sf3xxFaBR5vvnIIJzQKgA8vIuygT46fr6Q/NEKTgxw2pNkEu7/Gt61U20gykX",
+	"jzyBIUeFIAY3fo4kYVjq6i9gKPsH5rmEO0aAIyJ0+o+Eh7jQiEkELSlrIBTB+KMe95vMoipiZ08QSubq",
+	"L1dv7Zq9BKqN7lqw3Syk1Hw3KRB1goys5DIjamWi+fGTbrSAnxbGbzjAwQmIEi7A2HqkTtTaZcQKfPPR",
+	"WH03px919zffbENEMX9DdhFP+Egest3D+QpX9JsPr4fANpVjOUHCn+mML7ktlzSEAkuE01ZSVHGkrrDR",
+	"e1i9TuFQur9sn2rGrCJHa56SwXcdZCUCYB7LcB6GnJMxINWWCvShZCXwal7aRDMYIWHnhk+JnzCGiCkZ",
+	"PLIToBLHdDXPXgVXH14WZg4C5xqo5W8dXGqL7AVorJ5Kick6tSQ0HjOLcMTctd9Z7L16p3wpby+uzl69",
+	"8/joJrUsEPseRih/QLkN6634Pt6UZSA969hj6th/04QB6zQAmHABiY9SbabzU2WVAXCHIYBAe1ykWj4x",
+	"Gz70EFOOOJjLnkLqwzDrhmonpRoBM0nmNAEchcgXdfBvmgAfEpBwyQp2h33EgczyBmTK6C34h9Xa6qvc",
+	"Q/9zJ+UfUOnDrOveYBy7K0BJxlluCbDj8ZdlYnbYcAKeyoJYyAb8fKtJjjmF5WRrFd1olutoCcK6mUpo",
+	"dAVFzKBQZFoEsxAUPRnAPQ5DZSIgAiipg2upfPiMJmGgZJdke9OUewr6xuuV1UQMhXAu3aojCbaMirfG",
+	"YlEt9RG6NFdoGNJ7FTLDkI/wHbLhFxzYY4XlGJXbIMdY8rR8mgXPbr2eV7qjr3RXw+FPyTjTrb9cvd1i",
+	"OFOxwYGT+rvtwrFHnJ6OZtY8yfTyaiXYrNCCXpkSbHY+o7K3qd6Lqh4uFvwoVPgo7s7UU3CLVGkV3RbM",
+	"M6lYPSWqpPOoWq00632jgJW8424zL05levfnc2ZtlSDvANssbt+wYyvQZVk/C+vl5mdR72mASguh+DIo",
+	"OourLLpVzSpm73Ux0dujNLg7/SF3hbj5Ucnjjb7qPdePCqAeOSHL5u7ukQ1CXnS85juw5pMB4TpQ8w21",
+	"brDNHnWYNYOCmuzFziSYBP1GCwZNDw68MfQGvWYQtDwP+gOv1/Cbg+6432nomhQ+ZYGGEkIu5JLLxBhB",
+	"Yc6Oe/1Ot9vTLRERrp/iYy0f1ny2fuLkv3SgwiiUh1zfdf6eho99RxPxd/1rS6VXFkBAHd/CSx55D6+6",
+	"/TEMLl81O5f9Vn8AUXs86A66zfOXnWb3stFoTVoX8OXg8l8aQNP7u1Hf39k80L8LOP2ukLm5AKY9aHit",
+	"8cDrXk66zfOLXs9rtfuXLxutcb/R63YHvdZFt+s3GimY2o3LP1lzzK7bMfXlCW6r3UtHUbIla1Rrev2S",
+	"1GQTDIO4GBl+vAC1bsepeef24ZlIpRRatwpaq91ZF1rDa1eDkwTzEPIZCkbp1Y8aj1oSqyrhL0Cj0+x1",
+	"pY/oDjFu1zKfRvrcs9ZrtHs+8mtZSu1do96se6X1T3JTfuXBSqGBO702LoifkO1fr9C5eS2xN2QroFWc",
+	"0uwXTgVsR3GVB6ab5wDdRWkcgLEpSDGyPne/QqbW1lqirswLOQFdcriq27ioAEwARz4lAa8MlHMFfS20",
+	"fjUvrFydzVKYnjbtZiiZ3laC/ZA3TQtAYYx3qTp3Asps3bU6KhTFUzmgyss+hsKfjTj+E+2AlXXY+wjH",
+	"gu+zz+zyzV16WfeKjspulh9ObNfnftlV4t/Zf2pQLhBu04jOyszy7UOeb3LILUTPH7TCsKqQncEqx2Wr",
+	"ENgt7ufJsm0+5SPnbFhsPW2zbehcbBGZUoPpWZTC3T04d5nUFTZLhx/WdFt2s9U2zI2IPzyyEsquiNpw",
+	"9cPV4zaVBwriqfurX5tQ9D0JppHHzflxNRzuIS/wY+3+/r4Ox76Md6jDZFX5YAX1KWUDOmzYLg/wajjc",
+	"MQPwajj86+T+Mc63SPwrjNIhU05sUfNV9oGR5KeV7ZdNr798nl9BZJ4z/Moz/OxRwzb5fQUW7675nlxO",
+	"n6OtjpnQV1R3X0MqX06vPsVcPnchP84FZPLK50Ok+hn/si2P+SqKIPhtTggqhfZtgO++/3bW/P4NAXxG",
+	"mXjx7dms+f238ffvsT9DIb6TSQp+wgWNEAMvMQnm4Ar7M8gCTgngcM4Bn6mEHOmHvZ+hMLKF/X3KBT8B",
+	"mPhhEsh+GCLiBESUiSmcIh1roU5KgwRZ4kJKZNR8KDuWDA+RypOYIQZmNOGo/u1ZLPEb6qr/AMZxiFHg",
+	"HHDKpjyJEYOEJDoTiRKgMJ7hGEwZTUjAwTgRqmAbQ1IaUKA7njW//20GxTdcpYX8y3Ljt3OgrpZmPgIy",
+	"H4ILxMAwQeBVGCKmCoPJeQbDWz3pFCkSfUjmAI5pIgBDnIZ3NudDkWnpo8RSHMn2lt0cQIbABPqYTDV2",
+	"Z3K4/ofU8jdy15pes33q9U695rXXftFovWj1657n/afmJDvU9NjpEnVxTLnm2VguPworaVwDgu7BjEZI",
+	"5isQel8HbwgXCAZyjL/hBhcwhuSWJbHw57XVhtLKpMLsLFrOiTWy5BjnB8kIZGYx2WI/5TidS9Sz8UlL",
+	"zSzBOhlaZXuYhcO4ciMScgHSVrbrSu2+eIZXhSkiIouicbvNzNHSF9UbdhZrMmNcX3u/sXBIthw/1aoC",
+	"vTXd/rKLCmQWDrBKkTGtgG5VOgRrJhY5d3mWEi2f5voviIw93yt9XT0EdGL6qRQRe+KyagxsO3cUSrrO",
+	"nckUjhCXipButbrXxfPH8lHSzdbEdsVADatrg16ZREtndoMxlGpWrpP6PXPLQVlhUKt37MI91C1d4Ndw",
+	"ujZku43hKkVULZBw+g/+zy1mykIF0t3jN65zBnWZQWsb6EKcqHBRFPXVKUW5LBeXi0TfBp6DP4/RTvEs",
+	"aYHRPTDjV+dosIQVUyyAOT1U2dXL9EB6hF7Zk26xVFc526mKTgSc7qLtnIR5SObbn62YfrY4WbFv3iyi",
+	"5SR3VsR77XB4hXcpqLeYjLVdP6XBkmt1VcwE3qoQ9AI+hSjH9eZh9k4hFHH/h4HOjTCFrWFBQPZSE3tz",
+	"R0rei2KvFdooNtBZbNJ0/vXcHHCqX9t0BLK5m7uBaVlG9ZvLcmXzeLIZ0A+MTplyHJVsiI3FIJKyUpkr",
+	"hSTtukR95C/3WuIl9G11ihLIrr1WvJGquksUKJrQGp0WbqqqMNRkm/W6XGtuDTOhrR5+54Kosl3kG0mo",
+	"2S2mN2r9QmYIhmKmvnyA8kqhdTaYhqBhaqztaYe55e6yUCZ8xeFZsYw3CDG5tW4Hx0I0iV9alStfjI4e",
+	"sYHtpXtT3XrJXH1zueDlzgMyvVca9RteMb5MlZq+dvbe8qXimdcWBebbDDzMwQQTc/0aJT4qZVF+ALRT",
+	"SU3fvNTHiARGbNNOq85edZdbDVgem2pTcbMb2pcNmOlrtwGrikHkyE8YFvOhfMWI8xhBhpiMGVNfVW96",
+	"V6YeuCTPhIj1YlPD1hQozsLhsAVkBB04//DmRKe+/5FI92CaOSlz3XFgBjTEPiLmGme77Xv35rqWZhml",
+	"qaWqmLYuH0LZ9My8yM9ka7eiSc1Cr+VCDmuNulf37CZf+qHlj626V2+ZG89mmh9VIdfymbpYeenuE6gA",
+	"r0IJouJtr2kVgGxnasHY87AcEoDPuUBRPhP1DjJME264mxmQKpEPhwIxpdBstSDVqynt4/qjVZR2qglL",
+	"KyjJbszZwkm2K+UnhR39CUiPR0/UOxFlem+UBlu/CdQVVJSLsls1uBNqfaGr1lWIftYII/fbfHQh2f8j",
+	"Ku//PKXJrPOmfJIC1PS8ZQBN0+xT6eVhGPEr00CBaG/a6QUMrjQ56v3Opu+/IQIxAsNXpgCAM+m1vZ6b",
+	"7h91uiRPoggy2aCm+Ad+RAJYpgGHa26pvxz5JhOjMHdywSSHmztRVlPqrzd33Fi/Q8wd2//z3Nlo7qQC",
+	"cDEH7hBtMn2sEf3JfHhU5EzRqmnkxtZYVyijUcEXmp8l1sLR2elpCjuUCeEqX9KdGo5zd57OJWcW2Qt2",
+	"1PxwxH5R6peJelES32cH5Dl3SZXfLGvkfBzJhT611Kq3zeUvK32h07a2fNfN/NqyC1PYb7uXOSa57cB2",
+	"vSRE4HDnXngWvLLF24EbULXF+wJOt30zjW/d+FXr2RoVbkS5+TJUattrb/b+eypey1CDz6OQpSo2WmMH",
+	"K8aO2dkn++mQitjCeMqa2A082FUVV82IZ838rJmPqZmJmwbyrIoPoYqt2thBF4uHs084WE//2rKzi2EA",
+	"qZfvzeU66u764c3lPlSdBSs9kZ9NR5kuYjhFB5P1+V9d0i0f5Kr85nJTIf9knIFLxTxvHdgK57x4wlF0",
+	"n8BFn+NfwW1S4RHcz6xWXT3bL8/2S9GUeN6XPhtD1UuEVr6b2EJpZZTPdSqVIvAVetWLl4jt8zRqoe/9",
+	"etOL1y/+hU6hUtIX58hh3efZVHiarvOUMc9u82fj5qtxmzsXeN08fb359bnLl6jbQ7vJU0hP1UW+WLNo",
+	"n+7x3NV1z9r3Wft+ma7xZ5W7sVt8ic49ljs8ReHZFb6BbD+7wavd4EuE+jO5v79W30aJ+2Gfbu9nu+TZ",
+	"LvmyXd7Pe8rP6+peWAqMMPEzP8tDXmXd5G+c1QllWpc6b/AK/WhvdzB5z7sLQe72jCftii0ZrlJW5kZM",
+	"5QGbwbKVMPhaw6RqophsMWOQVo0JDdDPpm8Fr3JUfEoEMgmlAj0I6YnAJEtmgjZTUTIitR2wlA6QpStp",
+	"DFAgbRXv4aN3OoCnk/PT1zef2t5jZdLtwq0vZfR9IeOucLcsB5bnywf9LDXks6TetWQgl9C6QhSsGfnS",
+	"2i6r5UBVxNL1z8/+y+miNBRrRizJSC6z0gqFxxrNdtMbNLtevyKlWNUzSuIgvdagqpqRqqufy0TUulPW",
+	"rDLvF6oxyt9O5WvrVqEok9vzPDRrTwfW6JwkYTj/siQ5JSkVmxWinKY07leJLVdeGyzjuqsvbTVRY5Fl",
+	"ka8YA60W+MhJN967OtH59jzDaceRyWpjfJFjo9FfNUSM87NPcuO3obNp0Y9/NRwC2VHF6Mj6d3vYnjJb",
+	"22LHwc2qHD8b8mroXJ/OByhmOYlRxQsfTefsLh294u2j6vrdAN2hkMYRIgLo1oWs7xdnZ+qi3hnl4kXf",
+	"3KtkYVXLnphl8kcnCunCwm0TzSW6avO4Zlf5CMmKTi8X4wDX7D7zQFV0/drZqT7+/wEAyBL0ovY6AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
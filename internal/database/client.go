@@ -23,6 +23,8 @@ type Client interface {
 	DatasetENSNamehash
 	DatasetMastodonHandle
 	DatasetBlueskyProfile
+	DatasetBridgeTransfer
+	DatasetEASSchema
 
 	LoadCheckpoint(ctx context.Context, id string, network network.Network, worker string) (*engine.Checkpoint, error)
 	LoadCheckpoints(ctx context.Context, id string, network network.Network, worker string) ([]*engine.Checkpoint, error)
@@ -33,6 +35,8 @@ type Client interface {
 	FindActivities(ctx context.Context, query model.ActivitiesQuery) ([]*activityx.Activity, error)
 	FindActivitiesMetadata(ctx context.Context, query model.ActivitiesMetadataQuery) ([]*activityx.Activity, error)
 	DeleteExpiredActivities(ctx context.Context, network network.Network, timestamp time.Time) error
+	DeleteActivitiesSince(ctx context.Context, query model.DeleteActivitiesQuery) error
+	DeleteActivity(ctx context.Context, network network.Network, id string) error
 }
 
 type Session interface {
@@ -71,6 +75,16 @@ type DatasetBlueskyProfile interface {
 	SaveDatasetBlueskyProfiles(ctx context.Context, profiles []*model.BlueskyProfile) error
 }
 
+type DatasetBridgeTransfer interface {
+	LoadDatasetBridgeTransfers(ctx context.Context, query model.QueryBridgeTransfers) ([]*model.BridgeTransfer, error)
+	SaveDatasetBridgeTransfer(ctx context.Context, transfer *model.BridgeTransfer) error
+}
+
+type DatasetEASSchema interface {
+	LoadDatasetEASSchema(ctx context.Context, uid common.Hash) (*model.EASSchema, error)
+	SaveDatasetEASSchema(ctx context.Context, schema *model.EASSchema) error
+}
+
 var _ goose.Logger = (*SugaredLogger)(nil)
 
 type SugaredLogger struct {
@@ -228,14 +228,21 @@ func (c *client) SaveCheckpoint(ctx context.Context, checkpoint *engine.Checkpoi
 	ctx, span := otel.Tracer("").Start(ctx, "Database saveCheckpoint", spanStartOptions...)
 	defer span.End()
 
+	assignments := map[string]interface{}{
+		"state":       checkpoint.State,
+		"updated_at":  time.Now(),
+		"index_count": gorm.Expr("checkpoints.index_count + ?", checkpoint.IndexCount),
+	}
+
+	// Keep the indexed time if it is unknown to the caller.
+	if !checkpoint.IndexedAt.IsZero() {
+		assignments["indexed_at"] = checkpoint.IndexedAt
+	}
+
 	clauses := []clause.Expression{
 		clause.OnConflict{
-			Columns: []clause.Column{{Name: "id"}},
-			DoUpdates: clause.Assignments(map[string]interface{}{
-				"state":       checkpoint.State,
-				"updated_at":  time.Now(),
-				"index_count": gorm.Expr("checkpoints.index_count + ?", checkpoint.IndexCount),
-			}),
+			Columns:   []clause.Column{{Name: "id"}},
+			DoUpdates: clause.Assignments(assignments),
 		},
 	}
 
@@ -349,6 +356,24 @@ func (c *client) DeleteExpiredActivities(ctx context.Context, network networkx.N
 	return fmt.Errorf("not implemented")
 }
 
+// DeleteActivitiesSince deletes the activities matching the query since its timestamp.
+func (c *client) DeleteActivitiesSince(ctx context.Context, query model.DeleteActivitiesQuery) error {
+	if c.partition {
+		return c.deleteActivitiesSincePartitioned(ctx, query)
+	}
+
+	return fmt.Errorf("not implemented")
+}
+
+// DeleteActivity deletes the activity and its indexes by id, it is used when the source of the activity is deleted.
+func (c *client) DeleteActivity(ctx context.Context, network networkx.Network, id string) error {
+	if c.partition {
+		return c.deleteActivityPartitioned(ctx, network, id)
+	}
+
+	return fmt.Errorf("not implemented")
+}
+
 // LoadDatasetFarcasterProfile loads a profile.
 func (c *client) LoadDatasetFarcasterProfile(ctx context.Context, fid int64) (*model.Profile, error) {
 	var value table.DatasetFarcasterProfile
@@ -419,6 +444,37 @@ func (c *client) SaveDatasetENSNamehash(ctx context.Context, namehash *model.ENS
 	return c.database.WithContext(ctx).Clauses(clauses...).Create(&value).Error
 }
 
+// LoadDatasetEASSchema loads an EAS schema by the UID.
+func (c *client) LoadDatasetEASSchema(ctx context.Context, uid common.Hash) (*model.EASSchema, error) {
+	var value table.DatasetEASSchema
+
+	if err := c.database.WithContext(ctx).
+		Where("uid = ?", uid).
+		First(&value).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return value.Export()
+}
+
+// SaveDatasetEASSchema saves an EAS schema, which is immutable once registered.
+func (c *client) SaveDatasetEASSchema(ctx context.Context, schema *model.EASSchema) error {
+	clauses := []clause.Expression{
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "uid"}},
+			DoNothing: true,
+		},
+	}
+
+	var value table.DatasetEASSchema
+	if err := value.Import(schema); err != nil {
+		return err
+	}
+
+	return c.database.WithContext(ctx).Clauses(clauses...).Create(&value).Error
+}
+
 func (c *client) SaveRecentMastodonHandles(ctx context.Context, handles []*model.MastodonHandle) error {
 	// build the mastodon update handle table
 	values := make([]table.DatasetMastodonUpdateHandle, 0, len(handles))
@@ -561,6 +617,57 @@ func (c *client) LoadDatasetBlueskyProfiles(ctx context.Context, query model.Que
 	return result, nil
 }
 
+// SaveDatasetBridgeTransfer saves a side of a bridge transfer, and merges it with the other side if it has been saved.
+func (c *client) SaveDatasetBridgeTransfer(ctx context.Context, transfer *model.BridgeTransfer) error {
+	var value table.DatasetBridgeTransfer
+	if err := value.Import(transfer); err != nil {
+		return err
+	}
+
+	// Keep the saved side if the side of the transfer is empty.
+	onConflictClause := clause.OnConflict{
+		Columns: []clause.Column{{Name: "platform"}, {Name: "key"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"source_activity_id": gorm.Expr("COALESCE(EXCLUDED.source_activity_id, dataset_bridge_transfers.source_activity_id)"),
+			"source_timestamp":   gorm.Expr("COALESCE(EXCLUDED.source_timestamp, dataset_bridge_transfers.source_timestamp)"),
+			"target_activity_id": gorm.Expr("COALESCE(EXCLUDED.target_activity_id, dataset_bridge_transfers.target_activity_id)"),
+			"target_timestamp":   gorm.Expr("COALESCE(EXCLUDED.target_timestamp, dataset_bridge_transfers.target_timestamp)"),
+			"updated_at":         gorm.Expr("EXCLUDED.updated_at"),
+		}),
+	}
+
+	return c.database.WithContext(ctx).Clauses(onConflictClause).Create(&value).Error
+}
+
+// LoadDatasetBridgeTransfers returns the bridge transfers of which either side is one of the activities.
+func (c *client) LoadDatasetBridgeTransfers(ctx context.Context, query model.QueryBridgeTransfers) ([]*model.BridgeTransfer, error) {
+	if len(query.ActivityIDs) == 0 {
+		return nil, nil
+	}
+
+	var transfers []*table.DatasetBridgeTransfer
+
+	if err := c.database.WithContext(ctx).
+		Where("source_activity_id IN ? OR target_activity_id IN ?", query.ActivityIDs, query.ActivityIDs).
+		Find(&transfers).
+		Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.BridgeTransfer, 0, len(transfers))
+
+	for _, transfer := range transfers {
+		value, err := transfer.Export()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+	}
+
+	return result, nil
+}
+
 // Dial dials a database.
 func Dial(ctx context.Context, dataSourceName string, partition bool) (database.Client, error) {
 	var err error
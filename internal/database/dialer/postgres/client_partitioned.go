@@ -932,6 +932,157 @@ func (c *client) batchDeleteExpiredActivities(ctx context.Context, network netwo
 	return false, nil
 }
 
+// deleteActivitiesSincePartitioned deletes the activities matching the query since its timestamp in partitioned tables.
+func (c *client) deleteActivitiesSincePartitioned(ctx context.Context, query model.DeleteActivitiesQuery) error {
+	batchSize := 1000
+
+	zap.L().Info("starting to delete activities since timestamp",
+		zap.String("network", query.Network.String()),
+		zap.Strings("platforms", query.Platforms),
+		zap.Any("tags", query.Tags),
+		zap.Time("timestamp", query.Timestamp))
+
+	// Walk through the quarterly partitions from the one containing the timestamp to the current one.
+	quarter := time.Date(query.Timestamp.Year(), time.Month((int(query.Timestamp.Month())-1)/3*3+1), 1, 0, 0, 0, 0, time.UTC)
+
+	for ; !quarter.After(time.Now()); quarter = quarter.AddDate(0, 3, 0) {
+		indexTable := c.buildIndexesTableNames(quarter)
+
+		indexTableExists, err := c.findPartitionTableExists(ctx, indexTable)
+		if err != nil {
+			return fmt.Errorf("find partition table exists: %w", err)
+		}
+
+		if !indexTableExists {
+			continue
+		}
+
+		activityTable := c.buildActivitiesTableNames(query.Network, quarter)
+
+		activityTableExists, err := c.findPartitionTableExists(ctx, activityTable)
+		if err != nil {
+			return fmt.Errorf("find partition table exists: %w", err)
+		}
+
+		for {
+			done, err := c.batchDeleteActivitiesSince(ctx, query, batchSize, indexTable, lo.Ternary(activityTableExists, &activityTable, nil))
+			if err != nil {
+				return fmt.Errorf("batch delete activities: %w", err)
+			}
+
+			if done {
+				break
+			}
+		}
+	}
+
+	zap.L().Info("successfully deleted activities since timestamp",
+		zap.String("network", query.Network.String()),
+		zap.Strings("platforms", query.Platforms),
+		zap.Any("tags", query.Tags),
+		zap.Time("timestamp", query.Timestamp))
+
+	return nil
+}
+
+func (c *client) batchDeleteActivitiesSince(ctx context.Context, query model.DeleteActivitiesQuery, batchSize int, indexTable string, activityTable *string) (bool, error) {
+	var ids []string
+
+	databaseStatement := c.database.WithContext(ctx).Table(indexTable).Distinct("id").
+		Where("network = ? AND timestamp >= ?", query.Network.String(), query.Timestamp)
+
+	if query.Platforms != nil {
+		databaseStatement = databaseStatement.Where("platform IN ?", query.Platforms)
+	}
+
+	if len(query.Tags) > 0 {
+		databaseStatement = databaseStatement.Where("tag IN ?", query.Tags)
+	}
+
+	if err := databaseStatement.Limit(batchSize).Pluck("id", &ids).Error; err != nil {
+		return false, fmt.Errorf("find activities: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return true, nil
+	}
+
+	err := c.database.WithContext(ctx).Transaction(func(databaseTransaction *gorm.DB) error {
+		if err := databaseTransaction.Table(indexTable).Where("id IN ?", ids).Delete(&table.Index{}).Error; err != nil {
+			return fmt.Errorf("delete indexes: %w", err)
+		}
+
+		if activityTable != nil {
+			if err := databaseTransaction.Table(*activityTable).Where("id IN ?", ids).Delete(&table.Activity{}).Error; err != nil {
+				return fmt.Errorf("delete activities: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	zap.L().Debug("deleted batch of activities",
+		zap.String("index_table", indexTable),
+		zap.Int("count", len(ids)))
+
+	return false, nil
+}
+
+// deleteActivityPartitioned deletes the activity and its indexes by id in partitioned tables.
+func (c *client) deleteActivityPartitioned(ctx context.Context, network network.Network, id string) error {
+	index, err := c.findIndexPartitioned(ctx, model.ActivityQuery{ID: lo.ToPtr(id), Network: lo.ToPtr(network)})
+	if err != nil {
+		return fmt.Errorf("find index: %w", err)
+	}
+
+	if index == nil {
+		zap.L().Debug("no activity to delete",
+			zap.String("network", network.String()),
+			zap.String("id", id))
+
+		return nil
+	}
+
+	activity := table.Activity{
+		ID:        index.ID,
+		Network:   index.Network,
+		Timestamp: index.Timestamp,
+	}
+
+	activityTable := activity.PartitionName(nil)
+
+	activityTableExists, err := c.findPartitionTableExists(ctx, activityTable)
+	if err != nil {
+		return fmt.Errorf("find partition table exists: %w", err)
+	}
+
+	err = c.database.WithContext(ctx).Transaction(func(databaseTransaction *gorm.DB) error {
+		if err := databaseTransaction.Table(index.PartitionName()).Where("id = ?", id).Delete(&table.Index{}).Error; err != nil {
+			return fmt.Errorf("delete indexes: %w", err)
+		}
+
+		if activityTableExists {
+			if err := databaseTransaction.Table(activityTable).Where("id = ?", id).Delete(&table.Activity{}).Error; err != nil {
+				return fmt.Errorf("delete activity: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	zap.L().Debug("successfully deleted activity",
+		zap.String("network", network.String()),
+		zap.String("id", id))
+
+	return nil
+}
+
 // buildFindIndexStatement builds the query index statement.
 func (c *client) buildFindIndexStatement(ctx context.Context, partitionedName string, query model.ActivityQuery) *gorm.DB {
 	databaseStatement := c.database.WithContext(ctx).Table(partitionedName)
@@ -291,6 +291,15 @@ func TestClient(t *testing.T) {
 				require.Equal(t, data.Platform, activity.Platform)
 				require.Equal(t, data.Type, activity.Type)
 			}
+
+			// Delete activities by id.
+			for _, activity := range testcase.activityUpdatedHighPriority {
+				require.NoError(t, client.DeleteActivity(context.Background(), activity.Network, activity.ID))
+
+				data, _, err := client.FindActivity(context.Background(), model.ActivityQuery{ID: lo.ToPtr(activity.ID), ActionLimit: 10})
+				require.NoError(t, err)
+				require.Nil(t, data)
+			}
 		})
 	}
 }
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dataset_bridge_transfers
+(
+    "platform"           text        NOT NULL,
+    "key"                text        NOT NULL,
+    "source_network"     text        NOT NULL,
+    "target_network"     text        NOT NULL,
+    "source_activity_id" text,
+    "source_timestamp"   timestamptz,
+    "target_activity_id" text,
+    "target_timestamp"   timestamptz,
+    "created_at"         timestamptz NOT NULL DEFAULT now(),
+    "updated_at"         timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT pk_dataset_bridge_transfers PRIMARY KEY ("platform", "key")
+);
+
+CREATE INDEX idx_dataset_bridge_transfers_source_activity_id ON dataset_bridge_transfers (source_activity_id);
+
+CREATE INDEX idx_dataset_bridge_transfers_target_activity_id ON dataset_bridge_transfers (target_activity_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dataset_bridge_transfers;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "dataset_eas_schemas"
+(
+    "uid"       bytea NOT NULL,
+    "resolver"  bytea NOT NULL,
+    "revocable" bool  NOT NULL,
+    "schema"    text  NOT NULL,
+
+    CONSTRAINT "pk_dataset_eas_schemas" PRIMARY KEY ("uid")
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "dataset_eas_schemas";
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "checkpoints"
+    ADD "indexed_at" timestamptz;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "checkpoints"
+DROP COLUMN "indexed_at";
+-- +goose StatementEnd
@@ -8,9 +8,9 @@ import (
 	"math"
 	"time"
 
+	metadatax "github.com/rss3-network/node/schema/metadata"
 	"github.com/rss3-network/protocol-go/schema"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
-	"github.com/rss3-network/protocol-go/schema/metadata"
 	"github.com/rss3-network/protocol-go/schema/network"
 	"github.com/rss3-network/protocol-go/schema/tag"
 	"github.com/shopspring/decimal"
@@ -347,7 +347,7 @@ func (f *ActivityAction) Export() (*activityx.Action, error) {
 		return nil, err
 	}
 
-	if action.Metadata, err = metadata.Unmarshal(action.Type, f.Metadata); err != nil {
+	if action.Metadata, err = metadatax.Unmarshal(action.Platform, action.Type, f.Metadata); err != nil {
 		return nil, err
 	}
 
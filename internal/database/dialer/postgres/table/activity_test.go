@@ -0,0 +1,250 @@
+package table_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/internal/database/dialer/postgres/table"
+	metadatax "github.com/rss3-network/node/schema/metadata"
+	"github.com/rss3-network/node/schema/worker/decentralized"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityActions(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name   string
+		action *activityx.Action
+		want   *activityx.Action
+	}{
+		{
+			name: "GMX position",
+			action: &activityx.Action{
+				Tag:      tag.Exchange,
+				Type:     typex.ExchangeLoan,
+				Platform: decentralized.PlatformGMX.String(),
+				From:     "0x1f3A5C7E9B2d4F6a8c0e2B4d6f8a0C2E4b6d8f0A",
+				To:       "0x70d95587d40A2caf56bd97485aB3Eec10Bee6336",
+				Metadata: metadatax.ExchangePosition{
+					ExchangeLoan: metadata.ExchangeLoan{
+						Action: metadata.ActionExchangeLoanCreate,
+						Collateral: metadata.Token{
+							Address:  lo.ToPtr("0xaf88d065e77c8cC2239327C5EDb3A432268e5831"),
+							Value:    lo.ToPtr(lo.Must(decimal.NewFromString("2000000000"))),
+							Symbol:   "USDC",
+							Decimals: 6,
+						},
+					},
+					Market:   "ETH/USD",
+					Side:     metadatax.PositionSideLong,
+					Size:     lo.Must(decimal.NewFromString("10000")),
+					Leverage: lo.ToPtr(lo.Must(decimal.NewFromString("5"))),
+				},
+			},
+			want: &activityx.Action{
+				Tag:      tag.Exchange,
+				Type:     typex.ExchangeLoan,
+				Platform: decentralized.PlatformGMX.String(),
+				From:     "0x1f3A5C7E9B2d4F6a8c0e2B4d6f8a0C2E4b6d8f0A",
+				To:       "0x70d95587d40A2caf56bd97485aB3Eec10Bee6336",
+				Metadata: &metadatax.ExchangePosition{
+					ExchangeLoan: metadata.ExchangeLoan{
+						Action: metadata.ActionExchangeLoanCreate,
+						Collateral: metadata.Token{
+							Address:  lo.ToPtr("0xaf88d065e77c8cC2239327C5EDb3A432268e5831"),
+							Value:    lo.ToPtr(lo.Must(decimal.NewFromString("2000000000"))),
+							Symbol:   "USDC",
+							Decimals: 6,
+						},
+					},
+					Market:   "ETH/USD",
+					Side:     metadatax.PositionSideLong,
+					Size:     lo.Must(decimal.NewFromString("10000")),
+					Leverage: lo.ToPtr(lo.Must(decimal.NewFromString("5"))),
+				},
+			},
+		},
+		{
+			name: "Governor proposal",
+			action: &activityx.Action{
+				Tag:      tag.Governance,
+				Type:     typex.GovernanceProposal,
+				Platform: decentralized.PlatformGovernor.String(),
+				From:     "0x8E4ED221fa034245F14205f781E0b13C5bd6a42E",
+				To:       "0x408ED6354d4973f66138C91495F2f2FCbd8724C3",
+				Metadata: metadatax.GovernanceProposal{
+					GovernanceProposal: metadata.GovernanceProposal{
+						ID:   "66",
+						Body: "# Fund the Uniswap Foundation",
+					},
+					DAO: "Uniswap",
+				},
+			},
+			want: &activityx.Action{
+				Tag:      tag.Governance,
+				Type:     typex.GovernanceProposal,
+				Platform: decentralized.PlatformGovernor.String(),
+				From:     "0x8E4ED221fa034245F14205f781E0b13C5bd6a42E",
+				To:       "0x408ED6354d4973f66138C91495F2f2FCbd8724C3",
+				Metadata: &metadatax.GovernanceProposal{
+					GovernanceProposal: metadata.GovernanceProposal{
+						ID:   "66",
+						Body: "# Fund the Uniswap Foundation",
+					},
+					DAO: "Uniswap",
+				},
+			},
+		},
+		{
+			name: "Governor vote",
+			action: &activityx.Action{
+				Tag:      tag.Governance,
+				Type:     typex.GovernanceVote,
+				Platform: decentralized.PlatformGovernor.String(),
+				From:     "0x683a4F9915D6216f73d6Df50151725036bD26C02",
+				To:       "0x408ED6354d4973f66138C91495F2f2FCbd8724C3",
+				Metadata: metadatax.GovernanceVote{
+					GovernanceVote: metadata.GovernanceVote{
+						Action: metadata.ActionGovernanceVoteAgainst,
+						Count:  0,
+						Proposal: metadata.GovernanceProposal{
+							ID: "66",
+						},
+					},
+					DAO:    "Uniswap",
+					Weight: lo.Must(decimal.NewFromString("0.25")),
+				},
+			},
+			want: &activityx.Action{
+				Tag:      tag.Governance,
+				Type:     typex.GovernanceVote,
+				Platform: decentralized.PlatformGovernor.String(),
+				From:     "0x683a4F9915D6216f73d6Df50151725036bD26C02",
+				To:       "0x408ED6354d4973f66138C91495F2f2FCbd8724C3",
+				Metadata: &metadatax.GovernanceVote{
+					GovernanceVote: metadata.GovernanceVote{
+						Action: metadata.ActionGovernanceVoteAgainst,
+						Count:  0,
+						Proposal: metadata.GovernanceProposal{
+							ID: "66",
+						},
+					},
+					DAO:    "Uniswap",
+					Weight: lo.Must(decimal.NewFromString("0.25")),
+				},
+			},
+		},
+		{
+			name: "Nouns vote",
+			action: &activityx.Action{
+				Tag:      tag.Governance,
+				Type:     typex.GovernanceVote,
+				Platform: decentralized.PlatformNouns.String(),
+				From:     "0x0a049e014999A489b3D7174B8f70D4200b0Ce79B",
+				To:       "0x6f3E6272A167e8AcCb32072d08E0957F9c79223d",
+				Metadata: metadata.GovernanceVote{
+					Action: metadata.ActionGovernanceVoteFor,
+					Count:  2,
+					Proposal: metadata.GovernanceProposal{
+						ID: "622",
+					},
+				},
+			},
+			want: &activityx.Action{
+				Tag:      tag.Governance,
+				Type:     typex.GovernanceVote,
+				Platform: decentralized.PlatformNouns.String(),
+				From:     "0x0a049e014999A489b3D7174B8f70D4200b0Ce79B",
+				To:       "0x6f3E6272A167e8AcCb32072d08E0957F9c79223d",
+				Metadata: &metadata.GovernanceVote{
+					Action: metadata.ActionGovernanceVoteFor,
+					Count:  2,
+					Proposal: metadata.GovernanceProposal{
+						ID: "622",
+					},
+				},
+			},
+		},
+		{
+			name: "Safe owner",
+			action: &activityx.Action{
+				Tag:      tag.Transaction,
+				Type:     typex.TransactionApproval,
+				Platform: decentralized.PlatformSafe.String(),
+				From:     "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+				To:       "0x1a2B3c4d5E6f708192a3B4c5D6E7F8091A2b3C4d",
+				Metadata: metadatax.SafeApproval{
+					Action: metadata.ActionTransactionRevoke,
+					Owner:  lo.ToPtr(common.HexToAddress("0x1a2B3c4d5E6f708192a3B4c5D6E7F8091A2b3C4d")),
+				},
+			},
+			want: &activityx.Action{
+				Tag:      tag.Transaction,
+				Type:     typex.TransactionApproval,
+				Platform: decentralized.PlatformSafe.String(),
+				From:     "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+				To:       "0x1a2B3c4d5E6f708192a3B4c5D6E7F8091A2b3C4d",
+				Metadata: &metadatax.SafeApproval{
+					Action: metadata.ActionTransactionRevoke,
+					Owner:  lo.ToPtr(common.HexToAddress("0x1a2B3c4d5E6f708192a3B4c5D6E7F8091A2b3C4d")),
+				},
+			},
+		},
+		{
+			name: "Safe threshold",
+			action: &activityx.Action{
+				Tag:      tag.Transaction,
+				Type:     typex.TransactionApproval,
+				Platform: decentralized.PlatformSafe.String(),
+				From:     "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+				To:       "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+				Metadata: metadatax.SafeApproval{
+					Action:    metadata.ActionTransactionApprove,
+					Threshold: lo.ToPtr(uint64(2)),
+				},
+			},
+			want: &activityx.Action{
+				Tag:      tag.Transaction,
+				Type:     typex.TransactionApproval,
+				Platform: decentralized.PlatformSafe.String(),
+				From:     "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+				To:       "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+				Metadata: &metadatax.SafeApproval{
+					Action:    metadata.ActionTransactionApprove,
+					Threshold: lo.ToPtr(uint64(2)),
+				},
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var action table.ActivityAction
+			require.NoError(t, action.Import(testcase.action))
+
+			// Round trip the actions through the jsonb column.
+			value, err := table.ActivityActions{&action}.Value()
+			require.NoError(t, err)
+
+			var actions table.ActivityActions
+			require.NoError(t, actions.Scan(value))
+			require.Len(t, actions, 1)
+
+			got, err := actions[0].Export()
+			require.NoError(t, err)
+
+			require.Equal(t, testcase.want, got)
+		})
+	}
+}
@@ -14,6 +14,7 @@ type Checkpoint struct {
 	Worker     string          `gorm:"column:worker"`
 	State      json.RawMessage `gorm:"column:state;type:jsonb"`
 	IndexCount int64           `gorm:"column:index_count"`
+	IndexedAt  *time.Time      `gorm:"column:indexed_at"`
 	CreatedAt  time.Time       `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt  time.Time       `gorm:"column:updated_at;autoUpdateTime"`
 }
@@ -26,16 +27,26 @@ func (c *Checkpoint) Import(checkpoint *engine.Checkpoint) (err error) {
 	c.IndexCount = checkpoint.IndexCount
 	c.UpdatedAt = checkpoint.UpdatedAt
 
+	if !checkpoint.IndexedAt.IsZero() {
+		c.IndexedAt = &checkpoint.IndexedAt
+	}
+
 	return nil
 }
 
 func (c *Checkpoint) Export() (*engine.Checkpoint, error) {
-	return &engine.Checkpoint{
+	checkpoint := engine.Checkpoint{
 		ID:         c.ID,
 		Network:    c.Network,
 		Worker:     c.Worker,
 		State:      c.State,
 		IndexCount: c.IndexCount,
 		UpdatedAt:  c.UpdatedAt,
-	}, nil
+	}
+
+	if c.IndexedAt != nil {
+		checkpoint.IndexedAt = *c.IndexedAt
+	}
+
+	return &checkpoint, nil
 }
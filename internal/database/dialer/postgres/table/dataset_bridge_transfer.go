@@ -0,0 +1,68 @@
+package table
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rss3-network/node/internal/database/model"
+	"github.com/rss3-network/protocol-go/schema/network"
+)
+
+type DatasetBridgeTransfer struct {
+	Platform         string     `gorm:"column:platform;primaryKey"`
+	Key              string     `gorm:"column:key;primaryKey"`
+	SourceNetwork    string     `gorm:"column:source_network"`
+	TargetNetwork    string     `gorm:"column:target_network"`
+	SourceActivityID *string    `gorm:"column:source_activity_id"`
+	SourceTimestamp  *time.Time `gorm:"column:source_timestamp"`
+	TargetActivityID *string    `gorm:"column:target_activity_id"`
+	TargetTimestamp  *time.Time `gorm:"column:target_timestamp"`
+	CreatedAt        time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (DatasetBridgeTransfer) TableName() string {
+	return "dataset_bridge_transfers"
+}
+
+func (d *DatasetBridgeTransfer) Import(transfer *model.BridgeTransfer) error {
+	d.Platform = transfer.Platform
+	d.Key = transfer.Key
+	d.SourceNetwork = transfer.SourceNetwork.String()
+	d.TargetNetwork = transfer.TargetNetwork.String()
+	d.SourceActivityID = transfer.SourceActivityID
+	d.SourceTimestamp = transfer.SourceTimestamp
+	d.TargetActivityID = transfer.TargetActivityID
+	d.TargetTimestamp = transfer.TargetTimestamp
+	d.CreatedAt = transfer.CreatedAt
+	d.UpdatedAt = transfer.UpdatedAt
+
+	return nil
+}
+
+func (d *DatasetBridgeTransfer) Export() (*model.BridgeTransfer, error) {
+	sourceNetwork, err := network.NetworkString(d.SourceNetwork)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source network %s: %w", d.SourceNetwork, err)
+	}
+
+	targetNetwork, err := network.NetworkString(d.TargetNetwork)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target network %s: %w", d.TargetNetwork, err)
+	}
+
+	transfer := model.BridgeTransfer{
+		Platform:         d.Platform,
+		Key:              d.Key,
+		SourceNetwork:    sourceNetwork,
+		TargetNetwork:    targetNetwork,
+		SourceActivityID: d.SourceActivityID,
+		SourceTimestamp:  d.SourceTimestamp,
+		TargetActivityID: d.TargetActivityID,
+		TargetTimestamp:  d.TargetTimestamp,
+		CreatedAt:        d.CreatedAt,
+		UpdatedAt:        d.UpdatedAt,
+	}
+
+	return &transfer, nil
+}
@@ -0,0 +1,37 @@
+package table
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/internal/database/model"
+)
+
+type DatasetEASSchema struct {
+	UID       common.Hash    `gorm:"column:uid;primary_key"`
+	Resolver  common.Address `gorm:"column:resolver"`
+	Revocable bool           `gorm:"column:revocable"`
+	Schema    string         `gorm:"column:schema"`
+}
+
+func (DatasetEASSchema) TableName() string {
+	return "dataset_eas_schemas"
+}
+
+func (d *DatasetEASSchema) Import(schema *model.EASSchema) error {
+	d.UID = schema.UID
+	d.Resolver = schema.Resolver
+	d.Revocable = schema.Revocable
+	d.Schema = schema.Schema
+
+	return nil
+}
+
+func (d *DatasetEASSchema) Export() (*model.EASSchema, error) {
+	schema := model.EASSchema{
+		UID:       d.UID,
+		Resolver:  d.Resolver,
+		Revocable: d.Revocable,
+		Schema:    d.Schema,
+	}
+
+	return &schema, nil
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/rss3-network/node/schema/worker/decentralized"
 	"github.com/rss3-network/protocol-go/schema"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
@@ -50,3 +52,13 @@ type ActivitiesMetadataQuery struct {
 	ActionLimit    int
 	Metadata       *metadata.Metadata
 }
+
+// DeleteActivitiesQuery matches the activities indexed by a worker since the timestamp.
+type DeleteActivitiesQuery struct {
+	Network network.Network
+	// Platforms are the platforms of the activities, nil matches all and an empty string matches the activities without a platform.
+	Platforms []string
+	// Tags are the tags of the activities, nil matches all.
+	Tags      []tag.Tag
+	Timestamp time.Time
+}
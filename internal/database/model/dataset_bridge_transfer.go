@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+)
+
+type BridgeTransferStatus string
+
+const (
+	BridgeTransferStatusPending   BridgeTransferStatus = "pending"
+	BridgeTransferStatusFinalized BridgeTransferStatus = "finalized"
+)
+
+// BridgeTransfer links the activities on the source and target networks of a bridge transfer,
+// the key is an identifier shared by both sides, such as a message hash or a nonce.
+type BridgeTransfer struct {
+	Platform         string          `json:"platform"`
+	Key              string          `json:"key"`
+	SourceNetwork    network.Network `json:"source_network"`
+	TargetNetwork    network.Network `json:"target_network"`
+	SourceActivityID *string         `json:"source_activity_id"`
+	SourceTimestamp  *time.Time      `json:"source_timestamp"`
+	TargetActivityID *string         `json:"target_activity_id"`
+	TargetTimestamp  *time.Time      `json:"target_timestamp"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+}
+
+// Status returns finalized once the activity on the target network has been indexed.
+func (b *BridgeTransfer) Status() BridgeTransferStatus {
+	if b.TargetActivityID != nil {
+		return BridgeTransferStatusFinalized
+	}
+
+	return BridgeTransferStatusPending
+}
+
+// RelatedActivityID returns the ID of the activity on the other side of the bridge transfer.
+func (b *BridgeTransfer) RelatedActivityID(activityID string) *string {
+	switch activityID {
+	case lo.FromPtr(b.SourceActivityID):
+		return b.TargetActivityID
+	case lo.FromPtr(b.TargetActivityID):
+		return b.SourceActivityID
+	default:
+		return nil
+	}
+}
+
+type QueryBridgeTransfers struct {
+	ActivityIDs []string
+}
@@ -0,0 +1,12 @@
+package model
+
+import "github.com/ethereum/go-ethereum/common"
+
+// EASSchema is a schema registered in the SchemaRegistry of EAS,
+// the UID is derived from the schema, resolver and revocable, so it is the same on every network.
+type EASSchema struct {
+	UID       common.Hash    `json:"uid"`
+	Resolver  common.Address `json:"resolver"`
+	Revocable bool           `json:"revocable"`
+	Schema    string         `json:"schema"`
+}
@@ -13,5 +13,7 @@ type Checkpoint struct {
 	Worker     string          `json:"worker"`
 	State      json.RawMessage `json:"state"`
 	IndexCount int64           `json:"index_count"`
-	UpdatedAt  time.Time       `json:"updated_at"`
+	// IndexedAt is the timestamp of the latest indexed task, or the time the data source caught up without new tasks.
+	IndexedAt time.Time `json:"indexed_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
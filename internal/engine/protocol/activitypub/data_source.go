@@ -243,14 +243,28 @@ func (s *dataSource) buildMastodonMessageTasks(_ context.Context, object activit
 		return nil
 	}
 
-	if object.Published == "" {
-		zap.L().Debug("skipping mastodon message object with missing published timestamp",
+	if err := normalizeObject(&object); err != nil {
+		zap.L().Error("failed to normalize mastodon message object",
 			zap.String("objectID", object.ID),
-		)
+			zap.Error(err))
 
 		return nil
 	}
 
+	// Mastodon omits the published timestamp of Like, Follow, Accept, Delete and Undo messages,
+	// which are dated at the time they are received.
+	if object.Published == "" {
+		if object.Type == mastodon.MessageTypeCreate.String() || object.Type == mastodon.MessageTypeAnnounce.String() {
+			zap.L().Debug("skipping mastodon message object with missing published timestamp",
+				zap.String("objectID", object.ID),
+			)
+
+			return nil
+		}
+
+		object.Published = time.Now().UTC().Format(time.RFC3339)
+	}
+
 	// Check if the published timestamp is valid (within 3 months)
 	zap.L().Info("object.Published value before calling ValidatePublicationTimestamp", zap.String("published", object.Published))
 
@@ -264,8 +278,9 @@ func (s *dataSource) buildMastodonMessageTasks(_ context.Context, object activit
 	}
 
 	task := &Task{
-		Network: s.Network(),
-		Message: object,
+		Network:  s.Network(),
+		Platform: detectPlatform(object),
+		Message:  object,
 	}
 
 	tasks.Tasks = append(tasks.Tasks, task)
@@ -0,0 +1,265 @@
+package activitypub
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+
+	"github.com/rss3-network/node/provider/activitypub"
+	"github.com/rss3-network/node/provider/activitypub/mastodon"
+	"github.com/rss3-network/node/schema/worker/federated"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// platformPaths are the first segments of the paths of ids, which are specific to the ActivityPub software.
+// Mastodon, Misskey, Pleroma and Pixelfed share the /users path of actors, so the ids of objects are checked first.
+var platformPaths = map[string]federated.Platform{
+	"notes":          federated.PlatformMisskey,
+	"objects":        federated.PlatformPleroma,
+	"notice":         federated.PlatformPleroma,
+	"p":              federated.PlatformPixelfed,
+	"post":           federated.PlatformLemmy,
+	"comment":        federated.PlatformLemmy,
+	"u":              federated.PlatformLemmy,
+	"c":              federated.PlatformLemmy,
+	"videos":         federated.PlatformPeerTube,
+	"accounts":       federated.PlatformPeerTube,
+	"video-channels": federated.PlatformPeerTube,
+}
+
+// wrappedMessageTypes are the types of activities which Lemmy communities announce on behalf of their members.
+var wrappedMessageTypes = []string{
+	mastodon.MessageTypeCreate.String(),
+	mastodon.MessageTypeUpdate.String(),
+	mastodon.MessageTypeDelete.String(),
+	mastodon.MessageTypeLike.String(),
+	mastodon.MessageTypeUndo.String(),
+}
+
+// detectPlatform detects the ActivityPub software of the object by its extensions and the paths of its ids.
+func detectPlatform(object activitypub.Object) federated.Platform {
+	note, _ := object.Object.(map[string]interface{})
+
+	if _, ok := note[mastodon.MisskeyContent]; ok {
+		return federated.PlatformMisskey
+	}
+
+	noteID, _ := note["id"].(string)
+
+	for _, id := range []string{noteID, object.ID, object.Actor} {
+		parsedURL, err := url.Parse(id)
+		if err != nil {
+			continue
+		}
+
+		segment, _, _ := strings.Cut(strings.TrimPrefix(parsedURL.Path, "/"), "/")
+
+		if platform, found := platformPaths[segment]; found {
+			return platform
+		}
+	}
+
+	return federated.PlatformMastodon
+}
+
+// normalizeObject normalises the objects of ActivityPub software other than Mastodon to the shapes of Mastodon,
+// so they are indexed by the same worker with their native semantics.
+func normalizeObject(object *activitypub.Object) error {
+	if object.Type == mastodon.MessageTypeAnnounce.String() {
+		if err := unwrapAnnouncedActivity(object); err != nil {
+			return err
+		}
+	}
+
+	if object.Type != mastodon.MessageTypeCreate.String() && object.Type != mastodon.MessageTypeUpdate.String() {
+		return nil
+	}
+
+	note, ok := object.Object.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	// Lemmy dates the objects of activities only
+	if object.Published == "" {
+		object.Published, _ = note[mastodon.Published].(string)
+	}
+
+	normalizeQuote(note)
+	normalizeLinks(note)
+
+	switch note[mastodon.TagType] {
+	case mastodon.ObjectTypeImage, mastodon.ObjectTypeVideo, mastodon.ObjectTypeAudio:
+		normalizeMedia(note)
+	case mastodon.ObjectTypeQuestion:
+		normalizeQuestion(note)
+	}
+
+	return nil
+}
+
+// unwrapAnnouncedActivity replaces an activity announced by a Lemmy community with the activity itself,
+// the community is kept as the audience of the object.
+func unwrapAnnouncedActivity(object *activitypub.Object) error {
+	wrapped, ok := object.Object.(map[string]interface{})
+	if !ok || !lo.Contains(wrappedMessageTypes, fmt.Sprint(wrapped[mastodon.TagType])) {
+		return nil
+	}
+
+	data, err := json.Marshal(wrapped)
+	if err != nil {
+		return fmt.Errorf("marshal announced activity: %w", err)
+	}
+
+	var activity activitypub.Object
+	if err := json.Unmarshal(data, &activity); err != nil {
+		return fmt.Errorf("unmarshal announced activity: %w", err)
+	}
+
+	if activity.Published == "" {
+		activity.Published = object.Published
+	}
+
+	if note, ok := activity.Object.(map[string]interface{}); ok && note[mastodon.Audience] == nil {
+		note[mastodon.Audience] = object.Actor
+	}
+
+	activity.ID = strings.TrimSuffix(activity.ID, mastodon.ActivitySuffix)
+
+	zap.L().Debug("unwrapped announced activity",
+		zap.String("announceID", object.ID),
+		zap.String("activityID", activity.ID))
+
+	*object = activity
+
+	return nil
+}
+
+// normalizeQuote normalises the quotes of Misskey and the forks of Mastodon to the quoteUrl property.
+func normalizeQuote(note map[string]interface{}) {
+	for _, key := range []string{mastodon.QuoteURL, mastodon.MisskeyQuote, mastodon.QuoteURI} {
+		if quote, ok := note[key].(string); ok && quote != "" {
+			note[mastodon.QuoteURL] = quote
+
+			return
+		}
+	}
+}
+
+// normalizeLinks normalises the link attachments of Lemmy and PeerTube, links to media are attachments
+// and other links are the links of the post, so they are appended to the content.
+func normalizeLinks(note map[string]interface{}) {
+	attachments, ok := note[mastodon.Attachment].([]interface{})
+	if !ok {
+		return
+	}
+
+	for _, value := range attachments {
+		attachment, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		href, ok := attachment[mastodon.LinkHref].(string)
+		if !ok || attachment[mastodon.AttachmentURL] != nil {
+			continue
+		}
+
+		if mediaType, _ := attachment[mastodon.AttachmentMediaType].(string); isMediaType(mediaType) {
+			attachment[mastodon.AttachmentURL] = href
+
+			continue
+		}
+
+		appendContent(note, fmt.Sprintf(`<p><a href="%s">%s</a></p>`, html.EscapeString(href), html.EscapeString(href)))
+	}
+}
+
+// normalizeMedia normalises the media objects of Pixelfed and PeerTube, which are the attachments of themselves.
+// The url property is a link, a list of links or a plain URL.
+func normalizeMedia(note map[string]interface{}) {
+	var links []interface{}
+
+	switch value := note[mastodon.MediaURL].(type) {
+	case string:
+		links = append(links, map[string]interface{}{
+			mastodon.LinkHref:            value,
+			mastodon.AttachmentMediaType: note[mastodon.AttachmentMediaType],
+		})
+	case map[string]interface{}:
+		links = append(links, value)
+	case []interface{}:
+		links = value
+	}
+
+	attachments, _ := note[mastodon.Attachment].([]interface{})
+
+	for _, value := range links {
+		link, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		href, _ := link[mastodon.LinkHref].(string)
+		mediaType, _ := link[mastodon.AttachmentMediaType].(string)
+
+		// PeerTube links the watch page and the playlists of the video as well
+		if href == "" || mediaType != "" && !isMediaType(mediaType) {
+			continue
+		}
+
+		attachments = append(attachments, map[string]interface{}{
+			mastodon.AttachmentURL:       href,
+			mastodon.AttachmentMediaType: mediaType,
+		})
+	}
+
+	note[mastodon.Attachment] = attachments
+}
+
+// normalizeQuestion appends the options of a poll and their votes to the content.
+func normalizeQuestion(note map[string]interface{}) {
+	options, ok := note[mastodon.QuestionOneOf].([]interface{})
+	if !ok {
+		options, _ = note[mastodon.QuestionAnyOf].([]interface{})
+	}
+
+	var content strings.Builder
+
+	for _, value := range options {
+		option, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := option[mastodon.TagName].(string)
+
+		var votes float64
+		if replies, ok := option[mastodon.Replies].(map[string]interface{}); ok {
+			votes, _ = replies[mastodon.TotalItems].(float64)
+		}
+
+		fmt.Fprintf(&content, "<li>%s (%d votes)</li>", html.EscapeString(name), int64(votes))
+	}
+
+	if content.Len() > 0 {
+		appendContent(note, fmt.Sprintf("<ul>%s</ul>", content.String()))
+	}
+}
+
+// isMediaType checks if the media type is of an image, a video or an audio.
+func isMediaType(mediaType string) bool {
+	return lo.ContainsBy([]string{"image/", "video/", "audio/"}, func(prefix string) bool {
+		return strings.HasPrefix(mediaType, prefix)
+	})
+}
+
+// appendContent appends the HTML to the content of the note.
+func appendContent(note map[string]interface{}, content string) {
+	current, _ := note[mastodon.Content].(string)
+
+	note[mastodon.Content] = current + content
+}
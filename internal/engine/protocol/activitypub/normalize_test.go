@@ -0,0 +1,210 @@
+package activitypub
+
+import (
+	"testing"
+
+	"github.com/rss3-network/node/provider/activitypub"
+	"github.com/rss3-network/node/schema/worker/federated"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeObject(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		object       activitypub.Object
+		wantObject   activitypub.Object
+		wantPlatform federated.Platform
+	}{
+		{
+			name: "Mastodon Note",
+			object: activitypub.Object{
+				ID:        "https://mastodon.social/users/alice/statuses/113287832117292671",
+				Type:      "Create",
+				Actor:     "https://mastodon.social/users/alice",
+				Published: "2024-10-11T08:25:33Z",
+				Object: map[string]interface{}{
+					"id":      "https://mastodon.social/users/alice/statuses/113287832117292671",
+					"type":    "Note",
+					"content": "<p>Hello</p>",
+				},
+			},
+			wantObject: activitypub.Object{
+				ID:        "https://mastodon.social/users/alice/statuses/113287832117292671",
+				Type:      "Create",
+				Actor:     "https://mastodon.social/users/alice",
+				Published: "2024-10-11T08:25:33Z",
+				Object: map[string]interface{}{
+					"id":      "https://mastodon.social/users/alice/statuses/113287832117292671",
+					"type":    "Note",
+					"content": "<p>Hello</p>",
+				},
+			},
+			wantPlatform: federated.PlatformMastodon,
+		},
+		{
+			name: "Misskey quote",
+			object: activitypub.Object{
+				ID:        "https://misskey.io/notes/9xk2h3b8qz",
+				Type:      "Create",
+				Actor:     "https://misskey.io/users/9a8b7c6d5e",
+				Published: "2024-10-11T08:25:33Z",
+				Object: map[string]interface{}{
+					"id":               "https://misskey.io/notes/9xk2h3b8qz",
+					"type":             "Note",
+					"content":          "<p>Look at this</p>",
+					"_misskey_content": "Look at this",
+					"_misskey_quote":   "https://mastodon.social/users/alice/statuses/113287832117292671",
+				},
+			},
+			wantObject: activitypub.Object{
+				ID:        "https://misskey.io/notes/9xk2h3b8qz",
+				Type:      "Create",
+				Actor:     "https://misskey.io/users/9a8b7c6d5e",
+				Published: "2024-10-11T08:25:33Z",
+				Object: map[string]interface{}{
+					"id":               "https://misskey.io/notes/9xk2h3b8qz",
+					"type":             "Note",
+					"content":          "<p>Look at this</p>",
+					"_misskey_content": "Look at this",
+					"_misskey_quote":   "https://mastodon.social/users/alice/statuses/113287832117292671",
+					"quoteUrl":         "https://mastodon.social/users/alice/statuses/113287832117292671",
+				},
+			},
+			wantPlatform: federated.PlatformMisskey,
+		},
+		{
+			name: "Lemmy link post announced by a community",
+			object: activitypub.Object{
+				ID:        "https://lemmy.world/activities/announce/page/6e2d7f1a",
+				Type:      "Announce",
+				Actor:     "https://lemmy.world/c/technology",
+				Published: "2024-10-11T08:25:33Z",
+				Object: map[string]interface{}{
+					"id":    "https://lemmy.ml/activities/create/0c9a3b5e",
+					"type":  "Create",
+					"actor": "https://lemmy.ml/u/bob",
+					"object": map[string]interface{}{
+						"id":        "https://lemmy.ml/post/21483",
+						"type":      "Page",
+						"name":      "RSS is not dead",
+						"content":   "<p>A good read</p>",
+						"published": "2024-10-11T08:20:00Z",
+						"attachment": []interface{}{
+							map[string]interface{}{"type": "Link", "href": "https://example.com/rss"},
+						},
+					},
+				},
+			},
+			wantObject: activitypub.Object{
+				ID:        "https://lemmy.ml/activities/create/0c9a3b5e",
+				Type:      "Create",
+				Actor:     "https://lemmy.ml/u/bob",
+				Published: "2024-10-11T08:25:33Z",
+				Object: map[string]interface{}{
+					"id":        "https://lemmy.ml/post/21483",
+					"type":      "Page",
+					"name":      "RSS is not dead",
+					"content":   `<p>A good read</p><p><a href="https://example.com/rss">https://example.com/rss</a></p>`,
+					"published": "2024-10-11T08:20:00Z",
+					"audience":  "https://lemmy.world/c/technology",
+					"attachment": []interface{}{
+						map[string]interface{}{"type": "Link", "href": "https://example.com/rss"},
+					},
+				},
+			},
+			wantPlatform: federated.PlatformLemmy,
+		},
+		{
+			name: "PeerTube video",
+			object: activitypub.Object{
+				ID:    "https://peertube.tv/videos/watch/4b7e1f0a-2c3d-4e5f-8a9b-0c1d2e3f4a5b",
+				Type:  "Create",
+				Actor: "https://peertube.tv/accounts/carol",
+				Object: map[string]interface{}{
+					"id":        "https://peertube.tv/videos/watch/4b7e1f0a-2c3d-4e5f-8a9b-0c1d2e3f4a5b",
+					"type":      "Video",
+					"name":      "Self-hosting RSS3",
+					"content":   "<p>How to run a node</p>",
+					"published": "2024-10-11T08:20:00Z",
+					"url": []interface{}{
+						map[string]interface{}{"type": "Link", "mediaType": "text/html", "href": "https://peertube.tv/w/4b7e1f0a"},
+						map[string]interface{}{"type": "Link", "mediaType": "video/mp4", "href": "https://peertube.tv/static/web-videos/4b7e1f0a-1080.mp4"},
+						map[string]interface{}{"type": "Link", "mediaType": "application/x-mpegURL", "href": "https://peertube.tv/static/streaming-playlists/hls/master.m3u8"},
+					},
+				},
+			},
+			wantObject: activitypub.Object{
+				ID:        "https://peertube.tv/videos/watch/4b7e1f0a-2c3d-4e5f-8a9b-0c1d2e3f4a5b",
+				Type:      "Create",
+				Actor:     "https://peertube.tv/accounts/carol",
+				Published: "2024-10-11T08:20:00Z",
+				Object: map[string]interface{}{
+					"id":        "https://peertube.tv/videos/watch/4b7e1f0a-2c3d-4e5f-8a9b-0c1d2e3f4a5b",
+					"type":      "Video",
+					"name":      "Self-hosting RSS3",
+					"content":   "<p>How to run a node</p>",
+					"published": "2024-10-11T08:20:00Z",
+					"url": []interface{}{
+						map[string]interface{}{"type": "Link", "mediaType": "text/html", "href": "https://peertube.tv/w/4b7e1f0a"},
+						map[string]interface{}{"type": "Link", "mediaType": "video/mp4", "href": "https://peertube.tv/static/web-videos/4b7e1f0a-1080.mp4"},
+						map[string]interface{}{"type": "Link", "mediaType": "application/x-mpegURL", "href": "https://peertube.tv/static/streaming-playlists/hls/master.m3u8"},
+					},
+					"attachment": []interface{}{
+						map[string]interface{}{"url": "https://peertube.tv/static/web-videos/4b7e1f0a-1080.mp4", "mediaType": "video/mp4"},
+					},
+				},
+			},
+			wantPlatform: federated.PlatformPeerTube,
+		},
+		{
+			name: "Pleroma poll",
+			object: activitypub.Object{
+				ID:        "https://pleroma.site/activities/5f1e2d3c",
+				Type:      "Create",
+				Actor:     "https://pleroma.site/users/dave",
+				Published: "2024-10-11T08:25:33Z",
+				Object: map[string]interface{}{
+					"id":      "https://pleroma.site/objects/a1b2c3d4",
+					"type":    "Question",
+					"content": "<p>Tabs or spaces?</p>",
+					"oneOf": []interface{}{
+						map[string]interface{}{"type": "Note", "name": "Tabs", "replies": map[string]interface{}{"type": "Collection", "totalItems": float64(12)}},
+						map[string]interface{}{"type": "Note", "name": "Spaces", "replies": map[string]interface{}{"type": "Collection", "totalItems": float64(7)}},
+					},
+				},
+			},
+			wantObject: activitypub.Object{
+				ID:        "https://pleroma.site/activities/5f1e2d3c",
+				Type:      "Create",
+				Actor:     "https://pleroma.site/users/dave",
+				Published: "2024-10-11T08:25:33Z",
+				Object: map[string]interface{}{
+					"id":      "https://pleroma.site/objects/a1b2c3d4",
+					"type":    "Question",
+					"content": "<p>Tabs or spaces?</p><ul><li>Tabs (12 votes)</li><li>Spaces (7 votes)</li></ul>",
+					"oneOf": []interface{}{
+						map[string]interface{}{"type": "Note", "name": "Tabs", "replies": map[string]interface{}{"type": "Collection", "totalItems": float64(12)}},
+						map[string]interface{}{"type": "Note", "name": "Spaces", "replies": map[string]interface{}{"type": "Collection", "totalItems": float64(7)}},
+					},
+				},
+			},
+			wantPlatform: federated.PlatformPleroma,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			object := testcase.object
+
+			require.NoError(t, normalizeObject(&object))
+			require.Equal(t, testcase.wantObject, object)
+			require.Equal(t, testcase.wantPlatform, detectPlatform(object))
+		})
+	}
+}
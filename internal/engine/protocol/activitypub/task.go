@@ -6,6 +6,7 @@ import (
 
 	"github.com/rss3-network/node/internal/engine"
 	"github.com/rss3-network/node/provider/activitypub"
+	"github.com/rss3-network/node/schema/worker/federated"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
 	"github.com/rss3-network/protocol-go/schema/network"
 	"github.com/rss3-network/protocol-go/schema/typex"
@@ -15,8 +16,9 @@ import (
 var _ engine.Task = (*Task)(nil)
 
 type Task struct {
-	Network network.Network
-	Message activitypub.Object
+	Network  network.Network
+	Platform federated.Platform
+	Message  activitypub.Object
 }
 
 func (t Task) ID() string {
@@ -20,6 +20,7 @@ import (
 	"github.com/rss3-network/node/config"
 	"github.com/rss3-network/node/config/parameter"
 	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/internal/telemetry/meter"
 	"github.com/rss3-network/node/provider/arweave"
 	"github.com/rss3-network/node/provider/arweave/bundle"
 	"github.com/rss3-network/node/provider/arweave/bundle/irys"
@@ -89,6 +90,7 @@ func (s *dataSource) Start(ctx context.Context, tasksChan chan<- *engine.Tasks,
 
 		err := retry.Do(retryableFunc,
 			retry.Attempts(0),
+			retry.Context(ctx),
 			retry.Delay(time.Second),
 			retry.DelayType(retry.BackOffDelay),
 			retry.MaxDelay(5*time.Minute),
@@ -156,7 +158,9 @@ func (s *dataSource) pollBlocks(ctx context.Context, tasksChan chan<- *engine.Ta
 		blockHeightLatestRemote = int64(s.option.BlockTarget.Uint64())
 	} else {
 		// Get remote block height from arweave network.
-		blockHeightLatestRemote, err = s.arweaveClient.GetBlockHeight(ctx)
+		blockHeightLatestRemote, err = meter.ObserveRPC(ctx, s.Network(), "info", func() (int64, error) {
+			return s.arweaveClient.GetBlockHeight(ctx)
+		})
 		if err != nil {
 			return fmt.Errorf("get latest block height: %w", err)
 		}
@@ -181,7 +185,9 @@ func (s *dataSource) pollBlocks(ctx context.Context, tasksChan chan<- *engine.Ta
 		// Check if block height is latest.
 		if s.state.BlockHeight >= uint64(blockHeightLatestRemote) {
 			// Get the latest block height from arweave network for reconfirming.
-			if blockHeightLatestRemote, err = s.arweaveClient.GetBlockHeight(ctx); err != nil {
+			if blockHeightLatestRemote, err = meter.ObserveRPC(ctx, s.Network(), "info", func() (int64, error) {
+				return s.arweaveClient.GetBlockHeight(ctx)
+			}); err != nil {
 				return fmt.Errorf("get latest block height: %w", err)
 			}
 
@@ -426,7 +432,9 @@ func (s *dataSource) batchPullBlocks(ctx context.Context, blockHeights []*big.In
 
 		resultPool.Go(func(ctx context.Context) (*arweave.Block, error) {
 			retryableFunc := func() (*arweave.Block, error) {
-				return s.arweaveClient.GetBlockByHeight(ctx, blockHeight.Int64())
+				return meter.ObserveRPC(ctx, s.Network(), "block", func() (*arweave.Block, error) {
+					return s.arweaveClient.GetBlockByHeight(ctx, blockHeight.Int64())
+				})
 			}
 
 			return retry.DoWithData(
@@ -465,7 +473,9 @@ func (s *dataSource) batchPullTransactions(ctx context.Context, arweaveClient ar
 
 		resultPool.Go(func(ctx context.Context) (*arweave.Transaction, error) {
 			retryableFunc := func() (*arweave.Transaction, error) {
-				return arweaveClient.GetTransactionByID(ctx, transactionID)
+				return meter.ObserveRPC(ctx, s.Network(), "tx", func() (*arweave.Transaction, error) {
+					return arweaveClient.GetTransactionByID(ctx, transactionID)
+				})
 			}
 
 			return retry.DoWithData(
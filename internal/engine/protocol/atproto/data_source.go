@@ -95,6 +95,22 @@ func (s *dataSource) pollSubscribeRepos(ctx context.Context, tasksChan chan<- *e
 			messages := make([]*at.Message, 0)
 
 			for _, op := range evt.Ops {
+				// Deleted records are no longer available, so they are built from the operations
+				if op.Action == at.ActionDelete {
+					message, err := s.client.GetDeletedRecord(ctx, evt.Repo, op.Path, evt.Time)
+					if err != nil {
+						zap.L().Error("get deleted repo record failed", zap.Error(err), zap.String("repo", evt.Repo), zap.Any("op", op))
+
+						return fmt.Errorf("get deleted repo record failed: %w", err)
+					}
+
+					if message != nil {
+						messages = append(messages, message)
+					}
+
+					continue
+				}
+
 				if op.Cid == nil {
 					continue
 				}
@@ -108,6 +124,7 @@ func (s *dataSource) pollSubscribeRepos(ctx context.Context, tasksChan chan<- *e
 				}
 
 				if message != nil {
+					message.Action = op.Action
 					messages = append(messages, message)
 				}
 			}
@@ -186,6 +203,7 @@ func (s *dataSource) retrySource(ctx context.Context, tasksChan chan<- *engine.T
 			return sourceFunc(ctx, tasksChan)
 		},
 		retry.Attempts(0),
+		retry.Context(ctx),
 		retry.Delay(5*time.Second),
 		retry.DelayType(retry.BackOffDelay),
 		retry.OnRetry(func(n uint, err error) {
@@ -0,0 +1,296 @@
+package bitcoin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/internal/telemetry/meter"
+	"github.com/rss3-network/node/provider/bitcoin"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultPollInterval is the interval to poll the best block, which is far shorter than the block time
+	// so blocks generated on demand by a regtest node are indexed soon.
+	defaultPollInterval = 10 * time.Second
+	// maxBlockHashes is the number of recent block hashes kept in the state, which is the depth of reorgs recovered.
+	maxBlockHashes = 100
+)
+
+// Ensure that dataSource implements DataSource.
+var _ engine.DataSource = (*dataSource)(nil)
+
+type dataSource struct {
+	config        *config.Module
+	option        *Option
+	bitcoinClient bitcoin.Client
+	state         State
+}
+
+func (s *dataSource) Network() network.Network {
+	return s.config.Network
+}
+
+func (s *dataSource) State() json.RawMessage {
+	return lo.Must(json.Marshal(s.state))
+}
+
+func (s *dataSource) Start(ctx context.Context, tasksChan chan<- *engine.Tasks, errorChan chan<- error) {
+	zap.L().Info("starting bitcoin data source", zap.String("api", s.option.API))
+
+	// Initialize dataSource.
+	if err := s.initialize(ctx); err != nil {
+		errorChan <- fmt.Errorf("initialize dataSource: %w", err)
+
+		return
+	}
+
+	// Start a goroutine to poll blocks.
+	go s.startPolling(ctx, tasksChan, errorChan)
+
+	zap.L().Info("successfully started bitcoin data source")
+}
+
+func (s *dataSource) startPolling(ctx context.Context, tasksChan chan<- *engine.Tasks, errorChan chan<- error) {
+	retryableFunc := func() error {
+		if err := s.pollBlocks(ctx, tasksChan); err != nil {
+			return fmt.Errorf("poll blocks: %w", err)
+		}
+
+		return nil
+	}
+
+	err := retry.Do(retryableFunc,
+		retry.Attempts(0),
+		retry.Context(ctx),
+		retry.Delay(time.Second),
+		retry.DelayType(retry.BackOffDelay),
+		retry.MaxDelay(5*time.Minute),
+		retry.OnRetry(func(n uint, err error) {
+			zap.L().Error("retrying bitcoin data source start", zap.Uint("retry", n), zap.Error(err))
+		}),
+	)
+	if err != nil {
+		errorChan <- err
+	}
+}
+
+// initialize initializes the dataSource.
+func (s *dataSource) initialize(ctx context.Context) (err error) {
+	if s.bitcoinClient, err = bitcoin.Dial(ctx, s.config.Endpoint.URL, s.option.API); err != nil {
+		return fmt.Errorf("create bitcoin client: %w", err)
+	}
+
+	return nil
+}
+
+// getConfirmedBlockHeight returns the height of the last block with enough confirmations.
+func (s *dataSource) getConfirmedBlockHeight(ctx context.Context) (uint64, error) {
+	blockHeightLatestRemote, err := meter.ObserveRPC(ctx, s.Network(), "getblockcount", func() (uint64, error) {
+		return s.bitcoinClient.GetBlockHeight(ctx)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("get latest block height: %w", err)
+	}
+
+	blockHeight := blockHeightLatestRemote - min(blockHeightLatestRemote, *s.option.Confirmations)
+
+	if s.option.BlockTarget != nil {
+		blockHeight = min(blockHeight, s.option.BlockTarget.Uint64())
+	}
+
+	return blockHeight, nil
+}
+
+// nextBlockHeight returns the height of the next block to index.
+func (s *dataSource) nextBlockHeight(blockHeightConfirmed uint64) uint64 {
+	// Index from the block start, or the last confirmed block without a checkpoint
+	if s.state.BlockHeight == 0 && s.state.BlockHash() == "" {
+		if s.option.BlockStart != nil {
+			return s.option.BlockStart.Uint64()
+		}
+
+		return blockHeightConfirmed
+	}
+
+	// The block start takes precedence if it is ahead of the state
+	if s.option.BlockStart != nil && s.option.BlockStart.Uint64() > s.state.BlockHeight+1 {
+		return s.option.BlockStart.Uint64()
+	}
+
+	return s.state.BlockHeight + 1
+}
+
+// pollBlocks polls blocks one by one, each block is checked to be the child of the last block indexed.
+func (s *dataSource) pollBlocks(ctx context.Context, tasksChan chan<- *engine.Tasks) error {
+	for {
+		blockHeightConfirmed, err := s.getConfirmedBlockHeight(ctx)
+		if err != nil {
+			return err
+		}
+
+		blockHeight := s.nextBlockHeight(blockHeightConfirmed)
+
+		if blockHeight > blockHeightConfirmed {
+			if s.option.BlockTarget != nil && s.option.BlockTarget.Uint64() < blockHeight {
+				zap.L().Info("reached target block height", zap.Uint64("height", s.state.BlockHeight))
+
+				return nil
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(defaultPollInterval):
+			}
+
+			continue
+		}
+
+		block, err := s.getBlock(ctx, blockHeight)
+		if err != nil {
+			return fmt.Errorf("get block %d: %w", blockHeight, err)
+		}
+
+		// The last block indexed is not in the best chain anymore
+		if blockHeight == s.state.BlockHeight+1 && s.state.BlockHash() != "" && block.PreviousBlockHash != s.state.BlockHash() {
+			if err := s.rewind(ctx); err != nil {
+				return fmt.Errorf("rewind to fork point: %w", err)
+			}
+
+			continue
+		}
+
+		tasks := s.buildTasks(block)
+
+		select {
+		case tasksChan <- tasks:
+		case <-ctx.Done():
+			return nil
+		}
+
+		s.saveBlock(block)
+	}
+}
+
+func (s *dataSource) getBlock(ctx context.Context, blockHeight uint64) (*bitcoin.Block, error) {
+	return retry.DoWithData(
+		func() (*bitcoin.Block, error) {
+			blockHash, err := meter.ObserveRPC(ctx, s.Network(), "getblockhash", func() (string, error) {
+				return s.bitcoinClient.GetBlockHash(ctx, blockHeight)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("get block hash: %w", err)
+			}
+
+			return meter.ObserveRPC(ctx, s.Network(), "getblock", func() (*bitcoin.Block, error) {
+				return s.bitcoinClient.GetBlock(ctx, blockHash)
+			})
+		},
+		retry.Context(ctx),
+		retry.Attempts(defaultRetryAttempts),
+		retry.Delay(defaultRetryDelay),
+		retry.DelayType(retry.BackOffDelay),
+		retry.OnRetry(func(attempt uint, err error) {
+			zap.L().Error("retrying block pull", zap.Uint64("height", blockHeight), zap.Uint("attempt", attempt), zap.Error(err))
+		}),
+	)
+}
+
+// rewind rewinds the state to the last block indexed that is still in the best chain,
+// so the blocks after the fork point are indexed again. The activities indexed again replace the previous ones.
+func (s *dataSource) rewind(ctx context.Context) error {
+	for len(s.state.BlockHashes) > 0 {
+		blockHash, err := meter.ObserveRPC(ctx, s.Network(), "getblockhash", func() (string, error) {
+			return s.bitcoinClient.GetBlockHash(ctx, s.state.BlockHeight)
+		})
+		if err != nil {
+			return fmt.Errorf("get block hash %d: %w", s.state.BlockHeight, err)
+		}
+
+		if blockHash == s.state.BlockHash() {
+			zap.L().Warn("rewound to fork point after reorg", zap.Uint64("height", s.state.BlockHeight), zap.String("hash", blockHash))
+
+			return nil
+		}
+
+		s.state.BlockHashes = s.state.BlockHashes[:len(s.state.BlockHashes)-1]
+		s.state.BlockHeight--
+	}
+
+	// The fork point is deeper than the recent blocks kept, which are all indexed again
+	zap.L().Error("reorg is deeper than the recent blocks kept", zap.Uint64("height", s.state.BlockHeight))
+
+	return nil
+}
+
+// saveBlock saves the block as the last block indexed.
+func (s *dataSource) saveBlock(block *bitcoin.Block) {
+	if block.Height != s.state.BlockHeight+1 {
+		s.state.BlockHashes = nil
+	}
+
+	s.state.BlockHeight = block.Height
+	s.state.BlockHashes = append(s.state.BlockHashes, block.Hash)
+	s.state.BlockTimestamp = uint64(block.Time)
+
+	if len(s.state.BlockHashes) > maxBlockHashes {
+		s.state.BlockHashes = s.state.BlockHashes[len(s.state.BlockHashes)-maxBlockHashes:]
+	}
+}
+
+// buildTasks builds a task for each transaction of the block, coinbase transactions are skipped as they spend no outputs.
+func (s *dataSource) buildTasks(block *bitcoin.Block) *engine.Tasks {
+	var tasks engine.Tasks
+
+	for _, transaction := range block.Transactions {
+		if transaction.IsCoinbase() {
+			continue
+		}
+
+		tasks.Tasks = append(tasks.Tasks, &Task{
+			Network:     s.Network(),
+			BlockHeight: block.Height,
+			BlockHash:   block.Hash,
+			BlockTime:   block.Time,
+			Transaction: transaction,
+		})
+	}
+
+	zap.L().Debug("built tasks of block", zap.Uint64("height", block.Height), zap.Int("tasks", len(tasks.Tasks)))
+
+	return &tasks
+}
+
+// NewSource creates a new bitcoin dataSource.
+func NewSource(config *config.Module, checkpoint *engine.Checkpoint) (engine.DataSource, error) {
+	var (
+		state State
+		err   error
+	)
+
+	// Initialize state from checkpoint.
+	if checkpoint != nil && len(checkpoint.State) > 0 {
+		if err := json.Unmarshal(checkpoint.State, &state); err != nil {
+			return nil, fmt.Errorf("unmarshal checkpoint state: %w", err)
+		}
+	}
+
+	instance := dataSource{
+		config: config,
+		state:  state,
+	}
+
+	if instance.option, err = NewOption(config.Parameters); err != nil {
+		return nil, fmt.Errorf("parse config: %w", err)
+	}
+
+	return &instance, nil
+}
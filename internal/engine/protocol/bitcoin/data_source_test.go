@@ -0,0 +1,248 @@
+package bitcoin_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/engine"
+	source "github.com/rss3-network/node/internal/engine/protocol/bitcoin"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+// blockHash returns the hash of the block at the height of the branch.
+func blockHash(branch string, height uint64) string {
+	return fmt.Sprintf("%s%060x", branch, height)
+}
+
+// newNode starts a local JSON-RPC server of Bitcoin Core, of which the best chain is the main branch until the fork height
+// and the fork branch after. Every block has a coinbase transaction and a transaction named after the block hash.
+func newNode(t *testing.T, tip, forkHeight uint64) string {
+	t.Helper()
+
+	branchOf := func(height uint64) string {
+		return lo.Ternary(height > forkHeight, "f0f0", "0000")
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		var body struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		var result any
+
+		switch body.Method {
+		case "getblockcount":
+			result = tip
+		case "getblockhash":
+			var height uint64
+			_ = json.Unmarshal(body.Params[0], &height)
+
+			result = blockHash(branchOf(height), height)
+		case "getblock":
+			var hash string
+			_ = json.Unmarshal(body.Params[0], &hash)
+
+			var height uint64
+			_, _ = fmt.Sscanf(hash[4:], "%x", &height)
+
+			result = map[string]any{
+				"hash":              hash,
+				"height":            height,
+				"time":              1731405600 + height*600,
+				"previousblockhash": blockHash(branchOf(height-1), height-1),
+				"tx": []map[string]any{
+					{"txid": "c" + hash[1:], "vin": []map[string]any{{"coinbase": "0165"}}, "vout": []map[string]any{}},
+					{"txid": hash, "vin": []map[string]any{{"txid": blockHash("0000", 1), "vout": 0}}, "vout": []map[string]any{}},
+				},
+			}
+		}
+
+		_ = json.NewEncoder(writer).Encode(map[string]any{"result": result, "error": nil, "id": "rss3"})
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+func TestSource(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		tip        uint64
+		forkHeight uint64
+		parameters *config.Parameters
+		checkpoint *source.State
+		want       []string
+		wantState  source.State
+	}{
+		{
+			name:       "From the block start",
+			tip:        103,
+			forkHeight: 103,
+			parameters: &config.Parameters{
+				"block_start":   101,
+				"block_target":  102,
+				"confirmations": 1,
+			},
+			want: []string{
+				blockHash("0000", 101),
+				blockHash("0000", 102),
+			},
+			wantState: source.State{
+				BlockHeight:    102,
+				BlockHashes:    []string{blockHash("0000", 101), blockHash("0000", 102)},
+				BlockTimestamp: 1731405600 + 102*600,
+			},
+		},
+		{
+			name:       "Rewind after reorg",
+			tip:        102,
+			forkHeight: 100,
+			parameters: &config.Parameters{
+				"block_target":  102,
+				"confirmations": 0,
+			},
+			checkpoint: &source.State{
+				BlockHeight: 101,
+				BlockHashes: []string{blockHash("0000", 99), blockHash("0000", 100), blockHash("0000", 101)},
+			},
+			want: []string{
+				blockHash("f0f0", 101),
+				blockHash("f0f0", 102),
+			},
+			wantState: source.State{
+				BlockHeight:    102,
+				BlockHashes:    []string{blockHash("0000", 99), blockHash("0000", 100), blockHash("f0f0", 101), blockHash("f0f0", 102)},
+				BlockTimestamp: 1731405600 + 102*600,
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			module := config.Module{
+				Endpoint: config.Endpoint{
+					URL: newNode(t, testcase.tip, testcase.forkHeight),
+				},
+				Parameters: testcase.parameters,
+			}
+
+			var checkpoint *engine.Checkpoint
+			if testcase.checkpoint != nil {
+				checkpoint = &engine.Checkpoint{State: lo.Must(json.Marshal(testcase.checkpoint))}
+			}
+
+			instance, err := source.NewSource(&module, checkpoint)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			tasksChan := make(chan *engine.Tasks)
+			errorChan := make(chan error)
+
+			instance.Start(ctx, tasksChan, errorChan)
+
+			// Coinbase transactions are skipped
+			var txIDs []string
+
+			for len(txIDs) < len(testcase.want) {
+				select {
+				case tasks := <-tasksChan:
+					for _, task := range tasks.Tasks {
+						txIDs = append(txIDs, task.(*source.Task).Transaction.TxID)
+					}
+				case err := <-errorChan:
+					require.NoError(t, err)
+				case <-time.After(10 * time.Second):
+					require.FailNow(t, "timeout waiting for tasks")
+				}
+			}
+
+			require.Equal(t, testcase.want, txIDs)
+
+			require.Eventually(t, func() bool {
+				var state source.State
+
+				return json.Unmarshal(instance.State(), &state) == nil && state.BlockHeight == testcase.wantState.BlockHeight
+			}, 5*time.Second, 10*time.Millisecond)
+
+			var state source.State
+			require.NoError(t, json.Unmarshal(instance.State(), &state))
+			require.Equal(t, testcase.wantState, state)
+		})
+	}
+}
+
+func TestNewOption(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		parameters *config.Parameters
+		want       *source.Option
+		wantError  require.ErrorAssertionFunc
+	}{
+		{
+			name: "Default",
+			want: &source.Option{
+				API:           "rpc",
+				Confirmations: lo.ToPtr(uint64(6)),
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Esplora on regtest",
+			parameters: &config.Parameters{
+				"api":           "esplora",
+				"confirmations": 0,
+			},
+			want: &source.Option{
+				API:           "esplora",
+				Confirmations: lo.ToPtr(uint64(0)),
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Unsupported API",
+			parameters: &config.Parameters{
+				"api": "electrum",
+			},
+			wantError: require.Error,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			option, err := source.NewOption(testcase.parameters)
+			testcase.wantError(t, err)
+
+			if err == nil {
+				require.Equal(t, testcase.want, option)
+			}
+		})
+	}
+}
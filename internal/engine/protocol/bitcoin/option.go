@@ -0,0 +1,53 @@
+package bitcoin
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/provider/bitcoin"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultConfirmations = uint64(6)
+	defaultRetryAttempts = uint(10)
+	defaultRetryDelay    = 500 * time.Millisecond
+)
+
+type Option struct {
+	// API is the API of the endpoint, which is the JSON-RPC of Bitcoin Core or the Esplora API.
+	API string `json:"api" mapstructure:"api"`
+	// BlockStart is the block height on Bitcoin that the worker should start from.
+	BlockStart *big.Int `json:"block_start" mapstructure:"block_start"`
+	// BlockTarget is the block height on Bitcoin that the worker should stop at.
+	BlockTarget *big.Int `json:"block_target" mapstructure:"block_target"`
+	// Confirmations is the number of blocks on top of a block before it is indexed, a regtest node may set it to 0.
+	Confirmations *uint64 `json:"confirmations" mapstructure:"confirmations"`
+}
+
+func NewOption(parameters *config.Parameters) (*Option, error) {
+	var option Option
+
+	if parameters != nil {
+		if err := parameters.Decode(&option); err != nil {
+			return nil, err
+		}
+	}
+
+	// Set default values.
+	if option.API == "" {
+		option.API = bitcoin.APIRPC
+	}
+
+	if !lo.Contains([]string{bitcoin.APIRPC, bitcoin.APIEsplora}, option.API) {
+		return nil, fmt.Errorf("unsupported api %s", option.API)
+	}
+
+	if option.Confirmations == nil {
+		option.Confirmations = lo.ToPtr(defaultConfirmations)
+	}
+
+	return &option, nil
+}
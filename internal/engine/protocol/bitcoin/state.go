@@ -0,0 +1,18 @@
+package bitcoin
+
+type State struct {
+	BlockHeight uint64 `json:"block_height,omitempty"`
+	// BlockHashes are the hashes of the recent blocks indexed in ascending order, the last one is of the block height.
+	// They are compared with the blocks of the best chain to find the fork point after a reorg.
+	BlockHashes    []string `json:"block_hashes,omitempty"`
+	BlockTimestamp uint64   `json:"block_timestamp,omitempty"`
+}
+
+// BlockHash returns the hash of the last block indexed, or an empty string if it is unknown.
+func (s State) BlockHash() string {
+	if len(s.BlockHashes) == 0 {
+		return ""
+	}
+
+	return s.BlockHashes[len(s.BlockHashes)-1]
+}
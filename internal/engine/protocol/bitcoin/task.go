@@ -0,0 +1,58 @@
+package bitcoin
+
+import (
+	"fmt"
+
+	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/provider/bitcoin"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/typex"
+)
+
+var _ engine.Task = (*Task)(nil)
+
+type Task struct {
+	Network     network.Network
+	BlockHeight uint64
+	BlockHash   string
+	BlockTime   int64
+	Transaction bitcoin.Transaction
+}
+
+func (t Task) ID() string {
+	return fmt.Sprintf("%s-%s", t.Network, t.Transaction.TxID)
+}
+
+func (t Task) GetNetwork() network.Network {
+	return t.Network
+}
+
+func (t Task) GetTimestamp() uint64 {
+	return uint64(t.BlockTime)
+}
+
+func (t Task) Validate() error {
+	return nil
+}
+
+// BuildActivity builds an activity from the task, the addresses and the fee are left to the worker
+// as they are known after the prevouts of the inputs are resolved.
+func (t Task) BuildActivity(options ...activityx.Option) (*activityx.Activity, error) {
+	activity := activityx.Activity{
+		ID:        t.Transaction.TxID,
+		Network:   t.Network,
+		Type:      typex.Unknown,
+		Status:    true,
+		Actions:   make([]*activityx.Action, 0),
+		Timestamp: t.GetTimestamp(),
+	}
+
+	for _, option := range options {
+		if err := option(&activity); err != nil {
+			return nil, fmt.Errorf("apply option: %w", err)
+		}
+	}
+
+	return &activity, nil
+}
@@ -0,0 +1,181 @@
+package protocol
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/internal/engine/protocol/activitypub"
+	"github.com/rss3-network/node/internal/engine/protocol/arweave"
+	"github.com/rss3-network/node/internal/engine/protocol/atproto"
+	"github.com/rss3-network/node/internal/engine/protocol/bitcoin"
+	"github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/internal/engine/protocol/farcaster"
+	"github.com/rss3-network/node/internal/engine/protocol/near"
+	"github.com/rss3-network/node/internal/engine/protocol/rss"
+	"github.com/rss3-network/protocol-go/schema/network"
+)
+
+var ErrorUnsupportedPosition = errors.New("unsupported position")
+
+// Position is the point to move the checkpoint of a worker to, fields left nil are not changed.
+type Position struct {
+	// BlockNumber is the next block number or height to index.
+	BlockNumber *uint64 `json:"block_number,omitempty" query:"block_number"`
+	// EventID is the event id, cursor or offset to resume from.
+	EventID *uint64 `json:"event_id,omitempty" query:"event_id"`
+	// Timestamp is the timestamp in seconds recorded by the states which keep one.
+	Timestamp *uint64 `json:"timestamp,omitempty" query:"timestamp"`
+}
+
+// IsEmpty returns true if no field of the position is set.
+func (p Position) IsEmpty() bool {
+	return p.BlockNumber == nil && p.EventID == nil && p.Timestamp == nil
+}
+
+// DecodeState decodes the checkpoint state of the network to the state of its protocol.
+func DecodeState(network network.Network, state json.RawMessage) (any, error) {
+	value, err := newState(network)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(state) > 0 {
+		if err := json.Unmarshal(state, value); err != nil {
+			return nil, fmt.Errorf("unmarshal %s state: %w", NetworkProtocol(network), err)
+		}
+	}
+
+	return value, nil
+}
+
+// MoveState moves the checkpoint state of the network to the position.
+func MoveState(network network.Network, state json.RawMessage, position Position) (json.RawMessage, error) {
+	if position.IsEmpty() {
+		return nil, fmt.Errorf("%w: empty position", ErrorUnsupportedPosition)
+	}
+
+	value, err := DecodeState(network, state)
+	if err != nil {
+		return nil, err
+	}
+
+	unsupported := func(supported string) error {
+		return fmt.Errorf("%w: the %s protocol supports %s", ErrorUnsupportedPosition, NetworkProtocol(network), supported)
+	}
+
+	switch value := value.(type) {
+	case *ethereum.State:
+		if position.EventID != nil || position.Timestamp != nil || position.BlockNumber == nil {
+			return nil, unsupported("block number only")
+		}
+
+		// The data source starts from the next block of the state, and the hash of the block is unknown.
+		value.BlockNumber = previousBlock(*position.BlockNumber)
+		value.BlockHash = common.Hash{}
+	case *arweave.State:
+		if position.EventID != nil {
+			return nil, unsupported("block number and timestamp")
+		}
+
+		if position.BlockNumber != nil {
+			value.BlockHeight = previousBlock(*position.BlockNumber)
+			value.Cursor = ""
+		}
+
+		if position.Timestamp != nil {
+			value.BlockTimestamp = *position.Timestamp
+		}
+	case *near.State:
+		if position.EventID != nil {
+			return nil, unsupported("block number and timestamp")
+		}
+
+		if position.BlockNumber != nil {
+			value.BlockHeight = previousBlock(*position.BlockNumber)
+		}
+
+		if position.Timestamp != nil {
+			value.BlockTimestamp = *position.Timestamp
+		}
+	case *bitcoin.State:
+		if position.EventID != nil || position.Timestamp != nil || position.BlockNumber == nil {
+			return nil, unsupported("block number only")
+		}
+
+		// The hashes of the blocks before are unknown, so the block at the position is not checked for reorgs.
+		value.BlockHeight = previousBlock(*position.BlockNumber)
+		value.BlockHashes = nil
+	case *farcaster.State:
+		if position.BlockNumber != nil || position.Timestamp != nil || position.EventID == nil {
+			return nil, unsupported("event id only")
+		}
+
+		value.EventID = *position.EventID
+	case *atproto.State:
+		if position.BlockNumber != nil {
+			return nil, unsupported("event id and timestamp")
+		}
+
+		if position.EventID != nil {
+			value.SubscribeCursor = int64(*position.EventID)
+		}
+
+		if position.Timestamp != nil {
+			value.SubscribeTimestamp = int64(*position.Timestamp)
+		}
+	case *rss.State:
+		if position.BlockNumber != nil || position.EventID != nil || position.Timestamp == nil {
+			return nil, unsupported("timestamp only")
+		}
+
+		// Every feed is fetched again and indexes the items published since the timestamp.
+		for _, feedState := range value.Feeds {
+			*feedState = rss.FeedState{
+				LastItemTimestamp: *position.Timestamp,
+			}
+		}
+	case *activitypub.State:
+		// The data source resumes from the offset of its Kafka consumer group instead of the state.
+		return nil, fmt.Errorf("%w: the %s protocol does not resume from the checkpoint", ErrorUnsupportedPosition, NetworkProtocol(network))
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("marshal state: %w", err)
+	}
+
+	return data, nil
+}
+
+func newState(value network.Network) (any, error) {
+	switch NetworkProtocol(value) {
+	case network.EthereumProtocol:
+		return new(ethereum.State), nil
+	case network.ArweaveProtocol:
+		return new(arweave.State), nil
+	case network.FarcasterProtocol:
+		return new(farcaster.State), nil
+	case network.ActivityPubProtocol:
+		return new(activitypub.State), nil
+	case network.NearProtocol:
+		return new(near.State), nil
+	case network.ATProtocol:
+		return new(atproto.State), nil
+	case network.RSSProtocol:
+		return new(rss.State), nil
+	case BitcoinProtocol:
+		return new(bitcoin.State), nil
+	default:
+		return nil, fmt.Errorf("unsupported network protocol %s", value)
+	}
+}
+
+func previousBlock(blockNumber uint64) uint64 {
+	if blockNumber == 0 {
+		return 0
+	}
+
+	return blockNumber - 1
+}
@@ -0,0 +1,129 @@
+package protocol_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/rss3-network/node/internal/engine/protocol"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveState(t *testing.T) {
+	t.Parallel()
+
+	type arguments struct {
+		network  network.Network
+		state    json.RawMessage
+		position protocol.Position
+	}
+
+	testcases := []struct {
+		name      string
+		arguments arguments
+		want      string
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name: "Rewind Ethereum",
+			arguments: arguments{
+				network:  network.Ethereum,
+				state:    json.RawMessage(`{"block_hash":"0x5c4b4e0a7d46d3d4ad7ce8a8d2f34a3dbd6d4e1fb0c5c3b6e5d0a9b1e2f3a4b5","block_number":19000000}`),
+				position: protocol.Position{BlockNumber: lo.ToPtr(uint64(18000000))},
+			},
+			want:      `{"block_hash":"0x0000000000000000000000000000000000000000000000000000000000000000","block_number":17999999}`,
+			wantError: require.NoError,
+		},
+		{
+			name: "Rewind Bitcoin",
+			arguments: arguments{
+				network:  network.Bitcoin,
+				state:    json.RawMessage(`{"block_height":870000,"block_hashes":["00000000000000000001b8bd7f1b4e5e0b3a4c3f6e0a9d1c2b3a4f5e6d7c8b9a"],"block_timestamp":1731405600}`),
+				position: protocol.Position{BlockNumber: lo.ToPtr(uint64(860000))},
+			},
+			want:      `{"block_height":859999,"block_timestamp":1731405600}`,
+			wantError: require.NoError,
+		},
+		{
+			name: "Fast-forward Farcaster",
+			arguments: arguments{
+				network:  network.Farcaster,
+				state:    json.RawMessage(`{"event_id":100,"casts_fid":10,"casts_backfill":true,"reactions_fid":0,"reactions_backfill":false}`),
+				position: protocol.Position{EventID: lo.ToPtr(uint64(200))},
+			},
+			want:      `{"event_id":200,"casts_fid":10,"casts_backfill":true,"reactions_fid":0,"reactions_backfill":false}`,
+			wantError: require.NoError,
+		},
+		{
+			name: "Empty state of Arweave",
+			arguments: arguments{
+				network:  network.Arweave,
+				state:    json.RawMessage(`{}`),
+				position: protocol.Position{BlockNumber: lo.ToPtr(uint64(1000)), Timestamp: lo.ToPtr(uint64(1700000000))},
+			},
+			want:      `{"block_height":999,"block_timestamp":1700000000}`,
+			wantError: require.NoError,
+		},
+		{
+			name: "Rewind RSS feeds",
+			arguments: arguments{
+				network:  network.RSSHub,
+				state:    json.RawMessage(`{"feeds":{"https://rss3.io/blog/rss.xml":{"etag":"\"v1\"","last_item_id":"hello-1","last_item_timestamp":1725271200}}}`),
+				position: protocol.Position{Timestamp: lo.ToPtr(uint64(1700000000))},
+			},
+			want:      `{"feeds":{"https://rss3.io/blog/rss.xml":{"last_item_timestamp":1700000000}}}`,
+			wantError: require.NoError,
+		},
+		{
+			name: "Block number of RSS",
+			arguments: arguments{
+				network:  network.RSSHub,
+				state:    json.RawMessage(`{}`),
+				position: protocol.Position{BlockNumber: lo.ToPtr(uint64(1000))},
+			},
+			wantError: require.Error,
+		},
+		{
+			name: "Event id of Ethereum",
+			arguments: arguments{
+				network:  network.Ethereum,
+				state:    json.RawMessage(`{}`),
+				position: protocol.Position{EventID: lo.ToPtr(uint64(1))},
+			},
+			wantError: require.Error,
+		},
+		{
+			name: "Empty position",
+			arguments: arguments{
+				network: network.Near,
+				state:   json.RawMessage(`{}`),
+			},
+			wantError: require.Error,
+		},
+		{
+			name: "Mastodon",
+			arguments: arguments{
+				network:  network.Mastodon,
+				state:    json.RawMessage(`{"last_offset":10}`),
+				position: protocol.Position{EventID: lo.ToPtr(uint64(1))},
+			},
+			wantError: require.Error,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, err := protocol.MoveState(testcase.arguments.network, testcase.arguments.state, testcase.arguments.position)
+			testcase.wantError(t, err)
+
+			if err == nil {
+				require.JSONEq(t, testcase.want, string(state))
+			}
+		})
+	}
+}
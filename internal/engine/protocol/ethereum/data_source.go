@@ -15,6 +15,7 @@ import (
 	"github.com/rss3-network/node/config"
 	"github.com/rss3-network/node/config/parameter"
 	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/internal/telemetry/meter"
 	"github.com/rss3-network/node/provider/ethereum"
 	"github.com/rss3-network/protocol-go/schema/network"
 	"github.com/samber/lo"
@@ -74,6 +75,7 @@ func (s *dataSource) Start(ctx context.Context, tasksChan chan<- *engine.Tasks,
 
 		err := retry.Do(retryableFunc,
 			retry.Attempts(0),
+			retry.Context(ctx),
 			retry.Delay(time.Second),            // Set initial delay to 1 second.
 			retry.DelayType(retry.BackOffDelay), // Use backoff delay type, increasing delay on each retry.
 			retry.MaxDelay(5*time.Minute),
@@ -136,7 +138,9 @@ func (s *dataSource) pollBlocks(ctx context.Context, tasksChan chan<- *engine.Ta
 		// number is zero. If so, sync the remote block number and wait for the new block.
 		if blockNumberStart > blockNumberLatestRemote || blockNumberLatestRemote == 0 {
 			// Refresh the remote block number.
-			blockNumber, err := s.ethereumClient.BlockNumber(ctx)
+			blockNumber, err := meter.ObserveRPC(ctx, s.Network(), "eth_blockNumber", func() (*big.Int, error) {
+				return s.ethereumClient.BlockNumber(ctx)
+			})
 			if err != nil {
 				return fmt.Errorf("get latest block number: %w", err)
 			}
@@ -256,7 +260,9 @@ func (s *dataSource) pollLogs(ctx context.Context, tasksChan chan<- *engine.Task
 		// number is zero. If so, sync the remote block number and wait for the new block.
 		if blockNumberStart > blockNumberLatestRemote || blockNumberLatestRemote == 0 {
 			// Refresh the remote block number.
-			blockNumber, err := s.ethereumClient.BlockNumber(ctx)
+			blockNumber, err := meter.ObserveRPC(ctx, s.Network(), "eth_blockNumber", func() (*big.Int, error) {
+				return s.ethereumClient.BlockNumber(ctx)
+			})
 			if err != nil {
 				return fmt.Errorf("get latest block number: %w", err)
 			}
@@ -297,7 +303,9 @@ func (s *dataSource) pollLogs(ctx context.Context, tasksChan chan<- *engine.Task
 			},
 		}
 
-		logs, err := s.ethereumClient.FilterLogs(ctx, logFilter)
+		logs, err := meter.ObserveRPC(ctx, s.Network(), "eth_getLogs", func() ([]*ethereum.Log, error) {
+			return s.ethereumClient.FilterLogs(ctx, logFilter)
+		})
 		if err != nil {
 			return fmt.Errorf("get logs by filter: %w", err)
 		}
@@ -337,7 +345,9 @@ func (s *dataSource) pollLogs(ctx context.Context, tasksChan chan<- *engine.Task
 }
 
 func (s *dataSource) updateLatestBlock(ctx context.Context, blockNumberEnd uint64) (*ethereum.Block, error) {
-	latestBlock, err := s.ethereumClient.BlockByNumber(ctx, new(big.Int).SetUint64(blockNumberEnd))
+	latestBlock, err := meter.ObserveRPC(ctx, s.Network(), "eth_getBlockByNumber", func() (*ethereum.Block, error) {
+		return s.ethereumClient.BlockByNumber(ctx, new(big.Int).SetUint64(blockNumberEnd))
+	})
 	if err != nil {
 		return nil, fmt.Errorf("get block by number %d: %w", s.state.BlockNumber, err)
 	}
@@ -427,7 +437,9 @@ func (s *dataSource) getBlocks(ctx context.Context, blockNumbers []*big.Int) ([]
 		blockNumbers := blockNumbers
 
 		resultPool.Go(func(ctx context.Context) ([]*ethereum.Block, error) {
-			return s.ethereumClient.BatchBlockByNumbers(ctx, blockNumbers)
+			return meter.ObserveRPC(ctx, s.Network(), "eth_getBlockByNumber", func() ([]*ethereum.Block, error) {
+				return s.ethereumClient.BatchBlockByNumbers(ctx, blockNumbers)
+			})
 		})
 	}
 
@@ -494,7 +506,9 @@ func (s *dataSource) getReceiptsByBlockNumbers(ctx context.Context, blockNumbers
 		blockNumbers := blockNumbers
 
 		resultPool.Go(func(ctx context.Context) ([]*ethereum.Receipt, error) {
-			batchReceipts, err := s.ethereumClient.BatchBlockReceipts(ctx, blockNumbers)
+			batchReceipts, err := meter.ObserveRPC(ctx, s.Network(), "eth_getBlockReceipts", func() ([][]*ethereum.Receipt, error) {
+				return s.ethereumClient.BatchBlockReceipts(ctx, blockNumbers)
+			})
 			if err != nil {
 				return nil, err
 			}
@@ -530,7 +544,9 @@ func (s *dataSource) getReceiptsByTransactionHashes(ctx context.Context, transac
 		transactionHashes := transactionHashes
 
 		resultPool.Go(func(ctx context.Context) ([]*ethereum.Receipt, error) {
-			batchReceipts, err := s.ethereumClient.BatchTransactionReceipt(ctx, transactionHashes)
+			batchReceipts, err := meter.ObserveRPC(ctx, s.Network(), "eth_getTransactionReceipt", func() ([]*ethereum.Receipt, error) {
+				return s.ethereumClient.BatchTransactionReceipt(ctx, transactionHashes)
+			})
 			if err != nil {
 				return nil, err
 			}
@@ -553,7 +569,7 @@ func (s *dataSource) getReceiptsByTransactionHashes(ctx context.Context, transac
 
 func (s *dataSource) buildTasks(block *ethereum.Block, receipts []*ethereum.Receipt) ([]*Task, error) {
 	var (
-		tasks  = make([]*Task, len(block.Transactions))
+		tasks  = make([]*Task, 0, len(block.Transactions))
 		header = block.Header()
 	)
 
@@ -562,7 +578,7 @@ func (s *dataSource) buildTasks(block *ethereum.Block, receipts []*ethereum.Rece
 		zap.Uint64("block.number", block.Number.Uint64()),
 		zap.Int("transactions.count", len(block.Transactions)))
 
-	for index, transaction := range block.Transactions {
+	for _, transaction := range block.Transactions {
 		// There is no guarantee that the receipts provided by RPC will be in the same order as the transactions,
 		// so instead of using a transaction index, we can match the hash.
 		receipt, exists := lo.Find(receipts, func(receipt *ethereum.Receipt) bool {
@@ -588,7 +604,8 @@ func (s *dataSource) buildTasks(block *ethereum.Block, receipts []*ethereum.Rece
 			Receipt:     receipt,
 		}
 
-		tasks[index] = &task
+		// A bundle transaction of ERC-4337 is split into a task per user operation besides the task of the bundle.
+		tasks = append(tasks, SplitUserOperations(&task)...)
 	}
 
 	zap.L().Debug("successfully built tasks for block",
@@ -3,6 +3,7 @@ package ethereum
 import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rss3-network/node/internal/engine"
+	"github.com/samber/lo"
 )
 
 var _ engine.DataSourceFilter = (*Filter)(nil)
@@ -11,3 +12,79 @@ type Filter struct {
 	LogAddresses []common.Address `yaml:"log_addresses"`
 	LogTopics    []common.Hash    `yaml:"log_topics"`
 }
+
+// IsEmpty returns true if the filter has no log addresses and no log topics,
+// which means the dataSource has to poll all blocks.
+func (f *Filter) IsEmpty() bool {
+	return f == nil || (f.LogAddresses == nil && f.LogTopics == nil)
+}
+
+// Match reports whether the task contains a log that satisfies the filter.
+// An empty filter matches every task.
+func (f *Filter) Match(task *Task) bool {
+	if f.IsEmpty() {
+		return true
+	}
+
+	if task.Receipt == nil {
+		return false
+	}
+
+	for _, log := range task.Receipt.Logs {
+		if f.LogAddresses != nil && !lo.Contains(f.LogAddresses, log.Address) {
+			continue
+		}
+
+		if f.LogTopics != nil && (len(log.Topics) == 0 || !lo.Contains(f.LogTopics, log.Topics[0])) {
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// MergeFilters returns the union of the filters, the result matches every task matched by any of the filters.
+// A nil field in any filter means no restriction on that field, so it stays nil in the union.
+func MergeFilters(filters ...*Filter) *Filter {
+	if len(filters) == 0 {
+		return new(Filter)
+	}
+
+	var (
+		merged                  Filter
+		anyAddresses, anyTopics bool
+		logAddresses            []common.Address
+		logTopics               []common.Hash
+	)
+
+	for _, filter := range filters {
+		// A worker without filter consumes all blocks.
+		if filter.IsEmpty() {
+			return new(Filter)
+		}
+
+		if filter.LogAddresses == nil {
+			anyAddresses = true
+		} else {
+			logAddresses = append(logAddresses, filter.LogAddresses...)
+		}
+
+		if filter.LogTopics == nil {
+			anyTopics = true
+		} else {
+			logTopics = append(logTopics, filter.LogTopics...)
+		}
+	}
+
+	if !anyAddresses {
+		merged.LogAddresses = lo.Uniq(logAddresses)
+	}
+
+	if !anyTopics {
+		merged.LogTopics = lo.Uniq(logTopics)
+	}
+
+	return &merged
+}
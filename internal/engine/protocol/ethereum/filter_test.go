@@ -0,0 +1,121 @@
+package ethereum_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	ethereumx "github.com/rss3-network/node/provider/ethereum"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	addressA = common.HexToAddress("0x000000000000000000000000000000000000000a")
+	addressB = common.HexToAddress("0x000000000000000000000000000000000000000b")
+	topicA   = common.HexToHash("0x01")
+	topicB   = common.HexToHash("0x02")
+)
+
+func TestMergeFilters(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		filters []*ethereum.Filter
+		want    *ethereum.Filter
+	}{
+		{
+			name: "Union of addresses and topics",
+			filters: []*ethereum.Filter{
+				{LogAddresses: []common.Address{addressA}, LogTopics: []common.Hash{topicA}},
+				{LogAddresses: []common.Address{addressB, addressA}, LogTopics: []common.Hash{topicB}},
+			},
+			want: &ethereum.Filter{
+				LogAddresses: []common.Address{addressA, addressB},
+				LogTopics:    []common.Hash{topicA, topicB},
+			},
+		},
+		{
+			name: "Unrestricted addresses",
+			filters: []*ethereum.Filter{
+				{LogAddresses: []common.Address{addressA}, LogTopics: []common.Hash{topicA}},
+				{LogTopics: []common.Hash{topicB}},
+			},
+			want: &ethereum.Filter{
+				LogTopics: []common.Hash{topicA, topicB},
+			},
+		},
+		{
+			name: "Worker consuming all blocks",
+			filters: []*ethereum.Filter{
+				{LogTopics: []common.Hash{topicA}},
+				nil,
+			},
+			want: &ethereum.Filter{},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testcase.want, ethereum.MergeFilters(testcase.filters...))
+		})
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	task := &ethereum.Task{
+		Receipt: &ethereumx.Receipt{
+			Logs: []*ethereumx.Log{
+				{Address: addressA, Topics: []common.Hash{topicA}},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name   string
+		filter *ethereum.Filter
+		want   bool
+	}{
+		{
+			name:   "Empty filter",
+			filter: nil,
+			want:   true,
+		},
+		{
+			name:   "Matched address and topic",
+			filter: &ethereum.Filter{LogAddresses: []common.Address{addressA}, LogTopics: []common.Hash{topicA}},
+			want:   true,
+		},
+		{
+			name:   "Matched topic only",
+			filter: &ethereum.Filter{LogTopics: []common.Hash{topicA}},
+			want:   true,
+		},
+		{
+			name:   "Mismatched address",
+			filter: &ethereum.Filter{LogAddresses: []common.Address{addressB}, LogTopics: []common.Hash{topicA}},
+			want:   false,
+		},
+		{
+			name:   "Mismatched topic",
+			filter: &ethereum.Filter{LogTopics: []common.Hash{topicB}},
+			want:   false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testcase.want, testcase.filter.Match(task))
+		})
+	}
+}
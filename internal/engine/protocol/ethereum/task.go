@@ -13,6 +13,7 @@ import (
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
 	"github.com/rss3-network/protocol-go/schema/network"
 	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
@@ -26,9 +27,15 @@ type Task struct {
 	Header      *ethereum.Header
 	Transaction *ethereum.Transaction
 	Receipt     *ethereum.Receipt
+	// UserOperation is set if the task is split from a bundle transaction of ERC-4337.
+	UserOperation *UserOperation
 }
 
 func (t Task) ID() string {
+	if t.UserOperation != nil {
+		return fmt.Sprintf("%s.%s.%s", t.Network, t.Transaction.Hash, t.UserOperation.Hash)
+	}
+
 	return fmt.Sprintf("%s.%s", t.Network, t.Transaction.Hash)
 }
 
@@ -95,6 +102,17 @@ func (t Task) BuildActivity(options ...activityx.Option) (*activityx.Activity, e
 		Timestamp: t.Header.Timestamp,
 	}
 
+	// The user operations of a bundle transaction share the transaction hash,
+	// so the user operation hash is used as the ID, and the paymaster which pays the fee is recorded.
+	if t.UserOperation != nil {
+		activity.ID = t.UserOperation.Hash.String()
+		activity.Owner = t.UserOperation.Sender.String()
+
+		if t.UserOperation.Paymaster != ethereum.AddressGenesis {
+			activity.Fee.Address = lo.ToPtr(t.UserOperation.Paymaster.String())
+		}
+	}
+
 	// Apply activity options.
 	for _, option := range options {
 		if err := option(&activity); err != nil {
@@ -107,6 +125,8 @@ func (t Task) BuildActivity(options ...activityx.Option) (*activityx.Activity, e
 
 func (t Task) buildFee() (*big.Int, error) {
 	switch {
+	case t.UserOperation != nil: // The actual gas cost is paid by the sender or the paymaster of the user operation.
+		return utils.GetBigInt(t.UserOperation.ActualGasCost), nil
 	case network.IsOptimismSuperchain(t.ChainID):
 		return t.buildFeeOptimismSuperchain()
 	case t.Network == network.Arbitrum:
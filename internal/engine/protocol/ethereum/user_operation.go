@@ -0,0 +1,443 @@
+package ethereum
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+	"github.com/rss3-network/node/provider/ethereum/contract/entrypoint"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// UserOperation is an ERC-4337 user operation executed by an EntryPoint in a bundle transaction,
+// reference https://eips.ethereum.org/EIPS/eip-4337.
+type UserOperation struct {
+	Hash          common.Hash
+	EntryPoint    common.Address
+	Sender        common.Address
+	Paymaster     common.Address
+	Nonce         *big.Int
+	Success       bool
+	ActualGasCost *big.Int
+	// Bundler is the sender of the bundle transaction.
+	Bundler common.Address
+	// Calls are the calls executed by the smart account, which are empty if the call data is unknown.
+	Calls []UserOperationCall
+}
+
+type UserOperationCall struct {
+	To    common.Address
+	Value *big.Int
+	Input []byte
+}
+
+var entryPointFilterer = lo.Must(entrypoint.NewEntryPointV07Filterer(ethereum.AddressGenesis, nil))
+
+// SplitUserOperations splits a bundle transaction into a task per user operation besides the task of the bundle,
+// the task is returned as is if the transaction is not a bundle.
+// The activity of the bundle keeps the transaction hash as its ID, so the activities indexed before the split are
+// not duplicated, while the activities of the user operations use the user operation hashes as their IDs.
+// The logs of a user operation are removed from the task of the bundle to avoid indexing the same event twice,
+// and the task of the bundle is dropped if no logs are left.
+func SplitUserOperations(task *Task) []*Task {
+	if task.Receipt == nil {
+		return []*Task{task}
+	}
+
+	userOperations, err := parseUserOperations(task.Transaction, task.Receipt)
+	if err != nil {
+		zap.L().Warn("failed to parse user operations",
+			zap.String("transaction.hash", task.Transaction.Hash.String()),
+			zap.Error(err))
+
+		return []*Task{task}
+	}
+
+	if len(userOperations) == 0 {
+		return []*Task{task}
+	}
+
+	tasks := make([]*Task, 0, len(userOperations)+1)
+
+	if bundle := buildBundleTask(task, userOperations); bundle != nil {
+		tasks = append(tasks, bundle)
+	}
+
+	for _, userOperation := range userOperations {
+		tasks = append(tasks, userOperation.buildTask(task))
+	}
+
+	return tasks
+}
+
+// buildBundleTask builds the task of the bundle with the logs not claimed by any user operation,
+// it returns nil if all logs are claimed.
+func buildBundleTask(task *Task, userOperations []*parsedUserOperation) *Task {
+	claimed := make(map[*ethereum.Log]struct{})
+
+	for _, userOperation := range userOperations {
+		for _, log := range userOperation.logs {
+			claimed[log] = struct{}{}
+		}
+
+		claimed[userOperation.event] = struct{}{}
+	}
+
+	logs := lo.Reject(task.Receipt.Logs, func(log *ethereum.Log, _ int) bool {
+		_, exists := claimed[log]
+
+		return exists
+	})
+
+	if len(logs) == 0 {
+		return nil
+	}
+
+	receipt := *task.Receipt
+	receipt.Logs = logs
+
+	bundle := *task
+	bundle.Receipt = &receipt
+
+	return &bundle
+}
+
+type parsedUserOperation struct {
+	*UserOperation
+
+	callData []byte
+	logs     []*ethereum.Log
+	// event is the UserOperationEvent log of the user operation.
+	event *ethereum.Log
+}
+
+// buildTask builds a task for the user operation, the transaction is replaced with the call of the smart account,
+// and the receipt only contains the logs emitted during the execution of the user operation.
+func (o *parsedUserOperation) buildTask(task *Task) *Task {
+	transaction := *task.Transaction
+	transaction.From = o.Sender
+
+	if len(o.Calls) == 1 {
+		transaction.To = lo.ToPtr(o.Calls[0].To)
+		transaction.Value = o.Calls[0].Value
+		transaction.Input = o.Calls[0].Input
+	} else {
+		transaction.To = lo.ToPtr(o.Sender)
+		transaction.Value = big.NewInt(0)
+		transaction.Input = o.callData
+	}
+
+	receipt := *task.Receipt
+	receipt.Logs = o.logs
+	receipt.Status = lo.Ternary(o.Success, types.ReceiptStatusSuccessful, types.ReceiptStatusFailed)
+
+	return &Task{
+		Network:       task.Network,
+		ChainID:       task.ChainID,
+		Header:        task.Header,
+		Transaction:   &transaction,
+		Receipt:       &receipt,
+		UserOperation: o.UserOperation,
+	}
+}
+
+// parseUserOperations parses the user operations by the UserOperationEvent logs of the EntryPoints.
+// The logs between the BeforeExecution log or the previous UserOperationEvent log and
+// the UserOperationEvent log are emitted during the execution of the user operation.
+func parseUserOperations(transaction *ethereum.Transaction, receipt *ethereum.Receipt) ([]*parsedUserOperation, error) {
+	var (
+		userOperations = make([]*parsedUserOperation, 0)
+		start          int
+	)
+
+	for index, log := range receipt.Logs {
+		if len(log.Topics) == 0 || !contract.MatchAddresses(log.Address, entrypoint.AddressEntryPointV06, entrypoint.AddressEntryPointV07) {
+			continue
+		}
+
+		switch {
+		case contract.MatchEventHashes(log.Topics[0], entrypoint.EventBeforeExecution):
+			start = index + 1
+		case contract.MatchEventHashes(log.Topics[0], entrypoint.EventUserOperationEvent):
+			event, err := entryPointFilterer.ParseUserOperationEvent(log.Export())
+			if err != nil {
+				return nil, fmt.Errorf("parse UserOperationEvent event: %w", err)
+			}
+
+			userOperations = append(userOperations, &parsedUserOperation{
+				UserOperation: &UserOperation{
+					Hash:          event.UserOpHash,
+					EntryPoint:    log.Address,
+					Sender:        event.Sender,
+					Paymaster:     event.Paymaster,
+					Nonce:         event.Nonce,
+					Success:       event.Success,
+					ActualGasCost: event.ActualGasCost,
+					Bundler:       transaction.From,
+				},
+				logs:  receipt.Logs[start:index],
+				event: log,
+			})
+
+			start = index + 1
+		}
+	}
+
+	if len(userOperations) == 0 {
+		return nil, nil
+	}
+
+	// The call data is only available if the bundle transaction calls the EntryPoint directly.
+	callData, err := decodeBundleCallData(transaction)
+	if err != nil {
+		zap.L().Warn("failed to decode bundle call data",
+			zap.String("transaction.hash", transaction.Hash.String()),
+			zap.Error(err))
+	}
+
+	for _, userOperation := range userOperations {
+		userOperation.callData = callData[userOperationKey(userOperation.Sender, userOperation.Nonce)]
+		userOperation.Calls = decodeUserOperationCalls(userOperation.Sender, userOperation.callData)
+	}
+
+	return userOperations, nil
+}
+
+func userOperationKey(sender common.Address, nonce *big.Int) string {
+	return fmt.Sprintf("%s.%s", sender, nonce)
+}
+
+// decodeBundleCallData returns the call data of the user operations in the bundle transaction by the sender and nonce.
+func decodeBundleCallData(transaction *ethereum.Transaction) (map[string][]byte, error) {
+	callData := make(map[string][]byte)
+
+	if transaction.To == nil || !contract.MatchAddresses(*transaction.To, entrypoint.AddressEntryPointV06, entrypoint.AddressEntryPointV07) || len(transaction.Input) < 4 {
+		return callData, nil
+	}
+
+	var (
+		metadata *abi.ABI
+		err      error
+	)
+
+	if *transaction.To == entrypoint.AddressEntryPointV06 {
+		metadata, err = entrypoint.EntryPointV06MetaData.GetAbi()
+	} else {
+		metadata, err = entrypoint.EntryPointV07MetaData.GetAbi()
+	}
+
+	if err != nil {
+		return callData, fmt.Errorf("load EntryPoint ABI: %w", err)
+	}
+
+	method, err := metadata.MethodById(transaction.Input[:4])
+	if err != nil {
+		return callData, fmt.Errorf("load method by ID: %w", err)
+	}
+
+	values, err := method.Inputs.UnpackValues(transaction.Input[4:])
+	if err != nil {
+		return callData, fmt.Errorf("unpack values: %w", err)
+	}
+
+	switch {
+	case contract.MatchMethodIDs(transaction.Input, entrypoint.MethodIDEntryPointV06HandleOps):
+		var input entrypoint.EntryPointV06HandleOpsInput
+		if err := method.Inputs.Copy(&input, values); err != nil {
+			return callData, fmt.Errorf("copy input: %w", err)
+		}
+
+		for _, operation := range input.Ops {
+			callData[userOperationKey(operation.Sender, operation.Nonce)] = operation.CallData
+		}
+	case contract.MatchMethodIDs(transaction.Input, entrypoint.MethodIDEntryPointV06HandleAggregatedOps):
+		var input entrypoint.EntryPointV06HandleAggregatedOpsInput
+		if err := method.Inputs.Copy(&input, values); err != nil {
+			return callData, fmt.Errorf("copy input: %w", err)
+		}
+
+		for _, aggregator := range input.OpsPerAggregator {
+			for _, operation := range aggregator.UserOps {
+				callData[userOperationKey(operation.Sender, operation.Nonce)] = operation.CallData
+			}
+		}
+	case contract.MatchMethodIDs(transaction.Input, entrypoint.MethodIDEntryPointV07HandleOps):
+		var input entrypoint.EntryPointV07HandleOpsInput
+		if err := method.Inputs.Copy(&input, values); err != nil {
+			return callData, fmt.Errorf("copy input: %w", err)
+		}
+
+		for _, operation := range input.Ops {
+			callData[userOperationKey(operation.Sender, operation.Nonce)] = operation.CallData
+		}
+	case contract.MatchMethodIDs(transaction.Input, entrypoint.MethodIDEntryPointV07HandleAggregatedOps):
+		var input entrypoint.EntryPointV07HandleAggregatedOpsInput
+		if err := method.Inputs.Copy(&input, values); err != nil {
+			return callData, fmt.Errorf("copy input: %w", err)
+		}
+
+		for _, aggregator := range input.OpsPerAggregator {
+			for _, operation := range aggregator.UserOps {
+				callData[userOperationKey(operation.Sender, operation.Nonce)] = operation.CallData
+			}
+		}
+	}
+
+	return callData, nil
+}
+
+// decodeUserOperationCalls decodes the calls of the execute and executeBatch functions of the common smart accounts,
+// it returns nil if the call data is unknown.
+func decodeUserOperationCalls(sender common.Address, callData []byte) []UserOperationCall {
+	if len(callData) < 4 {
+		return nil
+	}
+
+	calls, err := decodeSmartAccountCalls(callData)
+	if err != nil {
+		zap.L().Debug("failed to decode smart account call data",
+			zap.String("sender", sender.String()),
+			zap.Error(err))
+
+		return nil
+	}
+
+	return calls
+}
+
+func decodeSmartAccountCalls(callData []byte) ([]UserOperationCall, error) {
+	if !contract.MatchMethodIDs(
+		callData,
+		entrypoint.MethodIDSmartAccountExecute,
+		entrypoint.MethodIDSmartAccountExecuteMode,
+		entrypoint.MethodIDSmartAccountExecuteBatch,
+		entrypoint.MethodIDSmartAccountExecuteBatchValues,
+		entrypoint.MethodIDSmartAccountExecuteBatchCalls,
+		entrypoint.MethodIDSmartAccountExecuteUserOp,
+	) {
+		return nil, nil
+	}
+
+	metadata, err := entrypoint.SmartAccountMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("load SmartAccount ABI: %w", err)
+	}
+
+	method, err := metadata.MethodById(callData[:4])
+	if err != nil {
+		return nil, fmt.Errorf("load method by ID: %w", err)
+	}
+
+	values, err := method.Inputs.UnpackValues(callData[4:])
+	if err != nil {
+		return nil, fmt.Errorf("unpack values: %w", err)
+	}
+
+	switch {
+	case contract.MatchMethodIDs(callData, entrypoint.MethodIDSmartAccountExecute):
+		var input entrypoint.SmartAccountExecuteInput
+		if err := method.Inputs.Copy(&input, values); err != nil {
+			return nil, fmt.Errorf("copy input: %w", err)
+		}
+
+		return []UserOperationCall{{To: input.Dest, Value: input.Value, Input: input.Func}}, nil
+	case contract.MatchMethodIDs(callData, entrypoint.MethodIDSmartAccountExecuteUserOp):
+		var input entrypoint.SmartAccountExecuteUserOpInput
+		if err := method.Inputs.Copy(&input, values); err != nil {
+			return nil, fmt.Errorf("copy input: %w", err)
+		}
+
+		return []UserOperationCall{{To: input.To, Value: input.Value, Input: input.Data}}, nil
+	case contract.MatchMethodIDs(callData, entrypoint.MethodIDSmartAccountExecuteBatch):
+		var input entrypoint.SmartAccountExecuteBatchInput
+		if err := method.Inputs.Copy(&input, values); err != nil {
+			return nil, fmt.Errorf("copy input: %w", err)
+		}
+
+		if len(input.Dest) != len(input.Func) {
+			return nil, fmt.Errorf("mismatched lengths of dest %d and func %d", len(input.Dest), len(input.Func))
+		}
+
+		return lo.Map(input.Dest, func(dest common.Address, index int) UserOperationCall {
+			return UserOperationCall{To: dest, Value: big.NewInt(0), Input: input.Func[index]}
+		}), nil
+	case contract.MatchMethodIDs(callData, entrypoint.MethodIDSmartAccountExecuteBatchValues):
+		var input entrypoint.SmartAccountExecuteBatchValuesInput
+		if err := method.Inputs.Copy(&input, values); err != nil {
+			return nil, fmt.Errorf("copy input: %w", err)
+		}
+
+		if len(input.Dest) != len(input.Value) || len(input.Dest) != len(input.Func) {
+			return nil, fmt.Errorf("mismatched lengths of dest %d, value %d and func %d", len(input.Dest), len(input.Value), len(input.Func))
+		}
+
+		return lo.Map(input.Dest, func(dest common.Address, index int) UserOperationCall {
+			return UserOperationCall{To: dest, Value: input.Value[index], Input: input.Func[index]}
+		}), nil
+	case contract.MatchMethodIDs(callData, entrypoint.MethodIDSmartAccountExecuteBatchCalls):
+		var input entrypoint.SmartAccountExecuteBatchCallsInput
+		if err := method.Inputs.Copy(&input, values); err != nil {
+			return nil, fmt.Errorf("copy input: %w", err)
+		}
+
+		return lo.Map(input.Calls, func(call entrypoint.CoinbaseSmartWalletCall, _ int) UserOperationCall {
+			return UserOperationCall{To: call.Target, Value: call.Value, Input: call.Data}
+		}), nil
+	case contract.MatchMethodIDs(callData, entrypoint.MethodIDSmartAccountExecuteMode):
+		var input entrypoint.SmartAccountExecuteModeInput
+		if err := method.Inputs.Copy(&input, values); err != nil {
+			return nil, fmt.Errorf("copy input: %w", err)
+		}
+
+		return decodeExecutionCalldata(metadata, input.Mode, input.ExecutionCalldata)
+	default:
+		return nil, nil
+	}
+}
+
+// decodeExecutionCalldata decodes the execution calldata of ERC-7579 accounts,
+// reference https://eips.ethereum.org/EIPS/eip-7579#execution-behavior.
+func decodeExecutionCalldata(metadata *abi.ABI, mode [32]byte, executionCalldata []byte) ([]UserOperationCall, error) {
+	switch mode[0] {
+	case entrypoint.CallTypeSingle:
+		// The single execution is encoded as abi.encodePacked(target, value, callData).
+		if len(executionCalldata) < common.AddressLength+32 {
+			return nil, fmt.Errorf("invalid execution calldata length %d", len(executionCalldata))
+		}
+
+		return []UserOperationCall{
+			{
+				To:    common.BytesToAddress(executionCalldata[:common.AddressLength]),
+				Value: new(big.Int).SetBytes(executionCalldata[common.AddressLength : common.AddressLength+32]),
+				Input: executionCalldata[common.AddressLength+32:],
+			},
+		}, nil
+	case entrypoint.CallTypeBatch:
+		// The batch execution is encoded as abi.encode(Execution[]), which is the same as the calls of executeBatch.
+		method, err := metadata.MethodById(entrypoint.MethodIDSmartAccountExecuteBatchCalls[:])
+		if err != nil {
+			return nil, fmt.Errorf("load method by ID: %w", err)
+		}
+
+		values, err := method.Inputs.UnpackValues(executionCalldata)
+		if err != nil {
+			return nil, fmt.Errorf("unpack values: %w", err)
+		}
+
+		var input entrypoint.SmartAccountExecuteBatchCallsInput
+		if err := method.Inputs.Copy(&input, values); err != nil {
+			return nil, fmt.Errorf("copy input: %w", err)
+		}
+
+		return lo.Map(input.Calls, func(call entrypoint.CoinbaseSmartWalletCall, _ int) UserOperationCall {
+			return UserOperationCall{To: call.Target, Value: call.Value, Input: call.Data}
+		}), nil
+	default:
+		return nil, fmt.Errorf("unsupported call type %d", mode[0])
+	}
+}
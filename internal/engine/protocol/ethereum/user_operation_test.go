@@ -0,0 +1,299 @@
+package ethereum_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	ethereumx "github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract/entrypoint"
+	"github.com/rss3-network/node/provider/ethereum/contract/erc20"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	addressBundler   = common.HexToAddress("0x00000000000000000000000000000000000000b0")
+	addressAccountA  = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	addressAccountB  = common.HexToAddress("0x00000000000000000000000000000000000000a2")
+	addressPaymaster = common.HexToAddress("0x00000000000000000000000000000000000000f0")
+	addressToken     = common.HexToAddress("0x00000000000000000000000000000000000000c0")
+	addressRecipient = common.HexToAddress("0x00000000000000000000000000000000000000d0")
+
+	userOperationHashA = common.HexToHash("0xaa")
+	userOperationHashB = common.HexToHash("0xbb")
+)
+
+type userOperationArgument struct {
+	hash      common.Hash
+	sender    common.Address
+	paymaster common.Address
+	success   bool
+	callData  []byte
+}
+
+func TestSplitUserOperations(t *testing.T) {
+	t.Parallel()
+
+	smartAccountABI := lo.Must(entrypoint.SmartAccountMetaData.GetAbi())
+
+	var (
+		transferInput = lo.Must(lo.Must(erc20.ERC20MetaData.GetAbi()).Pack("transfer", addressRecipient, big.NewInt(100)))
+		executeInput  = lo.Must(smartAccountABI.Pack("execute", addressToken, big.NewInt(0), transferInput))
+		batchInput    = lo.Must(smartAccountABI.Pack("executeBatch1", []entrypoint.CoinbaseSmartWalletCall{
+			{Target: addressToken, Value: big.NewInt(0), Data: transferInput},
+			{Target: addressRecipient, Value: big.NewInt(1), Data: []byte{}},
+		}))
+		// ERC-7579 single execution of abi.encodePacked(target, value, callData).
+		executeModeInput = lo.Must(smartAccountABI.Pack("execute0", [32]byte{entrypoint.CallTypeSingle}, append(append(addressToken.Bytes(), common.LeftPadBytes(big.NewInt(0).Bytes(), 32)...), transferInput...)))
+	)
+
+	type wantTask struct {
+		from      common.Address
+		to        common.Address
+		input     []byte
+		logsCount int
+		status    uint64
+		fee       *big.Int
+		calls     int
+		paymaster *string
+	}
+
+	testcases := []struct {
+		name           string
+		entryPoint     common.Address
+		userOperations []userOperationArgument
+		want           []wantTask
+	}{
+		{
+			name:       "EntryPoint v0.6 bundle with execute and executeBatch",
+			entryPoint: entrypoint.AddressEntryPointV06,
+			userOperations: []userOperationArgument{
+				{hash: userOperationHashA, sender: addressAccountA, paymaster: addressPaymaster, success: true, callData: executeInput},
+				{hash: userOperationHashB, sender: addressAccountB, success: false, callData: batchInput},
+			},
+			want: []wantTask{
+				{from: addressBundler, to: entrypoint.AddressEntryPointV06, logsCount: 1, status: types.ReceiptStatusSuccessful},
+				{from: addressAccountA, to: addressToken, input: transferInput, logsCount: 1, status: types.ReceiptStatusSuccessful, fee: big.NewInt(1000), calls: 1, paymaster: lo.ToPtr(addressPaymaster.String())},
+				{from: addressAccountB, to: addressAccountB, input: batchInput, logsCount: 1, status: types.ReceiptStatusFailed, fee: big.NewInt(1001), calls: 2},
+			},
+		},
+		{
+			name:       "EntryPoint v0.7 bundle with ERC-7579 execute",
+			entryPoint: entrypoint.AddressEntryPointV07,
+			userOperations: []userOperationArgument{
+				{hash: userOperationHashA, sender: addressAccountA, success: true, callData: executeModeInput},
+			},
+			want: []wantTask{
+				{from: addressBundler, to: entrypoint.AddressEntryPointV07, logsCount: 1, status: types.ReceiptStatusSuccessful},
+				{from: addressAccountA, to: addressToken, input: transferInput, logsCount: 1, status: types.ReceiptStatusSuccessful, fee: big.NewInt(1000), calls: 1},
+			},
+		},
+		{
+			name:       "Not a bundle keeps the transaction hash as the ID",
+			entryPoint: common.Address{},
+			want: []wantTask{
+				{from: addressBundler, to: addressToken, logsCount: 0, status: types.ReceiptStatusSuccessful},
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := buildBundleTask(t, testcase.entryPoint, testcase.userOperations)
+
+			tasks := ethereum.SplitUserOperations(task)
+			require.Len(t, tasks, len(testcase.want))
+
+			for index, want := range testcase.want {
+				actual := tasks[index]
+
+				require.Equal(t, want.from, actual.Transaction.From)
+				require.Equal(t, want.to, *actual.Transaction.To)
+				require.Len(t, actual.Receipt.Logs, want.logsCount)
+				require.Equal(t, want.status, actual.Receipt.Status)
+
+				if want.input != nil {
+					require.Equal(t, want.input, actual.Transaction.Input)
+				}
+
+				activity, err := actual.BuildActivity()
+				require.NoError(t, err)
+
+				// The task of the transaction keeps the transaction hash as the ID of its activity.
+				if actual.UserOperation == nil {
+					require.Nil(t, want.fee)
+					require.Equal(t, task.Transaction, actual.Transaction)
+					require.Equal(t, "ethereum."+task.Transaction.Hash.String(), actual.ID())
+					require.Equal(t, task.Transaction.Hash.String(), activity.ID)
+
+					continue
+				}
+
+				userOperation := testcase.userOperations[index-1]
+
+				require.Equal(t, userOperation.hash, actual.UserOperation.Hash)
+				require.Equal(t, addressBundler, actual.UserOperation.Bundler)
+				require.Len(t, actual.UserOperation.Calls, want.calls)
+
+				require.Equal(t, userOperation.hash.String(), activity.ID)
+				require.Equal(t, want.from.String(), activity.From)
+				require.Equal(t, want.from.String(), activity.Owner)
+				require.Equal(t, want.fee.String(), activity.Fee.Amount.String())
+				require.Equal(t, want.paymaster, activity.Fee.Address)
+			}
+		})
+	}
+}
+
+func TestSplitUserOperationsDuplicateActions(t *testing.T) {
+	t.Parallel()
+
+	transferInput := lo.Must(lo.Must(erc20.ERC20MetaData.GetAbi()).Pack("transfer", addressRecipient, big.NewInt(100)))
+	executeInput := lo.Must(lo.Must(entrypoint.SmartAccountMetaData.GetAbi()).Pack("execute", addressToken, big.NewInt(0), transferInput))
+
+	task := buildBundleTask(t, entrypoint.AddressEntryPointV06, []userOperationArgument{
+		{hash: userOperationHashA, sender: addressAccountA, success: true, callData: executeInput},
+		{hash: userOperationHashB, sender: addressAccountB, success: true, callData: executeInput},
+	})
+
+	erc20Filterer := lo.Must(erc20.NewERC20Filterer(ethereumx.AddressGenesis, nil))
+
+	// Build a transfer action per Transfer log of each task, as the workers do.
+	actions := make(map[uint]string)
+
+	for _, actual := range ethereum.SplitUserOperations(task) {
+		for _, log := range actual.Receipt.Logs {
+			if len(log.Topics) == 0 || log.Topics[0] != erc20.EventHashTransfer {
+				continue
+			}
+
+			event, err := erc20Filterer.ParseTransfer(log.Export())
+			require.NoError(t, err)
+
+			previous, exists := actions[log.Index]
+			require.False(t, exists, "transfer log %d is indexed by both %s and %s", log.Index, previous, actual.ID())
+
+			require.Equal(t, event.From, actual.Transaction.From)
+
+			actions[log.Index] = actual.ID()
+		}
+	}
+
+	require.Len(t, actions, 2)
+}
+
+// buildBundleTask builds a task of a bundle transaction, each user operation emits an ERC-20 transfer log.
+func buildBundleTask(t *testing.T, entryPoint common.Address, userOperations []userOperationArgument) *ethereum.Task {
+	t.Helper()
+
+	transaction := &ethereumx.Transaction{
+		Hash:     common.HexToHash("0x01"),
+		From:     addressBundler,
+		To:       lo.ToPtr(addressToken),
+		Value:    big.NewInt(0),
+		GasPrice: big.NewInt(1),
+		Type:     types.LegacyTxType,
+	}
+
+	receipt := &ethereumx.Receipt{
+		TransactionHash: transaction.Hash,
+		Status:          types.ReceiptStatusSuccessful,
+		GasUsed:         21000,
+	}
+
+	if len(userOperations) > 0 {
+		entryPointABI := lo.Must(entrypoint.EntryPointV06MetaData.GetAbi())
+		if entryPoint == entrypoint.AddressEntryPointV07 {
+			entryPointABI = lo.Must(entrypoint.EntryPointV07MetaData.GetAbi())
+		}
+
+		receipt.Logs = append(receipt.Logs, &ethereumx.Log{
+			Address:     entryPoint,
+			Topics:      []common.Hash{entrypoint.EventBeforeExecution},
+			BlockNumber: big.NewInt(1),
+		})
+
+		for index, userOperation := range userOperations {
+			nonce := big.NewInt(int64(index))
+
+			data, err := entryPointABI.Events["UserOperationEvent"].Inputs.NonIndexed().Pack(nonce, userOperation.success, big.NewInt(int64(1000+index)), big.NewInt(100))
+			require.NoError(t, err)
+
+			receipt.Logs = append(receipt.Logs,
+				&ethereumx.Log{
+					Address:     addressToken,
+					Topics:      []common.Hash{erc20.EventHashTransfer, common.BytesToHash(userOperation.sender.Bytes()), common.BytesToHash(addressRecipient.Bytes())},
+					Data:        common.LeftPadBytes(big.NewInt(100).Bytes(), 32),
+					BlockNumber: big.NewInt(1),
+				},
+				&ethereumx.Log{
+					Address:     entryPoint,
+					Topics:      []common.Hash{entrypoint.EventUserOperationEvent, userOperation.hash, common.BytesToHash(userOperation.sender.Bytes()), common.BytesToHash(userOperation.paymaster.Bytes())},
+					Data:        data,
+					BlockNumber: big.NewInt(1),
+				},
+			)
+		}
+
+		for index, log := range receipt.Logs {
+			log.Index = uint(index)
+		}
+
+		transaction.To = lo.ToPtr(entryPoint)
+		transaction.Input = packHandleOps(t, entryPoint, userOperations)
+	}
+
+	return &ethereum.Task{
+		Network:     network.Ethereum,
+		ChainID:     1,
+		Header:      &ethereumx.Header{Timestamp: 1},
+		Transaction: transaction,
+		Receipt:     receipt,
+	}
+}
+
+// packHandleOps packs the handleOps call of the EntryPoint, the nonce of each user operation is its index.
+func packHandleOps(t *testing.T, entryPoint common.Address, userOperations []userOperationArgument) []byte {
+	t.Helper()
+
+	var (
+		input []byte
+		err   error
+	)
+
+	if entryPoint == entrypoint.AddressEntryPointV07 {
+		input, err = lo.Must(entrypoint.EntryPointV07MetaData.GetAbi()).Pack("handleOps", lo.Map(userOperations, func(userOperation userOperationArgument, index int) entrypoint.PackedUserOperation {
+			return entrypoint.PackedUserOperation{
+				Sender:             userOperation.sender,
+				Nonce:              big.NewInt(int64(index)),
+				CallData:           userOperation.callData,
+				PreVerificationGas: big.NewInt(0),
+			}
+		}), addressBundler)
+	} else {
+		input, err = lo.Must(entrypoint.EntryPointV06MetaData.GetAbi()).Pack("handleOps", lo.Map(userOperations, func(userOperation userOperationArgument, index int) entrypoint.UserOperation {
+			return entrypoint.UserOperation{
+				Sender:               userOperation.sender,
+				Nonce:                big.NewInt(int64(index)),
+				CallData:             userOperation.callData,
+				CallGasLimit:         big.NewInt(0),
+				VerificationGasLimit: big.NewInt(0),
+				PreVerificationGas:   big.NewInt(0),
+				MaxFeePerGas:         big.NewInt(0),
+				MaxPriorityFeePerGas: big.NewInt(0),
+			}
+		}), addressBundler)
+	}
+
+	require.NoError(t, err)
+
+	return input
+}
@@ -10,15 +10,17 @@ import (
 	"github.com/rss3-network/node/internal/engine/protocol/activitypub"
 	"github.com/rss3-network/node/internal/engine/protocol/arweave"
 	"github.com/rss3-network/node/internal/engine/protocol/atproto"
+	"github.com/rss3-network/node/internal/engine/protocol/bitcoin"
 	"github.com/rss3-network/node/internal/engine/protocol/ethereum"
 	"github.com/rss3-network/node/internal/engine/protocol/farcaster"
 	"github.com/rss3-network/node/internal/engine/protocol/near"
+	"github.com/rss3-network/node/internal/engine/protocol/rss"
 	"github.com/rss3-network/protocol-go/schema/network"
 )
 
 // New creates a new protocol.
 func New(config *config.Module, sourceFilter engine.DataSourceFilter, checkpoint *engine.Checkpoint, databaseClient database.Client, redisClient rueidis.Client) (engine.DataSource, error) {
-	switch config.Network.Protocol() {
+	switch NetworkProtocol(config.Network) {
 	case network.EthereumProtocol:
 		return ethereum.NewSource(config, sourceFilter, checkpoint, redisClient)
 	case network.ArweaveProtocol:
@@ -31,6 +33,10 @@ func New(config *config.Module, sourceFilter engine.DataSourceFilter, checkpoint
 		return near.NewSource(config, sourceFilter, checkpoint, redisClient)
 	case network.ATProtocol:
 		return atproto.NewSource(config, sourceFilter, checkpoint, databaseClient)
+	case network.RSSProtocol:
+		return rss.NewSource(config, checkpoint)
+	case BitcoinProtocol:
+		return bitcoin.NewSource(config, checkpoint)
 	default:
 		return nil, fmt.Errorf("unsupported network protocol %s", config.Network)
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/rss3-network/node/internal/database"
 	"github.com/rss3-network/node/internal/database/model"
 	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/internal/telemetry/meter"
 	"github.com/rss3-network/node/provider/farcaster"
 	"github.com/rss3-network/protocol-go/schema/network"
 	"github.com/samber/lo"
@@ -344,7 +345,9 @@ func (s *dataSource) pollEvents(ctx context.Context, tasksChan chan<- *engine.Ta
 		zap.L().Debug("fetching events from farcaster hub",
 			zap.Uint64("event.from.id", cursor))
 
-		eventsResponse, err := s.farcasterClient.GetEvents(ctx, lo.ToPtr(int64(cursor)))
+		eventsResponse, err := meter.ObserveRPC(ctx, s.Network(), "events", func() (*farcaster.EventResponse, error) {
+			return s.farcasterClient.GetEvents(ctx, lo.ToPtr(int64(cursor)))
+		})
 
 		if err != nil || eventsResponse == nil {
 			zap.L().Error("failed to fetch events from farcaster hub",
@@ -421,33 +424,61 @@ func (s *dataSource) buildFarcasterEventTasks(ctx context.Context, events []farc
 				zap.L().Debug("successfully filled cast parameters", zap.String("hash", message.Hash))
 
 			case farcaster.MessageTypeReactionAdd.String():
-				if message.Data.ReactionBody.Type == farcaster.ReactionTypeRecast.String() {
-					if err := s.fillReactionParams(ctx, &message); err != nil {
-						zap.L().Error("failed to fill reaction parameters",
-							zap.Uint64("fid", message.Data.Fid),
-							zap.String("hash", message.Hash),
-							zap.Error(err))
-
-						return nil
-					}
-
-					zap.L().Debug("successfully filled recast parameters", zap.String("hash", message.Hash))
-				} else {
-					zap.L().Debug("skipping non-recast reaction", zap.String("reaction.type", message.Data.ReactionBody.Type))
+				if err := s.fillReactionParams(ctx, &message); err != nil {
+					zap.L().Error("failed to fill reaction parameters",
+						zap.Uint64("fid", message.Data.Fid),
+						zap.String("hash", message.Hash),
+						zap.Error(err))
+
+					return nil
+				}
+
+				zap.L().Debug("successfully filled reaction parameters",
+					zap.String("hash", message.Hash),
+					zap.String("reaction.type", message.Data.ReactionBody.Type))
+
+			case farcaster.MessageTypeLinkAdd.String(), farcaster.MessageTypeLinkRemove.String():
+				if message.Data.LinkBody == nil || message.Data.LinkBody.Type != farcaster.LinkTypeFollow {
+					zap.L().Debug("skipping non-follow link", zap.String("hash", message.Hash))
+					return nil
+				}
+
+				if err := s.fillLinkParams(ctx, &message); err != nil {
+					zap.L().Error("failed to fill link parameters",
+						zap.Uint64("fid", message.Data.Fid),
+						zap.String("hash", message.Hash),
+						zap.Error(err))
+
 					return nil
 				}
 
+				zap.L().Debug("successfully filled link parameters", zap.String("hash", message.Hash))
+
+			case farcaster.MessageTypeUsernameProof.String():
+				fid := int64(message.Data.Fid)
+
+				zap.L().Debug("processing username proof message", zap.Int64("fid", fid))
+
+				_, _ = s.updateProfileByFid(ctx, &fid)
+
+				return nil
 			case farcaster.MessageTypeVerificationRemove.String(),
 				farcaster.MessageTypeVerificationAddEthAddress.String(),
-				farcaster.MessageTypeUserDataAdd.String(),
-				farcaster.MessageTypeUsernameProof.String():
+				farcaster.MessageTypeUserDataAdd.String():
 				fid := int64(message.Data.Fid)
 
 				zap.L().Debug("processing verification/user data message",
 					zap.Int64("fid", fid),
 					zap.String("message.type", message.Data.Type))
 
-				_, _ = s.updateProfileByFid(ctx, &fid)
+				profile, err := s.updateProfileByFid(ctx, &fid)
+				if err != nil {
+					zap.L().Error("failed to update profile",
+						zap.Int64("fid", fid),
+						zap.Error(err))
+
+					return nil
+				}
 
 				if message.Data.Type == farcaster.MessageTypeVerificationAddEthAddress.String() {
 					zap.L().Debug("polling casts and reactions for new ETH address verification",
@@ -457,7 +488,7 @@ func (s *dataSource) buildFarcasterEventTasks(ctx context.Context, events []farc
 					_ = s.pollReactionsByFid(ctx, &fid, "", tasksChan)
 				}
 
-				return nil
+				message.Data.Profile = profile
 			default:
 				zap.L().Debug("skipping unsupported message type", zap.String("type", message.Data.Type))
 				return nil
@@ -600,7 +631,8 @@ func (s *dataSource) getEthAddresses(ctx context.Context, fid *int64) ([]string,
 
 // getProfileByFid get profile by fid.
 // It will fetch the profile by fid from the database.
-// If the profile is not found or the username, custody address, and eth addresses are empty, it will update the profile.
+// If the profile is not found or the username and eth addresses are empty, it will update the profile.
+// The custody address may be saved by the farcaster worker on Optimism before the profile is fetched from the hub.
 func (s *dataSource) getProfileByFid(ctx context.Context, fid *int64) (*model.Profile, error) {
 	var (
 		profile *model.Profile
@@ -614,7 +646,7 @@ func (s *dataSource) getProfileByFid(ctx context.Context, fid *int64) (*model.Pr
 		return nil, err
 	}
 
-	if profile != nil && profile.Username == "" && profile.CustodyAddress == "" && len(profile.EthAddresses) == 0 {
+	if profile != nil && profile.Username == "" && len(profile.EthAddresses) == 0 {
 		zap.L().Info("profile incomplete, updating profile data", zap.Int64("fid", *fid))
 		profile, err = s.updateProfileByFid(ctx, fid)
 
@@ -711,9 +743,9 @@ func (s *dataSource) fillCastParams(ctx context.Context, message *farcaster.Mess
 	return s.fillMentionsUsernames(ctx, message)
 }
 
-// fillReactionParams fill params in recast message.
+// fillReactionParams fill params in recast and like messages.
 func (s *dataSource) fillReactionParams(ctx context.Context, message *farcaster.Message) error {
-	if message.Data.ReactionBody.Type == farcaster.ReactionTypeRecast.String() {
+	if message.Data.ReactionBody.TargetCastID.Hash != "" {
 		targetFid := int64(message.Data.ReactionBody.TargetCastID.Fid)
 		zap.L().Debug("fetching target cast for reaction",
 			zap.Int64("target_fid", targetFid),
@@ -743,12 +775,28 @@ func (s *dataSource) fillReactionParams(ctx context.Context, message *farcaster.
 	return s.fillProfile(ctx, message)
 }
 
+// fillLinkParams fill params in follow messages.
+func (s *dataSource) fillLinkParams(ctx context.Context, message *farcaster.Message) error {
+	targetFid := int64(message.Data.LinkBody.TargetFid)
+	zap.L().Debug("fetching target profile for link", zap.Int64("target_fid", targetFid))
+
+	targetProfile, err := s.getProfileByFid(ctx, &targetFid)
+	if err != nil {
+		return fmt.Errorf("failed to fetch target profile for target fid %d: %w", targetFid, err)
+	}
+
+	message.Data.LinkBody.TargetProfile = targetProfile
+
+	return s.fillProfile(ctx, message)
+}
+
 func retryOperation(ctx context.Context, operation func(ctx context.Context) error) error {
 	return retry.Do(
 		func() error {
 			return operation(ctx)
 		},
 		retry.Attempts(0),
+		retry.Context(ctx),
 		retry.Delay(1*time.Second),
 		retry.DelayType(retry.BackOffDelay),
 		retry.OnRetry(func(n uint, err error) {
@@ -12,6 +12,7 @@ import (
 	"github.com/rss3-network/node/config"
 	"github.com/rss3-network/node/config/parameter"
 	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/internal/telemetry/meter"
 	"github.com/rss3-network/node/provider/near"
 	"github.com/rss3-network/protocol-go/schema/network"
 	"github.com/samber/lo"
@@ -72,6 +73,7 @@ func (s *dataSource) startPolling(ctx context.Context, tasksChan chan<- *engine.
 
 	err := retry.Do(retryableFunc,
 		retry.Attempts(0),
+		retry.Context(ctx),
 		retry.Delay(time.Second),
 		retry.DelayType(retry.BackOffDelay),
 		retry.MaxDelay(5*time.Minute),
@@ -133,7 +135,9 @@ func (s *dataSource) getLatestBlockHeight(ctx context.Context) (int64, error) {
 		return int64(s.option.BlockTarget.Uint64()), nil
 	}
 
-	blockHeightLatestRemote, err := s.nearClient.GetBlockHeight(ctx)
+	blockHeightLatestRemote, err := meter.ObserveRPC(ctx, s.Network(), "status", func() (int64, error) {
+		return s.nearClient.GetBlockHeight(ctx)
+	})
 	if err != nil {
 		return 0, fmt.Errorf("get latest block height: %w", err)
 	}
@@ -248,7 +252,9 @@ func (s *dataSource) processChunks(ctx context.Context, block *near.Block) ([]en
 
 		resultPool.Go(func(ctx context.Context) ([]engine.Task, error) {
 			retryableFunc := func() ([]engine.Task, error) {
-				chunk, err := s.nearClient.ChunkByHash(ctx, chunkHash.ChunkHash)
+				chunk, err := meter.ObserveRPC(ctx, s.Network(), "chunk", func() (*near.Chunk, error) {
+					return s.nearClient.ChunkByHash(ctx, chunkHash.ChunkHash)
+				})
 				if err != nil {
 					return nil, fmt.Errorf("get chunk by hash: %w", err)
 				}
@@ -270,7 +276,9 @@ func (s *dataSource) processChunks(ctx context.Context, block *near.Block) ([]en
 					}
 
 					transactionPool.Go(func(ctx context.Context) (*near.Transaction, error) {
-						return s.nearClient.TransactionByHash(ctx, transaction.Hash, transaction.SignerID)
+						return meter.ObserveRPC(ctx, s.Network(), "tx", func() (*near.Transaction, error) {
+							return s.nearClient.TransactionByHash(ctx, transaction.Hash, transaction.SignerID)
+						})
 					})
 				}
 
@@ -343,7 +351,9 @@ func (s *dataSource) batchPullBlocks(ctx context.Context, blockHeights []*big.In
 
 		resultPool.Go(func(ctx context.Context) (*near.Block, error) {
 			retryableFunc := func() (*near.Block, error) {
-				return s.nearClient.BlockByHeight(ctx, blockHeight)
+				return meter.ObserveRPC(ctx, s.Network(), "block", func() (*near.Block, error) {
+					return s.nearClient.BlockByHeight(ctx, blockHeight)
+				})
 			}
 
 			return retry.DoWithData(
@@ -0,0 +1,17 @@
+package protocol
+
+import (
+	"github.com/rss3-network/protocol-go/schema/network"
+)
+
+// BitcoinProtocol is the protocol of Bitcoin, protocol-go defines the network but no protocol of it yet.
+const BitcoinProtocol network.Protocol = "bitcoin"
+
+// NetworkProtocol returns the protocol of the network, including the protocols not defined by protocol-go yet.
+func NetworkProtocol(value network.Network) network.Protocol {
+	if value == network.Bitcoin {
+		return BitcoinProtocol
+	}
+
+	return value.Protocol()
+}
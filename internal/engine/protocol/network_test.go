@@ -0,0 +1,45 @@
+package protocol_test
+
+import (
+	"testing"
+
+	"github.com/rss3-network/node/internal/engine/protocol"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkProtocol(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		network network.Network
+		want    network.Protocol
+	}{
+		{
+			name:    "Ethereum",
+			network: network.Ethereum,
+			want:    network.EthereumProtocol,
+		},
+		{
+			name:    "Bluesky",
+			network: network.Bluesky,
+			want:    network.ATProtocol,
+		},
+		{
+			name:    "Bitcoin",
+			network: network.Bitcoin,
+			want:    protocol.BitcoinProtocol,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testcase.want, protocol.NetworkProtocol(testcase.network))
+		})
+	}
+}
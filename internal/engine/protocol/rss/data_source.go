@@ -0,0 +1,220 @@
+package rss
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/provider/rss"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// Ensure that dataSource implements DataSource.
+var _ engine.DataSource = (*dataSource)(nil)
+
+type dataSource struct {
+	config *config.Module
+	option *Option
+	client rss.Client
+
+	// mutex guards the state, which is updated by polling while the worker saves the checkpoint.
+	mutex sync.RWMutex
+	state State
+}
+
+func (s *dataSource) Network() network.Network {
+	return s.config.Network
+}
+
+func (s *dataSource) State() json.RawMessage {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return lo.Must(json.Marshal(s.state))
+}
+
+func (s *dataSource) Start(ctx context.Context, tasksChan chan<- *engine.Tasks, _ chan<- error) {
+	zap.L().Info("starting rss data source",
+		zap.Int("feeds", len(s.option.Feeds)),
+		zap.Duration("poll_interval", s.option.interval))
+
+	go s.pollFeeds(ctx, tasksChan)
+}
+
+// pollFeeds polls the feeds at every interval, a failed feed is retried at the next interval without stopping the others.
+func (s *dataSource) pollFeeds(ctx context.Context, tasksChan chan<- *engine.Tasks) {
+	ticker := time.NewTicker(s.option.interval)
+	defer ticker.Stop()
+
+	for {
+		for _, feedURL := range s.option.Feeds {
+			if ctx.Err() != nil {
+				return
+			}
+
+			if err := s.pollFeed(ctx, feedURL, tasksChan); err != nil {
+				zap.L().Warn("failed to poll feed", zap.String("feed", feedURL), zap.Error(err))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollFeed fetches the feed with the validator of the last fetch, and pushes the items after the last indexed item.
+func (s *dataSource) pollFeed(ctx context.Context, feedURL string, tasksChan chan<- *engine.Tasks) error {
+	feedState := s.loadFeedState(feedURL)
+
+	response, err := s.client.Fetch(ctx, feedURL, feedState.Validator)
+	if err != nil {
+		return fmt.Errorf("fetch feed: %w", err)
+	}
+
+	if response.Feed == nil {
+		zap.L().Debug("feed is not modified", zap.String("feed", feedURL))
+
+		return nil
+	}
+
+	feedState.Validator = response.Validator
+
+	if items := newItems(response.Feed.Items, feedState); len(items) > 0 {
+		latest := items[len(items)-1]
+
+		feedState.LastItemID = latest.ID
+
+		if timestamp := latest.Timestamp(); !timestamp.IsZero() {
+			feedState.LastItemTimestamp = max(feedState.LastItemTimestamp, uint64(timestamp.Unix()))
+		}
+
+		select {
+		case tasksChan <- s.buildTasks(feedURL, response.Feed, items):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		zap.L().Info("polled feed",
+			zap.String("feed", feedURL),
+			zap.Int("items", len(items)),
+			zap.String("last_item_id", feedState.LastItemID))
+	}
+
+	s.saveFeedState(feedURL, feedState)
+
+	return nil
+}
+
+// buildTasks builds the tasks of the items, items without a date are dated at the time they are found.
+func (s *dataSource) buildTasks(feedURL string, feed *rss.Feed, items []*rss.Item) *engine.Tasks {
+	var (
+		tasks engine.Tasks
+		now   = time.Now().UTC()
+	)
+
+	for _, item := range items {
+		if item.Timestamp().IsZero() {
+			item.PublishedAt = now
+		}
+
+		tasks.Tasks = append(tasks.Tasks, &Task{
+			Network: s.config.Network,
+			FeedURL: feedURL,
+			Feed:    feed,
+			Item:    item,
+		})
+	}
+
+	return &tasks
+}
+
+func (s *dataSource) loadFeedState(feedURL string) FeedState {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if feedState, exists := s.state.Feeds[feedURL]; exists {
+		return *feedState
+	}
+
+	return FeedState{}
+}
+
+func (s *dataSource) saveFeedState(feedURL string, feedState FeedState) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.state.Feeds == nil {
+		s.state.Feeds = make(map[string]*FeedState)
+	}
+
+	s.state.Feeds[feedURL] = &feedState
+}
+
+// newItems returns the items after the last indexed item of the feed, from the oldest to the latest.
+// Items are ordered by their dates if all of them are dated, otherwise the order of the feed, the latest first, is kept.
+func newItems(items []*rss.Item, feedState FeedState) []*rss.Item {
+	items = slices.Clone(items)
+
+	if lo.EveryBy(items, func(item *rss.Item) bool { return !item.Timestamp().IsZero() }) {
+		slices.SortStableFunc(items, func(a, b *rss.Item) int {
+			return b.Timestamp().Compare(a.Timestamp())
+		})
+	}
+
+	result := make([]*rss.Item, 0, len(items))
+
+	for _, item := range items {
+		if feedState.LastItemID != "" && item.ID == feedState.LastItemID {
+			break
+		}
+
+		if timestamp := item.Timestamp(); !timestamp.IsZero() && uint64(timestamp.Unix()) < feedState.LastItemTimestamp {
+			continue
+		}
+
+		result = append(result, item)
+	}
+
+	slices.Reverse(result)
+
+	return result
+}
+
+// NewSource creates a new data source to poll the feeds of the module.
+func NewSource(config *config.Module, checkpoint *engine.Checkpoint) (engine.DataSource, error) {
+	var (
+		state State
+		err   error
+	)
+
+	if checkpoint != nil && len(checkpoint.State) > 0 {
+		if err := json.Unmarshal(checkpoint.State, &state); err != nil {
+			return nil, fmt.Errorf("unmarshal checkpoint state: %w", err)
+		}
+	}
+
+	instance := dataSource{
+		config: config,
+		state:  state,
+	}
+
+	if instance.option, err = NewOption(config.Parameters); err != nil {
+		return nil, fmt.Errorf("parse config: %w", err)
+	}
+
+	if instance.client, err = rss.NewClient(); err != nil {
+		return nil, fmt.Errorf("new rss client: %w", err)
+	}
+
+	return &instance, nil
+}
@@ -0,0 +1,166 @@
+package rss_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/internal/engine/protocol/rss"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/stretchr/testify/require"
+)
+
+const feed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+  <channel>
+    <title>RSS3 Blog</title>
+    <link>https://rss3.io/blog</link>
+    <item>
+      <guid>third</guid>
+      <link>https://rss3.io/blog/third</link>
+      <pubDate>Tue, 03 Sep 2024 10:00:00 GMT</pubDate>
+    </item>
+    <item>
+      <guid>second</guid>
+      <link>https://rss3.io/blog/second</link>
+      <pubDate>Mon, 02 Sep 2024 10:00:00 GMT</pubDate>
+    </item>
+    <item>
+      <guid>first</guid>
+      <link>https://rss3.io/blog/first</link>
+      <pubDate>Sun, 01 Sep 2024 10:00:00 GMT</pubDate>
+    </item>
+  </channel>
+</rss>`
+
+func TestSource(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("ETag", `"v1"`)
+
+		_, _ = writer.Write([]byte(feed))
+	}))
+	t.Cleanup(server.Close)
+
+	testcases := []struct {
+		name       string
+		checkpoint *engine.Checkpoint
+		want       []string
+	}{
+		{
+			name: "Without checkpoint",
+			want: []string{"first", "second", "third"},
+		},
+		{
+			name: "After the last item",
+			checkpoint: &engine.Checkpoint{
+				State: json.RawMessage(`{"feeds":{"` + server.URL + `":{"last_item_id":"first","last_item_timestamp":1725184800}}}`),
+			},
+			want: []string{"second", "third"},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			module := config.Module{
+				Network: network.RSSHub,
+				Parameters: &config.Parameters{
+					"feeds": []string{server.URL},
+				},
+			}
+
+			source, err := rss.NewSource(&module, testcase.checkpoint)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			tasksChan := make(chan *engine.Tasks)
+
+			source.Start(ctx, tasksChan, make(chan error))
+
+			select {
+			case tasks := <-tasksChan:
+				ids := make([]string, 0, tasks.Len())
+
+				for _, task := range tasks.Tasks {
+					require.NoError(t, task.Validate())
+
+					ids = append(ids, task.(*rss.Task).Item.ID)
+				}
+
+				require.Equal(t, testcase.want, ids)
+			case <-time.After(10 * time.Second):
+				require.FailNow(t, "timeout waiting for tasks")
+			}
+
+			// The state is saved after the tasks are received.
+			require.Eventually(t, func() bool {
+				var state rss.State
+
+				if err := json.Unmarshal(source.State(), &state); err != nil || state.Feeds[server.URL] == nil {
+					return false
+				}
+
+				return state.Feeds[server.URL].LastItemID == "third" && state.Feeds[server.URL].ETag == `"v1"`
+			}, 5*time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestNewOption(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		parameters *config.Parameters
+		want       []string
+		wantError  require.ErrorAssertionFunc
+	}{
+		{
+			name: "Deduplicated feeds",
+			parameters: &config.Parameters{
+				"feeds": []string{"https://rss3.io/blog/rss.xml", " https://rss3.io/blog/rss.xml ", ""},
+			},
+			want:      []string{"https://rss3.io/blog/rss.xml"},
+			wantError: require.NoError,
+		},
+		{
+			name: "Invalid poll interval",
+			parameters: &config.Parameters{
+				"feeds":         []string{"https://rss3.io/blog/rss.xml"},
+				"poll_interval": "often",
+			},
+			wantError: require.Error,
+		},
+		{
+			name:      "Without feeds",
+			wantError: require.Error,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			option, err := rss.NewOption(testcase.parameters)
+			testcase.wantError(t, err)
+
+			if err == nil {
+				require.Equal(t, testcase.want, option.Feeds)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package rss
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/provider/rss"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultPollInterval = 15 * time.Minute
+	minimumPollInterval = time.Minute
+)
+
+type Option struct {
+	// Feeds are the URLs of RSS, Atom and JSON feeds to poll.
+	Feeds []string `json:"feeds" mapstructure:"feeds"`
+	// OPML is the path of an OPML file to import the feeds from.
+	OPML string `json:"opml" mapstructure:"opml"`
+	// PollInterval is the interval to poll the feeds, such as 15m.
+	PollInterval string `json:"poll_interval" mapstructure:"poll_interval"`
+
+	interval time.Duration
+}
+
+func NewOption(parameters *config.Parameters) (*Option, error) {
+	var option Option
+
+	if parameters != nil {
+		if err := parameters.Decode(&option); err != nil {
+			return nil, fmt.Errorf("decode parameters: %w", err)
+		}
+	}
+
+	if option.OPML != "" {
+		data, err := os.ReadFile(option.OPML)
+		if err != nil {
+			return nil, fmt.Errorf("read opml %s: %w", option.OPML, err)
+		}
+
+		feeds, err := rss.ParseOPML(data)
+		if err != nil {
+			return nil, fmt.Errorf("parse opml %s: %w", option.OPML, err)
+		}
+
+		option.Feeds = append(option.Feeds, feeds...)
+	}
+
+	option.Feeds = lo.Uniq(lo.Compact(lo.Map(option.Feeds, func(feed string, _ int) string {
+		return strings.TrimSpace(feed)
+	})))
+
+	if len(option.Feeds) == 0 {
+		return nil, fmt.Errorf("at least 1 feed is required by feeds or opml")
+	}
+
+	option.interval = defaultPollInterval
+
+	if option.PollInterval != "" {
+		interval, err := time.ParseDuration(option.PollInterval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid poll interval %s: %w", option.PollInterval, err)
+		}
+
+		option.interval = max(interval, minimumPollInterval)
+	}
+
+	return &option, nil
+}
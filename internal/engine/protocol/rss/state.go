@@ -0,0 +1,20 @@
+package rss
+
+import (
+	"github.com/rss3-network/node/provider/rss"
+)
+
+type State struct {
+	// Feeds are the states of feeds by their URLs.
+	Feeds map[string]*FeedState `json:"feeds,omitempty"`
+}
+
+// FeedState is the checkpoint of a feed.
+type FeedState struct {
+	rss.Validator
+
+	// LastItemID is the id of the latest item indexed.
+	LastItemID string `json:"last_item_id,omitempty"`
+	// LastItemTimestamp is the timestamp of the latest item indexed, older items are not indexed.
+	LastItemTimestamp uint64 `json:"last_item_timestamp,omitempty"`
+}
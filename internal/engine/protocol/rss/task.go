@@ -0,0 +1,70 @@
+package rss
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/provider/rss"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/typex"
+)
+
+var _ engine.Task = (*Task)(nil)
+
+type Task struct {
+	Network network.Network
+	// FeedURL is the URL of the feed polled, which may differ from the link of the feed.
+	FeedURL string
+	Feed    *rss.Feed
+	Item    *rss.Item
+}
+
+// ID returns the hash of the feed URL and the item id, as the id of an item is only unique within its feed.
+func (t Task) ID() string {
+	hash := sha256.Sum256([]byte(t.FeedURL + "\n" + t.Item.ID))
+
+	return "0x" + hex.EncodeToString(hash[:])
+}
+
+func (t Task) GetNetwork() network.Network {
+	return t.Network
+}
+
+func (t Task) GetTimestamp() uint64 {
+	return uint64(t.Item.Timestamp().Unix())
+}
+
+func (t Task) Validate() error {
+	if t.Feed == nil || t.Item == nil {
+		return fmt.Errorf("missing feed or item")
+	}
+
+	if t.Item.ID == "" {
+		return fmt.Errorf("missing item id")
+	}
+
+	return nil
+}
+
+func (t Task) BuildActivity(options ...activityx.Option) (*activityx.Activity, error) {
+	activity := activityx.Activity{
+		ID:        t.ID(),
+		Network:   t.Network,
+		Type:      typex.Unknown,
+		Status:    true,
+		Actions:   make([]*activityx.Action, 0),
+		Timestamp: t.GetTimestamp(),
+	}
+
+	// Apply activity options.
+	for _, option := range options {
+		if err := option(&activity); err != nil {
+			return nil, fmt.Errorf("apply option: %w", err)
+		}
+	}
+
+	return &activity, nil
+}
@@ -54,3 +54,16 @@ func (t *Tasks) Keys() []string {
 func (t *Tasks) Len() int {
 	return len(t.Tasks)
 }
+
+// Fork returns a new Tasks with the given tasks, carrying over the metadata of the original Tasks.
+func (t *Tasks) Fork(tasks []Task) *Tasks {
+	forked := Tasks{
+		Tasks: tasks,
+	}
+
+	for key, value := range t.metadata {
+		forked.Set(key, value)
+	}
+
+	return &forked
+}
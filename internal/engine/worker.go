@@ -25,3 +25,23 @@ type Worker interface {
 	// Transform the core logic of the worker and returns the Activity.
 	Transform(ctx context.Context, task Task) (*activityx.Activity, error)
 }
+
+// MutatingWorker is a worker whose tasks also change the activities indexed before, such as deletions and edits of posts.
+type MutatingWorker interface {
+	Worker
+	// TransformMutations transforms the task into the Activity and the mutations of the indexed activities,
+	// the mutations are applied after the activities of the batch are saved.
+	TransformMutations(ctx context.Context, task Task) (*activityx.Activity, []*Mutation, error)
+}
+
+// Mutation is a change of an indexed activity, or a write of the datasets derived from an activity of the batch.
+type Mutation struct {
+	Network network.Network
+	// ID is the id of the indexed activity.
+	ID string
+	// Revise revises the indexed activity, which is deleted if both Revise and Save are nil.
+	Revise func(activity *activityx.Activity)
+	// Save saves the datasets derived from the activity, such as the sides of bridge transfers,
+	// which leaves the indexed activity unchanged.
+	Save func(ctx context.Context) error
+}
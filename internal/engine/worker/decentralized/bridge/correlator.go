@@ -0,0 +1,95 @@
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rss3-network/node/internal/database"
+	"github.com/rss3-network/node/internal/database/model"
+	"github.com/rss3-network/node/internal/engine"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+)
+
+// Correlator links the activities on the source and target networks of bridge transfers.
+// Bridge workers transform the sides of a transfer separately, so each side is recorded with
+// a key shared by both sides, and the sides with the same key are merged into a bridge transfer.
+type Correlator struct {
+	databaseClient database.Client
+}
+
+// Mutations returns the mutations recording the activity as a side of the bridge transfers of the keys,
+// which are applied after the activity is saved, so a transfer never links to an activity that is not indexed.
+func (c *Correlator) Mutations(activity *activityx.Activity, keys ...string) []*engine.Mutation {
+	// The correlator is disabled without a database, such as in the worker tests.
+	if c == nil || c.databaseClient == nil || activity == nil || len(keys) == 0 {
+		return nil
+	}
+
+	return []*engine.Mutation{
+		{
+			Network: activity.Network,
+			ID:      activity.ID,
+			Save: func(ctx context.Context) error {
+				return c.Record(ctx, activity, keys...)
+			},
+		},
+	}
+}
+
+// Record records the activity as a side of the bridge transfers of the keys,
+// the side is determined by the network of the activity and the networks of the bridge action.
+func (c *Correlator) Record(ctx context.Context, activity *activityx.Activity, keys ...string) error {
+	// The correlator is disabled without a database, such as in the worker tests.
+	if c == nil || c.databaseClient == nil || len(keys) == 0 {
+		return nil
+	}
+
+	action, found := lo.Find(activity.Actions, func(action *activityx.Action) bool {
+		return action.Type == typex.TransactionBridge
+	})
+	if !found {
+		return nil
+	}
+
+	bridge, ok := action.Metadata.(metadata.TransactionBridge)
+	if !ok {
+		return fmt.Errorf("invalid metadata type %T", action.Metadata)
+	}
+
+	timestamp := time.Unix(int64(activity.Timestamp), 0)
+
+	for _, key := range lo.Uniq(keys) {
+		transfer := model.BridgeTransfer{
+			Platform:      activity.Platform,
+			Key:           key,
+			SourceNetwork: bridge.SourceNetwork,
+			TargetNetwork: bridge.TargetNetwork,
+		}
+
+		switch activity.Network {
+		case bridge.SourceNetwork:
+			transfer.SourceActivityID, transfer.SourceTimestamp = lo.ToPtr(activity.ID), &timestamp
+		case bridge.TargetNetwork:
+			transfer.TargetActivityID, transfer.TargetTimestamp = lo.ToPtr(activity.ID), &timestamp
+		default:
+			return fmt.Errorf("activity network %s is neither the source nor the target network", activity.Network)
+		}
+
+		if err := c.databaseClient.SaveDatasetBridgeTransfer(ctx, &transfer); err != nil {
+			return fmt.Errorf("save dataset bridge transfer %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// NewCorrelator returns a new correlator, which records nothing if the database client is nil.
+func NewCorrelator(databaseClient database.Client) *Correlator {
+	return &Correlator{
+		databaseClient: databaseClient,
+	}
+}
@@ -0,0 +1,111 @@
+package bridge_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rss3-network/node/internal/database"
+	"github.com/rss3-network/node/internal/database/model"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/bridge"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/stretchr/testify/require"
+)
+
+// databaseClient records the bridge transfers saved by the correlator.
+type databaseClient struct {
+	database.Client
+
+	transfers []*model.BridgeTransfer
+}
+
+func (c *databaseClient) SaveDatasetBridgeTransfer(_ context.Context, transfer *model.BridgeTransfer) error {
+	c.transfers = append(c.transfers, transfer)
+
+	return nil
+}
+
+func TestCorrelator_Mutations(t *testing.T) {
+	t.Parallel()
+
+	activity := &activityx.Activity{
+		ID:        "0x0101010101010101010101010101010101010101010101010101010101010101",
+		Network:   network.Arbitrum,
+		Platform:  "Arbitrum",
+		Timestamp: 1723190411,
+		Actions: []*activityx.Action{
+			{
+				Type: typex.TransactionBridge,
+				Metadata: metadata.TransactionBridge{
+					Action:        metadata.ActionTransactionBridgeDeposit,
+					SourceNetwork: network.Ethereum,
+					TargetNetwork: network.Arbitrum,
+				},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name      string
+		client    *databaseClient
+		keys      []string
+		want      []string
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name:      "Record The Target Side",
+			client:    &databaseClient{},
+			keys:      []string{"0xaa", "0xaa", "0xbb"},
+			want:      []string{"0xaa", "0xbb"},
+			wantError: require.NoError,
+		},
+		{
+			name:      "No Keys",
+			client:    &databaseClient{},
+			wantError: require.NoError,
+		},
+		{
+			name:      "No Database",
+			keys:      []string{"0xaa"},
+			wantError: require.NoError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var client database.Client
+			if testcase.client != nil {
+				client = testcase.client
+			}
+
+			mutations := bridge.NewCorrelator(client).Mutations(activity, testcase.keys...)
+			if len(testcase.want) == 0 {
+				require.Empty(t, mutations)
+
+				return
+			}
+
+			// Nothing is recorded until the mutations are applied after the activity is saved.
+			require.Len(t, mutations, 1)
+			require.Empty(t, testcase.client.transfers)
+			require.Equal(t, activity.ID, mutations[0].ID)
+			require.Nil(t, mutations[0].Revise)
+
+			testcase.wantError(t, mutations[0].Save(context.Background()))
+
+			require.Len(t, testcase.client.transfers, len(testcase.want))
+
+			for index, transfer := range testcase.client.transfers {
+				require.Equal(t, testcase.want[index], transfer.Key)
+				require.Nil(t, transfer.SourceActivityID)
+				require.Equal(t, activity.ID, *transfer.TargetActivityID)
+			}
+		})
+	}
+}
@@ -7,8 +7,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/database"
 	"github.com/rss3-network/node/internal/engine"
 	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/bridge"
 	"github.com/rss3-network/node/internal/utils"
 	"github.com/rss3-network/node/provider/ethereum"
 	"github.com/rss3-network/node/provider/ethereum/contract"
@@ -26,7 +28,7 @@ import (
 	"go.uber.org/zap"
 )
 
-var _ engine.Worker = (*worker)(nil)
+var _ engine.MutatingWorker = (*worker)(nil)
 
 type worker struct {
 	config                                 *config.Module
@@ -37,6 +39,7 @@ type worker struct {
 	contractL1CustomGatewayFilterer        *arbitrum.L1CustomGatewayFilterer
 	contractL2ReverseCustomGatewayFilterer *arbitrum.L2ReverseCustomGatewayFilterer
 	contractArbSysFilterer                 *arbitrum.ArbSysFilterer
+	bridgeCorrelator                       *bridge.Correlator
 }
 
 func (w *worker) Name() string {
@@ -87,14 +90,21 @@ func (w *worker) Filter() engine.DataSourceFilter {
 }
 
 func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	activity, _, err := w.TransformMutations(ctx, task)
+
+	return activity, err
+}
+
+// TransformMutations transforms the task into the activity and the mutations recording the sides of its bridge transfers.
+func (w *worker) TransformMutations(ctx context.Context, task engine.Task) (*activityx.Activity, []*engine.Mutation, error) {
 	ethereumTask, ok := task.(*source.Task)
 	if !ok {
-		return nil, fmt.Errorf("invalid task type: %T", task)
+		return nil, nil, fmt.Errorf("invalid task type: %T", task)
 	}
 
 	activity, err := ethereumTask.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
 	if err != nil {
-		return nil, fmt.Errorf("build activity: %w", err)
+		return nil, nil, fmt.Errorf("build activity: %w", err)
 	}
 
 	for _, log := range ethereumTask.Receipt.Logs {
@@ -137,7 +147,8 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 
 	activity.Type = typex.TransactionBridge
 
-	return activity, nil
+	// The sides of the bridge transfers are recorded after the activity is saved.
+	return activity, w.bridgeCorrelator.Mutations(activity, w.buildBridgeKeys(ethereumTask)...), nil
 }
 
 func (w *worker) matchBridgeMessageDeliveredLog(_ *source.Task, log *ethereum.Log) bool {
@@ -278,6 +289,45 @@ func (w *worker) transformArbSysL2ToL1TxLog(ctx context.Context, task *source.Ta
 	return []*activityx.Action{action}, nil
 }
 
+// buildBridgeKeys returns the keys of the token withdrawals in the transaction, the WithdrawalInitiated event on Arbitrum
+// and the WithdrawalFinalized event on Ethereum share the L1 token and the exit number of the gateway.
+func (w *worker) buildBridgeKeys(task *source.Task) []string {
+	keys := make([]string, 0)
+
+	for _, log := range task.Receipt.Logs {
+		if len(log.Topics) == 0 {
+			continue
+		}
+
+		switch {
+		case w.matchL2ReverseCustomGatewayWithdrawalInitiatedLog(task, log):
+			event, err := w.contractL2ReverseCustomGatewayFilterer.ParseWithdrawalInitiated(log.Export())
+			if err != nil {
+				zap.L().Warn("failed to parse WithdrawalInitiated event", zap.Error(err), zap.String("task", task.ID()))
+
+				continue
+			}
+
+			keys = append(keys, w.buildWithdrawalKey(event.L1Token, event.ExitNum))
+		case w.matchL1CustomGatewayWithdrawalFinalizedLog(task, log):
+			event, err := w.contractL1CustomGatewayFilterer.ParseWithdrawalFinalized(log.Export())
+			if err != nil {
+				zap.L().Warn("failed to parse WithdrawalFinalized event", zap.Error(err), zap.String("task", task.ID()))
+
+				continue
+			}
+
+			keys = append(keys, w.buildWithdrawalKey(event.L1Token, event.ExitNum))
+		}
+	}
+
+	return keys
+}
+
+func (w *worker) buildWithdrawalKey(l1Token common.Address, exitNum *big.Int) string {
+	return fmt.Sprintf("withdrawal:%s:%s", l1Token, exitNum)
+}
+
 func (w *worker) buildTransactionBridgeAction(ctx context.Context, chainID uint64, sender, receiver common.Address,
 	source, target network.Network, bridgeAction metadata.TransactionBridgeAction, tokenAddress *common.Address,
 	tokenValue *big.Int, blockNumber *big.Int) (*activityx.Action, error) {
@@ -309,11 +359,12 @@ func (w *worker) buildTransactionBridgeAction(ctx context.Context, chainID uint6
 	return action, nil
 }
 
-func NewWorker(config *config.Module) (engine.Worker, error) {
+func NewWorker(config *config.Module, databaseClient database.Client) (engine.Worker, error) {
 	var (
 		err      error
 		instance = worker{
-			config: config,
+			config:           config,
+			bridgeCorrelator: bridge.NewCorrelator(databaseClient),
 		}
 	)
 
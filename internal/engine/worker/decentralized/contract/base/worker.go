@@ -7,12 +7,15 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/database"
 	"github.com/rss3-network/node/internal/engine"
 	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/bridge"
 	"github.com/rss3-network/node/internal/utils"
 	"github.com/rss3-network/node/provider/ethereum"
 	"github.com/rss3-network/node/provider/ethereum/contract"
 	"github.com/rss3-network/node/provider/ethereum/contract/base"
+	"github.com/rss3-network/node/provider/ethereum/contract/optimism"
 	"github.com/rss3-network/node/provider/ethereum/token"
 	"github.com/rss3-network/node/schema/worker/decentralized"
 	"github.com/rss3-network/protocol-go/schema"
@@ -26,14 +29,17 @@ import (
 	"go.uber.org/zap"
 )
 
-// make sure worker implements engine.Worker
-var _ engine.Worker = (*worker)(nil)
+// make sure worker implements engine.MutatingWorker
+var _ engine.MutatingWorker = (*worker)(nil)
 
 type worker struct {
 	baseOptimismPortalFilterer   *base.OptimismPortalFilterer
 	baseL1StandardBridgeFilterer *base.L1StandardBridgeFilterer
+	// The L2StandardBridge of Base is the same as the one of Optimism.
+	baseL2StandardBridgeFilterer *optimism.L2StandardBridgeFilterer
 	ethereumClient               ethereum.Client
 	tokenClient                  token.Client
+	bridgeCorrelator             *bridge.Correlator
 }
 
 func (w *worker) Name() string {
@@ -70,6 +76,7 @@ func (w *worker) Filter() engine.DataSourceFilter {
 			base.AddressOptimismPortal,
 			base.AddressL1StandardBridge,
 			base.AddressL2CrossDomainMessenger,
+			optimism.AddressL2StandardBridge,
 		},
 		LogTopics: []common.Hash{
 			base.EventHashOptimismPortalTransactionDeposited,
@@ -78,22 +85,31 @@ func (w *worker) Filter() engine.DataSourceFilter {
 			base.EventHashL1StandardBridgeERC20DepositInitiated,
 			base.EventHashL1StandardBridgeETHWithdrawalFinalized,
 			base.EventHashL1StandardBridgeERC20WithdrawalFinalized,
+			optimism.EventHashAddressL2StandardBridgeWithdrawalInitiated,
+			optimism.EventHashAddressL2StandardBridgeDepositFinalized,
 		},
 	}
 }
 
 // Transform base task to activity.
 func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	activity, _, err := w.TransformMutations(ctx, task)
+
+	return activity, err
+}
+
+// TransformMutations transforms the task into the activity and the mutations recording the sides of its bridge transfers.
+func (w *worker) TransformMutations(ctx context.Context, task engine.Task) (*activityx.Activity, []*engine.Mutation, error) {
 	// Cast the task to a base task.
 	ethereumTask, ok := task.(*source.Task)
 	if !ok {
-		return nil, fmt.Errorf("invalid task type: %T", task)
+		return nil, nil, fmt.Errorf("invalid task type: %T", task)
 	}
 
 	// Build the activity.
 	activity, err := task.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
 	if err != nil {
-		return nil, fmt.Errorf("build activity: %w", err)
+		return nil, nil, fmt.Errorf("build activity: %w", err)
 	}
 
 	for _, log := range ethereumTask.Receipt.Logs {
@@ -118,6 +134,10 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 			action, err = w.handleEthereumL1StandardBridgeETHWithdrawalFinalizedLog(ctx, *ethereumTask, activity, log)
 		case w.matchEthereumL1StandardBridgeERC20WithdrawalFinalizedLog(log):
 			action, err = w.handleEthereumL1StandardBridgeERC20WithdrawalFinalizedLog(ctx, *ethereumTask, activity, log)
+		case w.matchBaseL2StandardBridgeWithdrawalInitiatedLog(log):
+			action, err = w.handleBaseL2StandardBridgeWithdrawalInitiatedLog(ctx, *ethereumTask, activity, log)
+		case w.matchBaseL2StandardBridgeDepositFinalizedLog(log):
+			action, err = w.handleBaseL2StandardBridgeDepositFinalizedLog(ctx, *ethereumTask, activity, log)
 		default:
 			continue
 		}
@@ -138,10 +158,11 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 	if len(activity.Actions) == 0 {
 		zap.L().Info("no actions found for task")
 
-		return nil, nil
+		return nil, nil, nil
 	}
 
-	return activity, nil
+	// The sides of the bridge transfers are recorded after the activity is saved.
+	return activity, w.bridgeCorrelator.Mutations(activity, w.buildBridgeKeys(*ethereumTask)...), nil
 }
 
 func (w *worker) matchEthereumOptimismPortalTransactionDepositedLog(log *ethereum.Log) bool {
@@ -184,6 +205,22 @@ func (w *worker) matchEthereumL1StandardBridgeERC20WithdrawalFinalizedLog(log *e
 	return contract.MatchAddresses(log.Address, base.AddressL1StandardBridge)
 }
 
+func (w *worker) matchBaseL2StandardBridgeWithdrawalInitiatedLog(log *ethereum.Log) bool {
+	if !contract.MatchEventHashes(log.Topics[0], optimism.EventHashAddressL2StandardBridgeWithdrawalInitiated) {
+		return false
+	}
+
+	return contract.MatchAddresses(log.Address, optimism.AddressL2StandardBridge)
+}
+
+func (w *worker) matchBaseL2StandardBridgeDepositFinalizedLog(log *ethereum.Log) bool {
+	if !contract.MatchEventHashes(log.Topics[0], optimism.EventHashAddressL2StandardBridgeDepositFinalized) {
+		return false
+	}
+
+	return contract.MatchAddresses(log.Address, optimism.AddressL2StandardBridge)
+}
+
 func (w *worker) handleEthereumOptimismPortalTransactionDepositedLog(ctx context.Context, task source.Task, activity *activityx.Activity, log *ethereum.Log) (*activityx.Action, error) {
 	event, err := w.baseOptimismPortalFilterer.ParseTransactionDeposited(log.Export())
 	if err != nil {
@@ -242,6 +279,54 @@ func (w *worker) handleEthereumL1StandardBridgeERC20WithdrawalFinalizedLog(ctx c
 	return w.buildEthereumTransactionBridgeAction(ctx, task.Header.Number, task.ChainID, event.From, event.To, network.Base, network.Ethereum, metadata.ActionTransactionBridgeWithdraw, &event.L1Token, event.Amount)
 }
 
+func (w *worker) handleBaseL2StandardBridgeWithdrawalInitiatedLog(ctx context.Context, task source.Task, activity *activityx.Activity, log *ethereum.Log) (*activityx.Action, error) {
+	activity.Type = typex.TransactionBridge
+
+	event, err := w.baseL2StandardBridgeFilterer.ParseWithdrawalInitiated(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse WithdrawalInitiated event: %w", err)
+	}
+
+	return w.buildEthereumTransactionBridgeAction(ctx, task.Header.Number, task.ChainID, event.From, event.To, network.Base, network.Ethereum, metadata.ActionTransactionBridgeDeposit, w.buildL2TokenAddress(event.L2Token), event.Amount)
+}
+
+func (w *worker) handleBaseL2StandardBridgeDepositFinalizedLog(ctx context.Context, task source.Task, activity *activityx.Activity, log *ethereum.Log) (*activityx.Action, error) {
+	activity.Type = typex.TransactionBridge
+
+	event, err := w.baseL2StandardBridgeFilterer.ParseDepositFinalized(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse DepositFinalized event: %w", err)
+	}
+
+	return w.buildEthereumTransactionBridgeAction(ctx, task.Header.Number, task.ChainID, event.From, event.To, network.Ethereum, network.Base, metadata.ActionTransactionBridgeWithdraw, w.buildL2TokenAddress(event.L2Token), event.Amount)
+}
+
+// buildL2TokenAddress returns nil for the legacy ETH token address, which represents the native token.
+func (w *worker) buildL2TokenAddress(tokenAddress common.Address) *common.Address {
+	if tokenAddress == optimism.AddressL1ETH {
+		return nil
+	}
+
+	return &tokenAddress
+}
+
+// buildBridgeKeys returns the hashes of the cross domain messages in the transaction,
+// which are shared by the transactions of a bridge transfer on both networks.
+func (w *worker) buildBridgeKeys(task source.Task) []string {
+	messenger := lo.Ternary(task.Network == network.Ethereum, base.AddressL1CrossDomainMessenger, base.AddressL2CrossDomainMessenger)
+
+	hashes, err := optimism.CrossDomainMessageHashes(messenger, task.Receipt.Logs)
+	if err != nil {
+		zap.L().Warn("failed to build cross domain message hashes", zap.Error(err), zap.String("task", task.ID()))
+
+		return nil
+	}
+
+	return lo.Map(hashes, func(hash common.Hash, _ int) string {
+		return hash.String()
+	})
+}
+
 func (w *worker) buildEthereumTransactionBridgeAction(ctx context.Context, blockNumber *big.Int, chainID uint64, sender, receiver common.Address, source, target network.Network, bridgeAction metadata.TransactionBridgeAction, tokenAddress *common.Address, tokenValue *big.Int) (*activityx.Action, error) {
 	tokenMetadata, err := w.tokenClient.Lookup(ctx, chainID, tokenAddress, nil, blockNumber)
 	if err != nil {
@@ -268,10 +353,12 @@ func (w *worker) buildEthereumTransactionBridgeAction(ctx context.Context, block
 }
 
 // NewWorker returns a new base worker.
-func NewWorker(config *config.Module) (engine.Worker, error) {
+func NewWorker(config *config.Module, databaseClient database.Client) (engine.Worker, error) {
 	instance := worker{
 		baseOptimismPortalFilterer:   lo.Must(base.NewOptimismPortalFilterer(ethereum.AddressGenesis, nil)),
 		baseL1StandardBridgeFilterer: lo.Must(base.NewL1StandardBridgeFilterer(ethereum.AddressGenesis, nil)),
+		baseL2StandardBridgeFilterer: lo.Must(optimism.NewL2StandardBridgeFilterer(ethereum.AddressGenesis, nil)),
+		bridgeCorrelator:             bridge.NewCorrelator(databaseClient),
 	}
 
 	var err error
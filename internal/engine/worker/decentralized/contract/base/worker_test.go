@@ -478,7 +478,7 @@ func TestWorker_Base(t *testing.T) {
 
 			ctx := context.Background()
 
-			instance, err := worker.NewWorker(testcase.arguments.config)
+			instance, err := worker.NewWorker(testcase.arguments.config, nil)
 			require.NoError(t, err)
 
 			activity, err := instance.Transform(ctx, testcase.arguments.task)
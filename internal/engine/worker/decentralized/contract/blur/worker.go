@@ -0,0 +1,542 @@
+package blur
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/engine"
+	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/marketplace"
+	"github.com/rss3-network/node/internal/utils"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+	"github.com/rss3-network/node/provider/ethereum/contract/blur"
+	"github.com/rss3-network/node/provider/ethereum/contract/erc1155"
+	"github.com/rss3-network/node/provider/ethereum/contract/erc20"
+	"github.com/rss3-network/node/provider/ethereum/contract/erc721"
+	"github.com/rss3-network/node/provider/ethereum/token"
+	"github.com/rss3-network/node/schema/worker/decentralized"
+	"github.com/rss3-network/protocol-go/schema"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ engine.Worker = (*worker)(nil)
+
+type worker struct {
+	config                 *config.Module
+	ethereumClient         ethereum.Client
+	tokenClient            token.Client
+	blurExchangeFilterer   *blur.BlurExchangeFilterer
+	blurExchangeV2Filterer *blur.BlurExchangeV2Filterer
+	blendFilterer          *blur.BlendFilterer
+	erc20Filterer          *erc20.ERC20Filterer
+	erc721Filterer         *erc721.ERC721Filterer
+	erc1155Filterer        *erc1155.ERC1155Filterer
+}
+
+func (w *worker) Name() string {
+	return decentralized.Blur.String()
+}
+
+func (w *worker) Platform() string {
+	return decentralized.PlatformBlur.String()
+}
+
+func (w *worker) Network() []network.Network {
+	return []network.Network{
+		network.Ethereum,
+	}
+}
+
+func (w *worker) Tags() []tag.Tag {
+	return []tag.Tag{
+		tag.Collectible,
+		tag.Exchange,
+	}
+}
+
+func (w *worker) Types() []schema.Type {
+	return []schema.Type{
+		typex.CollectibleTrade,
+		typex.CollectibleAuction,
+		typex.ExchangeLoan,
+	}
+}
+
+// Filter returns a filter of the trades of the Blur exchanges and the loans of Blend.
+func (w *worker) Filter() engine.DataSourceFilter {
+	return &source.Filter{
+		LogAddresses: []common.Address{
+			blur.AddressBlurExchange,
+			blur.AddressBlurExchangeV2,
+			blur.AddressBlend,
+		},
+		LogTopics: []common.Hash{
+			blur.EventHashBlurExchangeOrdersMatched,
+			blur.EventHashBlurExchangeV2Execution,
+			blur.EventHashBlurExchangeV2Execution721Packed,
+			blur.EventHashBlurExchangeV2Execution721TakerFeePacked,
+			blur.EventHashBlurExchangeV2Execution721MakerFeePacked,
+			blur.EventHashBlendLoanOfferTaken,
+			blur.EventHashBlendRefinance,
+			blur.EventHashBlendRepay,
+			blur.EventHashBlendStartAuction,
+			blur.EventHashBlendSeize,
+			blur.EventHashBlendBuyLocked,
+		},
+	}
+}
+
+func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	ethereumTask, ok := task.(*source.Task)
+	if !ok {
+		return nil, fmt.Errorf("invalid task type: %T", task)
+	}
+
+	activity, err := ethereumTask.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
+	if err != nil {
+		return nil, fmt.Errorf("build activity: %w", err)
+	}
+
+	for _, log := range ethereumTask.Receipt.Logs {
+		if len(log.Topics) == 0 {
+			continue
+		}
+
+		var (
+			action *activityx.Action
+			err    error
+		)
+
+		switch {
+		case w.matchBlurExchange(log, blur.EventHashBlurExchangeOrdersMatched):
+			action, err = w.handleBlurExchangeOrdersMatched(ctx, ethereumTask, log)
+		case w.matchBlurExchangeV2(log, blur.EventHashBlurExchangeV2Execution):
+			action, err = w.handleBlurExchangeV2Execution(ctx, ethereumTask, log)
+		case w.matchBlurExchangeV2(log, blur.EventHashBlurExchangeV2Execution721Packed, blur.EventHashBlurExchangeV2Execution721TakerFeePacked, blur.EventHashBlurExchangeV2Execution721MakerFeePacked):
+			action, err = w.handleBlurExchangeV2Execution721Packed(ctx, ethereumTask, log)
+		case w.matchBlend(log, blur.EventHashBlendLoanOfferTaken):
+			action, err = w.handleBlendLoanOfferTaken(ctx, ethereumTask, log)
+		case w.matchBlend(log, blur.EventHashBlendRefinance):
+			action, err = w.handleBlendRefinance(ctx, ethereumTask, log)
+		case w.matchBlend(log, blur.EventHashBlendRepay):
+			action, err = w.handleBlendRepay(ctx, ethereumTask, log)
+		case w.matchBlend(log, blur.EventHashBlendStartAuction):
+			action, err = w.handleBlendStartAuction(ctx, ethereumTask, log)
+		case w.matchBlend(log, blur.EventHashBlendSeize):
+			action, err = w.handleBlendSeize(ctx, ethereumTask, log)
+		case w.matchBlend(log, blur.EventHashBlendBuyLocked):
+			action, err = w.handleBlendBuyLocked(ctx, ethereumTask, log)
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The lien is not found in the calldata.
+		if action == nil {
+			continue
+		}
+
+		if activity.Type == typex.Unknown {
+			activity.Type = action.Type
+		}
+
+		activity.Actions = append(activity.Actions, action)
+	}
+
+	if len(activity.Actions) == 0 {
+		zap.L().Debug("no actions generated for task", zap.String("task_id", task.ID()))
+
+		return nil, nil
+	}
+
+	// Attribute the trades to the aggregator or front-end sourcing them.
+	marketplace.Attribute(ethereumTask.Transaction, activity.Actions)
+
+	return activity, nil
+}
+
+func (w *worker) matchBlurExchange(log *ethereum.Log, eventHashes ...common.Hash) bool {
+	return log.Address == blur.AddressBlurExchange && contract.MatchEventHashes(log.Topics[0], eventHashes...)
+}
+
+func (w *worker) matchBlurExchangeV2(log *ethereum.Log, eventHashes ...common.Hash) bool {
+	return log.Address == blur.AddressBlurExchangeV2 && contract.MatchEventHashes(log.Topics[0], eventHashes...)
+}
+
+func (w *worker) matchBlend(log *ethereum.Log, eventHashes ...common.Hash) bool {
+	return log.Address == blur.AddressBlend && contract.MatchEventHashes(log.Topics[0], eventHashes...)
+}
+
+// handleBlurExchangeOrdersMatched returns a trade action of the sell and buy orders matched by the BlurExchange,
+// which are traded at the price of the maker order.
+func (w *worker) handleBlurExchangeOrdersMatched(ctx context.Context, task *source.Task, log *ethereum.Log) (*activityx.Action, error) {
+	event, err := w.blurExchangeFilterer.ParseOrdersMatched(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse OrdersMatched event: %w", err)
+	}
+
+	makerOrder := lo.Ternary(event.Maker == event.Sell.Trader, event.Sell, event.Buy)
+
+	return w.buildTradeAction(ctx, task, event.Sell.Trader, event.Buy.Trader, event.Sell.Collection, event.Sell.TokenId, event.Sell.Amount, paymentToken(makerOrder.PaymentToken), makerOrder.Price)
+}
+
+func (w *worker) handleBlurExchangeV2Execution(ctx context.Context, task *source.Task, log *ethereum.Log) (*activityx.Action, error) {
+	event, err := w.blurExchangeV2Filterer.ParseExecution(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse Execution event: %w", err)
+	}
+
+	return w.handleBlurExchangeV2Trade(ctx, task, event.OrderType, event.Transfer.Trader, event.Transfer.Collection, event.Transfer.Id, event.Transfer.Amount, event.Price)
+}
+
+// handleBlurExchangeV2Execution721Packed handles the packed executions of ERC-721 orders,
+// which are emitted with or without the fees of the maker or the taker.
+func (w *worker) handleBlurExchangeV2Execution721Packed(ctx context.Context, task *source.Task, log *ethereum.Log) (*activityx.Action, error) {
+	var tokenIDListingIndexTrader, collectionPriceSide *big.Int
+
+	switch log.Topics[0] {
+	case blur.EventHashBlurExchangeV2Execution721Packed:
+		event, err := w.blurExchangeV2Filterer.ParseExecution721Packed(log.Export())
+		if err != nil {
+			return nil, fmt.Errorf("parse Execution721Packed event: %w", err)
+		}
+
+		tokenIDListingIndexTrader, collectionPriceSide = event.TokenIdListingIndexTrader, event.CollectionPriceSide
+	case blur.EventHashBlurExchangeV2Execution721TakerFeePacked:
+		event, err := w.blurExchangeV2Filterer.ParseExecution721TakerFeePacked(log.Export())
+		if err != nil {
+			return nil, fmt.Errorf("parse Execution721TakerFeePacked event: %w", err)
+		}
+
+		tokenIDListingIndexTrader, collectionPriceSide = event.TokenIdListingIndexTrader, event.CollectionPriceSide
+	case blur.EventHashBlurExchangeV2Execution721MakerFeePacked:
+		event, err := w.blurExchangeV2Filterer.ParseExecution721MakerFeePacked(log.Export())
+		if err != nil {
+			return nil, fmt.Errorf("parse Execution721MakerFeePacked event: %w", err)
+		}
+
+		tokenIDListingIndexTrader, collectionPriceSide = event.TokenIdListingIndexTrader, event.CollectionPriceSide
+	}
+
+	execution := blur.UnpackExecution(tokenIDListingIndexTrader, collectionPriceSide)
+
+	return w.handleBlurExchangeV2Trade(ctx, task, execution.OrderType, execution.Trader, execution.Collection, execution.TokenID, big.NewInt(1), execution.Price)
+}
+
+// handleBlurExchangeV2Trade returns a trade action of an order of the BlurExchangeV2, which is paid in ETH or Blur Pool.
+// The trader of the order is the seller of an ask or the buyer of a bid, and the other side is the sender or the recipient of the token.
+func (w *worker) handleBlurExchangeV2Trade(ctx context.Context, task *source.Task, orderType uint8, trader, collection common.Address, tokenID, amount, price *big.Int) (*activityx.Action, error) {
+	seller, buyer := trader, task.Transaction.From
+	if orderType == blur.OrderTypeBid {
+		seller, buyer = task.Transaction.From, trader
+	}
+
+	if from, to, found := w.findTokenTransfer(task, collection, tokenID); found {
+		seller, buyer = from, to
+	}
+
+	return w.buildTradeAction(ctx, task, seller, buyer, collection, tokenID, amount, nil, price)
+}
+
+// handleBlendLoanOfferTaken returns a loan action of the loan offer taken by the borrower.
+func (w *worker) handleBlendLoanOfferTaken(ctx context.Context, task *source.Task, log *ethereum.Log) (*activityx.Action, error) {
+	event, err := w.blendFilterer.ParseLoanOfferTaken(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse LoanOfferTaken event: %w", err)
+	}
+
+	return w.buildLoanAction(ctx, task, event.Lender, event.Borrower, event.Collection, event.TokenId, event.LoanAmount, metadata.ActionExchangeLoanCreate)
+}
+
+// handleBlendRefinance returns a loan action of the lien refinanced by the new lender.
+func (w *worker) handleBlendRefinance(ctx context.Context, task *source.Task, log *ethereum.Log) (*activityx.Action, error) {
+	event, err := w.blendFilterer.ParseRefinance(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse Refinance event: %w", err)
+	}
+
+	lien, found := w.findLien(task, event.Collection, event.LienId)
+	if !found {
+		return nil, nil
+	}
+
+	return w.buildLoanAction(ctx, task, event.NewLender, lien.Borrower, event.Collection, lien.TokenID, event.NewAmount, metadata.ActionExchangeLoanRefinance)
+}
+
+// handleBlendRepay returns a loan action of the lien repaid by the borrower, the amount is the debt paid to the lender in Blur Pool.
+func (w *worker) handleBlendRepay(ctx context.Context, task *source.Task, log *ethereum.Log) (*activityx.Action, error) {
+	event, err := w.blendFilterer.ParseRepay(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse Repay event: %w", err)
+	}
+
+	lien, found := w.findLien(task, event.Collection, event.LienId)
+	if !found {
+		return nil, nil
+	}
+
+	amount, err := w.sumBlurPoolTransfers(task, lien.Borrower, &lien.Lender)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.buildLoanAction(ctx, task, lien.Borrower, lien.Lender, event.Collection, lien.TokenID, amount, metadata.ActionExchangeLoanRepay)
+}
+
+// handleBlendStartAuction returns an auction action of the lien auctioned by the lender for refinancing.
+func (w *worker) handleBlendStartAuction(ctx context.Context, task *source.Task, log *ethereum.Log) (*activityx.Action, error) {
+	event, err := w.blendFilterer.ParseStartAuction(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse StartAuction event: %w", err)
+	}
+
+	lien, found := w.findLien(task, event.Collection, event.LienId)
+	if !found {
+		return nil, nil
+	}
+
+	tokenMetadata, err := w.lookupCollectible(ctx, task, event.Collection, lien.TokenID, big.NewInt(1))
+	if err != nil {
+		return nil, err
+	}
+
+	action := activityx.Action{
+		Type:     typex.CollectibleAuction,
+		Platform: w.Platform(),
+		From:     lien.Lender.String(),
+		To:       lien.Borrower.String(),
+		Metadata: metadata.CollectibleAuction{
+			Action: metadata.ActionCollectibleAuctionCreate,
+			Token:  *tokenMetadata,
+		},
+	}
+
+	return &action, nil
+}
+
+// handleBlendSeize returns a loan action of the collateral seized by the lender from the defaulted lien.
+func (w *worker) handleBlendSeize(ctx context.Context, task *source.Task, log *ethereum.Log) (*activityx.Action, error) {
+	event, err := w.blendFilterer.ParseSeize(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse Seize event: %w", err)
+	}
+
+	lien, found := w.findLien(task, event.Collection, event.LienId)
+	if !found {
+		return nil, nil
+	}
+
+	return w.buildLoanAction(ctx, task, lien.Borrower, lien.Lender, event.Collection, lien.TokenID, nil, metadata.ActionExchangeLoanSeize)
+}
+
+// handleBlendBuyLocked returns a trade action of the collateral bought from the borrower,
+// the cost is the Blur Pool paid by the buyer, which repays the lien and pays the borrower and the fees.
+func (w *worker) handleBlendBuyLocked(ctx context.Context, task *source.Task, log *ethereum.Log) (*activityx.Action, error) {
+	event, err := w.blendFilterer.ParseBuyLocked(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse BuyLocked event: %w", err)
+	}
+
+	cost, err := w.sumBlurPoolTransfers(task, event.Buyer, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.buildTradeAction(ctx, task, event.Seller, event.Buyer, event.Collection, event.TokenId, big.NewInt(1), nil, cost)
+}
+
+// findLien finds the lien in the calldata, as only the ID of the lien is emitted by the events.
+func (w *worker) findLien(task *source.Task, collection common.Address, lienID *big.Int) (*blur.Lien, bool) {
+	lien, found := blur.FindLien(task.Transaction.Input, collection, lienID)
+	if !found {
+		zap.L().Debug("lien not found in calldata", zap.String("transaction_hash", task.Transaction.Hash.String()), zap.Stringer("lien_id", lienID))
+	}
+
+	return lien, found
+}
+
+// findTokenTransfer finds the sender and the recipient of the token in the transfers of the transaction.
+func (w *worker) findTokenTransfer(task *source.Task, collection common.Address, tokenID *big.Int) (from, to common.Address, found bool) {
+	for _, log := range task.Receipt.Logs {
+		if len(log.Topics) == 0 || log.Address != collection {
+			continue
+		}
+
+		switch {
+		case log.Topics[0] == erc721.EventHashTransfer && len(log.Topics) == 4:
+			event, err := w.erc721Filterer.ParseTransfer(log.Export())
+			if err != nil || event.TokenId.Cmp(tokenID) != 0 {
+				continue
+			}
+
+			return event.From, event.To, true
+		case log.Topics[0] == erc1155.EventHashTransferSingle:
+			event, err := w.erc1155Filterer.ParseTransferSingle(log.Export())
+			if err != nil || event.Id.Cmp(tokenID) != 0 {
+				continue
+			}
+
+			return event.From, event.To, true
+		}
+	}
+
+	return ethereum.AddressGenesis, ethereum.AddressGenesis, false
+}
+
+// sumBlurPoolTransfers returns the sum of the Blur Pool transferred from the sender to the recipient,
+// or to anyone if the recipient is nil, and returns nil if nothing is transferred.
+func (w *worker) sumBlurPoolTransfers(task *source.Task, from common.Address, to *common.Address) (*big.Int, error) {
+	var sum *big.Int
+
+	for _, log := range task.Receipt.Logs {
+		if log.Address != blur.AddressBlurPool || len(log.Topics) != 3 || log.Topics[0] != erc20.EventHashTransfer {
+			continue
+		}
+
+		event, err := w.erc20Filterer.ParseTransfer(log.Export())
+		if err != nil {
+			return nil, fmt.Errorf("parse Transfer event: %w", err)
+		}
+
+		if event.From != from || (to != nil && event.To != *to) {
+			continue
+		}
+
+		if sum == nil {
+			sum = new(big.Int)
+		}
+
+		sum.Add(sum, event.Value)
+	}
+
+	return sum, nil
+}
+
+func (w *worker) buildTradeAction(ctx context.Context, task *source.Task, seller, buyer, collection common.Address, tokenID, amount *big.Int, paymentToken *common.Address, price *big.Int) (*activityx.Action, error) {
+	tokenMetadata, err := w.lookupCollectible(ctx, task, collection, tokenID, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	costMetadata, err := w.lookupToken(ctx, task, paymentToken, price)
+	if err != nil {
+		return nil, err
+	}
+
+	action := activityx.Action{
+		Type:     typex.CollectibleTrade,
+		Platform: w.Platform(),
+		From:     seller.String(),
+		To:       buyer.String(),
+		Metadata: metadata.CollectibleTrade{
+			Action: lo.Ternary(task.Transaction.From == seller, metadata.ActionCollectibleTradeSell, metadata.ActionCollectibleTradeBuy),
+			Token:  *tokenMetadata,
+			Cost:   costMetadata,
+		},
+	}
+
+	return &action, nil
+}
+
+// buildLoanAction returns a loan action of the collateral, the amount of the loan is in ETH, which is lent in Blur Pool.
+func (w *worker) buildLoanAction(ctx context.Context, task *source.Task, from, to, collection common.Address, tokenID, amount *big.Int, loanAction metadata.ExchangeLoanAction) (*activityx.Action, error) {
+	collateralMetadata, err := w.lookupCollectible(ctx, task, collection, tokenID, big.NewInt(1))
+	if err != nil {
+		return nil, err
+	}
+
+	amountMetadata, err := w.lookupToken(ctx, task, nil, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	action := activityx.Action{
+		Type:     typex.ExchangeLoan,
+		Platform: w.Platform(),
+		From:     from.String(),
+		To:       to.String(),
+		Metadata: metadata.ExchangeLoan{
+			Action:     loanAction,
+			Collateral: *collateralMetadata,
+			Amount:     amountMetadata,
+		},
+	}
+
+	return &action, nil
+}
+
+func (w *worker) lookupCollectible(ctx context.Context, task *source.Task, collection common.Address, tokenID, amount *big.Int) (*metadata.Token, error) {
+	tokenMetadata, err := w.tokenClient.Lookup(ctx, task.ChainID, &collection, tokenID, task.Header.Number)
+	if err != nil {
+		return nil, fmt.Errorf("lookup collectible %s %s: %w", collection, tokenID, err)
+	}
+
+	tokenMetadata.Value = lo.ToPtr(decimal.NewFromBigInt(utils.GetBigInt(amount), 0))
+
+	return tokenMetadata, nil
+}
+
+// lookupToken returns the metadata of the token with the value, or nil if the value is nil.
+func (w *worker) lookupToken(ctx context.Context, task *source.Task, tokenAddress *common.Address, value *big.Int) (*metadata.Token, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	tokenMetadata, err := w.tokenClient.Lookup(ctx, task.ChainID, tokenAddress, nil, task.Header.Number)
+	if err != nil {
+		return nil, fmt.Errorf("lookup token %s: %w", lo.FromPtr(tokenAddress), err)
+	}
+
+	tokenMetadata.Value = lo.ToPtr(decimal.NewFromBigInt(value, 0))
+
+	return tokenMetadata, nil
+}
+
+// paymentToken returns the token paying the trade, or nil if it is paid in ETH or Blur Pool,
+// as the Blur Pool is redeemable for ETH at 1:1.
+func paymentToken(address common.Address) *common.Address {
+	if address == ethereum.AddressGenesis || address == blur.AddressBlurPool {
+		return nil
+	}
+
+	return &address
+}
+
+// NewWorker returns a new Blur worker.
+func NewWorker(config *config.Module) (engine.Worker, error) {
+	var (
+		err      error
+		instance = worker{
+			config: config,
+		}
+	)
+
+	if instance.ethereumClient, err = ethereum.Dial(context.Background(), config.Endpoint.URL, config.Endpoint.BuildEthereumOptions()...); err != nil {
+		return nil, fmt.Errorf("initialize ethereum client: %w", err)
+	}
+
+	instance.tokenClient = token.NewClient(instance.ethereumClient)
+
+	instance.blurExchangeFilterer = lo.Must(blur.NewBlurExchangeFilterer(ethereum.AddressGenesis, nil))
+	instance.blurExchangeV2Filterer = lo.Must(blur.NewBlurExchangeV2Filterer(ethereum.AddressGenesis, nil))
+	instance.blendFilterer = lo.Must(blur.NewBlendFilterer(ethereum.AddressGenesis, nil))
+	instance.erc20Filterer = lo.Must(erc20.NewERC20Filterer(ethereum.AddressGenesis, nil))
+	instance.erc721Filterer = lo.Must(erc721.NewERC721Filterer(ethereum.AddressGenesis, nil))
+	instance.erc1155Filterer = lo.Must(erc1155.NewERC1155Filterer(ethereum.AddressGenesis, nil))
+
+	return &instance, nil
+}
@@ -0,0 +1,534 @@
+package blur_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rss3-network/node/config"
+	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	worker "github.com/rss3-network/node/internal/engine/worker/decentralized/contract/blur"
+	"github.com/rss3-network/node/internal/testsuite"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract/blur"
+	workerx "github.com/rss3-network/node/schema/worker/decentralized"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// The collections of the test cases are served by a local JSON-RPC server.
+var collections = map[common.Address]testsuite.ERC721Collection{
+	common.HexToAddress("0xED5AF388653567Af2F388E6224dC7C4b3241C544"): {Name: "Azuki", Symbol: "AZUKI"},
+}
+
+func TestWorker_Ethereum(t *testing.T) {
+	t.Parallel()
+
+	type arguments struct {
+		task   *source.Task
+		config *config.Module
+	}
+
+	testcases := []struct {
+		name      string
+		arguments arguments
+		want      *activityx.Activity
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name: "Buy Azuki #4103 on Blur Exchange V2",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+						ParentHash: common.HexToHash("0xcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcb"),
+						Number:     lo.Must(new(big.Int).SetString("20800000", 0)),
+						GasLimit:   30000000,
+						GasUsed:    15000000,
+						Timestamp:  1727500000,
+						BaseFee:    lo.Must(new(big.Int).SetString("10000000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+						From:      common.HexToAddress("0x2a0a0b2c8a7f1d3e4c5b6a798877665544332211"),
+						Gas:       300000,
+						GasPrice:  lo.Must(new(big.Int).SetString("11000000000", 10)),
+						Hash:      common.HexToHash("0x5a1f3b2c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8"),
+						Input:     hexutil.MustDecode("0x3925c3c30000000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(blur.AddressBlurExchangeV2),
+						Value:     lo.Must(new(big.Int).SetString("5200000000000000000", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20800000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x28fa6ae00"),
+						GasUsed:           150000,
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0xED5AF388653567Af2F388E6224dC7C4b3241C544"),
+								Topics: []common.Hash{
+									common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+									common.HexToHash("0x0000000000000000000000004e2b1d8c3a5f6e7d8c9b0a1f2e3d4c5b6a798877"),
+									common.HexToHash("0x0000000000000000000000002a0a0b2c8a7f1d3e4c5b6a798877665544332211"),
+									common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000001007"),
+								},
+								Data:            hexutil.MustDecode("0x"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20800000", 0)),
+								TransactionHash: common.HexToHash("0x5a1f3b2c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8"),
+								Index:           100,
+								Removed:         false,
+							},
+							{
+								Address: blur.AddressBlurExchangeV2,
+								Topics: []common.Hash{
+									common.HexToHash("0x1d5e12b51dee5e4d34434576c3fb99714a85f57b0fd546ada4b0bddd736d12b2"),
+								},
+								Data:            hexutil.MustDecode("0x00000000000000000000000000000000000000000000000000000000000000ab0000000000000000001007004e2b1d8c3a5f6e7d8c9b0a1f2e3d4c5b6a79887700000000482a1c7300080000ed5af388653567af2f388e6224dc7c4b3241c544"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20800000", 0)),
+								TransactionHash: common.HexToHash("0x5a1f3b2c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8"),
+								Index:           101,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x5a1f3b2c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8"),
+						TransactionIndex: 42,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x5a1f3b2c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8",
+				Network:  network.Ethereum,
+				Index:    42,
+				From:     "0x2a0a0B2C8A7f1d3E4C5b6A798877665544332211",
+				To:       blur.AddressBlurExchangeV2.String(),
+				Type:     typex.CollectibleTrade,
+				Platform: workerx.PlatformBlur.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("1650000000000000")),
+					Decimal: 18,
+				},
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x3925c3c3",
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.CollectibleTrade,
+						Platform: workerx.PlatformBlur.String(),
+						From:     "0x4E2B1d8C3a5f6e7D8C9B0A1f2E3d4c5b6a798877",
+						To:       "0x2a0a0B2C8A7f1d3E4C5b6A798877665544332211",
+						Metadata: metadata.CollectibleTrade{
+							Action: metadata.ActionCollectibleTradeBuy,
+							Token: metadata.Token{
+								Address:  lo.ToPtr("0xED5AF388653567Af2F388E6224dC7C4b3241C544"),
+								ID:       lo.ToPtr(lo.Must(decimal.NewFromString("4103"))),
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("1"))),
+								Name:     "Azuki",
+								Symbol:   "AZUKI",
+								Standard: metadata.StandardERC721,
+							},
+							Cost: &metadata.Token{
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("5200000000000000000"))),
+								Name:     "Ethereum",
+								Symbol:   "ETH",
+								Decimals: 18,
+							},
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1727500000,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Buy Azuki #4103 on Blur Exchange through Blur aggregator",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+						ParentHash: common.HexToHash("0xcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcb"),
+						Number:     lo.Must(new(big.Int).SetString("20800100", 0)),
+						GasLimit:   30000000,
+						GasUsed:    15000000,
+						Timestamp:  1727501200,
+						BaseFee:    lo.Must(new(big.Int).SetString("10000000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+						From:      common.HexToAddress("0x2a0a0b2c8a7f1d3e4c5b6a798877665544332211"),
+						Gas:       300000,
+						GasPrice:  lo.Must(new(big.Int).SetString("11000000000", 10)),
+						Hash:      common.HexToHash("0x6b2f4c3d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809"),
+						Input:     hexutil.MustDecode("0x9a2b81150000000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(blur.AddressAggregator),
+						Value:     lo.Must(new(big.Int).SetString("5200000000000000000", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20800100", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x28fa6ae00"),
+						GasUsed:           150000,
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0xED5AF388653567Af2F388E6224dC7C4b3241C544"),
+								Topics: []common.Hash{
+									common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+									common.HexToHash("0x0000000000000000000000004e2b1d8c3a5f6e7d8c9b0a1f2e3d4c5b6a798877"),
+									common.HexToHash("0x0000000000000000000000002a0a0b2c8a7f1d3e4c5b6a798877665544332211"),
+									common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000001007"),
+								},
+								Data:            hexutil.MustDecode("0x"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20800100", 0)),
+								TransactionHash: common.HexToHash("0x6b2f4c3d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809"),
+								Index:           100,
+								Removed:         false,
+							},
+							{
+								Address: blur.AddressBlurExchange,
+								Topics: []common.Hash{
+									common.HexToHash("0x61cbb2a3dee0b6064c2e681aadd61677fb4ef319f0b547508d495626f5a62f64"),
+									common.HexToHash("0x0000000000000000000000004e2b1d8c3a5f6e7d8c9b0a1f2e3d4c5b6a798877"),
+									common.HexToHash("0x0000000000000000000000002a0a0b2c8a7f1d3e4c5b6a798877665544332211"),
+								},
+								Data:            hexutil.MustDecode("0x00000000000000000000000000000000000000000000000000000000000000800000000000000000000000000000000000000000000000000000000000000011000000000000000000000000000000000000000000000000000000000000026000000000000000000000000000000000000000000000000000000000000000220000000000000000000000004e2b1d8c3a5f6e7d8c9b0a1f2e3d4c5b6a79887700000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000dab4a563819e8fd93dba3b25bc3495000000000000000000000000ed5af388653567af2f388e6224dc7c4b3241c544000000000000000000000000000000000000000000000000000000000000100700000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000482a1c7300080000000000000000000000000000000000000000000000000000000000006553f100000000000000000000000000000000000000000000000000000000006b49d20000000000000000000000000000000000000000000000000000000000000001a0000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000001c0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002a0a0b2c8a7f1d3e4c5b6a79887766554433221100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000dab4a563819e8fd93dba3b25bc3495000000000000000000000000ed5af388653567af2f388e6224dc7c4b3241c544000000000000000000000000000000000000000000000000000000000000100700000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000482a1c7300080000000000000000000000000000000000000000000000000000000000006553f100000000000000000000000000000000000000000000000000000000006b49d20000000000000000000000000000000000000000000000000000000000000001a0000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20800100", 0)),
+								TransactionHash: common.HexToHash("0x6b2f4c3d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809"),
+								Index:           101,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x6b2f4c3d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809"),
+						TransactionIndex: 42,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x6b2f4c3d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809",
+				Network:  network.Ethereum,
+				Index:    42,
+				From:     "0x2a0a0B2C8A7f1d3E4C5b6A798877665544332211",
+				To:       blur.AddressAggregator.String(),
+				Type:     typex.CollectibleTrade,
+				Platform: workerx.PlatformBlur.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("1650000000000000")),
+					Decimal: 18,
+				},
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x9a2b8115",
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.CollectibleTrade,
+						Platform: workerx.PlatformBlur.String(),
+						From:     "0x4E2B1d8C3a5f6e7D8C9B0A1f2E3d4c5b6a798877",
+						To:       "0x2a0a0B2C8A7f1d3E4C5b6A798877665544332211",
+						Metadata: metadata.CollectibleTrade{
+							Action: metadata.ActionCollectibleTradeBuy,
+							Token: metadata.Token{
+								Address:  lo.ToPtr("0xED5AF388653567Af2F388E6224dC7C4b3241C544"),
+								ID:       lo.ToPtr(lo.Must(decimal.NewFromString("4103"))),
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("1"))),
+								Name:     "Azuki",
+								Symbol:   "AZUKI",
+								Standard: metadata.StandardERC721,
+							},
+							Cost: &metadata.Token{
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("5200000000000000000"))),
+								Name:     "Ethereum",
+								Symbol:   "ETH",
+								Decimals: 18,
+							},
+						},
+						RelatedURLs: []string{"https://blur.io"},
+					},
+				},
+				Status:    true,
+				Timestamp: 1727501200,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Borrow against Azuki #4103 on Blend",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+						ParentHash: common.HexToHash("0xcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcb"),
+						Number:     lo.Must(new(big.Int).SetString("20800200", 0)),
+						GasLimit:   30000000,
+						GasUsed:    15000000,
+						Timestamp:  1727502400,
+						BaseFee:    lo.Must(new(big.Int).SetString("10000000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+						From:      common.HexToAddress("0x2a0a0b2c8a7f1d3e4c5b6a798877665544332211"),
+						Gas:       300000,
+						GasPrice:  lo.Must(new(big.Int).SetString("11000000000", 10)),
+						Hash:      common.HexToHash("0x7c3f5d4e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a"),
+						Input:     hexutil.MustDecode("0xe1ec3c680000000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(blur.AddressBlend),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20800200", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x28fa6ae00"),
+						GasUsed:           150000,
+						Logs: []*ethereum.Log{
+							{
+								Address: blur.AddressBlend,
+								Topics: []common.Hash{
+									common.HexToHash("0x06a333c2d6fe967ca967f7a35be2eb45e8caeb6cf05e16f55d42b91b5fe31255"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000033000000000000000000000000000000000000000000000000000000000001e240000000000000000000000000ed5af388653567af2f388e6224dc7c4b3241c544000000000000000000000000843829986e895fac0f1a2d8e7e3b0e33f9a9c0d10000000000000000000000002a0a0b2c8a7f1d3e4c5b6a79887766554433221100000000000000000000000000000000000000000000000029a2241af62c000000000000000000000000000000000000000000000000000000000000000005dc00000000000000000000000000000000000000000000000000000000000010070000000000000000000000000000000000000000000000000000000000002328"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20800200", 0)),
+								TransactionHash: common.HexToHash("0x7c3f5d4e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a"),
+								Index:           100,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x7c3f5d4e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a"),
+						TransactionIndex: 42,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x7c3f5d4e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a",
+				Network:  network.Ethereum,
+				Index:    42,
+				From:     "0x2a0a0B2C8A7f1d3E4C5b6A798877665544332211",
+				To:       blur.AddressBlend.String(),
+				Type:     typex.ExchangeLoan,
+				Platform: workerx.PlatformBlur.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("1650000000000000")),
+					Decimal: 18,
+				},
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xe1ec3c68",
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeLoan,
+						Platform: workerx.PlatformBlur.String(),
+						From:     "0x843829986E895faC0f1A2d8E7e3b0E33F9a9C0D1",
+						To:       "0x2a0a0B2C8A7f1d3E4C5b6A798877665544332211",
+						Metadata: metadata.ExchangeLoan{
+							Action: metadata.ActionExchangeLoanCreate,
+							Collateral: metadata.Token{
+								Address:  lo.ToPtr("0xED5AF388653567Af2F388E6224dC7C4b3241C544"),
+								ID:       lo.ToPtr(lo.Must(decimal.NewFromString("4103"))),
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("1"))),
+								Name:     "Azuki",
+								Symbol:   "AZUKI",
+								Standard: metadata.StandardERC721,
+							},
+							Amount: &metadata.Token{
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("3000000000000000000"))),
+								Name:     "Ethereum",
+								Symbol:   "ETH",
+								Decimals: 18,
+							},
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1727502400,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Repay a loan of Azuki #4103 on Blend",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+						ParentHash: common.HexToHash("0xcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcbcb"),
+						Number:     lo.Must(new(big.Int).SetString("20810000", 0)),
+						GasLimit:   30000000,
+						GasUsed:    15000000,
+						Timestamp:  1727620000,
+						BaseFee:    lo.Must(new(big.Int).SetString("10000000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+						From:      common.HexToAddress("0x2a0a0b2c8a7f1d3e4c5b6a798877665544332211"),
+						Gas:       300000,
+						GasPrice:  lo.Must(new(big.Int).SetString("11000000000", 10)),
+						Hash:      common.HexToHash("0x8d4f6e5f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b"),
+						Input:     hexutil.MustDecode("0xd4a9e0a8000000000000000000000000843829986e895fac0f1a2d8e7e3b0e33f9a9c0d10000000000000000000000002a0a0b2c8a7f1d3e4c5b6a798877665544332211000000000000000000000000ed5af388653567af2f388e6224dc7c4b3241c544000000000000000000000000000000000000000000000000000000000000100700000000000000000000000000000000000000000000000029a2241af62c000000000000000000000000000000000000000000000000000000000000670e724000000000000000000000000000000000000000000000000000000000000005dcffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000000000000000000000000000000000000000000000000000000000002328000000000000000000000000000000000000000000000000000000000001e240"),
+						To:        lo.ToPtr(blur.AddressBlend),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20810000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x28fa6ae00"),
+						GasUsed:           150000,
+						Logs: []*ethereum.Log{
+							{
+								Address: blur.AddressBlurPool,
+								Topics: []common.Hash{
+									common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+									common.HexToHash("0x0000000000000000000000002a0a0b2c8a7f1d3e4c5b6a798877665544332211"),
+									common.HexToHash("0x000000000000000000000000843829986e895fac0f1a2d8e7e3b0e33f9a9c0d1"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000000000000000000000000000002a53c6d724f10000"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20810000", 0)),
+								TransactionHash: common.HexToHash("0x8d4f6e5f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b"),
+								Index:           100,
+								Removed:         false,
+							},
+							{
+								Address: blur.AddressBlend,
+								Topics: []common.Hash{
+									common.HexToHash("0x2469cc9e12e74c63438d5b1117b318cd3a4cdaf9d659d9eac6d975d14d963254"),
+								},
+								Data:            hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000001e240000000000000000000000000ed5af388653567af2f388e6224dc7c4b3241c544"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20810000", 0)),
+								TransactionHash: common.HexToHash("0x8d4f6e5f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b"),
+								Index:           101,
+								Removed:         false,
+							},
+							{
+								Address: common.HexToAddress("0xED5AF388653567Af2F388E6224dC7C4b3241C544"),
+								Topics: []common.Hash{
+									common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+									common.HexToHash("0x00000000000000000000000029469395eaf6f95920e59f858042f0e28d98a20b"),
+									common.HexToHash("0x0000000000000000000000002a0a0b2c8a7f1d3e4c5b6a798877665544332211"),
+									common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000001007"),
+								},
+								Data:            hexutil.MustDecode("0x"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20810000", 0)),
+								TransactionHash: common.HexToHash("0x8d4f6e5f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b"),
+								Index:           102,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x8d4f6e5f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b"),
+						TransactionIndex: 42,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x8d4f6e5f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b",
+				Network:  network.Ethereum,
+				Index:    42,
+				From:     "0x2a0a0B2C8A7f1d3E4C5b6A798877665544332211",
+				To:       blur.AddressBlend.String(),
+				Type:     typex.ExchangeLoan,
+				Platform: workerx.PlatformBlur.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("1650000000000000")),
+					Decimal: 18,
+				},
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xd4a9e0a8",
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeLoan,
+						Platform: workerx.PlatformBlur.String(),
+						From:     "0x2a0a0B2C8A7f1d3E4C5b6A798877665544332211",
+						To:       "0x843829986E895faC0f1A2d8E7e3b0E33F9a9C0D1",
+						Metadata: metadata.ExchangeLoan{
+							Action: metadata.ActionExchangeLoanRepay,
+							Collateral: metadata.Token{
+								Address:  lo.ToPtr("0xED5AF388653567Af2F388E6224dC7C4b3241C544"),
+								ID:       lo.ToPtr(lo.Must(decimal.NewFromString("4103"))),
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("1"))),
+								Name:     "Azuki",
+								Symbol:   "AZUKI",
+								Standard: metadata.StandardERC721,
+							},
+							Amount: &metadata.Token{
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("3050000000000000000"))),
+								Name:     "Ethereum",
+								Symbol:   "ETH",
+								Decimals: 18,
+							},
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1727620000,
+			},
+			wantError: require.NoError,
+		},
+	}
+
+	endpointURL := testsuite.NewEthereumRPCServer(t, testsuite.ERC721Call(collections))
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			module := *testcase.arguments.config
+			module.Endpoint = config.Endpoint{URL: endpointURL}
+
+			instance, err := worker.NewWorker(&module)
+			require.NoError(t, err)
+
+			activity, err := instance.Transform(ctx, testcase.arguments.task)
+			testcase.wantError(t, err)
+
+			t.Log(string(lo.Must(json.MarshalIndent(activity, "", "\x20\x20"))))
+
+			require.Equal(t, testcase.want, activity)
+		})
+	}
+}
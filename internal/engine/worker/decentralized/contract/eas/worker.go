@@ -0,0 +1,333 @@
+package eas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/database"
+	"github.com/rss3-network/node/internal/database/model"
+	"github.com/rss3-network/node/internal/engine"
+	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+	"github.com/rss3-network/node/provider/ethereum/contract/eas"
+	"github.com/rss3-network/node/schema/worker/decentralized"
+	"github.com/rss3-network/protocol-go/schema"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+var _ engine.MutatingWorker = (*worker)(nil)
+
+type worker struct {
+	config         *config.Module
+	ethereumClient ethereum.Client
+	databaseClient database.Client
+	easFilterer    *eas.EASFilterer
+}
+
+func (w *worker) Name() string {
+	return decentralized.EAS.String()
+}
+
+func (w *worker) Platform() string {
+	return decentralized.PlatformEAS.String()
+}
+
+func (w *worker) Network() []network.Network {
+	return []network.Network{
+		network.Arbitrum,
+		network.Base,
+		network.Ethereum,
+		network.Linea,
+		network.Optimism,
+	}
+}
+
+func (w *worker) Tags() []tag.Tag {
+	return []tag.Tag{
+		tag.Social,
+	}
+}
+
+func (w *worker) Types() []schema.Type {
+	return []schema.Type{
+		typex.SocialPost,
+		typex.SocialDelete,
+	}
+}
+
+// Filter returns a filter of the attestations and revocations of EAS.
+func (w *worker) Filter() engine.DataSourceFilter {
+	return &source.Filter{
+		LogAddresses: []common.Address{
+			eas.AddressEASMainnet,
+			eas.AddressEASOPStack,
+			eas.AddressEASArbitrum,
+			eas.AddressEASLinea,
+		},
+		LogTopics: []common.Hash{
+			eas.EventAttested,
+			eas.EventRevoked,
+		},
+	}
+}
+
+func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	activity, _, err := w.TransformMutations(ctx, task)
+
+	return activity, err
+}
+
+// TransformMutations transforms the task into the activity and the mutation saving the schemas fetched from the
+// SchemaRegistry, which are saved to the dataset after the activity is saved.
+func (w *worker) TransformMutations(ctx context.Context, task engine.Task) (*activityx.Activity, []*engine.Mutation, error) {
+	ethereumTask, ok := task.(*source.Task)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid task type: %T", task)
+	}
+
+	deployment, ok := eas.DeploymentOf(ethereumTask.Network)
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported network %s", ethereumTask.Network)
+	}
+
+	activity, err := ethereumTask.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("build activity: %w", err)
+	}
+
+	// The schemas fetched from the SchemaRegistry for the task.
+	schemas := make(map[common.Hash]*model.EASSchema)
+
+	for _, log := range ethereumTask.Receipt.Logs {
+		if len(log.Topics) == 0 || log.Address != deployment.EAS {
+			continue
+		}
+
+		var (
+			action *activityx.Action
+			err    error
+		)
+
+		switch {
+		case w.matchAttested(log):
+			action, err = w.handleAttested(ctx, ethereumTask, deployment, log, schemas)
+		case w.matchRevoked(log):
+			action, err = w.handleRevoked(ctx, ethereumTask, deployment, log, schemas)
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if activity.Type == typex.Unknown {
+			activity.Type = action.Type
+		}
+
+		activity.Actions = append(activity.Actions, action)
+	}
+
+	if len(activity.Actions) == 0 {
+		zap.L().Debug("no actions generated for task", zap.String("task_id", task.ID()))
+
+		return nil, nil, nil
+	}
+
+	return activity, w.buildSchemaMutations(activity, lo.Values(schemas)), nil
+}
+
+// buildSchemaMutations returns the mutation saving the schemas to the dataset, which is empty without a database.
+func (w *worker) buildSchemaMutations(activity *activityx.Activity, schemas []*model.EASSchema) []*engine.Mutation {
+	if w.databaseClient == nil || len(schemas) == 0 {
+		return nil
+	}
+
+	return []*engine.Mutation{
+		{
+			Network: activity.Network,
+			ID:      activity.ID,
+			Save: func(ctx context.Context) error {
+				for _, schemaRecord := range schemas {
+					if err := w.databaseClient.SaveDatasetEASSchema(ctx, schemaRecord); err != nil {
+						return fmt.Errorf("save dataset eas schema %s: %w", schemaRecord.UID, err)
+					}
+				}
+
+				return nil
+			},
+		},
+	}
+}
+
+func (w *worker) matchAttested(log *ethereum.Log) bool {
+	return len(log.Topics) == 4 && contract.MatchEventHashes(log.Topics[0], eas.EventAttested)
+}
+
+func (w *worker) matchRevoked(log *ethereum.Log) bool {
+	return len(log.Topics) == 4 && contract.MatchEventHashes(log.Topics[0], eas.EventRevoked)
+}
+
+// handleAttested returns a post action of the attestation from the attester to the recipient.
+func (w *worker) handleAttested(ctx context.Context, task *source.Task, deployment eas.Deployment, log *ethereum.Log, schemas map[common.Hash]*model.EASSchema) (*activityx.Action, error) {
+	event, err := w.easFilterer.ParseAttested(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse attested event: %w", err)
+	}
+
+	post, err := w.buildAttestationMetadata(ctx, task, deployment, event.Uid, event.SchemaUID, false, schemas)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.buildAction(typex.SocialPost, event.Attester, event.Recipient, *post), nil
+}
+
+// handleRevoked returns a delete action of the attestation revoked by the attester.
+func (w *worker) handleRevoked(ctx context.Context, task *source.Task, deployment eas.Deployment, log *ethereum.Log, schemas map[common.Hash]*model.EASSchema) (*activityx.Action, error) {
+	event, err := w.easFilterer.ParseRevoked(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse revoked event: %w", err)
+	}
+
+	post, err := w.buildAttestationMetadata(ctx, task, deployment, event.Uid, event.SchemaUID, true, schemas)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.buildAction(typex.SocialDelete, event.Attester, event.Recipient, metadata.SocialDelete(*post)), nil
+}
+
+func (w *worker) buildAction(actionType schema.Type, attester, recipient common.Address, actionMetadata metadata.Metadata) *activityx.Action {
+	action := activityx.Action{
+		Type:     actionType,
+		Tag:      tag.Social,
+		Platform: w.Platform(),
+		From:     attester.String(),
+		Metadata: actionMetadata,
+	}
+
+	// The attestations without a recipient are attested to the zero address.
+	if recipient != ethereum.AddressGenesis {
+		action.To = recipient.String()
+	}
+
+	return &action
+}
+
+// buildAttestationMetadata returns the post of an attestation, the metadata has no fields for attestations,
+// so the data decoded by the schema is the body in JSON, and the schema is the summary and the tag.
+// The timestamp is the time of the revocation if the attestation is revoked.
+func (w *worker) buildAttestationMetadata(ctx context.Context, task *source.Task, deployment eas.Deployment, uid, schemaUID common.Hash, revoked bool, schemas map[common.Hash]*model.EASSchema) (*metadata.SocialPost, error) {
+	caller, err := eas.NewEASCaller(deployment.EAS, w.ethereumClient)
+	if err != nil {
+		return nil, fmt.Errorf("initialize eas caller: %w", err)
+	}
+
+	attestation, err := caller.GetAttestation(&bind.CallOpts{Context: ctx, BlockNumber: task.Header.Number}, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get attestation %s: %w", uid, err)
+	}
+
+	schemaRecord, err := w.getSchema(ctx, deployment, schemaUID, task.Header.Number, schemas)
+	if err != nil {
+		return nil, err
+	}
+
+	post := metadata.SocialPost{
+		Summary:       schemaRecord.Schema,
+		PublicationID: uid.String(),
+		Tags:          []string{schemaUID.String()},
+		Timestamp:     lo.Ternary(revoked, attestation.RevocationTime, attestation.Time),
+	}
+
+	// The attestation references another attestation.
+	if refUID := common.Hash(attestation.RefUID); refUID != (common.Hash{}) {
+		post.Target = &metadata.SocialPost{
+			PublicationID: refUID.String(),
+		}
+	}
+
+	fields, err := eas.DecodeData(schemaRecord.Schema, attestation.Data)
+	if err != nil {
+		// The attestation is still indexed without the data, which is not encoded by the schema.
+		zap.L().Warn("failed to decode attestation data", zap.String("uid", post.PublicationID), zap.String("schema", schemaRecord.Schema), zap.Error(err))
+
+		return &post, nil
+	}
+
+	body, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("marshal attestation data: %w", err)
+	}
+
+	post.Body = string(body)
+
+	return &post, nil
+}
+
+// getSchema returns the schema of the UID from the dataset, or from the SchemaRegistry if it is not found,
+// the schemas fetched from the SchemaRegistry are added to the schemas to be saved after the activity is saved.
+func (w *worker) getSchema(ctx context.Context, deployment eas.Deployment, uid common.Hash, blockNumber *big.Int, schemas map[common.Hash]*model.EASSchema) (*model.EASSchema, error) {
+	if schemaRecord, exists := schemas[uid]; exists {
+		return schemaRecord, nil
+	}
+
+	if w.databaseClient != nil {
+		if schemaRecord, err := w.databaseClient.LoadDatasetEASSchema(ctx, uid); err == nil {
+			return schemaRecord, nil
+		}
+	}
+
+	caller, err := eas.NewSchemaRegistryCaller(deployment.SchemaRegistry, w.ethereumClient)
+	if err != nil {
+		return nil, fmt.Errorf("initialize schema registry caller: %w", err)
+	}
+
+	record, err := caller.GetSchema(&bind.CallOpts{Context: ctx, BlockNumber: blockNumber}, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get schema %s: %w", uid, err)
+	}
+
+	schemaRecord := model.EASSchema{
+		UID:       record.Uid,
+		Resolver:  record.Resolver,
+		Revocable: record.Revocable,
+		Schema:    record.Schema,
+	}
+
+	schemas[uid] = &schemaRecord
+
+	return &schemaRecord, nil
+}
+
+// NewWorker returns a new EAS worker.
+func NewWorker(config *config.Module, databaseClient database.Client) (engine.Worker, error) {
+	var (
+		err      error
+		instance = worker{
+			config:         config,
+			databaseClient: databaseClient,
+		}
+	)
+
+	if instance.ethereumClient, err = ethereum.Dial(context.Background(), config.Endpoint.URL, config.Endpoint.BuildEthereumOptions()...); err != nil {
+		return nil, fmt.Errorf("initialize ethereum client: %w", err)
+	}
+
+	instance.easFilterer = lo.Must(eas.NewEASFilterer(ethereum.AddressGenesis, nil))
+
+	return &instance, nil
+}
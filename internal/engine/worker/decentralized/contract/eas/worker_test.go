@@ -0,0 +1,527 @@
+package eas_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/database"
+	"github.com/rss3-network/node/internal/database/model"
+	"github.com/rss3-network/node/internal/engine"
+	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	worker "github.com/rss3-network/node/internal/engine/worker/decentralized/contract/eas"
+	"github.com/rss3-network/node/internal/testsuite"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract/eas"
+	workerx "github.com/rss3-network/node/schema/worker/decentralized"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// The transactions of the test cases are built for the tests, and the attestations and the schemas
+// are served by a local JSON-RPC server instead of the EAS and SchemaRegistry contracts.
+var (
+	addressCoinbaseAttester = common.HexToAddress("0x00000000000000000000000000000000000000b1")
+	addressPassportAttester = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+	addressRecipient        = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	addressPassportHolder   = common.HexToAddress("0x00000000000000000000000000000000000000a2")
+
+	uidVerifiedAccount        = common.HexToHash("0x01")
+	uidRevokedVerifiedAccount = common.HexToHash("0x02")
+	uidPassportScore          = common.HexToHash("0x03")
+	uidPassport               = common.HexToHash("0x04")
+
+	schemaUIDVerifiedAccount = common.HexToHash("0xf8b05c79f090979bf4a80270aba232dff11a10d9ca55c4f88de95317970f0de9")
+	schemaUIDPassportScore   = common.HexToHash("0x6ab5d34260fca0cfcf0e76e96d439cace6aa7c3c019d7c4580ed52c6845e9c89")
+
+	attestations = []eas.Attestation{
+		{
+			Uid:       uidVerifiedAccount,
+			Schema:    schemaUIDVerifiedAccount,
+			Time:      1728550000,
+			Recipient: addressRecipient,
+			Attester:  addressCoinbaseAttester,
+			Revocable: true,
+			Data:      common.LeftPadBytes([]byte{1}, 32),
+		},
+		{
+			Uid:            uidRevokedVerifiedAccount,
+			Schema:         schemaUIDVerifiedAccount,
+			Time:           1728540000,
+			RevocationTime: 1728551000,
+			Recipient:      addressRecipient,
+			Attester:       addressCoinbaseAttester,
+			Revocable:      true,
+			Data:           common.LeftPadBytes([]byte{1}, 32),
+		},
+		{
+			Uid:       uidPassportScore,
+			Schema:    schemaUIDPassportScore,
+			Time:      1728560000,
+			RefUID:    uidPassport,
+			Recipient: addressPassportHolder,
+			Attester:  addressPassportAttester,
+			Data:      hexutil.MustDecode("0x00000000000000000000000000000000000000000000000168d28e3f00280000000000000000000000000000000000000000000000000000000000000000050c0000000000000000000000000000000000000000000000000000000000000012"),
+		},
+	}
+
+	schemas = []eas.SchemaRecord{
+		{Uid: schemaUIDVerifiedAccount, Revocable: true, Schema: "bool verifiedAccount"},
+		{Uid: schemaUIDPassportScore, Schema: "uint256 score,uint32 scorer_id,uint8 score_decimals"},
+	}
+)
+
+// callContract serves the getAttestation method of EAS and the getSchema method of SchemaRegistry.
+func callContract(_ common.Address, input []byte) ([]byte, error) {
+	var (
+		easABI            = lo.Must(eas.EASMetaData.GetAbi())
+		schemaRegistryABI = lo.Must(eas.SchemaRegistryMetaData.GetAbi())
+	)
+
+	if len(input) != 4+common.HashLength {
+		return nil, fmt.Errorf("invalid input %s", hexutil.Encode(input))
+	}
+
+	uid := common.BytesToHash(input[4:])
+
+	switch {
+	case bytes.Equal(input[:4], easABI.Methods["getAttestation"].ID):
+		attestation, found := lo.Find(attestations, func(attestation eas.Attestation) bool { return attestation.Uid == uid })
+		if !found {
+			return nil, fmt.Errorf("attestation %s not found", uid)
+		}
+
+		return easABI.Methods["getAttestation"].Outputs.Pack(attestation)
+	case bytes.Equal(input[:4], schemaRegistryABI.Methods["getSchema"].ID):
+		schema, found := lo.Find(schemas, func(schema eas.SchemaRecord) bool { return schema.Uid == uid })
+		if !found {
+			return nil, fmt.Errorf("schema %s not found", uid)
+		}
+
+		return schemaRegistryABI.Methods["getSchema"].Outputs.Pack(schema)
+	default:
+		return nil, fmt.Errorf("unsupported method %s", hexutil.Encode(input[:4]))
+	}
+}
+
+func TestWorker_EAS(t *testing.T) {
+	t.Parallel()
+
+	type arguments struct {
+		task   *source.Task
+		config *config.Module
+	}
+
+	testcases := []struct {
+		name      string
+		arguments arguments
+		want      *activityx.Activity
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name: "Attest Coinbase Verified Account",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Base,
+					ChainID: 8453,
+					Header: &ethereum.Header{
+						Number:    lo.Must(new(big.Int).SetString("21000000", 0)),
+						GasLimit:  30000000,
+						GasUsed:   12345678,
+						Timestamp: 1728550000,
+						BaseFee:   lo.Must(new(big.Int).SetString("1000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						From:     addressCoinbaseAttester,
+						Gas:      300000,
+						GasPrice: lo.Must(new(big.Int).SetString("1500000", 10)),
+						Hash:     common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+						Input:    hexutil.MustDecode("0xf17325e7"),
+						To:       lo.ToPtr(eas.AddressEASOPStack),
+						Value:    lo.Must(new(big.Int).SetString("0", 0)),
+						Type:     2,
+						ChainID:  lo.Must(new(big.Int).SetString("8453", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockNumber:       lo.Must(new(big.Int).SetString("21000000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x16e360"),
+						GasUsed:           150000,
+						L1GasPrice:        lo.Must(new(big.Int).SetString("12000000000", 0)),
+						L1GasUsed:         lo.Must(new(big.Int).SetString("1600", 0)),
+						L1Fee:             lo.Must(new(big.Int).SetString("2944000000", 0)),
+						FeeScalar:         lo.Must(new(big.Float).SetString("0.684")),
+						Logs: []*ethereum.Log{
+							{
+								Address: eas.AddressEASOPStack,
+								Topics: []common.Hash{
+									eas.EventAttested,
+									common.BytesToHash(addressRecipient.Bytes()),
+									common.BytesToHash(addressCoinbaseAttester.Bytes()),
+									schemaUIDVerifiedAccount,
+								},
+								Data:            uidVerifiedAccount.Bytes(),
+								BlockNumber:     lo.Must(new(big.Int).SetString("21000000", 0)),
+								TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+								Index:           3,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+						TransactionIndex: 5,
+					},
+				},
+				config: &config.Module{
+					Network: network.Base,
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x0000000000000000000000000000000000000000000000000000000000000001",
+				Network:  network.Base,
+				Index:    5,
+				From:     addressCoinbaseAttester.String(),
+				To:       eas.AddressEASOPStack.String(),
+				Type:     typex.SocialPost,
+				Platform: workerx.PlatformEAS.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("227944000000")),
+					Decimal: 18,
+				},
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xf17325e7",
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialPost,
+						Tag:      tag.Social,
+						Platform: workerx.PlatformEAS.String(),
+						From:     addressCoinbaseAttester.String(),
+						To:       addressRecipient.String(),
+						Metadata: metadata.SocialPost{
+							Summary:       "bool verifiedAccount",
+							Body:          `[{"name":"verifiedAccount","type":"bool","value":true}]`,
+							PublicationID: uidVerifiedAccount.String(),
+							Tags:          []string{schemaUIDVerifiedAccount.String()},
+							Timestamp:     1728550000,
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1728550000,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Revoke Coinbase Verified Account",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Base,
+					ChainID: 8453,
+					Header: &ethereum.Header{
+						Number:    lo.Must(new(big.Int).SetString("21000500", 0)),
+						GasLimit:  30000000,
+						GasUsed:   12345678,
+						Timestamp: 1728551000,
+						BaseFee:   lo.Must(new(big.Int).SetString("1000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						From:     addressCoinbaseAttester,
+						Gas:      300000,
+						GasPrice: lo.Must(new(big.Int).SetString("1500000", 10)),
+						Hash:     common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+						Input:    hexutil.MustDecode("0x46926267"),
+						To:       lo.ToPtr(eas.AddressEASOPStack),
+						Value:    lo.Must(new(big.Int).SetString("0", 0)),
+						Type:     2,
+						ChainID:  lo.Must(new(big.Int).SetString("8453", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockNumber:       lo.Must(new(big.Int).SetString("21000500", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x16e360"),
+						GasUsed:           150000,
+						L1GasPrice:        lo.Must(new(big.Int).SetString("12000000000", 0)),
+						L1GasUsed:         lo.Must(new(big.Int).SetString("1600", 0)),
+						L1Fee:             lo.Must(new(big.Int).SetString("2944000000", 0)),
+						FeeScalar:         lo.Must(new(big.Float).SetString("0.684")),
+						Logs: []*ethereum.Log{
+							{
+								Address: eas.AddressEASOPStack,
+								Topics: []common.Hash{
+									eas.EventRevoked,
+									common.BytesToHash(addressRecipient.Bytes()),
+									common.BytesToHash(addressCoinbaseAttester.Bytes()),
+									schemaUIDVerifiedAccount,
+								},
+								Data:            uidRevokedVerifiedAccount.Bytes(),
+								BlockNumber:     lo.Must(new(big.Int).SetString("21000500", 0)),
+								TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+								Index:           3,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+						TransactionIndex: 5,
+					},
+				},
+				config: &config.Module{
+					Network: network.Base,
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x0000000000000000000000000000000000000000000000000000000000000002",
+				Network:  network.Base,
+				Index:    5,
+				From:     addressCoinbaseAttester.String(),
+				To:       eas.AddressEASOPStack.String(),
+				Type:     typex.SocialDelete,
+				Platform: workerx.PlatformEAS.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("227944000000")),
+					Decimal: 18,
+				},
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x46926267",
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialDelete,
+						Tag:      tag.Social,
+						Platform: workerx.PlatformEAS.String(),
+						From:     addressCoinbaseAttester.String(),
+						To:       addressRecipient.String(),
+						Metadata: metadata.SocialDelete{
+							Summary:       "bool verifiedAccount",
+							Body:          `[{"name":"verifiedAccount","type":"bool","value":true}]`,
+							PublicationID: uidRevokedVerifiedAccount.String(),
+							Tags:          []string{schemaUIDVerifiedAccount.String()},
+							Timestamp:     1728551000,
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1728551000,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Attest Gitcoin Passport Score",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Optimism,
+					ChainID: 10,
+					Header: &ethereum.Header{
+						Number:    lo.Must(new(big.Int).SetString("126500000", 0)),
+						GasLimit:  30000000,
+						GasUsed:   12345678,
+						Timestamp: 1728560000,
+						BaseFee:   lo.Must(new(big.Int).SetString("1000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						From:     addressPassportHolder,
+						Gas:      300000,
+						GasPrice: lo.Must(new(big.Int).SetString("1500000", 10)),
+						Hash:     common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000003"),
+						Input:    hexutil.MustDecode("0xf17325e7"),
+						To:       lo.ToPtr(eas.AddressEASOPStack),
+						Value:    lo.Must(new(big.Int).SetString("0", 0)),
+						Type:     2,
+						ChainID:  lo.Must(new(big.Int).SetString("10", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockNumber:       lo.Must(new(big.Int).SetString("126500000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x16e360"),
+						GasUsed:           150000,
+						L1GasPrice:        lo.Must(new(big.Int).SetString("12000000000", 0)),
+						L1GasUsed:         lo.Must(new(big.Int).SetString("1600", 0)),
+						L1Fee:             lo.Must(new(big.Int).SetString("2944000000", 0)),
+						FeeScalar:         lo.Must(new(big.Float).SetString("0.684")),
+						Logs: []*ethereum.Log{
+							{
+								Address: eas.AddressEASOPStack,
+								Topics: []common.Hash{
+									eas.EventAttested,
+									common.BytesToHash(addressPassportHolder.Bytes()),
+									common.BytesToHash(addressPassportAttester.Bytes()),
+									schemaUIDPassportScore,
+								},
+								Data:            uidPassportScore.Bytes(),
+								BlockNumber:     lo.Must(new(big.Int).SetString("126500000", 0)),
+								TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000003"),
+								Index:           3,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000003"),
+						TransactionIndex: 5,
+					},
+				},
+				config: &config.Module{
+					Network: network.Optimism,
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x0000000000000000000000000000000000000000000000000000000000000003",
+				Network:  network.Optimism,
+				Index:    5,
+				From:     addressPassportHolder.String(),
+				To:       eas.AddressEASOPStack.String(),
+				Type:     typex.SocialPost,
+				Platform: workerx.PlatformEAS.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("227944000000")),
+					Decimal: 18,
+				},
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xf17325e7",
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialPost,
+						Tag:      tag.Social,
+						Platform: workerx.PlatformEAS.String(),
+						From:     addressPassportAttester.String(),
+						To:       addressPassportHolder.String(),
+						Metadata: metadata.SocialPost{
+							Summary:       "uint256 score,uint32 scorer_id,uint8 score_decimals",
+							Body:          `[{"name":"score","type":"uint256","value":"26000000000000000000"},{"name":"scorer_id","type":"uint32","value":1292},{"name":"score_decimals","type":"uint8","value":18}]`,
+							PublicationID: uidPassportScore.String(),
+							Tags:          []string{schemaUIDPassportScore.String()},
+							Timestamp:     1728560000,
+							Target: &metadata.SocialPost{
+								PublicationID: uidPassport.String(),
+							},
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1728560000,
+			},
+			wantError: require.NoError,
+		},
+	}
+
+	endpointURL := testsuite.NewEthereumRPCServer(t, callContract)
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			module := *testcase.arguments.config
+			module.Endpoint = config.Endpoint{URL: endpointURL}
+
+			instance, err := worker.NewWorker(&module, nil)
+			require.NoError(t, err)
+
+			activity, err := instance.Transform(ctx, testcase.arguments.task)
+			testcase.wantError(t, err)
+
+			t.Log(string(lo.Must(json.MarshalIndent(activity, "", "\x20\x20"))))
+
+			require.Equal(t, testcase.want, activity)
+		})
+	}
+}
+
+// databaseClient serves no schemas and records the schemas saved by the worker.
+type databaseClient struct {
+	database.Client
+
+	schemas []*model.EASSchema
+}
+
+func (c *databaseClient) LoadDatasetEASSchema(_ context.Context, uid common.Hash) (*model.EASSchema, error) {
+	return nil, fmt.Errorf("schema %s not found", uid)
+}
+
+func (c *databaseClient) SaveDatasetEASSchema(_ context.Context, schema *model.EASSchema) error {
+	c.schemas = append(c.schemas, schema)
+
+	return nil
+}
+
+func TestWorker_EAS_SchemaMutations(t *testing.T) {
+	t.Parallel()
+
+	task := &source.Task{
+		Network: network.Base,
+		ChainID: 8453,
+		Header: &ethereum.Header{
+			Number:    lo.Must(new(big.Int).SetString("21000000", 0)),
+			Timestamp: 1728550000,
+		},
+		Transaction: &ethereum.Transaction{
+			From:     addressCoinbaseAttester,
+			GasPrice: lo.Must(new(big.Int).SetString("1500000", 10)),
+			Hash:     common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+			To:       lo.ToPtr(eas.AddressEASOPStack),
+			Value:    big.NewInt(0),
+			Type:     2,
+		},
+		Receipt: &ethereum.Receipt{
+			EffectiveGasPrice: hexutil.MustDecodeBig("0x16e360"),
+			GasUsed:           150000,
+			L1Fee:             lo.Must(new(big.Int).SetString("2944000000", 0)),
+			Logs: lo.Map([]common.Hash{uidVerifiedAccount, uidRevokedVerifiedAccount}, func(uid common.Hash, index int) *ethereum.Log {
+				return &ethereum.Log{
+					Address: eas.AddressEASOPStack,
+					Topics: []common.Hash{
+						eas.EventAttested,
+						common.BytesToHash(addressRecipient.Bytes()),
+						common.BytesToHash(addressCoinbaseAttester.Bytes()),
+						schemaUIDVerifiedAccount,
+					},
+					Data:        uid.Bytes(),
+					BlockNumber: lo.Must(new(big.Int).SetString("21000000", 0)),
+					Index:       uint(index),
+				}
+			}),
+			Status:          1,
+			TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+		},
+	}
+
+	client := &databaseClient{}
+
+	instance, err := worker.NewWorker(&config.Module{
+		Network:  network.Base,
+		Endpoint: config.Endpoint{URL: testsuite.NewEthereumRPCServer(t, callContract)},
+	}, client)
+	require.NoError(t, err)
+
+	activity, mutations, err := instance.(engine.MutatingWorker).TransformMutations(context.Background(), task)
+	require.NoError(t, err)
+	require.Len(t, activity.Actions, 2)
+
+	// The schema fetched from the SchemaRegistry is saved once after the activity is saved.
+	require.Empty(t, client.schemas)
+	require.Len(t, mutations, 1)
+	require.Equal(t, activity.ID, mutations[0].ID)
+	require.NoError(t, mutations[0].Save(context.Background()))
+	require.Len(t, client.schemas, 1)
+	require.Equal(t, schemaUIDVerifiedAccount, client.schemas[0].UID)
+	require.Equal(t, "bool verifiedAccount", client.schemas[0].Schema)
+}
@@ -0,0 +1,535 @@
+package eigenlayer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/engine"
+	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/internal/utils"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+	"github.com/rss3-network/node/provider/ethereum/contract/eigenlayer"
+	"github.com/rss3-network/node/provider/ethereum/contract/erc20"
+	"github.com/rss3-network/node/provider/ethereum/token"
+	"github.com/rss3-network/node/schema/worker/decentralized"
+	"github.com/rss3-network/protocol-go/schema"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ engine.Worker = (*worker)(nil)
+
+type worker struct {
+	config                    *config.Module
+	ethereumClient            ethereum.Client
+	tokenClient               token.Client
+	strategyManagerFilterer   *eigenlayer.StrategyManagerFilterer
+	delegationManagerFilterer *eigenlayer.DelegationManagerFilterer
+	erc20Filterer             *erc20.ERC20Filterer
+}
+
+func (w *worker) Name() string {
+	return decentralized.EigenLayer.String()
+}
+
+func (w *worker) Platform() string {
+	return decentralized.PlatformEigenLayer.String()
+}
+
+func (w *worker) Network() []network.Network {
+	return []network.Network{
+		network.Ethereum,
+	}
+}
+
+func (w *worker) Tags() []tag.Tag {
+	return []tag.Tag{
+		tag.Exchange,
+		tag.Transaction,
+	}
+}
+
+func (w *worker) Types() []schema.Type {
+	return []schema.Type{
+		typex.ExchangeStaking,
+		typex.TransactionTransfer,
+	}
+}
+
+func (w *worker) Filter() engine.DataSourceFilter {
+	return &source.Filter{
+		LogAddresses: []common.Address{
+			eigenlayer.AddressStrategyManager,
+			eigenlayer.AddressDelegationManager,
+			eigenlayer.AddressEtherFiETH,
+			eigenlayer.AddressRenzoETH,
+			eigenlayer.AddressKelpETH,
+		},
+		LogTopics: []common.Hash{
+			eigenlayer.EventDeposit,
+			eigenlayer.EventStakerDelegated,
+			eigenlayer.EventStakerUndelegated,
+			eigenlayer.EventWithdrawalQueued,
+			eigenlayer.EventWithdrawalCompleted,
+			erc20.EventHashTransfer,
+		},
+	}
+}
+
+func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	ethereumTask, ok := task.(*source.Task)
+	if !ok {
+		return nil, fmt.Errorf("invalid task type: %T", task)
+	}
+
+	activity, err := ethereumTask.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
+	if err != nil {
+		return nil, fmt.Errorf("build activity: %w", err)
+	}
+
+	for _, log := range ethereumTask.Receipt.Logs {
+		if len(log.Topics) == 0 {
+			continue
+		}
+
+		var (
+			actions []*activityx.Action
+			err     error
+		)
+
+		switch {
+		case w.matchDeposit(log):
+			actions, err = w.handleDeposit(ctx, ethereumTask, log)
+		case w.matchStakerDelegated(log):
+			actions, err = w.handleStakerDelegated(ctx, log)
+		case w.matchStakerUndelegated(log):
+			actions, err = w.handleStakerUndelegated(ctx, log)
+		case w.matchWithdrawalQueued(log):
+			actions, err = w.handleWithdrawalQueued(ctx, ethereumTask, log)
+		case w.matchWithdrawalCompleted(log):
+			actions, err = w.handleWithdrawalCompleted(ctx, ethereumTask, log)
+		case w.matchLiquidRestakingMint(log):
+			actions, err = w.handleLiquidRestakingMint(ctx, ethereumTask, log)
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if activity.Type == typex.Unknown && len(actions) > 0 {
+			activity.Type = actions[0].Type
+		}
+
+		activity.Actions = append(activity.Actions, actions...)
+	}
+
+	if len(activity.Actions) == 0 {
+		zap.L().Debug("no actions generated for task", zap.String("task_id", task.ID()))
+
+		return nil, nil
+	}
+
+	return activity, nil
+}
+
+func (w *worker) matchDeposit(log *ethereum.Log) bool {
+	return log.Address == eigenlayer.AddressStrategyManager && len(log.Topics) == 1 && contract.MatchEventHashes(log.Topics[0], eigenlayer.EventDeposit)
+}
+
+func (w *worker) matchStakerDelegated(log *ethereum.Log) bool {
+	return log.Address == eigenlayer.AddressDelegationManager && len(log.Topics) == 3 && contract.MatchEventHashes(log.Topics[0], eigenlayer.EventStakerDelegated)
+}
+
+func (w *worker) matchStakerUndelegated(log *ethereum.Log) bool {
+	return log.Address == eigenlayer.AddressDelegationManager && len(log.Topics) == 3 && contract.MatchEventHashes(log.Topics[0], eigenlayer.EventStakerUndelegated)
+}
+
+func (w *worker) matchWithdrawalQueued(log *ethereum.Log) bool {
+	return log.Address == eigenlayer.AddressDelegationManager && len(log.Topics) == 1 && contract.MatchEventHashes(log.Topics[0], eigenlayer.EventWithdrawalQueued)
+}
+
+func (w *worker) matchWithdrawalCompleted(log *ethereum.Log) bool {
+	return log.Address == eigenlayer.AddressDelegationManager && len(log.Topics) == 1 && contract.MatchEventHashes(log.Topics[0], eigenlayer.EventWithdrawalCompleted)
+}
+
+// matchLiquidRestakingMint matches the mints of the liquid restaking tokens, which are the transfers from the zero address.
+func (w *worker) matchLiquidRestakingMint(log *ethereum.Log) bool {
+	_, exists := eigenlayer.LiquidRestakingPlatforms[log.Address]
+
+	return exists && len(log.Topics) == 3 &&
+		contract.MatchEventHashes(log.Topics[0], erc20.EventHashTransfer) &&
+		log.Topics[1] == common.BytesToHash(ethereum.AddressGenesis.Bytes())
+}
+
+// handleDeposit returns a stake action of the deposit into a strategy, the amount is the transfer of the token to the strategy,
+// or the underlying amount of the shares if the transfer is not found.
+func (w *worker) handleDeposit(ctx context.Context, task *source.Task, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.strategyManagerFilterer.ParseDeposit(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse deposit event: %w", err)
+	}
+
+	amount, err := w.findTransferAmount(task, event.Token, event.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount == nil {
+		if _, amount, err = w.lookupStrategy(ctx, task, event.Strategy, event.Shares); err != nil {
+			return nil, err
+		}
+	}
+
+	action, err := w.buildExchangeStakingAction(ctx, task, event.Staker, event.Strategy, &event.Token, amount, metadata.ActionExchangeStakingStake, w.Platform())
+	if err != nil {
+		return nil, err
+	}
+
+	return []*activityx.Action{action}, nil
+}
+
+// handleStakerDelegated returns a stake action of the delegation to an operator, which moves no tokens.
+func (w *worker) handleStakerDelegated(_ context.Context, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.delegationManagerFilterer.ParseStakerDelegated(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse staker delegated event: %w", err)
+	}
+
+	return []*activityx.Action{w.buildDelegationAction(event.Staker, event.Operator, metadata.ActionExchangeStakingStake)}, nil
+}
+
+// handleStakerUndelegated returns an unstake action of the undelegation from an operator,
+// the withdrawals of the shares are queued by the WithdrawalQueued events in the same transaction.
+func (w *worker) handleStakerUndelegated(_ context.Context, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.delegationManagerFilterer.ParseStakerUndelegated(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse staker undelegated event: %w", err)
+	}
+
+	return []*activityx.Action{w.buildDelegationAction(event.Staker, event.Operator, metadata.ActionExchangeStakingUnstake)}, nil
+}
+
+// handleWithdrawalQueued returns an unstake action of each strategy of the queued withdrawal.
+func (w *worker) handleWithdrawalQueued(ctx context.Context, task *source.Task, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.delegationManagerFilterer.ParseWithdrawalQueued(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse withdrawal queued event: %w", err)
+	}
+
+	return w.buildWithdrawalActions(ctx, task, event.Withdrawal, func(strategy common.Address) (common.Address, common.Address, metadata.ExchangeStakingAction) {
+		return event.Withdrawal.Staker, strategy, metadata.ActionExchangeStakingUnstake
+	})
+}
+
+// handleWithdrawalCompleted returns a claim action of each strategy of the completed withdrawal, which is decoded
+// from the input of the transaction and matched by the withdrawal root. The shares of a withdrawal completed
+// without receiving the tokens are restaked, which are stake actions instead.
+func (w *worker) handleWithdrawalCompleted(ctx context.Context, task *source.Task, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.delegationManagerFilterer.ParseWithdrawalCompleted(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse withdrawal completed event: %w", err)
+	}
+
+	if task.Transaction.To == nil || *task.Transaction.To != eigenlayer.AddressDelegationManager {
+		return nil, nil
+	}
+
+	withdrawals, receiveAsTokens, err := w.decodeCompleteQueuedWithdrawals(task.Transaction.Input)
+	if err != nil {
+		return nil, fmt.Errorf("decode complete queued withdrawals: %w", err)
+	}
+
+	for index, withdrawal := range withdrawals {
+		root, err := w.calculateWithdrawalRoot(withdrawal)
+		if err != nil {
+			return nil, err
+		}
+
+		if root != event.WithdrawalRoot {
+			continue
+		}
+
+		return w.buildWithdrawalActions(ctx, task, withdrawal, func(strategy common.Address) (common.Address, common.Address, metadata.ExchangeStakingAction) {
+			if receiveAsTokens[index] {
+				return strategy, withdrawal.Withdrawer, metadata.ActionExchangeStakingClaim
+			}
+
+			return withdrawal.Withdrawer, strategy, metadata.ActionExchangeStakingStake
+		})
+	}
+
+	return nil, nil
+}
+
+// handleLiquidRestakingMint returns a stake action of the deposited token and a transfer action of the minted
+// liquid restaking token. The deposit is the value of the transaction, or the first transfer of a token from the receiver.
+func (w *worker) handleLiquidRestakingMint(ctx context.Context, task *source.Task, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.erc20Filterer.ParseTransfer(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse transfer event: %w", err)
+	}
+
+	platform := eigenlayer.LiquidRestakingPlatforms[log.Address]
+
+	actions := make([]*activityx.Action, 0, 2)
+
+	var (
+		depositToken  *common.Address
+		depositAmount *big.Int
+	)
+
+	if task.Transaction.Value != nil && task.Transaction.Value.Sign() > 0 {
+		depositAmount = task.Transaction.Value
+	} else {
+		for _, transferLog := range task.Receipt.Logs {
+			if transferLog.Address == log.Address || len(transferLog.Topics) != 3 || !contract.MatchEventHashes(transferLog.Topics[0], erc20.EventHashTransfer) {
+				continue
+			}
+
+			transfer, err := w.erc20Filterer.ParseTransfer(transferLog.Export())
+			if err != nil {
+				return nil, fmt.Errorf("parse transfer event: %w", err)
+			}
+
+			if transfer.From == event.To {
+				depositToken, depositAmount = &transferLog.Address, transfer.Value
+
+				break
+			}
+		}
+	}
+
+	if depositAmount != nil {
+		action, err := w.buildExchangeStakingAction(ctx, task, event.To, log.Address, depositToken, depositAmount, metadata.ActionExchangeStakingStake, platform)
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, action)
+	}
+
+	tokenMetadata, err := w.tokenClient.Lookup(ctx, task.ChainID, &log.Address, nil, task.Header.Number)
+	if err != nil {
+		return nil, fmt.Errorf("lookup token metadata: %w", err)
+	}
+
+	tokenMetadata.Value = lo.ToPtr(decimal.NewFromBigInt(utils.GetBigInt(event.Value), 0))
+
+	actions = append(actions, &activityx.Action{
+		Type:     typex.TransactionTransfer,
+		Platform: platform,
+		From:     event.From.String(),
+		To:       event.To.String(),
+		Metadata: metadata.TransactionTransfer(*tokenMetadata),
+	})
+
+	return actions, nil
+}
+
+// decodeCompleteQueuedWithdrawals returns the withdrawals of completeQueuedWithdrawal or completeQueuedWithdrawals,
+// and whether the tokens are received for each withdrawal.
+func (w *worker) decodeCompleteQueuedWithdrawals(input []byte) ([]eigenlayer.IDelegationManagerWithdrawal, []bool, error) {
+	if !contract.MatchMethodIDs(input, eigenlayer.MethodIDCompleteQueuedWithdrawal, eigenlayer.MethodIDCompleteQueuedWithdrawals) {
+		return nil, nil, nil
+	}
+
+	delegationManagerABI, err := eigenlayer.DelegationManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, nil, fmt.Errorf("load DelegationManager ABI: %w", err)
+	}
+
+	method, err := delegationManagerABI.MethodById(input[:4])
+	if err != nil {
+		return nil, nil, fmt.Errorf("load method by ID: %w", err)
+	}
+
+	values, err := method.Inputs.UnpackValues(input[4:])
+	if err != nil {
+		return nil, nil, fmt.Errorf("unpack values: %w", err)
+	}
+
+	if contract.MatchMethodIDs(input, eigenlayer.MethodIDCompleteQueuedWithdrawal) {
+		var completeQueuedWithdrawal eigenlayer.CompleteQueuedWithdrawalInput
+		if err := method.Inputs.Copy(&completeQueuedWithdrawal, values); err != nil {
+			return nil, nil, fmt.Errorf("copy input: %w", err)
+		}
+
+		return []eigenlayer.IDelegationManagerWithdrawal{completeQueuedWithdrawal.Withdrawal}, []bool{completeQueuedWithdrawal.ReceiveAsTokens}, nil
+	}
+
+	var completeQueuedWithdrawals eigenlayer.CompleteQueuedWithdrawalsInput
+	if err := method.Inputs.Copy(&completeQueuedWithdrawals, values); err != nil {
+		return nil, nil, fmt.Errorf("copy input: %w", err)
+	}
+
+	if len(completeQueuedWithdrawals.Withdrawals) != len(completeQueuedWithdrawals.ReceiveAsTokens) {
+		return nil, nil, fmt.Errorf("invalid receive as tokens length %d", len(completeQueuedWithdrawals.ReceiveAsTokens))
+	}
+
+	return completeQueuedWithdrawals.Withdrawals, completeQueuedWithdrawals.ReceiveAsTokens, nil
+}
+
+// calculateWithdrawalRoot returns the root of the withdrawal, which is keccak256(abi.encode(withdrawal)).
+func (w *worker) calculateWithdrawalRoot(withdrawal eigenlayer.IDelegationManagerWithdrawal) (common.Hash, error) {
+	delegationManagerABI, err := eigenlayer.DelegationManagerMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("load DelegationManager ABI: %w", err)
+	}
+
+	arguments := abi.Arguments{
+		{Type: delegationManagerABI.Events["WithdrawalQueued"].Inputs[1].Type},
+	}
+
+	data, err := arguments.Pack(withdrawal)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("pack withdrawal: %w", err)
+	}
+
+	return crypto.Keccak256Hash(data), nil
+}
+
+// findTransferAmount returns the value of the transfer of the token to the strategy, or nil if it is not found.
+func (w *worker) findTransferAmount(task *source.Task, tokenAddress, strategy common.Address) (*big.Int, error) {
+	for _, log := range task.Receipt.Logs {
+		if log.Address != tokenAddress || len(log.Topics) != 3 || !contract.MatchEventHashes(log.Topics[0], erc20.EventHashTransfer) {
+			continue
+		}
+
+		event, err := w.erc20Filterer.ParseTransfer(log.Export())
+		if err != nil {
+			return nil, fmt.Errorf("parse transfer event: %w", err)
+		}
+
+		if event.To == strategy {
+			return event.Value, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// lookupStrategy returns the underlying token and the underlying amount of the shares of the strategy,
+// the beacon chain ETH strategy has no underlying token as the shares are native ETH.
+func (w *worker) lookupStrategy(ctx context.Context, task *source.Task, strategy common.Address, shares *big.Int) (*common.Address, *big.Int, error) {
+	if strategy == eigenlayer.AddressBeaconChainETHStrategy {
+		return nil, shares, nil
+	}
+
+	caller, err := eigenlayer.NewStrategyCaller(strategy, w.ethereumClient)
+	if err != nil {
+		return nil, nil, fmt.Errorf("initialize strategy caller: %w", err)
+	}
+
+	callOptions := &bind.CallOpts{Context: ctx, BlockNumber: task.Header.Number}
+
+	underlyingToken, err := caller.UnderlyingToken(callOptions)
+	if err != nil {
+		return nil, nil, fmt.Errorf("call underlying token of strategy %s: %w", strategy, err)
+	}
+
+	amount, err := caller.SharesToUnderlyingView(callOptions, shares)
+	if err != nil {
+		return nil, nil, fmt.Errorf("call shares to underlying view of strategy %s: %w", strategy, err)
+	}
+
+	return &underlyingToken, amount, nil
+}
+
+// buildWithdrawalActions returns a staking action of each strategy of the withdrawal, the direction and the action are returned by the build function.
+func (w *worker) buildWithdrawalActions(ctx context.Context, task *source.Task, withdrawal eigenlayer.IDelegationManagerWithdrawal, build func(strategy common.Address) (common.Address, common.Address, metadata.ExchangeStakingAction)) ([]*activityx.Action, error) {
+	if len(withdrawal.Strategies) != len(withdrawal.Shares) {
+		return nil, fmt.Errorf("invalid withdrawal shares length %d", len(withdrawal.Shares))
+	}
+
+	actions := make([]*activityx.Action, 0, len(withdrawal.Strategies))
+
+	for index, strategy := range withdrawal.Strategies {
+		underlyingToken, amount, err := w.lookupStrategy(ctx, task, strategy, withdrawal.Shares[index])
+		if err != nil {
+			return nil, err
+		}
+
+		from, to, stakingAction := build(strategy)
+
+		action, err := w.buildExchangeStakingAction(ctx, task, from, to, underlyingToken, amount, stakingAction, w.Platform())
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions, nil
+}
+
+func (w *worker) buildExchangeStakingAction(ctx context.Context, task *source.Task, from, to common.Address, tokenAddress *common.Address, amount *big.Int, stakingAction metadata.ExchangeStakingAction, platform string) (*activityx.Action, error) {
+	tokenMetadata, err := w.tokenClient.Lookup(ctx, task.ChainID, tokenAddress, nil, task.Header.Number)
+	if err != nil {
+		return nil, fmt.Errorf("lookup token metadata: %w", err)
+	}
+
+	tokenMetadata.Value = lo.ToPtr(decimal.NewFromBigInt(utils.GetBigInt(amount), 0))
+
+	return &activityx.Action{
+		Type:     typex.ExchangeStaking,
+		Platform: platform,
+		From:     from.String(),
+		To:       to.String(),
+		Metadata: metadata.ExchangeStaking{
+			Action: stakingAction,
+			Token:  *tokenMetadata,
+		},
+	}, nil
+}
+
+// buildDelegationAction returns a staking action of the delegation, the operator is the recipient of the action.
+func (w *worker) buildDelegationAction(staker, operator common.Address, stakingAction metadata.ExchangeStakingAction) *activityx.Action {
+	return &activityx.Action{
+		Type:     typex.ExchangeStaking,
+		Platform: w.Platform(),
+		From:     staker.String(),
+		To:       operator.String(),
+		Metadata: metadata.ExchangeStaking{
+			Action: stakingAction,
+		},
+	}
+}
+
+// NewWorker creates a new EigenLayer worker.
+func NewWorker(config *config.Module) (engine.Worker, error) {
+	var (
+		instance = worker{
+			config: config,
+		}
+
+		err error
+	)
+
+	if instance.ethereumClient, err = ethereum.Dial(context.Background(), config.Endpoint.URL, config.Endpoint.BuildEthereumOptions()...); err != nil {
+		return nil, fmt.Errorf("initialize ethereum client: %w", err)
+	}
+
+	instance.tokenClient = token.NewClient(instance.ethereumClient)
+
+	// Initialize contract filterers.
+	instance.strategyManagerFilterer = lo.Must(eigenlayer.NewStrategyManagerFilterer(ethereum.AddressGenesis, nil))
+	instance.delegationManagerFilterer = lo.Must(eigenlayer.NewDelegationManagerFilterer(ethereum.AddressGenesis, nil))
+	instance.erc20Filterer = lo.Must(erc20.NewERC20Filterer(ethereum.AddressGenesis, nil))
+
+	return &instance, nil
+}
@@ -0,0 +1,578 @@
+package eigenlayer_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rss3-network/node/config"
+	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	worker "github.com/rss3-network/node/internal/engine/worker/decentralized/contract/eigenlayer"
+	"github.com/rss3-network/node/internal/testsuite"
+	"github.com/rss3-network/node/provider/ethereum"
+	workerx "github.com/rss3-network/node/schema/worker/decentralized"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorker_Ethereum(t *testing.T) {
+	t.Parallel()
+
+	endpointURL := testsuite.NewEthereumRPCServer(t, testsuite.ERC20Call(map[common.Address]testsuite.ERC20Token{
+		common.HexToAddress("0xae7ab96520DE3A18E5e111B5EaAb095312D7fE84"): {Name: "Liquid staked Ether 2.0", Symbol: "stETH", Decimals: 18},
+		common.HexToAddress("0x35fA164735182de50811E8e2E824cFb9B6118ac2"): {Name: "ether.fi ETH", Symbol: "eETH", Decimals: 18},
+	}))
+
+	type arguments struct {
+		task   *source.Task
+		config *config.Module
+	}
+
+	testcases := []struct {
+		name      string
+		arguments arguments
+		want      *activityx.Activity
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name: "Delegate To Operator",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						ParentHash: common.HexToHash("0xbabababababababababababababababababababababababababababababababa"),
+						Number:     lo.Must(new(big.Int).SetString("20500000", 0)),
+						GasLimit:   30000000,
+						GasUsed:    12345678,
+						Timestamp:  1723190411,
+						BaseFee:    lo.Must(new(big.Int).SetString("1000000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						From:      common.HexToAddress("0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a"),
+						Gas:       300000,
+						GasPrice:  lo.Must(new(big.Int).SetString("20000000000", 10)),
+						Hash:      common.HexToHash("0x0101010101010101010101010101010101010101010101010101010101010101"),
+						Input:     hexutil.MustDecode("0xeea9064b"),
+						To:        lo.ToPtr(common.HexToAddress("0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20500000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x4a817c800"),
+						GasUsed:           200000,
+
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A"),
+								Topics: []common.Hash{
+									common.HexToHash("0xc3ee9f2e5fda98e8066a1f745b2df9285f416fe98cf2559cd21484b3d8743304"),
+									common.HexToHash("0x0000000000000000000000005a8c3f2e4b6d8f0a1c3e5b7d9f1a3c5e7b9d1f3a"),
+									common.HexToHash("0x0000000000000000000000002b4d6f8a0c2e4b6d8f0a1c3e5b7d9f1a3c5e7b9d"),
+								},
+								Data:            hexutil.MustDecode("0x"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20500000", 0)),
+								TransactionHash: common.HexToHash("0x0101010101010101010101010101010101010101010101010101010101010101"),
+								Index:           100,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0101010101010101010101010101010101010101010101010101010101010101"),
+						TransactionIndex: 9,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+					Endpoint: config.Endpoint{
+						URL: endpointURL,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0101010101010101010101010101010101010101010101010101010101010101",
+				Network: network.Ethereum,
+				Index:   9,
+				From:    "0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a",
+				To:      "0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A",
+				Type:    typex.ExchangeStaking,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xeea9064b",
+				},
+				Platform: workerx.PlatformEigenLayer.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("4000000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeStaking,
+						Platform: workerx.PlatformEigenLayer.String(),
+						From:     "0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a",
+						To:       "0x2B4D6f8A0C2e4b6D8f0a1c3E5b7d9f1A3c5E7b9d",
+						Metadata: metadata.ExchangeStaking{
+							Action: metadata.ActionExchangeStakingStake,
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1723190411,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Queue Withdrawal Of Beacon Chain ETH",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						ParentHash: common.HexToHash("0xbabababababababababababababababababababababababababababababababa"),
+						Number:     lo.Must(new(big.Int).SetString("20500000", 0)),
+						GasLimit:   30000000,
+						GasUsed:    12345678,
+						Timestamp:  1723190411,
+						BaseFee:    lo.Must(new(big.Int).SetString("1000000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						From:      common.HexToAddress("0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a"),
+						Gas:       300000,
+						GasPrice:  lo.Must(new(big.Int).SetString("20000000000", 10)),
+						Hash:      common.HexToHash("0x0202020202020202020202020202020202020202020202020202020202020202"),
+						Input:     hexutil.MustDecode("0x0dd8dd02"),
+						To:        lo.ToPtr(common.HexToAddress("0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20500000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x4a817c800"),
+						GasUsed:           200000,
+
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A"),
+								Topics: []common.Hash{
+									common.HexToHash("0x9009ab153e8014fbfb02f2217f5cde7aa7f9ad734ae85ca3ee3f4ca2fdd499f9"),
+								},
+								Data:            hexutil.MustDecode("0xe5b0f6c27d9b46a8e3678e6a56ca0b42f1d40c6d9b380f0d8843354403c7333f00000000000000000000000000000000000000000000000000000000000000400000000000000000000000005a8c3f2e4b6d8f0a1c3e5b7d9f1a3c5e7b9d1f3a0000000000000000000000002b4d6f8a0c2e4b6d8f0a1c3e5b7d9f1a3c5e7b9d0000000000000000000000005a8c3f2e4b6d8f0a1c3e5b7d9f1a3c5e7b9d1f3a00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001312d0000000000000000000000000000000000000000000000000000000000000000e000000000000000000000000000000000000000000000000000000000000001200000000000000000000000000000000000000000000000000000000000000001000000000000000000000000beac0eeeeeeeeeeeeeeeeeeeeeeeeeeeeeebeac00000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000001bc16d674ec800000"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20500000", 0)),
+								TransactionHash: common.HexToHash("0x0202020202020202020202020202020202020202020202020202020202020202"),
+								Index:           101,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0202020202020202020202020202020202020202020202020202020202020202"),
+						TransactionIndex: 9,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+					Endpoint: config.Endpoint{
+						URL: endpointURL,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0202020202020202020202020202020202020202020202020202020202020202",
+				Network: network.Ethereum,
+				Index:   9,
+				From:    "0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a",
+				To:      "0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A",
+				Type:    typex.ExchangeStaking,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x0dd8dd02",
+				},
+				Platform: workerx.PlatformEigenLayer.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("4000000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeStaking,
+						Platform: workerx.PlatformEigenLayer.String(),
+						From:     "0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a",
+						To:       "0xbeaC0eeEeeeeEEeEeEEEEeeEEeEeeeEeeEEBEaC0",
+						Metadata: metadata.ExchangeStaking{
+							Action: metadata.ActionExchangeStakingUnstake,
+							Token: metadata.Token{
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("32000000000000000000"))),
+								Name:     "Ethereum",
+								Symbol:   "ETH",
+								Decimals: 18,
+							},
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1723190411,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Complete Withdrawal Of Beacon Chain ETH",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						ParentHash: common.HexToHash("0xbabababababababababababababababababababababababababababababababa"),
+						Number:     lo.Must(new(big.Int).SetString("20500000", 0)),
+						GasLimit:   30000000,
+						GasUsed:    12345678,
+						Timestamp:  1723190411,
+						BaseFee:    lo.Must(new(big.Int).SetString("1000000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						From:      common.HexToAddress("0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a"),
+						Gas:       300000,
+						GasPrice:  lo.Must(new(big.Int).SetString("20000000000", 10)),
+						Hash:      common.HexToHash("0x0303030303030303030303030303030303030303030303030303030303030303"),
+						Input:     hexutil.MustDecode("0x60d7faed000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000001e0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010000000000000000000000005a8c3f2e4b6d8f0a1c3e5b7d9f1a3c5e7b9d1f3a0000000000000000000000002b4d6f8a0c2e4b6d8f0a1c3e5b7d9f1a3c5e7b9d0000000000000000000000005a8c3f2e4b6d8f0a1c3e5b7d9f1a3c5e7b9d1f3a00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001312d0000000000000000000000000000000000000000000000000000000000000000e000000000000000000000000000000000000000000000000000000000000001200000000000000000000000000000000000000000000000000000000000000001000000000000000000000000beac0eeeeeeeeeeeeeeeeeeeeeeeeeeeeeebeac00000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000001bc16d674ec80000000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(common.HexToAddress("0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20500000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x4a817c800"),
+						GasUsed:           200000,
+
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A"),
+								Topics: []common.Hash{
+									common.HexToHash("0xc97098c2f658800b4df29001527f7324bcdffcf6e8751a699ab920a1eced5b1d"),
+								},
+								Data:            hexutil.MustDecode("0xe5b0f6c27d9b46a8e3678e6a56ca0b42f1d40c6d9b380f0d8843354403c7333f"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20500000", 0)),
+								TransactionHash: common.HexToHash("0x0303030303030303030303030303030303030303030303030303030303030303"),
+								Index:           102,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0303030303030303030303030303030303030303030303030303030303030303"),
+						TransactionIndex: 9,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+					Endpoint: config.Endpoint{
+						URL: endpointURL,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0303030303030303030303030303030303030303030303030303030303030303",
+				Network: network.Ethereum,
+				Index:   9,
+				From:    "0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a",
+				To:      "0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A",
+				Type:    typex.ExchangeStaking,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x60d7faed",
+				},
+				Platform: workerx.PlatformEigenLayer.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("4000000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeStaking,
+						Platform: workerx.PlatformEigenLayer.String(),
+						From:     "0xbeaC0eeEeeeeEEeEeEEEEeeEEeEeeeEeeEEBEaC0",
+						To:       "0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a",
+						Metadata: metadata.ExchangeStaking{
+							Action: metadata.ActionExchangeStakingClaim,
+							Token: metadata.Token{
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("32000000000000000000"))),
+								Name:     "Ethereum",
+								Symbol:   "ETH",
+								Decimals: 18,
+							},
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1723190411,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Deposit stETH Into Strategy",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						ParentHash: common.HexToHash("0xbabababababababababababababababababababababababababababababababa"),
+						Number:     lo.Must(new(big.Int).SetString("20500000", 0)),
+						GasLimit:   30000000,
+						GasUsed:    12345678,
+						Timestamp:  1723190411,
+						BaseFee:    lo.Must(new(big.Int).SetString("1000000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						From:      common.HexToAddress("0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a"),
+						Gas:       300000,
+						GasPrice:  lo.Must(new(big.Int).SetString("20000000000", 10)),
+						Hash:      common.HexToHash("0x0404040404040404040404040404040404040404040404040404040404040404"),
+						Input:     hexutil.MustDecode("0xe7a050aa"),
+						To:        lo.ToPtr(common.HexToAddress("0x858646372CC42E1A627fcE94aa7A7033e7CF075A")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20500000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x4a817c800"),
+						GasUsed:           200000,
+
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0xae7ab96520DE3A18E5e111B5EaAb095312D7fE84"),
+								Topics: []common.Hash{
+									common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+									common.HexToHash("0x0000000000000000000000005a8c3f2e4b6d8f0a1c3e5b7d9f1a3c5e7b9d1f3a"),
+									common.HexToHash("0x00000000000000000000000093c4b944d05dfe6df7645a86cd2206016c51564d"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000de0b6b3a763ffff"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20500000", 0)),
+								TransactionHash: common.HexToHash("0x0404040404040404040404040404040404040404040404040404040404040404"),
+								Index:           103,
+								Removed:         false,
+							},
+							{
+								Address: common.HexToAddress("0x858646372CC42E1A627fcE94aa7A7033e7CF075A"),
+								Topics: []common.Hash{
+									common.HexToHash("0x7cfff908a4b583f36430b25d75964c458d8ede8a99bd61be750e97ee1b2f3a96"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000005a8c3f2e4b6d8f0a1c3e5b7d9f1a3c5e7b9d1f3a000000000000000000000000ae7ab96520de3a18e5e111b5eaab095312d7fe8400000000000000000000000093c4b944d05dfe6df7645a86cd2206016c51564d0000000000000000000000000000000000000000000000000db4da5f49f8b478"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20500000", 0)),
+								TransactionHash: common.HexToHash("0x0404040404040404040404040404040404040404040404040404040404040404"),
+								Index:           104,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0404040404040404040404040404040404040404040404040404040404040404"),
+						TransactionIndex: 9,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+					Endpoint: config.Endpoint{
+						URL: endpointURL,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0404040404040404040404040404040404040404040404040404040404040404",
+				Network: network.Ethereum,
+				Index:   9,
+				From:    "0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a",
+				To:      "0x858646372CC42E1A627fcE94aa7A7033e7CF075A",
+				Type:    typex.ExchangeStaking,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xe7a050aa",
+				},
+				Platform: workerx.PlatformEigenLayer.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("4000000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeStaking,
+						Platform: workerx.PlatformEigenLayer.String(),
+						From:     "0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a",
+						To:       "0x93c4b944D05dfe6df7645A86cd2206016c51564D",
+						Metadata: metadata.ExchangeStaking{
+							Action: metadata.ActionExchangeStakingStake,
+							Token: metadata.Token{
+								Address:  lo.ToPtr("0xae7ab96520DE3A18E5e111B5EaAb095312D7fE84"),
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("999999999999999999"))),
+								Name:     "Liquid staked Ether 2.0",
+								Symbol:   "stETH",
+								Decimals: 18,
+								Standard: metadata.StandardERC20,
+							},
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1723190411,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Mint eETH",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						ParentHash: common.HexToHash("0xbabababababababababababababababababababababababababababababababa"),
+						Number:     lo.Must(new(big.Int).SetString("20500000", 0)),
+						GasLimit:   30000000,
+						GasUsed:    12345678,
+						Timestamp:  1723190411,
+						BaseFee:    lo.Must(new(big.Int).SetString("1000000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						From:      common.HexToAddress("0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a"),
+						Gas:       300000,
+						GasPrice:  lo.Must(new(big.Int).SetString("20000000000", 10)),
+						Hash:      common.HexToHash("0x0505050505050505050505050505050505050505050505050505050505050505"),
+						Input:     hexutil.MustDecode("0xd0e30db0"),
+						To:        lo.ToPtr(common.HexToAddress("0x308861A430be4cce5502d0A12724771Fc6DaF216")),
+						Value:     lo.Must(new(big.Int).SetString("1000000000000000000", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20500000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x4a817c800"),
+						GasUsed:           200000,
+
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0x35fA164735182de50811E8e2E824cFb9B6118ac2"),
+								Topics: []common.Hash{
+									common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+									common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
+									common.HexToHash("0x0000000000000000000000005a8c3f2e4b6d8f0a1c3e5b7d9f1a3c5e7b9d1f3a"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000de0b6b3a7640000"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20500000", 0)),
+								TransactionHash: common.HexToHash("0x0505050505050505050505050505050505050505050505050505050505050505"),
+								Index:           105,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0505050505050505050505050505050505050505050505050505050505050505"),
+						TransactionIndex: 9,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+					Endpoint: config.Endpoint{
+						URL: endpointURL,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0505050505050505050505050505050505050505050505050505050505050505",
+				Network: network.Ethereum,
+				Index:   9,
+				From:    "0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a",
+				To:      "0x308861A430be4cce5502d0A12724771Fc6DaF216",
+				Type:    typex.ExchangeStaking,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xd0e30db0",
+				},
+				Platform: workerx.PlatformEigenLayer.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("4000000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeStaking,
+						Platform: "ether.fi",
+						From:     "0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a",
+						To:       "0x35fA164735182de50811E8e2E824cFb9B6118ac2",
+						Metadata: metadata.ExchangeStaking{
+							Action: metadata.ActionExchangeStakingStake,
+							Token: metadata.Token{
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("1000000000000000000"))),
+								Name:     "Ethereum",
+								Symbol:   "ETH",
+								Decimals: 18,
+							},
+						},
+					},
+					{
+						Type:     typex.TransactionTransfer,
+						Platform: "ether.fi",
+						From:     "0x0000000000000000000000000000000000000000",
+						To:       "0x5A8C3F2e4b6D8f0A1c3e5B7D9F1A3c5e7b9d1F3a",
+						Metadata: metadata.TransactionTransfer{
+							Address:  lo.ToPtr("0x35fA164735182de50811E8e2E824cFb9B6118ac2"),
+							Value:    lo.ToPtr(lo.Must(decimal.NewFromString("1000000000000000000"))),
+							Name:     "ether.fi ETH",
+							Symbol:   "eETH",
+							Decimals: 18,
+							Standard: metadata.StandardERC20,
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1723190411,
+			},
+			wantError: require.NoError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			instance, err := worker.NewWorker(testcase.arguments.config)
+			require.NoError(t, err)
+
+			activity, err := instance.Transform(ctx, testcase.arguments.task)
+			testcase.wantError(t, err)
+
+			t.Log(string(lo.Must(json.MarshalIndent(activity, "", "\x20\x20"))))
+
+			require.Equal(t, testcase.want, activity)
+		})
+	}
+}
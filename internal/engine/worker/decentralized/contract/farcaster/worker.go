@@ -0,0 +1,433 @@
+package farcaster
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/database"
+	"github.com/rss3-network/node/internal/engine"
+	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+	"github.com/rss3-network/node/provider/ethereum/contract/farcaster"
+	"github.com/rss3-network/node/schema/worker/decentralized"
+	"github.com/rss3-network/protocol-go/schema"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// Keys of the social profile actions, as there are no activity types of the Farcaster registries.
+const (
+	ProfileKeyCustody   = "custody"
+	ProfileKeyRecovery  = "recovery"
+	ProfileKeyAddKey    = "add_key"
+	ProfileKeyRemoveKey = "remove_key"
+	ProfileKeyStorage   = "storage"
+)
+
+var _ engine.MutatingWorker = (*worker)(nil)
+
+type worker struct {
+	config                  *config.Module
+	ethereumClient          ethereum.Client
+	databaseClient          database.Client
+	idRegistryFilterer      *farcaster.IdRegistryFilterer
+	keyRegistryFilterer     *farcaster.KeyRegistryFilterer
+	storageRegistryFilterer *farcaster.StorageRegistryFilterer
+	idRegistryCaller        *farcaster.IdRegistryCaller
+}
+
+func (w *worker) Name() string {
+	return decentralized.Farcaster.String()
+}
+
+func (w *worker) Platform() string {
+	return decentralized.PlatformFarcaster.String()
+}
+
+func (w *worker) Network() []network.Network {
+	return []network.Network{
+		network.Optimism,
+	}
+}
+
+func (w *worker) Tags() []tag.Tag {
+	return []tag.Tag{
+		tag.Social,
+	}
+}
+
+func (w *worker) Types() []schema.Type {
+	return []schema.Type{
+		typex.SocialProfile,
+	}
+}
+
+// Filter returns a filter of the events of the Farcaster registries,
+// the registrations of the IdGateway and the keys of the KeyGateway are emitted by the registries.
+func (w *worker) Filter() engine.DataSourceFilter {
+	return &source.Filter{
+		LogAddresses: []common.Address{
+			farcaster.AddressIdRegistry,
+			farcaster.AddressKeyRegistry,
+			farcaster.AddressStorageRegistry,
+		},
+		LogTopics: []common.Hash{
+			farcaster.EventHashIdRegistryRegister,
+			farcaster.EventHashIdRegistryTransfer,
+			farcaster.EventHashIdRegistryRecover,
+			farcaster.EventHashIdRegistryChangeRecoveryAddress,
+			farcaster.EventHashKeyRegistryAdd,
+			farcaster.EventHashKeyRegistryRemove,
+			farcaster.EventHashKeyRegistryAdminReset,
+			farcaster.EventHashStorageRegistryRent,
+		},
+	}
+}
+
+func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	activity, _, err := w.TransformMutations(ctx, task)
+
+	return activity, err
+}
+
+// TransformMutations transforms the task into the activity and the mutation updating the custody addresses
+// of the FIDs in the dataset of profiles, which is applied after the activity is saved.
+func (w *worker) TransformMutations(ctx context.Context, task engine.Task) (*activityx.Activity, []*engine.Mutation, error) {
+	ethereumTask, ok := task.(*source.Task)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid task type: %T", task)
+	}
+
+	activity, err := ethereumTask.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("build activity: %w", err)
+	}
+
+	// The custody addresses of the FIDs after the task, which are the recipients of the latest registrations and transfers.
+	custodies := make(map[int64]common.Address)
+
+	for _, log := range ethereumTask.Receipt.Logs {
+		if len(log.Topics) == 0 {
+			continue
+		}
+
+		var (
+			action *activityx.Action
+			err    error
+		)
+
+		switch {
+		case w.matchIdRegistryRegister(log):
+			action, err = w.handleIdRegistryRegister(ctx, ethereumTask, log, custodies)
+		case w.matchIdRegistryTransfer(log):
+			action, err = w.handleIdRegistryTransfer(ctx, ethereumTask, log, custodies)
+		case w.matchIdRegistryRecover(log):
+			action, err = w.handleIdRegistryRecover(ctx, ethereumTask, log, custodies)
+		case w.matchIdRegistryChangeRecoveryAddress(log):
+			action, err = w.handleIdRegistryChangeRecoveryAddress(ctx, ethereumTask, log)
+		case w.matchKeyRegistryAdd(log):
+			action, err = w.handleKeyRegistryAdd(ctx, ethereumTask, log)
+		case w.matchKeyRegistryRemove(log):
+			action, err = w.handleKeyRegistryRemove(ctx, ethereumTask, log)
+		case w.matchStorageRegistryRent(log):
+			action, err = w.handleStorageRegistryRent(ctx, ethereumTask, log)
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		activity.Actions = append(activity.Actions, action)
+	}
+
+	if len(activity.Actions) == 0 {
+		zap.L().Debug("no actions generated for task", zap.String("task_id", task.ID()))
+
+		return nil, nil, nil
+	}
+
+	activity.Type = typex.SocialProfile
+
+	return activity, w.buildCustodyMutations(activity, custodies), nil
+}
+
+func (w *worker) matchIdRegistryRegister(log *ethereum.Log) bool {
+	return log.Address == farcaster.AddressIdRegistry && contract.MatchEventHashes(log.Topics[0], farcaster.EventHashIdRegistryRegister)
+}
+
+func (w *worker) matchIdRegistryTransfer(log *ethereum.Log) bool {
+	return log.Address == farcaster.AddressIdRegistry && contract.MatchEventHashes(log.Topics[0], farcaster.EventHashIdRegistryTransfer)
+}
+
+func (w *worker) matchIdRegistryRecover(log *ethereum.Log) bool {
+	return log.Address == farcaster.AddressIdRegistry && contract.MatchEventHashes(log.Topics[0], farcaster.EventHashIdRegistryRecover)
+}
+
+func (w *worker) matchIdRegistryChangeRecoveryAddress(log *ethereum.Log) bool {
+	return log.Address == farcaster.AddressIdRegistry && contract.MatchEventHashes(log.Topics[0], farcaster.EventHashIdRegistryChangeRecoveryAddress)
+}
+
+func (w *worker) matchKeyRegistryAdd(log *ethereum.Log) bool {
+	return log.Address == farcaster.AddressKeyRegistry && contract.MatchEventHashes(log.Topics[0], farcaster.EventHashKeyRegistryAdd)
+}
+
+// matchKeyRegistryRemove matches the keys removed by the custody addresses and reset by the admin.
+func (w *worker) matchKeyRegistryRemove(log *ethereum.Log) bool {
+	return log.Address == farcaster.AddressKeyRegistry && contract.MatchEventHashes(log.Topics[0], farcaster.EventHashKeyRegistryRemove, farcaster.EventHashKeyRegistryAdminReset)
+}
+
+func (w *worker) matchStorageRegistryRent(log *ethereum.Log) bool {
+	return log.Address == farcaster.AddressStorageRegistry && contract.MatchEventHashes(log.Topics[0], farcaster.EventHashStorageRegistryRent)
+}
+
+// handleIdRegistryRegister returns a profile action of the FID registered to the custody address.
+func (w *worker) handleIdRegistryRegister(ctx context.Context, task *source.Task, log *ethereum.Log, custodies map[int64]common.Address) (*activityx.Action, error) {
+	event, err := w.idRegistryFilterer.ParseRegister(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse register event: %w", err)
+	}
+
+	custodies[event.Id.Int64()] = event.To
+
+	profile := metadata.SocialProfile{
+		Action:  metadata.ActionSocialProfileCreate,
+		Address: event.To,
+		Key:     ProfileKeyRecovery,
+		Value:   event.Recovery.String(),
+	}
+
+	return w.buildProfileAction(ctx, task, event.Id, task.Transaction.From, event.To, profile)
+}
+
+// handleIdRegistryTransfer returns a profile action of the FID transferred to the new custody address.
+func (w *worker) handleIdRegistryTransfer(ctx context.Context, task *source.Task, log *ethereum.Log, custodies map[int64]common.Address) (*activityx.Action, error) {
+	event, err := w.idRegistryFilterer.ParseTransfer(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse transfer event: %w", err)
+	}
+
+	return w.handleCustodyChange(ctx, task, event.Id, event.From, event.To, custodies)
+}
+
+// handleIdRegistryRecover returns a profile action of the FID recovered to the new custody address by the recovery address.
+func (w *worker) handleIdRegistryRecover(ctx context.Context, task *source.Task, log *ethereum.Log, custodies map[int64]common.Address) (*activityx.Action, error) {
+	event, err := w.idRegistryFilterer.ParseRecover(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse recover event: %w", err)
+	}
+
+	return w.handleCustodyChange(ctx, task, event.Id, event.From, event.To, custodies)
+}
+
+func (w *worker) handleCustodyChange(ctx context.Context, task *source.Task, fid *big.Int, from, to common.Address, custodies map[int64]common.Address) (*activityx.Action, error) {
+	custodies[fid.Int64()] = to
+
+	profile := metadata.SocialProfile{
+		Action:  metadata.ActionSocialProfileUpdate,
+		Address: to,
+		Key:     ProfileKeyCustody,
+		Value:   to.String(),
+	}
+
+	return w.buildProfileAction(ctx, task, fid, from, to, profile)
+}
+
+func (w *worker) handleIdRegistryChangeRecoveryAddress(ctx context.Context, task *source.Task, log *ethereum.Log) (*activityx.Action, error) {
+	event, err := w.idRegistryFilterer.ParseChangeRecoveryAddress(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse change recovery address event: %w", err)
+	}
+
+	profile := metadata.SocialProfile{
+		Action: metadata.ActionSocialProfileUpdate,
+		Key:    ProfileKeyRecovery,
+		Value:  event.Recovery.String(),
+	}
+
+	return w.buildProfileAction(ctx, task, event.Id, task.Transaction.From, event.Recovery, profile)
+}
+
+// handleKeyRegistryAdd returns a profile action of the key added to the FID, which is the public key of an app signing messages.
+func (w *worker) handleKeyRegistryAdd(ctx context.Context, task *source.Task, log *ethereum.Log) (*activityx.Action, error) {
+	event, err := w.keyRegistryFilterer.ParseAdd(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse add event: %w", err)
+	}
+
+	profile := metadata.SocialProfile{
+		Action: metadata.ActionSocialProfileUpdate,
+		Key:    ProfileKeyAddKey,
+		Value:  hexutil.Encode(event.KeyBytes),
+	}
+
+	return w.buildProfileAction(ctx, task, event.Fid, task.Transaction.From, ethereum.AddressGenesis, profile)
+}
+
+func (w *worker) handleKeyRegistryRemove(ctx context.Context, task *source.Task, log *ethereum.Log) (*activityx.Action, error) {
+	var (
+		fid      *big.Int
+		keyBytes []byte
+	)
+
+	switch log.Topics[0] {
+	case farcaster.EventHashKeyRegistryRemove:
+		event, err := w.keyRegistryFilterer.ParseRemove(log.Export())
+		if err != nil {
+			return nil, fmt.Errorf("parse remove event: %w", err)
+		}
+
+		fid, keyBytes = event.Fid, event.KeyBytes
+	case farcaster.EventHashKeyRegistryAdminReset:
+		event, err := w.keyRegistryFilterer.ParseAdminReset(log.Export())
+		if err != nil {
+			return nil, fmt.Errorf("parse admin reset event: %w", err)
+		}
+
+		fid, keyBytes = event.Fid, event.KeyBytes
+	}
+
+	profile := metadata.SocialProfile{
+		Action: metadata.ActionSocialProfileUpdate,
+		Key:    ProfileKeyRemoveKey,
+		Value:  hexutil.Encode(keyBytes),
+	}
+
+	return w.buildProfileAction(ctx, task, fid, task.Transaction.From, ethereum.AddressGenesis, profile)
+}
+
+// handleStorageRegistryRent returns a profile action of the storage units rented for the FID by the payer.
+func (w *worker) handleStorageRegistryRent(ctx context.Context, task *source.Task, log *ethereum.Log) (*activityx.Action, error) {
+	event, err := w.storageRegistryFilterer.ParseRent(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse rent event: %w", err)
+	}
+
+	profile := metadata.SocialProfile{
+		Action: metadata.ActionSocialProfileRenew,
+		Key:    ProfileKeyStorage,
+		Value:  event.Units.String(),
+	}
+
+	// The storage rented by the IdGateway on registrations is paid by the sender of the transaction.
+	payer := lo.Ternary(event.Payer == farcaster.AddressIdGateway, task.Transaction.From, event.Payer)
+
+	return w.buildProfileAction(ctx, task, event.Fid, payer, ethereum.AddressGenesis, profile)
+}
+
+// buildProfileAction returns a profile action of the FID, the handle is the username in the dataset of profiles,
+// and the address of the profile and the recipient of the action are the custody address at the block if they are not set.
+func (w *worker) buildProfileAction(ctx context.Context, task *source.Task, fid *big.Int, from, to common.Address, profile metadata.SocialProfile) (*activityx.Action, error) {
+	profile.ProfileID = fid.String()
+
+	if profile.Address == ethereum.AddressGenesis {
+		custody, err := w.idRegistryCaller.CustodyOf(&bind.CallOpts{Context: ctx, BlockNumber: task.Header.Number}, fid)
+		if err != nil {
+			return nil, fmt.Errorf("get custody of fid %s: %w", fid, err)
+		}
+
+		profile.Address = custody
+	}
+
+	if to == ethereum.AddressGenesis {
+		to = profile.Address
+	}
+
+	if w.databaseClient != nil {
+		datasetProfile, err := w.databaseClient.LoadDatasetFarcasterProfile(ctx, fid.Int64())
+		if err != nil {
+			return nil, fmt.Errorf("load dataset farcaster profile %s: %w", fid, err)
+		}
+
+		profile.Handle = datasetProfile.Username
+	}
+
+	action := activityx.Action{
+		Type:     typex.SocialProfile,
+		Tag:      tag.Social,
+		Platform: w.Platform(),
+		From:     from.String(),
+		To:       to.String(),
+		Metadata: profile,
+	}
+
+	return &action, nil
+}
+
+// buildCustodyMutations returns the mutation updating the custody addresses of the FIDs in the dataset of profiles,
+// which is empty without a database. The tasks are applied in order, so the dataset ends with the latest custody address.
+func (w *worker) buildCustodyMutations(activity *activityx.Activity, custodies map[int64]common.Address) []*engine.Mutation {
+	if w.databaseClient == nil || len(custodies) == 0 {
+		return nil
+	}
+
+	return []*engine.Mutation{
+		{
+			Network: activity.Network,
+			ID:      activity.ID,
+			Save: func(ctx context.Context) error {
+				for fid, custody := range custodies {
+					if err := w.updateCustodyAddress(ctx, fid, custody); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+		},
+	}
+}
+
+// updateCustodyAddress updates the custody address of the FID in the dataset of profiles.
+func (w *worker) updateCustodyAddress(ctx context.Context, fid int64, custody common.Address) error {
+	profile, err := w.databaseClient.LoadDatasetFarcasterProfile(ctx, fid)
+	if err != nil {
+		return fmt.Errorf("load dataset farcaster profile %d: %w", fid, err)
+	}
+
+	if profile.CustodyAddress == custody.String() {
+		return nil
+	}
+
+	profile.CustodyAddress = custody.String()
+
+	if err := w.databaseClient.SaveDatasetFarcasterProfile(ctx, profile); err != nil {
+		return fmt.Errorf("save dataset farcaster profile %d: %w", fid, err)
+	}
+
+	return nil
+}
+
+// NewWorker returns a new Farcaster worker.
+func NewWorker(config *config.Module, databaseClient database.Client) (engine.Worker, error) {
+	var (
+		err      error
+		instance = worker{
+			config:         config,
+			databaseClient: databaseClient,
+		}
+	)
+
+	if instance.ethereumClient, err = ethereum.Dial(context.Background(), config.Endpoint.URL, config.Endpoint.BuildEthereumOptions()...); err != nil {
+		return nil, fmt.Errorf("initialize ethereum client: %w", err)
+	}
+
+	instance.idRegistryFilterer = lo.Must(farcaster.NewIdRegistryFilterer(ethereum.AddressGenesis, nil))
+	instance.keyRegistryFilterer = lo.Must(farcaster.NewKeyRegistryFilterer(ethereum.AddressGenesis, nil))
+	instance.storageRegistryFilterer = lo.Must(farcaster.NewStorageRegistryFilterer(ethereum.AddressGenesis, nil))
+	instance.idRegistryCaller = lo.Must(farcaster.NewIdRegistryCaller(farcaster.AddressIdRegistry, instance.ethereumClient))
+
+	return &instance, nil
+}
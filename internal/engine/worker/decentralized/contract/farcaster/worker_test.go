@@ -0,0 +1,694 @@
+package farcaster_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/database"
+	"github.com/rss3-network/node/internal/database/model"
+	"github.com/rss3-network/node/internal/engine"
+	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	worker "github.com/rss3-network/node/internal/engine/worker/decentralized/contract/farcaster"
+	"github.com/rss3-network/node/internal/testsuite"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract/farcaster"
+	workerx "github.com/rss3-network/node/schema/worker/decentralized"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// The transactions of the test cases are built for the tests,
+// and the custody addresses are served by a local JSON-RPC server instead of the IdRegistry contract.
+var (
+	addressCustody   = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	addressRecipient = common.HexToAddress("0x00000000000000000000000000000000000000a2")
+
+	custodies = map[uint64]common.Address{
+		880000: addressCustody,
+	}
+)
+
+// callContract serves the custodyOf method of IdRegistry.
+func callContract(_ common.Address, input []byte) ([]byte, error) {
+	idRegistryABI := lo.Must(farcaster.IdRegistryMetaData.GetAbi())
+
+	if len(input) != 4+common.HashLength || !bytes.Equal(input[:4], idRegistryABI.Methods["custodyOf"].ID) {
+		return nil, fmt.Errorf("unsupported input %s", hexutil.Encode(input))
+	}
+
+	custody, found := custodies[new(big.Int).SetBytes(input[4:]).Uint64()]
+	if !found {
+		return nil, fmt.Errorf("fid %s not found", new(big.Int).SetBytes(input[4:]))
+	}
+
+	return idRegistryABI.Methods["custodyOf"].Outputs.Pack(custody)
+}
+
+func TestWorker_Farcaster(t *testing.T) {
+	t.Parallel()
+
+	type arguments struct {
+		task   *source.Task
+		config *config.Module
+	}
+
+	testcases := []struct {
+		name      string
+		arguments arguments
+		want      *activityx.Activity
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name: "Register FID",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Optimism,
+					ChainID: 10,
+					Header: &ethereum.Header{
+						Number:    lo.Must(new(big.Int).SetString("126900000", 0)),
+						GasLimit:  30000000,
+						GasUsed:   12345678,
+						Timestamp: 1729350000,
+						BaseFee:   lo.Must(new(big.Int).SetString("1000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						From:     addressCustody,
+						Gas:      300000,
+						GasPrice: lo.Must(new(big.Int).SetString("1500000", 10)),
+						Hash:     common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+						Input:    hexutil.MustDecode("0x4420e486"),
+						To:       lo.ToPtr(farcaster.AddressIdGateway),
+						Value:    lo.Must(new(big.Int).SetString("1700000000000000", 0)),
+						Type:     2,
+						ChainID:  lo.Must(new(big.Int).SetString("10", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockNumber:       lo.Must(new(big.Int).SetString("126900000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x16e360"),
+						GasUsed:           150000,
+						L1GasPrice:        lo.Must(new(big.Int).SetString("12000000000", 0)),
+						L1GasUsed:         lo.Must(new(big.Int).SetString("1600", 0)),
+						L1Fee:             lo.Must(new(big.Int).SetString("2944000000", 0)),
+						FeeScalar:         lo.Must(new(big.Float).SetString("0.684")),
+						Logs: []*ethereum.Log{
+							{
+								Address: farcaster.AddressIdRegistry,
+								Topics: []common.Hash{
+									common.HexToHash("0xf2e19a901b0748d8b08e428d0468896a039ac751ec4fec49b44b7b9c28097e45"),
+									common.BytesToHash(addressCustody.Bytes()),
+									common.HexToHash("0x00000000000000000000000000000000000000000000000000000000000d6d80"),
+								},
+								Data:            hexutil.MustDecode("0x00000000000000000000000000000000fcb080a4d6c39a9354da9eb9bc104cd7"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("126900000", 0)),
+								TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+								Index:           4,
+								Removed:         false,
+							},
+							{
+								Address: farcaster.AddressStorageRegistry,
+								Topics: []common.Hash{
+									common.HexToHash("0xaabd75b90fb7114eb9587a54f00ce5ebe8cb4a70627f3a6c26e506ffd771fe2f"),
+									common.HexToHash("0x00000000000000000000000000000000fc25870c6ed6b6c7e41fb078b7656f69"),
+									common.HexToHash("0x00000000000000000000000000000000000000000000000000000000000d6d80"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000001"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("126900000", 0)),
+								TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+								Index:           5,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+						TransactionIndex: 5,
+					},
+				},
+				config: &config.Module{
+					Network: network.Optimism,
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x0000000000000000000000000000000000000000000000000000000000000001",
+				Network:  network.Optimism,
+				Index:    5,
+				From:     addressCustody.String(),
+				To:       farcaster.AddressIdGateway.String(),
+				Type:     typex.SocialProfile,
+				Platform: workerx.PlatformFarcaster.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("227944000000")),
+					Decimal: 18,
+				},
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x4420e486",
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialProfile,
+						Tag:      tag.Social,
+						Platform: workerx.PlatformFarcaster.String(),
+						From:     addressCustody.String(),
+						To:       addressCustody.String(),
+						Metadata: metadata.SocialProfile{
+							Action:    metadata.ActionSocialProfileCreate,
+							ProfileID: "880000",
+							Address:   addressCustody,
+							Key:       worker.ProfileKeyRecovery,
+							Value:     "0x00000000FcB080a4D6c39a9354dA9EB9bC104cd7",
+						},
+					},
+					{
+						Type:     typex.SocialProfile,
+						Tag:      tag.Social,
+						Platform: workerx.PlatformFarcaster.String(),
+						From:     addressCustody.String(),
+						To:       addressCustody.String(),
+						Metadata: metadata.SocialProfile{
+							Action:    metadata.ActionSocialProfileRenew,
+							ProfileID: "880000",
+							Address:   addressCustody,
+							Key:       worker.ProfileKeyStorage,
+							Value:     "1",
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1729350000,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Transfer FID",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Optimism,
+					ChainID: 10,
+					Header: &ethereum.Header{
+						Number:    lo.Must(new(big.Int).SetString("126900500", 0)),
+						GasLimit:  30000000,
+						GasUsed:   12345678,
+						Timestamp: 1729351000,
+						BaseFee:   lo.Must(new(big.Int).SetString("1000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						From:     addressRecipient,
+						Gas:      300000,
+						GasPrice: lo.Must(new(big.Int).SetString("1500000", 10)),
+						Hash:     common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+						Input:    hexutil.MustDecode("0xbe45fd62"),
+						To:       lo.ToPtr(farcaster.AddressIdRegistry),
+						Value:    lo.Must(new(big.Int).SetString("0", 0)),
+						Type:     2,
+						ChainID:  lo.Must(new(big.Int).SetString("10", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockNumber:       lo.Must(new(big.Int).SetString("126900500", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x16e360"),
+						GasUsed:           150000,
+						L1GasPrice:        lo.Must(new(big.Int).SetString("12000000000", 0)),
+						L1GasUsed:         lo.Must(new(big.Int).SetString("1600", 0)),
+						L1Fee:             lo.Must(new(big.Int).SetString("2944000000", 0)),
+						FeeScalar:         lo.Must(new(big.Float).SetString("0.684")),
+						Logs: []*ethereum.Log{
+							{
+								Address: farcaster.AddressIdRegistry,
+								Topics: []common.Hash{
+									common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+									common.BytesToHash(addressCustody.Bytes()),
+									common.BytesToHash(addressRecipient.Bytes()),
+									common.HexToHash("0x00000000000000000000000000000000000000000000000000000000000d6d80"),
+								},
+								Data:            hexutil.MustDecode("0x"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("126900500", 0)),
+								TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+								Index:           4,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+						TransactionIndex: 5,
+					},
+				},
+				config: &config.Module{
+					Network: network.Optimism,
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x0000000000000000000000000000000000000000000000000000000000000002",
+				Network:  network.Optimism,
+				Index:    5,
+				From:     addressRecipient.String(),
+				To:       farcaster.AddressIdRegistry.String(),
+				Type:     typex.SocialProfile,
+				Platform: workerx.PlatformFarcaster.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("227944000000")),
+					Decimal: 18,
+				},
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xbe45fd62",
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialProfile,
+						Tag:      tag.Social,
+						Platform: workerx.PlatformFarcaster.String(),
+						From:     addressCustody.String(),
+						To:       addressRecipient.String(),
+						Metadata: metadata.SocialProfile{
+							Action:    metadata.ActionSocialProfileUpdate,
+							ProfileID: "880000",
+							Address:   addressRecipient,
+							Key:       worker.ProfileKeyCustody,
+							Value:     addressRecipient.String(),
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1729351000,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Add Key",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Optimism,
+					ChainID: 10,
+					Header: &ethereum.Header{
+						Number:    lo.Must(new(big.Int).SetString("126901000", 0)),
+						GasLimit:  30000000,
+						GasUsed:   12345678,
+						Timestamp: 1729352000,
+						BaseFee:   lo.Must(new(big.Int).SetString("1000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						From:     addressCustody,
+						Gas:      300000,
+						GasPrice: lo.Must(new(big.Int).SetString("1500000", 10)),
+						Hash:     common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000003"),
+						Input:    hexutil.MustDecode("0x22b1a414"),
+						To:       lo.ToPtr(farcaster.AddressKeyGateway),
+						Value:    lo.Must(new(big.Int).SetString("0", 0)),
+						Type:     2,
+						ChainID:  lo.Must(new(big.Int).SetString("10", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockNumber:       lo.Must(new(big.Int).SetString("126901000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x16e360"),
+						GasUsed:           150000,
+						L1GasPrice:        lo.Must(new(big.Int).SetString("12000000000", 0)),
+						L1GasUsed:         lo.Must(new(big.Int).SetString("1600", 0)),
+						L1Fee:             lo.Must(new(big.Int).SetString("2944000000", 0)),
+						FeeScalar:         lo.Must(new(big.Float).SetString("0.684")),
+						Logs: []*ethereum.Log{
+							{
+								Address: farcaster.AddressKeyRegistry,
+								Topics: []common.Hash{
+									common.HexToHash("0x7d285df41058466977811345cd453c0c52e8d841ffaabc74fc050f277ad4de02"),
+									common.HexToHash("0x00000000000000000000000000000000000000000000000000000000000d6d80"),
+									common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+									crypto.Keccak256Hash(hexutil.MustDecode("0x5f9b2d7a1c3e8f406b2a9d1e7c5f3a8b0d4e6f2a1b9c8d7e6f5a4b3c2d1e0f9a")),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000060000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000205f9b2d7a1c3e8f406b2a9d1e7c5f3a8b0d4e6f2a1b9c8d7e6f5a4b3c2d1e0f9a0000000000000000000000000000000000000000000000000000000000000000"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("126901000", 0)),
+								TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000003"),
+								Index:           4,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000003"),
+						TransactionIndex: 5,
+					},
+				},
+				config: &config.Module{
+					Network: network.Optimism,
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x0000000000000000000000000000000000000000000000000000000000000003",
+				Network:  network.Optimism,
+				Index:    5,
+				From:     addressCustody.String(),
+				To:       farcaster.AddressKeyGateway.String(),
+				Type:     typex.SocialProfile,
+				Platform: workerx.PlatformFarcaster.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("227944000000")),
+					Decimal: 18,
+				},
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x22b1a414",
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialProfile,
+						Tag:      tag.Social,
+						Platform: workerx.PlatformFarcaster.String(),
+						From:     addressCustody.String(),
+						To:       addressCustody.String(),
+						Metadata: metadata.SocialProfile{
+							Action:    metadata.ActionSocialProfileUpdate,
+							ProfileID: "880000",
+							Address:   addressCustody,
+							Key:       worker.ProfileKeyAddKey,
+							Value:     "0x5f9b2d7a1c3e8f406b2a9d1e7c5f3a8b0d4e6f2a1b9c8d7e6f5a4b3c2d1e0f9a",
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1729352000,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Remove Key",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Optimism,
+					ChainID: 10,
+					Header: &ethereum.Header{
+						Number:    lo.Must(new(big.Int).SetString("126901500", 0)),
+						GasLimit:  30000000,
+						GasUsed:   12345678,
+						Timestamp: 1729353000,
+						BaseFee:   lo.Must(new(big.Int).SetString("1000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						From:     addressCustody,
+						Gas:      300000,
+						GasPrice: lo.Must(new(big.Int).SetString("1500000", 10)),
+						Hash:     common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000004"),
+						Input:    hexutil.MustDecode("0x58edef4c"),
+						To:       lo.ToPtr(farcaster.AddressKeyRegistry),
+						Value:    lo.Must(new(big.Int).SetString("0", 0)),
+						Type:     2,
+						ChainID:  lo.Must(new(big.Int).SetString("10", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockNumber:       lo.Must(new(big.Int).SetString("126901500", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x16e360"),
+						GasUsed:           150000,
+						L1GasPrice:        lo.Must(new(big.Int).SetString("12000000000", 0)),
+						L1GasUsed:         lo.Must(new(big.Int).SetString("1600", 0)),
+						L1Fee:             lo.Must(new(big.Int).SetString("2944000000", 0)),
+						FeeScalar:         lo.Must(new(big.Float).SetString("0.684")),
+						Logs: []*ethereum.Log{
+							{
+								Address: farcaster.AddressKeyRegistry,
+								Topics: []common.Hash{
+									common.HexToHash("0x09e77066e0155f46785be12f6938a6b2e4be4381e59058129ce15f355cb96958"),
+									common.HexToHash("0x00000000000000000000000000000000000000000000000000000000000d6d80"),
+									crypto.Keccak256Hash(hexutil.MustDecode("0x5f9b2d7a1c3e8f406b2a9d1e7c5f3a8b0d4e6f2a1b9c8d7e6f5a4b3c2d1e0f9a")),
+								},
+								Data:            hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000205f9b2d7a1c3e8f406b2a9d1e7c5f3a8b0d4e6f2a1b9c8d7e6f5a4b3c2d1e0f9a"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("126901500", 0)),
+								TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000004"),
+								Index:           4,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000004"),
+						TransactionIndex: 5,
+					},
+				},
+				config: &config.Module{
+					Network: network.Optimism,
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x0000000000000000000000000000000000000000000000000000000000000004",
+				Network:  network.Optimism,
+				Index:    5,
+				From:     addressCustody.String(),
+				To:       farcaster.AddressKeyRegistry.String(),
+				Type:     typex.SocialProfile,
+				Platform: workerx.PlatformFarcaster.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("227944000000")),
+					Decimal: 18,
+				},
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x58edef4c",
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialProfile,
+						Tag:      tag.Social,
+						Platform: workerx.PlatformFarcaster.String(),
+						From:     addressCustody.String(),
+						To:       addressCustody.String(),
+						Metadata: metadata.SocialProfile{
+							Action:    metadata.ActionSocialProfileUpdate,
+							ProfileID: "880000",
+							Address:   addressCustody,
+							Key:       worker.ProfileKeyRemoveKey,
+							Value:     "0x5f9b2d7a1c3e8f406b2a9d1e7c5f3a8b0d4e6f2a1b9c8d7e6f5a4b3c2d1e0f9a",
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1729353000,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Change Recovery Address",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Optimism,
+					ChainID: 10,
+					Header: &ethereum.Header{
+						Number:    lo.Must(new(big.Int).SetString("126902000", 0)),
+						GasLimit:  30000000,
+						GasUsed:   12345678,
+						Timestamp: 1729354000,
+						BaseFee:   lo.Must(new(big.Int).SetString("1000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						From:     addressCustody,
+						Gas:      300000,
+						GasPrice: lo.Must(new(big.Int).SetString("1500000", 10)),
+						Hash:     common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000005"),
+						Input:    hexutil.MustDecode("0xf1f0b224"),
+						To:       lo.ToPtr(farcaster.AddressIdRegistry),
+						Value:    lo.Must(new(big.Int).SetString("0", 0)),
+						Type:     2,
+						ChainID:  lo.Must(new(big.Int).SetString("10", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockNumber:       lo.Must(new(big.Int).SetString("126902000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x16e360"),
+						GasUsed:           150000,
+						L1GasPrice:        lo.Must(new(big.Int).SetString("12000000000", 0)),
+						L1GasUsed:         lo.Must(new(big.Int).SetString("1600", 0)),
+						L1Fee:             lo.Must(new(big.Int).SetString("2944000000", 0)),
+						FeeScalar:         lo.Must(new(big.Float).SetString("0.684")),
+						Logs: []*ethereum.Log{
+							{
+								Address: farcaster.AddressIdRegistry,
+								Topics: []common.Hash{
+									common.HexToHash("0x8e700b803af43e14651431cd73c9fe7d11b131ad797576a70b893ce5766f65c3"),
+									common.HexToHash("0x00000000000000000000000000000000000000000000000000000000000d6d80"),
+									common.BytesToHash(addressRecipient.Bytes()),
+								},
+								Data:            hexutil.MustDecode("0x"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("126902000", 0)),
+								TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000005"),
+								Index:           4,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000005"),
+						TransactionIndex: 5,
+					},
+				},
+				config: &config.Module{
+					Network: network.Optimism,
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x0000000000000000000000000000000000000000000000000000000000000005",
+				Network:  network.Optimism,
+				Index:    5,
+				From:     addressCustody.String(),
+				To:       farcaster.AddressIdRegistry.String(),
+				Type:     typex.SocialProfile,
+				Platform: workerx.PlatformFarcaster.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("227944000000")),
+					Decimal: 18,
+				},
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xf1f0b224",
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialProfile,
+						Tag:      tag.Social,
+						Platform: workerx.PlatformFarcaster.String(),
+						From:     addressCustody.String(),
+						To:       addressRecipient.String(),
+						Metadata: metadata.SocialProfile{
+							Action:    metadata.ActionSocialProfileUpdate,
+							ProfileID: "880000",
+							Address:   addressCustody,
+							Key:       worker.ProfileKeyRecovery,
+							Value:     addressRecipient.String(),
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1729354000,
+			},
+			wantError: require.NoError,
+		},
+	}
+
+	endpointURL := testsuite.NewEthereumRPCServer(t, callContract)
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			module := *testcase.arguments.config
+			module.Endpoint = config.Endpoint{URL: endpointURL}
+
+			instance, err := worker.NewWorker(&module, nil)
+			require.NoError(t, err)
+
+			activity, err := instance.Transform(ctx, testcase.arguments.task)
+			testcase.wantError(t, err)
+
+			t.Log(string(lo.Must(json.MarshalIndent(activity, "", "\x20\x20"))))
+
+			require.Equal(t, testcase.want, activity)
+		})
+	}
+}
+
+// databaseClient serves and records the profiles saved by the worker.
+type databaseClient struct {
+	database.Client
+
+	profiles map[int64]*model.Profile
+}
+
+func (c *databaseClient) LoadDatasetFarcasterProfile(_ context.Context, fid int64) (*model.Profile, error) {
+	if profile, found := c.profiles[fid]; found {
+		return lo.ToPtr(*profile), nil
+	}
+
+	return &model.Profile{Fid: fid}, nil
+}
+
+func (c *databaseClient) SaveDatasetFarcasterProfile(_ context.Context, profile *model.Profile) error {
+	c.profiles[profile.Fid] = profile
+
+	return nil
+}
+
+func TestWorker_Farcaster_CustodyMutations(t *testing.T) {
+	t.Parallel()
+
+	task := &source.Task{
+		Network: network.Optimism,
+		ChainID: 10,
+		Header: &ethereum.Header{
+			Number:    lo.Must(new(big.Int).SetString("126900500", 0)),
+			Timestamp: 1729351000,
+			BaseFee:   lo.Must(new(big.Int).SetString("1000000", 0)),
+		},
+		Transaction: &ethereum.Transaction{
+			From:     addressRecipient,
+			Gas:      300000,
+			GasPrice: lo.Must(new(big.Int).SetString("1500000", 10)),
+			Hash:     common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+			Input:    hexutil.MustDecode("0xbe45fd62"),
+			To:       lo.ToPtr(farcaster.AddressIdRegistry),
+			Value:    lo.Must(new(big.Int).SetString("0", 0)),
+			Type:     2,
+			ChainID:  lo.Must(new(big.Int).SetString("10", 0)),
+		},
+		Receipt: &ethereum.Receipt{
+			BlockNumber:       lo.Must(new(big.Int).SetString("126900500", 0)),
+			EffectiveGasPrice: hexutil.MustDecodeBig("0x16e360"),
+			GasUsed:           150000,
+			L1Fee:             lo.Must(new(big.Int).SetString("2944000000", 0)),
+			Logs: []*ethereum.Log{
+				{
+					Address: farcaster.AddressIdRegistry,
+					Topics: []common.Hash{
+						common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+						common.BytesToHash(addressCustody.Bytes()),
+						common.BytesToHash(addressRecipient.Bytes()),
+						common.HexToHash("0x00000000000000000000000000000000000000000000000000000000000d6d80"),
+					},
+					Data:            hexutil.MustDecode("0x"),
+					BlockNumber:     lo.Must(new(big.Int).SetString("126900500", 0)),
+					TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+					Index:           4,
+				},
+			},
+			Status:           1,
+			TransactionHash:  common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+			TransactionIndex: 5,
+		},
+	}
+
+	client := &databaseClient{
+		profiles: map[int64]*model.Profile{
+			880000: {Fid: 880000, Username: "farcaster", CustodyAddress: addressCustody.String()},
+		},
+	}
+
+	instance, err := worker.NewWorker(&config.Module{
+		Network:  network.Optimism,
+		Endpoint: config.Endpoint{URL: testsuite.NewEthereumRPCServer(t, callContract)},
+	}, client)
+	require.NoError(t, err)
+
+	activity, mutations, err := instance.(engine.MutatingWorker).TransformMutations(context.Background(), task)
+	require.NoError(t, err)
+	require.Len(t, activity.Actions, 1)
+
+	// The custody address is the recipient of the transfer, and is saved after the activity is saved.
+	require.Equal(t, addressCustody.String(), client.profiles[880000].CustodyAddress)
+	require.Len(t, mutations, 1)
+	require.Equal(t, activity.ID, mutations[0].ID)
+	require.NoError(t, mutations[0].Save(context.Background()))
+	require.Equal(t, addressRecipient.String(), client.profiles[880000].CustodyAddress)
+	require.Equal(t, "farcaster", client.profiles[880000].Username)
+}
@@ -0,0 +1,338 @@
+package gmx
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/engine"
+	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+	"github.com/rss3-network/node/provider/ethereum/contract/gmx"
+	"github.com/rss3-network/node/provider/ethereum/token"
+	metadatax "github.com/rss3-network/node/schema/metadata"
+	"github.com/rss3-network/node/schema/worker/decentralized"
+	"github.com/rss3-network/protocol-go/schema"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// symbolUSD is the symbol of the size of positions, which are denominated in USD.
+const symbolUSD = "USD"
+
+var _ engine.Worker = (*worker)(nil)
+
+type worker struct {
+	config               *config.Module
+	ethereumClient       ethereum.Client
+	tokenClient          token.Client
+	eventEmitterFilterer *gmx.EventEmitterFilterer
+}
+
+// position is a position change decoded from the data of a PositionIncrease or PositionDecrease event.
+type position struct {
+	Account               common.Address
+	Market                common.Address
+	CollateralToken       common.Address
+	IsLong                bool
+	OrderType             uint64
+	SizeInUsd             *big.Int
+	SizeDeltaUsd          *big.Int
+	CollateralAmount      *big.Int
+	CollateralDeltaAmount *big.Int
+	CollateralTokenPrice  *big.Int
+	// BasePnlUsd is the realized PnL of a decreased position, which is nil for an increased position.
+	BasePnlUsd *big.Int
+}
+
+func (w *worker) Name() string {
+	return decentralized.GMX.String()
+}
+
+func (w *worker) Platform() string {
+	return decentralized.PlatformGMX.String()
+}
+
+func (w *worker) Network() []network.Network {
+	return []network.Network{
+		network.Arbitrum,
+		network.Avalanche,
+	}
+}
+
+func (w *worker) Tags() []tag.Tag {
+	return []tag.Tag{
+		tag.Exchange,
+	}
+}
+
+func (w *worker) Types() []schema.Type {
+	return []schema.Type{
+		typex.ExchangeLoan,
+	}
+}
+
+func (w *worker) Filter() engine.DataSourceFilter {
+	return &source.Filter{
+		LogAddresses: []common.Address{
+			gmx.AddressEventEmitterArbitrum,
+			gmx.AddressEventEmitterAvalanche,
+		},
+		LogTopics: []common.Hash{
+			gmx.EventEventLog1,
+		},
+	}
+}
+
+func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	ethereumTask, ok := task.(*source.Task)
+	if !ok {
+		return nil, fmt.Errorf("invalid task type: %T", task)
+	}
+
+	activity, err := ethereumTask.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
+	if err != nil {
+		return nil, fmt.Errorf("build activity: %w", err)
+	}
+
+	for _, log := range ethereumTask.Receipt.Logs {
+		if !w.matchPositionEvent(log) {
+			continue
+		}
+
+		action, err := w.handlePositionEvent(ctx, ethereumTask, log)
+		if err != nil {
+			return nil, err
+		}
+
+		// The orders are executed by the keepers, so the activity is attributed to the account of the position.
+		activity.From = action.From
+		activity.Type = action.Type
+		activity.Actions = append(activity.Actions, action)
+	}
+
+	if len(activity.Actions) == 0 {
+		zap.L().Debug("no actions generated for task", zap.String("task_id", task.ID()))
+
+		return nil, nil
+	}
+
+	return activity, nil
+}
+
+func (w *worker) matchPositionEvent(log *ethereum.Log) bool {
+	return lo.Contains([]common.Address{gmx.AddressEventEmitterArbitrum, gmx.AddressEventEmitterAvalanche}, log.Address) &&
+		len(log.Topics) == 3 &&
+		contract.MatchEventHashes(log.Topics[0], gmx.EventEventLog1) &&
+		contract.MatchEventHashes(log.Topics[1], gmx.EventNameHashPositionIncrease, gmx.EventNameHashPositionDecrease)
+}
+
+// handlePositionEvent returns a loan action of the position change, the size of the change is the amount of the loan.
+func (w *worker) handlePositionEvent(ctx context.Context, task *source.Task, log *ethereum.Log) (*activityx.Action, error) {
+	event, err := w.eventEmitterFilterer.ParseEventLog1(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse event log1 event: %w", err)
+	}
+
+	position, err := w.decodePosition(event.EventName, event.EventData)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s event: %w", event.EventName, err)
+	}
+
+	collateral, err := w.tokenClient.Lookup(ctx, task.ChainID, &position.CollateralToken, nil, task.Header.Number)
+	if err != nil {
+		return nil, fmt.Errorf("lookup token metadata: %w", err)
+	}
+
+	collateral.Value = lo.ToPtr(decimal.NewFromBigInt(position.CollateralDeltaAmount, 0).Abs())
+
+	var pnl *decimal.Decimal
+
+	if position.BasePnlUsd != nil {
+		pnl = lo.ToPtr(decimal.NewFromBigInt(position.BasePnlUsd, -gmx.USDDecimals))
+	}
+
+	return &activityx.Action{
+		Type:     typex.ExchangeLoan,
+		Platform: w.Platform(),
+		From:     position.Account.String(),
+		To:       position.Market.String(),
+		Metadata: metadatax.ExchangePosition{
+			ExchangeLoan: metadata.ExchangeLoan{
+				Action:     w.buildLoanAction(event.EventName, position),
+				Collateral: *collateral,
+				Amount: &metadata.Token{
+					Address:  lo.ToPtr(position.Market.String()),
+					Value:    lo.ToPtr(decimal.NewFromBigInt(position.SizeDeltaUsd, 0)),
+					Symbol:   symbolUSD,
+					Decimals: gmx.USDDecimals,
+				},
+			},
+			Market:   w.buildMarketName(position.Market),
+			Side:     lo.Ternary(position.IsLong, metadatax.PositionSideLong, metadatax.PositionSideShort),
+			Size:     decimal.NewFromBigInt(position.SizeInUsd, -gmx.USDDecimals),
+			Leverage: w.buildLeverage(position),
+			PnL:      pnl,
+		},
+	}, nil
+}
+
+// decodePosition decodes the position from the key-value items of the event data.
+func (w *worker) decodePosition(eventName string, data gmx.EventUtilsEventLogData) (*position, error) {
+	var (
+		result position
+		err    error
+	)
+
+	addresses := lo.SliceToMap(data.AddressItems.Items, func(item gmx.EventUtilsAddressKeyValue) (string, common.Address) {
+		return item.Key, item.Value
+	})
+
+	uints := lo.SliceToMap(data.UintItems.Items, func(item gmx.EventUtilsUintKeyValue) (string, *big.Int) {
+		return item.Key, item.Value
+	})
+
+	ints := lo.SliceToMap(data.IntItems.Items, func(item gmx.EventUtilsIntKeyValue) (string, *big.Int) {
+		return item.Key, item.Value
+	})
+
+	bools := lo.SliceToMap(data.BoolItems.Items, func(item gmx.EventUtilsBoolKeyValue) (string, bool) {
+		return item.Key, item.Value
+	})
+
+	if result.Account, err = lookupItem(addresses, "account"); err != nil {
+		return nil, err
+	}
+
+	if result.Market, err = lookupItem(addresses, "market"); err != nil {
+		return nil, err
+	}
+
+	if result.CollateralToken, err = lookupItem(addresses, "collateralToken"); err != nil {
+		return nil, err
+	}
+
+	if result.IsLong, err = lookupItem(bools, "isLong"); err != nil {
+		return nil, err
+	}
+
+	orderType, err := lookupItem(uints, "orderType")
+	if err != nil {
+		return nil, err
+	}
+
+	result.OrderType = orderType.Uint64()
+
+	if result.SizeInUsd, err = lookupItem(uints, "sizeInUsd"); err != nil {
+		return nil, err
+	}
+
+	if result.SizeDeltaUsd, err = lookupItem(uints, "sizeDeltaUsd"); err != nil {
+		return nil, err
+	}
+
+	if result.CollateralAmount, err = lookupItem(uints, "collateralAmount"); err != nil {
+		return nil, err
+	}
+
+	if result.CollateralTokenPrice, err = lookupItem(uints, "collateralTokenPrice.min"); err != nil {
+		return nil, err
+	}
+
+	// The collateral delta amount of PositionIncrease is signed, while the one of PositionDecrease is unsigned.
+	switch eventName {
+	case gmx.EventNamePositionIncrease:
+		if result.CollateralDeltaAmount, err = lookupItem(ints, "collateralDeltaAmount"); err != nil {
+			return nil, err
+		}
+	case gmx.EventNamePositionDecrease:
+		if result.CollateralDeltaAmount, err = lookupItem(uints, "collateralDeltaAmount"); err != nil {
+			return nil, err
+		}
+
+		if result.BasePnlUsd, err = lookupItem(ints, "basePnlUsd"); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported event name %s", eventName)
+	}
+
+	return &result, nil
+}
+
+// buildLoanAction returns the loan action of the position change, opening a position creates a loan,
+// closing a position repays the loan and the other changes refinance the loan.
+func (w *worker) buildLoanAction(eventName string, position *position) metadata.ExchangeLoanAction {
+	switch {
+	case eventName == gmx.EventNamePositionIncrease && position.SizeInUsd.Cmp(position.SizeDeltaUsd) == 0:
+		return metadata.ActionExchangeLoanCreate
+	case eventName == gmx.EventNamePositionDecrease && position.OrderType == gmx.OrderTypeLiquidation:
+		return metadata.ActionExchangeLoanLiquidate
+	case eventName == gmx.EventNamePositionDecrease && position.SizeInUsd.Sign() == 0:
+		return metadata.ActionExchangeLoanRepay
+	default:
+		return metadata.ActionExchangeLoanRefinance
+	}
+}
+
+// buildMarketName returns the name of the market, such as "ETH/USD", or the address of an unknown market.
+func (w *worker) buildMarketName(market common.Address) string {
+	if name, exists := gmx.Markets[market]; exists {
+		return name
+	}
+
+	return market.String()
+}
+
+// buildLeverage returns the leverage of the position after the change, which is rounded to 2 decimal places,
+// or nil if the position is closed.
+func (w *worker) buildLeverage(position *position) *decimal.Decimal {
+	// The USD value of the collateral is the amount multiplied by the price, which is scaled to 30 decimals.
+	collateralUsd := new(big.Int).Mul(position.CollateralAmount, position.CollateralTokenPrice)
+	if position.SizeInUsd.Sign() <= 0 || collateralUsd.Sign() <= 0 {
+		return nil
+	}
+
+	return lo.ToPtr(decimal.NewFromBigInt(position.SizeInUsd, 0).Div(decimal.NewFromBigInt(collateralUsd, 0)).Round(2))
+}
+
+// lookupItem returns the value of the key in the items of the event data.
+func lookupItem[T any](items map[string]T, key string) (T, error) {
+	value, exists := items[key]
+	if !exists {
+		return value, fmt.Errorf("item %s not found", key)
+	}
+
+	return value, nil
+}
+
+// NewWorker creates a new GMX worker.
+func NewWorker(config *config.Module) (engine.Worker, error) {
+	var (
+		instance = worker{
+			config: config,
+		}
+
+		err error
+	)
+
+	if instance.ethereumClient, err = ethereum.Dial(context.Background(), config.Endpoint.URL, config.Endpoint.BuildEthereumOptions()...); err != nil {
+		return nil, fmt.Errorf("initialize ethereum client: %w", err)
+	}
+
+	instance.tokenClient = token.NewClient(instance.ethereumClient)
+
+	// Initialize contract filterers.
+	instance.eventEmitterFilterer = lo.Must(gmx.NewEventEmitterFilterer(ethereum.AddressGenesis, nil))
+
+	return &instance, nil
+}
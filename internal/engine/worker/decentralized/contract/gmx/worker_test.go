@@ -0,0 +1,519 @@
+package gmx_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rss3-network/node/config"
+	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	worker "github.com/rss3-network/node/internal/engine/worker/decentralized/contract/gmx"
+	"github.com/rss3-network/node/internal/testsuite"
+	"github.com/rss3-network/node/provider/ethereum"
+	metadatax "github.com/rss3-network/node/schema/metadata"
+	workerx "github.com/rss3-network/node/schema/worker/decentralized"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorker_Arbitrum(t *testing.T) {
+	t.Parallel()
+
+	endpointURL := testsuite.NewEthereumRPCServer(t, testsuite.ERC20Call(map[common.Address]testsuite.ERC20Token{
+		common.HexToAddress("0xaf88d065e77c8cC2239327C5EDb3A432268e5831"): {Name: "USD Coin", Symbol: "USDC", Decimals: 6},
+		common.HexToAddress("0x82aF49447D8a07e3bd95BD0d56f35241523fBab1"): {Name: "Wrapped Ether", Symbol: "WETH", Decimals: 18},
+	}))
+
+	type arguments struct {
+		task   *source.Task
+		config *config.Module
+	}
+
+	testcases := []struct {
+		name      string
+		arguments arguments
+		want      *activityx.Activity
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name: "Open Long Position",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Arbitrum,
+					ChainID: 42161,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						ParentHash: common.HexToHash("0xbabababababababababababababababababababababababababababababababa"),
+						Number:     lo.Must(new(big.Int).SetString("250000000", 0)),
+						GasLimit:   1125899906842624,
+						GasUsed:    12345678,
+						Timestamp:  1725000000,
+						BaseFee:    lo.Must(new(big.Int).SetString("10000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						From:      common.HexToAddress("0x3c3E1A1b1A3f4c2e1b0E4d0B4D1e6fbfB4A6B9cd"),
+						Gas:       5000000,
+						GasPrice:  lo.Must(new(big.Int).SetString("10000000", 10)),
+						Hash:      common.HexToHash("0x0101010101010101010101010101010101010101010101010101010101010101"),
+						Input:     hexutil.MustDecode("0xd3d60b7e"),
+						To:        lo.ToPtr(common.HexToAddress("0x7C68C7866A64FA2160F78EEaE12217FFbf871fa8")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("42161", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("250000000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x989680"),
+						GasUsed:           2000000,
+
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0xC8ee91A54287DB53897056e12D9819156D3822Fb"),
+								Topics: []common.Hash{
+									common.HexToHash("0x137a44067c8961cd7e1d876f4754a5a3a75989b4552f1843fc69c3b372def160"),
+									common.HexToHash("0xf94196ccb31f81a3e67df18f2a62cbfb50009c80a7d3c728a3f542e3abc5cb63"),
+									common.HexToHash("0x0000000000000000000000001f3a5c7e9b2d4f6a8c0e2b4d6f8a0c2e4b6d8f0a"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000003c3e1a1b1a3f4c2e1b0e4d0b4d1e6fbfb4a6b9cd000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000010506f736974696f6e496e6372656173650000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e00000000000000000000000000000000000000000000000000000000000000340000000000000000000000000000000000000000000000000000000000000078000000000000000000000000000000000000000000000000000000000000008a000000000000000000000000000000000000000000000000000000000000009c00000000000000000000000000000000000000000000000000000000000000a400000000000000000000000000000000000000000000000000000000000000ac0000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000002400000000000000000000000000000000000000000000000000000000000000003000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000e0000000000000000000000000000000000000000000000000000000000000016000000000000000000000000000000000000000000000000000000000000000400000000000000000000000001f3a5c7e9b2d4f6a8c0e2b4d6f8a0c2e4b6d8f0a00000000000000000000000000000000000000000000000000000000000000076163636f756e7400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000070d95587d40a2caf56bd97485ab3eec10bee633600000000000000000000000000000000000000000000000000000000000000066d61726b657400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000af88d065e77c8cc2239327c5edb3a432268e5831000000000000000000000000000000000000000000000000000000000000000f636f6c6c61746572616c546f6b656e0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000420000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000000000014000000000000000000000000000000000000000000000000000000000000001c0000000000000000000000000000000000000000000000000000000000000024000000000000000000000000000000000000000000000000000000000000002c000000000000000000000000000000000000000000000000000000000000003400000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000001ed09bead87c0378d8e6400000000000000000000000000000000000000000000000000000000000000000000000973697a65496e55736400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000001ed09bead87c0378d8e6400000000000000000000000000000000000000000000000000000000000000000000000c73697a6544656c74615573640000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000773594000000000000000000000000000000000000000000000000000000000000000010636f6c6c61746572616c416d6f756e7400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000d3c21bcecceda10000000000000000000000000000000000000000000000000000000000000000000018636f6c6c61746572616c546f6b656e50726963652e6d61780000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000d3c21bcecceda10000000000000000000000000000000000000000000000000000000000000000000018636f6c6c61746572616c546f6b656e50726963652e6d696e00000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000096f7264657254797065000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000773594000000000000000000000000000000000000000000000000000000000000000015636f6c6c61746572616c44656c7461416d6f756e7400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000669734c6f6e6700000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000060000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("250000000", 0)),
+								TransactionHash: common.HexToHash("0x0101010101010101010101010101010101010101010101010101010101010101"),
+								Index:           12,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0101010101010101010101010101010101010101010101010101010101010101"),
+						TransactionIndex: 3,
+					},
+				},
+				config: &config.Module{
+					Network: network.Arbitrum,
+					Endpoint: config.Endpoint{
+						URL: endpointURL,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0101010101010101010101010101010101010101010101010101010101010101",
+				Network: network.Arbitrum,
+				Index:   3,
+				From:    "0x1f3A5C7E9B2d4F6a8c0e2B4d6f8a0C2E4b6d8f0A",
+				To:      "0x7C68C7866A64FA2160F78EEaE12217FFbf871fa8",
+				Type:    typex.ExchangeLoan,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xd3d60b7e",
+				},
+				Platform: workerx.PlatformGMX.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("20000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeLoan,
+						Platform: workerx.PlatformGMX.String(),
+						From:     "0x1f3A5C7E9B2d4F6a8c0e2B4d6f8a0C2E4b6d8f0A",
+						To:       "0x70d95587d40A2caf56bd97485aB3Eec10Bee6336",
+						Metadata: metadatax.ExchangePosition{
+							ExchangeLoan: metadata.ExchangeLoan{
+								Action: metadata.ActionExchangeLoanCreate,
+								Collateral: metadata.Token{
+									Address:  lo.ToPtr("0xaf88d065e77c8cC2239327C5EDb3A432268e5831"),
+									Value:    lo.ToPtr(lo.Must(decimal.NewFromString("2000000000"))),
+									Name:     "USD Coin",
+									Symbol:   "USDC",
+									Decimals: 6,
+									Standard: metadata.StandardERC20,
+								},
+								Amount: &metadata.Token{
+									Address:  lo.ToPtr("0x70d95587d40A2caf56bd97485aB3Eec10Bee6336"),
+									Value:    lo.ToPtr(lo.Must(decimal.NewFromString("10000000000000000000000000000000000"))),
+									Symbol:   "USD",
+									Decimals: 30,
+								},
+							},
+							Market:   "ETH/USD",
+							Side:     metadatax.PositionSideLong,
+							Size:     lo.Must(decimal.NewFromString("10000.000000000000000000000000000000")),
+							Leverage: lo.ToPtr(lo.Must(decimal.NewFromString("5.00"))),
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1725000000,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Increase Long Position",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Arbitrum,
+					ChainID: 42161,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						ParentHash: common.HexToHash("0xbabababababababababababababababababababababababababababababababa"),
+						Number:     lo.Must(new(big.Int).SetString("250000000", 0)),
+						GasLimit:   1125899906842624,
+						GasUsed:    12345678,
+						Timestamp:  1725000000,
+						BaseFee:    lo.Must(new(big.Int).SetString("10000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						From:      common.HexToAddress("0x3c3E1A1b1A3f4c2e1b0E4d0B4D1e6fbfB4A6B9cd"),
+						Gas:       5000000,
+						GasPrice:  lo.Must(new(big.Int).SetString("10000000", 10)),
+						Hash:      common.HexToHash("0x0202020202020202020202020202020202020202020202020202020202020202"),
+						Input:     hexutil.MustDecode("0xd3d60b7e"),
+						To:        lo.ToPtr(common.HexToAddress("0x7C68C7866A64FA2160F78EEaE12217FFbf871fa8")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("42161", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("250000000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x989680"),
+						GasUsed:           2000000,
+
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0xC8ee91A54287DB53897056e12D9819156D3822Fb"),
+								Topics: []common.Hash{
+									common.HexToHash("0x137a44067c8961cd7e1d876f4754a5a3a75989b4552f1843fc69c3b372def160"),
+									common.HexToHash("0xf94196ccb31f81a3e67df18f2a62cbfb50009c80a7d3c728a3f542e3abc5cb63"),
+									common.HexToHash("0x0000000000000000000000001f3a5c7e9b2d4f6a8c0e2b4d6f8a0c2e4b6d8f0a"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000003c3e1a1b1a3f4c2e1b0e4d0b4d1e6fbfb4a6b9cd000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000010506f736974696f6e496e6372656173650000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e00000000000000000000000000000000000000000000000000000000000000340000000000000000000000000000000000000000000000000000000000000078000000000000000000000000000000000000000000000000000000000000008a000000000000000000000000000000000000000000000000000000000000009c00000000000000000000000000000000000000000000000000000000000000a400000000000000000000000000000000000000000000000000000000000000ac0000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000002400000000000000000000000000000000000000000000000000000000000000003000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000e0000000000000000000000000000000000000000000000000000000000000016000000000000000000000000000000000000000000000000000000000000000400000000000000000000000001f3a5c7e9b2d4f6a8c0e2b4d6f8a0c2e4b6d8f0a00000000000000000000000000000000000000000000000000000000000000076163636f756e7400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000070d95587d40a2caf56bd97485ab3eec10bee633600000000000000000000000000000000000000000000000000000000000000066d61726b657400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000af88d065e77c8cc2239327c5edb3a432268e5831000000000000000000000000000000000000000000000000000000000000000f636f6c6c61746572616c546f6b656e0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000420000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000000000014000000000000000000000000000000000000000000000000000000000000001c0000000000000000000000000000000000000000000000000000000000000024000000000000000000000000000000000000000000000000000000000000002c000000000000000000000000000000000000000000000000000000000000003400000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000002e38e9e044ba05354559600000000000000000000000000000000000000000000000000000000000000000000000973697a65496e55736400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000f684df56c3e01bc6c73200000000000000000000000000000000000000000000000000000000000000000000000c73697a6544656c74615573640000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000773594000000000000000000000000000000000000000000000000000000000000000010636f6c6c61746572616c416d6f756e7400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000d3c21bcecceda10000000000000000000000000000000000000000000000000000000000000000000018636f6c6c61746572616c546f6b656e50726963652e6d61780000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000d3c21bcecceda10000000000000000000000000000000000000000000000000000000000000000000018636f6c6c61746572616c546f6b656e50726963652e6d696e00000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000000300000000000000000000000000000000000000000000000000000000000000096f7264657254797065000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000015636f6c6c61746572616c44656c7461416d6f756e7400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000669734c6f6e6700000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000060000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("250000000", 0)),
+								TransactionHash: common.HexToHash("0x0202020202020202020202020202020202020202020202020202020202020202"),
+								Index:           12,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0202020202020202020202020202020202020202020202020202020202020202"),
+						TransactionIndex: 3,
+					},
+				},
+				config: &config.Module{
+					Network: network.Arbitrum,
+					Endpoint: config.Endpoint{
+						URL: endpointURL,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0202020202020202020202020202020202020202020202020202020202020202",
+				Network: network.Arbitrum,
+				Index:   3,
+				From:    "0x1f3A5C7E9B2d4F6a8c0e2B4d6f8a0C2E4b6d8f0A",
+				To:      "0x7C68C7866A64FA2160F78EEaE12217FFbf871fa8",
+				Type:    typex.ExchangeLoan,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xd3d60b7e",
+				},
+				Platform: workerx.PlatformGMX.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("20000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeLoan,
+						Platform: workerx.PlatformGMX.String(),
+						From:     "0x1f3A5C7E9B2d4F6a8c0e2B4d6f8a0C2E4b6d8f0A",
+						To:       "0x70d95587d40A2caf56bd97485aB3Eec10Bee6336",
+						Metadata: metadatax.ExchangePosition{
+							ExchangeLoan: metadata.ExchangeLoan{
+								Action: metadata.ActionExchangeLoanRefinance,
+								Collateral: metadata.Token{
+									Address:  lo.ToPtr("0xaf88d065e77c8cC2239327C5EDb3A432268e5831"),
+									Value:    lo.ToPtr(lo.Must(decimal.NewFromString("0"))),
+									Name:     "USD Coin",
+									Symbol:   "USDC",
+									Decimals: 6,
+									Standard: metadata.StandardERC20,
+								},
+								Amount: &metadata.Token{
+									Address:  lo.ToPtr("0x70d95587d40A2caf56bd97485aB3Eec10Bee6336"),
+									Value:    lo.ToPtr(lo.Must(decimal.NewFromString("5000000000000000000000000000000000"))),
+									Symbol:   "USD",
+									Decimals: 30,
+								},
+							},
+							Market:   "ETH/USD",
+							Side:     metadatax.PositionSideLong,
+							Size:     lo.Must(decimal.NewFromString("15000.000000000000000000000000000000")),
+							Leverage: lo.ToPtr(lo.Must(decimal.NewFromString("7.50"))),
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1725000000,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Close Short Position",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Arbitrum,
+					ChainID: 42161,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						ParentHash: common.HexToHash("0xbabababababababababababababababababababababababababababababababa"),
+						Number:     lo.Must(new(big.Int).SetString("250000000", 0)),
+						GasLimit:   1125899906842624,
+						GasUsed:    12345678,
+						Timestamp:  1725000000,
+						BaseFee:    lo.Must(new(big.Int).SetString("10000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						From:      common.HexToAddress("0x3c3E1A1b1A3f4c2e1b0E4d0B4D1e6fbfB4A6B9cd"),
+						Gas:       5000000,
+						GasPrice:  lo.Must(new(big.Int).SetString("10000000", 10)),
+						Hash:      common.HexToHash("0x0303030303030303030303030303030303030303030303030303030303030303"),
+						Input:     hexutil.MustDecode("0xd3d60b7e"),
+						To:        lo.ToPtr(common.HexToAddress("0x7C68C7866A64FA2160F78EEaE12217FFbf871fa8")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("42161", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("250000000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x989680"),
+						GasUsed:           2000000,
+
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0xC8ee91A54287DB53897056e12D9819156D3822Fb"),
+								Topics: []common.Hash{
+									common.HexToHash("0x137a44067c8961cd7e1d876f4754a5a3a75989b4552f1843fc69c3b372def160"),
+									common.HexToHash("0x07d51b51b408d7c62dcc47cc558da5ce6a6e0fd129a427ebce150f52b0e5171a"),
+									common.HexToHash("0x0000000000000000000000001f3a5c7e9b2d4f6a8c0e2b4d6f8a0c2e4b6d8f0a"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000003c3e1a1b1a3f4c2e1b0e4d0b4d1e6fbfb4a6b9cd000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000010506f736974696f6e44656372656173650000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e00000000000000000000000000000000000000000000000000000000000000340000000000000000000000000000000000000000000000000000000000000082000000000000000000000000000000000000000000000000000000000000009400000000000000000000000000000000000000000000000000000000000000a600000000000000000000000000000000000000000000000000000000000000ae00000000000000000000000000000000000000000000000000000000000000b60000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000002400000000000000000000000000000000000000000000000000000000000000003000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000e0000000000000000000000000000000000000000000000000000000000000016000000000000000000000000000000000000000000000000000000000000000400000000000000000000000001f3a5c7e9b2d4f6a8c0e2b4d6f8a0c2e4b6d8f0a00000000000000000000000000000000000000000000000000000000000000076163636f756e7400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000047c031236e19d024b42f8ae6780e44a57317070300000000000000000000000000000000000000000000000000000000000000066d61726b657400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000af88d065e77c8cc2239327c5edb3a432268e5831000000000000000000000000000000000000000000000000000000000000000f636f6c6c61746572616c546f6b656e00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000004c0000000000000000000000000000000000000000000000000000000000000000700000000000000000000000000000000000000000000000000000000000000e0000000000000000000000000000000000000000000000000000000000000016000000000000000000000000000000000000000000000000000000000000001e0000000000000000000000000000000000000000000000000000000000000026000000000000000000000000000000000000000000000000000000000000002e0000000000000000000000000000000000000000000000000000000000000036000000000000000000000000000000000000000000000000000000000000003e000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000973697a65496e55736400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000f684df56c3e01bc6c73200000000000000000000000000000000000000000000000000000000000000000000000c73697a6544656c74615573640000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010636f6c6c61746572616c416d6f756e7400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000d3c21bcecceda10000000000000000000000000000000000000000000000000000000000000000000018636f6c6c61746572616c546f6b656e50726963652e6d61780000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000d3c21bcecceda10000000000000000000000000000000000000000000000000000000000000000000018636f6c6c61746572616c546f6b656e50726963652e6d696e00000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000096f726465725479706500000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000003b9aca000000000000000000000000000000000000000000000000000000000000000015636f6c6c61746572616c44656c7461416d6f756e7400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000c59c12771ccf16acdb7a0000000000000000000000000000000000000000000000000000000000000000000000a62617365506e6c557364000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000669734c6f6e6700000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000060000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("250000000", 0)),
+								TransactionHash: common.HexToHash("0x0303030303030303030303030303030303030303030303030303030303030303"),
+								Index:           12,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0303030303030303030303030303030303030303030303030303030303030303"),
+						TransactionIndex: 3,
+					},
+				},
+				config: &config.Module{
+					Network: network.Arbitrum,
+					Endpoint: config.Endpoint{
+						URL: endpointURL,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0303030303030303030303030303030303030303030303030303030303030303",
+				Network: network.Arbitrum,
+				Index:   3,
+				From:    "0x1f3A5C7E9B2d4F6a8c0e2B4d6f8a0C2E4b6d8f0A",
+				To:      "0x7C68C7866A64FA2160F78EEaE12217FFbf871fa8",
+				Type:    typex.ExchangeLoan,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xd3d60b7e",
+				},
+				Platform: workerx.PlatformGMX.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("20000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeLoan,
+						Platform: workerx.PlatformGMX.String(),
+						From:     "0x1f3A5C7E9B2d4F6a8c0e2B4d6f8a0C2E4b6d8f0A",
+						To:       "0x47c031236e19d024b42f8AE6780E44A573170703",
+						Metadata: metadatax.ExchangePosition{
+							ExchangeLoan: metadata.ExchangeLoan{
+								Action: metadata.ActionExchangeLoanRepay,
+								Collateral: metadata.Token{
+									Address:  lo.ToPtr("0xaf88d065e77c8cC2239327C5EDb3A432268e5831"),
+									Value:    lo.ToPtr(lo.Must(decimal.NewFromString("1000000000"))),
+									Name:     "USD Coin",
+									Symbol:   "USDC",
+									Decimals: 6,
+									Standard: metadata.StandardERC20,
+								},
+								Amount: &metadata.Token{
+									Address:  lo.ToPtr("0x47c031236e19d024b42f8AE6780E44A573170703"),
+									Value:    lo.ToPtr(lo.Must(decimal.NewFromString("5000000000000000000000000000000000"))),
+									Symbol:   "USD",
+									Decimals: 30,
+								},
+							},
+							Market: "BTC/USD",
+							Side:   metadatax.PositionSideShort,
+							Size:   lo.Must(decimal.NewFromString("0.000000000000000000000000000000")),
+							PnL:    lo.ToPtr(lo.Must(decimal.NewFromString("250.500000000000000000000000000000"))),
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1725000000,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Liquidate Long Position",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Arbitrum,
+					ChainID: 42161,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						ParentHash: common.HexToHash("0xbabababababababababababababababababababababababababababababababa"),
+						Number:     lo.Must(new(big.Int).SetString("250000000", 0)),
+						GasLimit:   1125899906842624,
+						GasUsed:    12345678,
+						Timestamp:  1725000000,
+						BaseFee:    lo.Must(new(big.Int).SetString("10000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						From:      common.HexToAddress("0x3c3E1A1b1A3f4c2e1b0E4d0B4D1e6fbfB4A6B9cd"),
+						Gas:       5000000,
+						GasPrice:  lo.Must(new(big.Int).SetString("10000000", 10)),
+						Hash:      common.HexToHash("0x0404040404040404040404040404040404040404040404040404040404040404"),
+						Input:     hexutil.MustDecode("0xd3d60b7e"),
+						To:        lo.ToPtr(common.HexToAddress("0x7C68C7866A64FA2160F78EEaE12217FFbf871fa8")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("42161", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("250000000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x989680"),
+						GasUsed:           2000000,
+
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0xC8ee91A54287DB53897056e12D9819156D3822Fb"),
+								Topics: []common.Hash{
+									common.HexToHash("0x137a44067c8961cd7e1d876f4754a5a3a75989b4552f1843fc69c3b372def160"),
+									common.HexToHash("0x07d51b51b408d7c62dcc47cc558da5ce6a6e0fd129a427ebce150f52b0e5171a"),
+									common.HexToHash("0x0000000000000000000000001f3a5c7e9b2d4f6a8c0e2b4d6f8a0c2e4b6d8f0a"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000003c3e1a1b1a3f4c2e1b0e4d0b4d1e6fbfb4a6b9cd000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000010506f736974696f6e44656372656173650000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e00000000000000000000000000000000000000000000000000000000000000340000000000000000000000000000000000000000000000000000000000000082000000000000000000000000000000000000000000000000000000000000009400000000000000000000000000000000000000000000000000000000000000a600000000000000000000000000000000000000000000000000000000000000ae00000000000000000000000000000000000000000000000000000000000000b60000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000002400000000000000000000000000000000000000000000000000000000000000003000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000e0000000000000000000000000000000000000000000000000000000000000016000000000000000000000000000000000000000000000000000000000000000400000000000000000000000001f3a5c7e9b2d4f6a8c0e2b4d6f8a0c2e4b6d8f0a00000000000000000000000000000000000000000000000000000000000000076163636f756e7400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000070d95587d40a2caf56bd97485ab3eec10bee633600000000000000000000000000000000000000000000000000000000000000066d61726b65740000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000082af49447d8a07e3bd95bd0d56f35241523fbab1000000000000000000000000000000000000000000000000000000000000000f636f6c6c61746572616c546f6b656e00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000004c0000000000000000000000000000000000000000000000000000000000000000700000000000000000000000000000000000000000000000000000000000000e0000000000000000000000000000000000000000000000000000000000000016000000000000000000000000000000000000000000000000000000000000001e0000000000000000000000000000000000000000000000000000000000000026000000000000000000000000000000000000000000000000000000000000002e0000000000000000000000000000000000000000000000000000000000000036000000000000000000000000000000000000000000000000000000000000003e000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000973697a65496e55736400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000003da137d5b0f806f1b1cc800000000000000000000000000000000000000000000000000000000000000000000000c73697a6544656c74615573640000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010636f6c6c61746572616c416d6f756e740000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000008e1bc9bf040000000000000000000000000000000000000000000000000000000000000000018636f6c6c61746572616c546f6b656e50726963652e6d6178000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000008e1bc9bf040000000000000000000000000000000000000000000000000000000000000000018636f6c6c61746572616c546f6b656e50726963652e6d696e00000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000000700000000000000000000000000000000000000000000000000000000000000096f72646572547970650000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000006f05b59d3b200000000000000000000000000000000000000000000000000000000000000000015636f6c6c61746572616c44656c7461416d6f756e740000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000040ffffffffffffffffffffffffffffffffffffb60b56992ba32addf77100000000000000000000000000000000000000000000000000000000000000000000000a62617365506e6c557364000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000669734c6f6e6700000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000400000000000000000000000000000000000000000000000000000000000000060000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("250000000", 0)),
+								TransactionHash: common.HexToHash("0x0404040404040404040404040404040404040404040404040404040404040404"),
+								Index:           12,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0404040404040404040404040404040404040404040404040404040404040404"),
+						TransactionIndex: 3,
+					},
+				},
+				config: &config.Module{
+					Network: network.Arbitrum,
+					Endpoint: config.Endpoint{
+						URL: endpointURL,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0404040404040404040404040404040404040404040404040404040404040404",
+				Network: network.Arbitrum,
+				Index:   3,
+				From:    "0x1f3A5C7E9B2d4F6a8c0e2B4d6f8a0C2E4b6d8f0A",
+				To:      "0x7C68C7866A64FA2160F78EEaE12217FFbf871fa8",
+				Type:    typex.ExchangeLoan,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xd3d60b7e",
+				},
+				Platform: workerx.PlatformGMX.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("20000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeLoan,
+						Platform: workerx.PlatformGMX.String(),
+						From:     "0x1f3A5C7E9B2d4F6a8c0e2B4d6f8a0C2E4b6d8f0A",
+						To:       "0x70d95587d40A2caf56bd97485aB3Eec10Bee6336",
+						Metadata: metadatax.ExchangePosition{
+							ExchangeLoan: metadata.ExchangeLoan{
+								Action: metadata.ActionExchangeLoanLiquidate,
+								Collateral: metadata.Token{
+									Address:  lo.ToPtr("0x82aF49447D8a07e3bd95BD0d56f35241523fBab1"),
+									Value:    lo.ToPtr(lo.Must(decimal.NewFromString("500000000000000000"))),
+									Name:     "Wrapped Ether",
+									Symbol:   "WETH",
+									Decimals: 18,
+									Standard: metadata.StandardERC20,
+								},
+								Amount: &metadata.Token{
+									Address:  lo.ToPtr("0x70d95587d40A2caf56bd97485aB3Eec10Bee6336"),
+									Value:    lo.ToPtr(lo.Must(decimal.NewFromString("20000000000000000000000000000000000"))),
+									Symbol:   "USD",
+									Decimals: 30,
+								},
+							},
+							Market: "ETH/USD",
+							Side:   metadatax.PositionSideLong,
+							Size:   lo.Must(decimal.NewFromString("0.000000000000000000000000000000")),
+							PnL:    lo.ToPtr(lo.Must(decimal.NewFromString("-1500.000000000000000000000000000000"))),
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1725000000,
+			},
+			wantError: require.NoError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			instance, err := worker.NewWorker(testcase.arguments.config)
+			require.NoError(t, err)
+
+			activity, err := instance.Transform(ctx, testcase.arguments.task)
+			testcase.wantError(t, err)
+
+			t.Log(string(lo.Must(json.MarshalIndent(activity, "", "\x20\x20"))))
+
+			require.Equal(t, testcase.want, activity)
+		})
+	}
+}
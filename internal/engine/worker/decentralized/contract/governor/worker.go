@@ -0,0 +1,373 @@
+package governor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/engine"
+	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/internal/utils"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+	"github.com/rss3-network/node/provider/ethereum/contract/governor"
+	metadatax "github.com/rss3-network/node/schema/metadata"
+	"github.com/rss3-network/node/schema/worker/decentralized"
+	"github.com/rss3-network/protocol-go/schema"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// defaultVoteDecimals is the decimals of the voting tokens of unknown governors, which are usually ERC20Votes tokens.
+const defaultVoteDecimals = uint8(18)
+
+var _ engine.Worker = (*worker)(nil)
+
+type worker struct {
+	config            *config.Module
+	governorFilterer  *governor.GovernorFilterer
+	governorAddresses []common.Address
+	daos              map[common.Address]governor.DAO
+}
+
+// Option is the parameters of the worker.
+type Option struct {
+	// Governors is the allow-list of the governors on the network, the worker matches all governors if it is empty.
+	Governors []Governor `json:"governors" mapstructure:"governors"`
+}
+
+// Governor is a governor contract with the name of its DAO.
+type Governor struct {
+	Address common.Address `json:"address" mapstructure:"address"`
+	Name    string         `json:"name" mapstructure:"name"`
+	// Decimals is the decimals of the voting token, which is 0 for NFTs.
+	Decimals *uint8 `json:"decimals" mapstructure:"decimals"`
+}
+
+func (w *worker) Name() string {
+	return decentralized.Governor.String()
+}
+
+func (w *worker) Platform() string {
+	return decentralized.PlatformGovernor.String()
+}
+
+func (w *worker) Network() []network.Network {
+	return []network.Network{
+		network.Arbitrum,
+		network.Avalanche,
+		network.Base,
+		network.BinanceSmartChain,
+		network.Ethereum,
+		network.Gnosis,
+		network.Optimism,
+		network.Polygon,
+	}
+}
+
+func (w *worker) Tags() []tag.Tag {
+	return []tag.Tag{
+		tag.Governance,
+	}
+}
+
+func (w *worker) Types() []schema.Type {
+	return []schema.Type{
+		typex.GovernanceProposal,
+		typex.GovernanceVote,
+	}
+}
+
+// Filter returns a filter of the events of governors, the addresses are only set if the allow-list is configured.
+func (w *worker) Filter() engine.DataSourceFilter {
+	return &source.Filter{
+		LogAddresses: w.governorAddresses,
+		LogTopics: []common.Hash{
+			governor.EventProposalCreated,
+			governor.EventProposalQueued,
+			governor.EventProposalExecuted,
+			governor.EventVoteCast,
+			governor.EventVoteCastWithParams,
+		},
+	}
+}
+
+func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	ethereumTask, ok := task.(*source.Task)
+	if !ok {
+		return nil, fmt.Errorf("invalid task type: %T", task)
+	}
+
+	activity, err := ethereumTask.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
+	if err != nil {
+		return nil, fmt.Errorf("build activity: %w", err)
+	}
+
+	for _, log := range ethereumTask.Receipt.Logs {
+		if len(log.Topics) == 0 || !w.matchGovernor(log) {
+			continue
+		}
+
+		var (
+			actions []*activityx.Action
+			err     error
+		)
+
+		switch {
+		case w.matchProposalCreated(log):
+			actions, err = w.handleProposalCreated(ctx, log)
+			activity.Type = typex.GovernanceProposal
+		case w.matchProposalQueued(log):
+			actions, err = w.handleProposalQueued(ctx, ethereumTask, log)
+			activity.Type = typex.GovernanceProposal
+		case w.matchProposalExecuted(log):
+			actions, err = w.handleProposalExecuted(ctx, ethereumTask, log)
+			activity.Type = typex.GovernanceProposal
+		case w.matchVoteCast(log):
+			actions, err = w.handleVoteCast(ctx, log)
+			activity.Type = typex.GovernanceVote
+		case w.matchVoteCastWithParams(log):
+			actions, err = w.handleVoteCastWithParams(ctx, log)
+			activity.Type = typex.GovernanceVote
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		activity.Actions = append(activity.Actions, actions...)
+	}
+
+	if len(activity.Actions) == 0 {
+		zap.L().Debug("no actions generated for task", zap.String("task_id", task.ID()))
+
+		return nil, nil
+	}
+
+	return activity, nil
+}
+
+// matchGovernor reports whether the log is emitted by a governor of the allow-list, or by any contract if the allow-list is empty.
+// The filter of the data source is shared with other workers, so the allow-list is checked again.
+func (w *worker) matchGovernor(log *ethereum.Log) bool {
+	return len(w.governorAddresses) == 0 || contract.MatchAddresses(log.Address, w.governorAddresses...)
+}
+
+// The events are matched by the number of topics as well, as other contracts may emit events of the same signatures with indexed arguments.
+func (w *worker) matchProposalCreated(log *ethereum.Log) bool {
+	return len(log.Topics) == 1 && contract.MatchEventHashes(log.Topics[0], governor.EventProposalCreated)
+}
+
+func (w *worker) matchProposalQueued(log *ethereum.Log) bool {
+	return len(log.Topics) == 1 && contract.MatchEventHashes(log.Topics[0], governor.EventProposalQueued)
+}
+
+func (w *worker) matchProposalExecuted(log *ethereum.Log) bool {
+	return len(log.Topics) == 1 && contract.MatchEventHashes(log.Topics[0], governor.EventProposalExecuted)
+}
+
+func (w *worker) matchVoteCast(log *ethereum.Log) bool {
+	return len(log.Topics) == 2 && contract.MatchEventHashes(log.Topics[0], governor.EventVoteCast)
+}
+
+func (w *worker) matchVoteCastWithParams(log *ethereum.Log) bool {
+	return len(log.Topics) == 2 && contract.MatchEventHashes(log.Topics[0], governor.EventVoteCastWithParams)
+}
+
+func (w *worker) handleProposalCreated(_ context.Context, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.governorFilterer.ParseProposalCreated(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse proposal created event: %w", err)
+	}
+
+	action := w.buildGovernanceProposalAction(event.Proposer, log.Address, metadata.GovernanceProposal{
+		ID:   event.ProposalId.String(),
+		Body: event.Description,
+		Options: []string{
+			metadata.ActionGovernanceVoteFor.String(),
+			metadata.ActionGovernanceVoteAgainst.String(),
+			metadata.ActionGovernanceVoteAbstain.String(),
+		},
+		StartBlock: event.VoteStart.String(),
+		EndBlock:   event.VoteEnd.String(),
+	})
+
+	return []*activityx.Action{action}, nil
+}
+
+// handleProposalQueued returns a proposal action of the proposal queued by the sender of the transaction.
+func (w *worker) handleProposalQueued(_ context.Context, task *source.Task, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.governorFilterer.ParseProposalQueued(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse proposal queued event: %w", err)
+	}
+
+	action := w.buildGovernanceProposalAction(task.Transaction.From, log.Address, metadata.GovernanceProposal{
+		ID: event.ProposalId.String(),
+	})
+
+	return []*activityx.Action{action}, nil
+}
+
+// handleProposalExecuted returns a proposal action of the proposal executed by the sender of the transaction.
+func (w *worker) handleProposalExecuted(_ context.Context, task *source.Task, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.governorFilterer.ParseProposalExecuted(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse proposal executed event: %w", err)
+	}
+
+	action := w.buildGovernanceProposalAction(task.Transaction.From, log.Address, metadata.GovernanceProposal{
+		ID: event.ProposalId.String(),
+	})
+
+	return []*activityx.Action{action}, nil
+}
+
+func (w *worker) handleVoteCast(_ context.Context, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.governorFilterer.ParseVoteCast(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse vote cast event: %w", err)
+	}
+
+	action, err := w.buildGovernanceVoteAction(event.Voter, log.Address, event.ProposalId, event.Weight, event.Support, event.Reason)
+	if err != nil {
+		return nil, fmt.Errorf("build governance vote action: %w", err)
+	}
+
+	return []*activityx.Action{action}, nil
+}
+
+func (w *worker) handleVoteCastWithParams(_ context.Context, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.governorFilterer.ParseVoteCastWithParams(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse vote cast with params event: %w", err)
+	}
+
+	action, err := w.buildGovernanceVoteAction(event.Voter, log.Address, event.ProposalId, event.Weight, event.Support, event.Reason)
+	if err != nil {
+		return nil, fmt.Errorf("build governance vote action: %w", err)
+	}
+
+	return []*activityx.Action{action}, nil
+}
+
+func (w *worker) buildGovernanceProposalAction(from, governorAddress common.Address, proposal metadata.GovernanceProposal) *activityx.Action {
+	return &activityx.Action{
+		Type:     typex.GovernanceProposal,
+		Platform: w.Platform(),
+		From:     from.String(),
+		To:       governorAddress.String(),
+		Metadata: metadatax.GovernanceProposal{
+			GovernanceProposal: proposal,
+			DAO:                w.daoName(governorAddress),
+		},
+	}
+}
+
+func (w *worker) buildGovernanceVoteAction(voter, governorAddress common.Address, proposalID, weight *big.Int, support uint8, reason string) (*activityx.Action, error) {
+	// The support of the simple counting of Governor and GovernorBravo.
+	var voteAction metadata.GovernanceVoteAction
+
+	switch support {
+	case 0:
+		voteAction = metadata.ActionGovernanceVoteAgainst
+	case 1:
+		voteAction = metadata.ActionGovernanceVoteFor
+	case 2:
+		voteAction = metadata.ActionGovernanceVoteAbstain
+	default:
+		return nil, fmt.Errorf("invalid support value: %d", support)
+	}
+
+	voteWeight := decimal.NewFromBigInt(utils.GetBigInt(weight), -int32(w.voteDecimals(governorAddress)))
+
+	return &activityx.Action{
+		Type:     typex.GovernanceVote,
+		Platform: w.Platform(),
+		From:     voter.String(),
+		To:       governorAddress.String(),
+		Metadata: metadatax.GovernanceVote{
+			GovernanceVote: metadata.GovernanceVote{
+				Action: voteAction,
+				Count:  uint64(voteWeight.IntPart()),
+				Reason: reason,
+				Proposal: metadata.GovernanceProposal{
+					ID: proposalID.String(),
+				},
+			},
+			DAO:    w.daoName(governorAddress),
+			Weight: voteWeight,
+		},
+	}, nil
+}
+
+// daoName returns the name of the DAO of the governor, or an empty string if the governor is unknown.
+func (w *worker) daoName(governorAddress common.Address) string {
+	return w.daos[governorAddress].Name
+}
+
+// voteDecimals returns the decimals of the voting token of the governor, which scale the weights of the votes.
+func (w *worker) voteDecimals(governorAddress common.Address) uint8 {
+	if dao, exists := w.daos[governorAddress]; exists {
+		return dao.VoteDecimals
+	}
+
+	return defaultVoteDecimals
+}
+
+// NewWorker creates a new Governor worker.
+func NewWorker(config *config.Module) (engine.Worker, error) {
+	var instance = worker{
+		config: config,
+		daos:   make(map[common.Address]governor.DAO),
+	}
+
+	var option Option
+
+	if config.Parameters != nil {
+		if err := config.Parameters.Decode(&option); err != nil {
+			return nil, fmt.Errorf("parse config: %w", err)
+		}
+	}
+
+	// The known DAOs are set by default, which are overridden by the allow-list.
+	for address, dao := range governor.DAOs[config.Network] {
+		instance.daos[address] = dao
+	}
+
+	for _, item := range option.Governors {
+		instance.governorAddresses = append(instance.governorAddresses, item.Address)
+
+		dao, exists := instance.daos[item.Address]
+		if !exists {
+			dao.VoteDecimals = defaultVoteDecimals
+		}
+
+		if item.Name != "" {
+			dao.Name = item.Name
+		}
+
+		if item.Decimals != nil {
+			dao.VoteDecimals = *item.Decimals
+		}
+
+		instance.daos[item.Address] = dao
+	}
+
+	instance.governorAddresses = lo.Uniq(instance.governorAddresses)
+
+	// Initialize contract filterers.
+	instance.governorFilterer = lo.Must(governor.NewGovernorFilterer(ethereum.AddressGenesis, nil))
+
+	return &instance, nil
+}
@@ -0,0 +1,754 @@
+package governor_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rss3-network/node/config"
+	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	worker "github.com/rss3-network/node/internal/engine/worker/decentralized/contract/governor"
+	"github.com/rss3-network/node/provider/ethereum"
+	metadatax "github.com/rss3-network/node/schema/metadata"
+	workerx "github.com/rss3-network/node/schema/worker/decentralized"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorker_Ethereum(t *testing.T) {
+	t.Parallel()
+
+	type arguments struct {
+		task   *source.Task
+		config *config.Module
+	}
+
+	testcases := []struct {
+		name      string
+		arguments arguments
+		want      *activityx.Activity
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name: "Uniswap Proposal Created",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:         common.HexToHash("0x2134d2d377dbede28678cac9e2656075f7274fa37c3e6fcb8b05445d21b6c994"),
+						ParentHash:   common.HexToHash("0x8c738d9cf73fe7f5f927ffc106dfab5d075c40c07807c51a5f216b58f2e0939c"),
+						UncleHash:    common.HexToHash("0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"),
+						Coinbase:     common.HexToAddress("0x95222290DD7278Aa3Ddd389Cc1E1d165CC4BAfe5"),
+						Number:       lo.Must(new(big.Int).SetString("20499145", 0)),
+						GasLimit:     30000000,
+						GasUsed:      15432101,
+						Timestamp:    1723040003,
+						BaseFee:      lo.Must(new(big.Int).SetString("2012345678", 0)),
+						Transactions: nil,
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0x2134d2d377dbede28678cac9e2656075f7274fa37c3e6fcb8b05445d21b6c994"),
+						From:      common.HexToAddress("0x8E4ED221fa034245F14205f781E0b13C5bd6a42E"),
+						Gas:       618517,
+						GasPrice:  lo.Must(new(big.Int).SetString("3012345678", 10)),
+						Hash:      common.HexToHash("0xca75c98c070e44a58131d962bf40334518a705d19c432d0fc70242ecd800ea54"),
+						Input:     hexutil.MustDecode("0xda95691a0000000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(common.HexToAddress("0x408ED6354d4973f66138C91495F2f2FCbd8724C3")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0x2134d2d377dbede28678cac9e2656075f7274fa37c3e6fcb8b05445d21b6c994"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20499145", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 8123456,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0xb38cbf4e"),
+						GasUsed:           412345,
+
+						Logs: []*ethereum.Log{{
+							Address: common.HexToAddress("0x408ED6354d4973f66138C91495F2f2FCbd8724C3"),
+							Topics: []common.Hash{
+								common.HexToHash("0x7d84a6263ae0d98d3329bd7b46bb4e8d6f98cd35a7adb45c274c8b7fd5ebd5e0"),
+							},
+							Data:            hexutil.MustDecode("0x00000000000000000000000000000000000000000000000000000000000000420000000000000000000000008e4ed221fa034245f14205f781e0b13c5bd6a42e0000000000000000000000000000000000000000000000000000000000000120000000000000000000000000000000000000000000000000000000000000016000000000000000000000000000000000000000000000000000000000000001a00000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000138fe590000000000000000000000000000000000000000000000000000000001399bd900000000000000000000000000000000000000000000000000000000000002c000000000000000000000000000000000000000000000000000000000000000010000000000000000000000001a9c8182c09f50c8318d769245bea52c32be35bc00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000044a9059cbb000000000000000000000000e571dc7a558bb6d68ffe264c3d7bb98b0c6c73fc00000000000000000000000000000000000000000000a968163f0a57b4000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000065232046756e642074686520556e697377617020466f756e646174696f6e0a0a5472616e73666572203830302c30303020554e4920746f2074686520556e697377617020466f756e646174696f6e20666f7220746865206772616e7473206f6620323032352e000000000000000000000000000000000000000000000000000000"),
+							BlockNumber:     lo.Must(new(big.Int).SetString("20499145", 0)),
+							TransactionHash: common.HexToHash("0xca75c98c070e44a58131d962bf40334518a705d19c432d0fc70242ecd800ea54"),
+							Index:           100,
+							Removed:         false,
+						}},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0xca75c98c070e44a58131d962bf40334518a705d19c432d0fc70242ecd800ea54"),
+						TransactionIndex: 42,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0xca75c98c070e44a58131d962bf40334518a705d19c432d0fc70242ecd800ea54",
+				Network: network.Ethereum,
+				Index:   42,
+				From:    "0x8E4ED221fa034245F14205f781E0b13C5bd6a42E",
+				To:      "0x408ED6354d4973f66138C91495F2f2FCbd8724C3",
+				Type:    typex.GovernanceProposal,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xda95691a",
+				},
+				Platform: workerx.PlatformGovernor.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("1242125678594910")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.GovernanceProposal,
+						Platform: workerx.PlatformGovernor.String(),
+						From:     "0x8E4ED221fa034245F14205f781E0b13C5bd6a42E",
+						To:       "0x408ED6354d4973f66138C91495F2f2FCbd8724C3",
+						Metadata: metadatax.GovernanceProposal{
+							GovernanceProposal: metadata.GovernanceProposal{
+								ID:   "66",
+								Body: "# Fund the Uniswap Foundation\n\nTransfer 800,000 UNI to the Uniswap Foundation for the grants of 2025.",
+								Options: []string{
+									metadata.ActionGovernanceVoteFor.String(),
+									metadata.ActionGovernanceVoteAgainst.String(),
+									metadata.ActionGovernanceVoteAbstain.String(),
+								},
+								StartBlock: "20512345",
+								EndBlock:   "20552665",
+							},
+							DAO: "Uniswap",
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1723040003,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Uniswap Vote Cast With Reason",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:         common.HexToHash("0x71619b05fd2f13ce22028edc8142ccbf6b2596588ff27750228a754845b0f222"),
+						ParentHash:   common.HexToHash("0x3b4268b72492525e768f692bdfbefbc9430e49dfe4fa72b239987a60ed2e73d4"),
+						UncleHash:    common.HexToHash("0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"),
+						Coinbase:     common.HexToAddress("0x95222290DD7278Aa3Ddd389Cc1E1d165CC4BAfe5"),
+						Number:       lo.Must(new(big.Int).SetString("20513001", 0)),
+						GasLimit:     30000000,
+						GasUsed:      15432101,
+						Timestamp:    1723208555,
+						BaseFee:      lo.Must(new(big.Int).SetString("1412345678", 0)),
+						Transactions: nil,
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0x71619b05fd2f13ce22028edc8142ccbf6b2596588ff27750228a754845b0f222"),
+						From:      common.HexToAddress("0x683a4F9915D6216f73d6Df50151725036bD26C02"),
+						Gas:       148147,
+						GasPrice:  lo.Must(new(big.Int).SetString("2412345678", 10)),
+						Hash:      common.HexToHash("0x2aeb522bcb5e585220a9aa061d30488b5fcf88570816a99898922894c0b7bded"),
+						Input:     hexutil.MustDecode("0x7b3c71d30000000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(common.HexToAddress("0x408ED6354d4973f66138C91495F2f2FCbd8724C3")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0x71619b05fd2f13ce22028edc8142ccbf6b2596588ff27750228a754845b0f222"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20513001", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 8123456,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x8fc9794e"),
+						GasUsed:           98765,
+
+						Logs: []*ethereum.Log{{
+							Address: common.HexToAddress("0x408ED6354d4973f66138C91495F2f2FCbd8724C3"),
+							Topics: []common.Hash{
+								common.HexToHash("0xb8e138887d0aa13bab447e82de9d5c1777041ecd21ca36ba824ff1e6c07ddda4"),
+								common.HexToHash("0x000000000000000000000000683a4f9915d6216f73d6df50151725036bd26c02"),
+							},
+							Data:            hexutil.MustDecode("0x00000000000000000000000000000000000000000000000000000000000000420000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000021165473b9b9db8b0f34e000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000185468652062756467657420697320746f6f206c617267652e0000000000000000"),
+							BlockNumber:     lo.Must(new(big.Int).SetString("20513001", 0)),
+							TransactionHash: common.HexToHash("0x2aeb522bcb5e585220a9aa061d30488b5fcf88570816a99898922894c0b7bded"),
+							Index:           100,
+							Removed:         false,
+						}},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x2aeb522bcb5e585220a9aa061d30488b5fcf88570816a99898922894c0b7bded"),
+						TransactionIndex: 42,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x2aeb522bcb5e585220a9aa061d30488b5fcf88570816a99898922894c0b7bded",
+				Network: network.Ethereum,
+				Index:   42,
+				From:    "0x683a4F9915D6216f73d6Df50151725036bD26C02",
+				To:      "0x408ED6354d4973f66138C91495F2f2FCbd8724C3",
+				Type:    typex.GovernanceVote,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x7b3c71d3",
+				},
+				Platform: workerx.PlatformGovernor.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("238255320887670")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.GovernanceVote,
+						Platform: workerx.PlatformGovernor.String(),
+						From:     "0x683a4F9915D6216f73d6Df50151725036bD26C02",
+						To:       "0x408ED6354d4973f66138C91495F2f2FCbd8724C3",
+						Metadata: metadatax.GovernanceVote{
+							GovernanceVote: metadata.GovernanceVote{
+								Action: metadata.ActionGovernanceVoteAgainst,
+								Count:  2500000,
+								Reason: "The budget is too large.",
+								Proposal: metadata.GovernanceProposal{
+									ID: "66",
+								},
+							},
+							DAO:    "Uniswap",
+							Weight: lo.Must(decimal.NewFromString("2500000.123456789012345678")),
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1723208555,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Arbitrum Vote Cast With Params",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Arbitrum,
+					ChainID: 42161,
+					Header: &ethereum.Header{
+						Hash:         common.HexToHash("0xe405fc1324e6653c45125103ca28815b8e3eeb79e021dfda68080e4857e55f62"),
+						ParentHash:   common.HexToHash("0x24846e38971e5ae9d13d7800b08eaa66a2b76146c8a6b5c29ef80c1b3ed02c13"),
+						UncleHash:    common.HexToHash("0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"),
+						Coinbase:     common.HexToAddress("0x95222290DD7278Aa3Ddd389Cc1E1d165CC4BAfe5"),
+						Number:       lo.Must(new(big.Int).SetString("273456789", 0)),
+						GasLimit:     30000000,
+						GasUsed:      15432101,
+						Timestamp:    1731234567,
+						BaseFee:      lo.Must(new(big.Int).SetString("10000000", 0)),
+						Transactions: nil,
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xe405fc1324e6653c45125103ca28815b8e3eeb79e021dfda68080e4857e55f62"),
+						From:      common.HexToAddress("0xF4B0556B9B6F53E00A1FDD2b0478Ce841991D8fA"),
+						Gas:       281481,
+						GasPrice:  lo.Must(new(big.Int).SetString("10000000", 10)),
+						Hash:      common.HexToHash("0xc430e76dac7de98c1d96b551ac1f1fd85e4ed37f0264911b1bea8ab72600dc02"),
+						Input:     hexutil.MustDecode("0x5f398a140000000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(common.HexToAddress("0xf07DeD9dC292157749B6Fd268E37DF6EA38395B9")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("42161", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xe405fc1324e6653c45125103ca28815b8e3eeb79e021dfda68080e4857e55f62"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("273456789", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 8123456,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x989680"),
+						GasUsed:           187654,
+
+						Logs: []*ethereum.Log{{
+							Address: common.HexToAddress("0xf07DeD9dC292157749B6Fd268E37DF6EA38395B9"),
+							Topics: []common.Hash{
+								common.HexToHash("0xe2babfbac5889a709b63bb7f598b324e08bc5a4fb9ec647fb3cbc9ec07eb8712"),
+								common.HexToHash("0x000000000000000000000000f4b0556b9b6f53e00a1fdd2b0478ce841991d8fa"),
+							},
+							Data:            hexutil.MustDecode("0xaa58bcc5bae8f52eec9fbf2e8d5bf900efa8a6faf42163b68decb61883e850d80000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000043c33c19375648000000000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010100000000000000000000000000000000000000000000000000000000000000"),
+							BlockNumber:     lo.Must(new(big.Int).SetString("273456789", 0)),
+							TransactionHash: common.HexToHash("0xc430e76dac7de98c1d96b551ac1f1fd85e4ed37f0264911b1bea8ab72600dc02"),
+							Index:           100,
+							Removed:         false,
+						}},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0xc430e76dac7de98c1d96b551ac1f1fd85e4ed37f0264911b1bea8ab72600dc02"),
+						TransactionIndex: 42,
+					},
+				},
+				config: &config.Module{
+					Network: network.Arbitrum,
+					Parameters: &config.Parameters{
+						"governors": []map[string]any{
+							{
+								"address": "0xf07DeD9dC292157749B6Fd268E37DF6EA38395B9",
+								"name":    "Arbitrum DAO",
+							},
+						},
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0xc430e76dac7de98c1d96b551ac1f1fd85e4ed37f0264911b1bea8ab72600dc02",
+				Network: network.Arbitrum,
+				Index:   42,
+				From:    "0xF4B0556B9B6F53E00A1FDD2b0478Ce841991D8fA",
+				To:      "0xf07DeD9dC292157749B6Fd268E37DF6EA38395B9",
+				Type:    typex.GovernanceVote,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x5f398a14",
+				},
+				Platform: workerx.PlatformGovernor.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("1876540000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.GovernanceVote,
+						Platform: workerx.PlatformGovernor.String(),
+						From:     "0xF4B0556B9B6F53E00A1FDD2b0478Ce841991D8fA",
+						To:       "0xf07DeD9dC292157749B6Fd268E37DF6EA38395B9",
+						Metadata: metadatax.GovernanceVote{
+							GovernanceVote: metadata.GovernanceVote{
+								Action: metadata.ActionGovernanceVoteAbstain,
+								Count:  1250,
+								Proposal: metadata.GovernanceProposal{
+									ID: "77049969659962393408182308518930939247285848107346513112985531885924337078488",
+								},
+							},
+							DAO:    "Arbitrum DAO",
+							Weight: lo.Must(decimal.NewFromString("1250.000000000000000000")),
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1731234567,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Compound Proposal Queued",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:         common.HexToHash("0xe71ceb245427aaed7b54e0d869c09d7dd9072646cd5e5953bc6fe40039aa54fc"),
+						ParentHash:   common.HexToHash("0x37ecec18fa35ee22ee763d6030df82935712a354a95c79ff9cb896cbef82c0e2"),
+						UncleHash:    common.HexToHash("0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"),
+						Coinbase:     common.HexToAddress("0x95222290DD7278Aa3Ddd389Cc1E1d165CC4BAfe5"),
+						Number:       lo.Must(new(big.Int).SetString("21150012", 0)),
+						GasLimit:     30000000,
+						GasUsed:      15432101,
+						Timestamp:    1731405611,
+						BaseFee:      lo.Must(new(big.Int).SetString("8876543210", 0)),
+						Transactions: nil,
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xe71ceb245427aaed7b54e0d869c09d7dd9072646cd5e5953bc6fe40039aa54fc"),
+						From:      common.HexToAddress("0x7B3c54e17d618CC94daDFe7671c1e2F50C4Ecc33"),
+						Gas:       214815,
+						GasPrice:  lo.Must(new(big.Int).SetString("9876543210", 10)),
+						Hash:      common.HexToHash("0x92428666dfe3838747dbf6c443682cb0c6b2021d6eb785fcc6d29d8076dbc3c7"),
+						Input:     hexutil.MustDecode("0xddf0b0090000000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(common.HexToAddress("0xc0Da02939E1441F497fd74F78cE7Decb17B66529")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xe71ceb245427aaed7b54e0d869c09d7dd9072646cd5e5953bc6fe40039aa54fc"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("21150012", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 8123456,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x24cb016ea"),
+						GasUsed:           143210,
+
+						Logs: []*ethereum.Log{{
+							Address: common.HexToAddress("0xc0Da02939E1441F497fd74F78cE7Decb17B66529"),
+							Topics: []common.Hash{
+								common.HexToHash("0x9a2e42fd6722813d69113e7d0079d3d940171428df7373df9c7f7617cfda2892"),
+							},
+							Data:            hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000149000000000000000000000000000000000000000000000000000000006735ca20"),
+							BlockNumber:     lo.Must(new(big.Int).SetString("21150012", 0)),
+							TransactionHash: common.HexToHash("0x92428666dfe3838747dbf6c443682cb0c6b2021d6eb785fcc6d29d8076dbc3c7"),
+							Index:           100,
+							Removed:         false,
+						}},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x92428666dfe3838747dbf6c443682cb0c6b2021d6eb785fcc6d29d8076dbc3c7"),
+						TransactionIndex: 42,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x92428666dfe3838747dbf6c443682cb0c6b2021d6eb785fcc6d29d8076dbc3c7",
+				Network: network.Ethereum,
+				Index:   42,
+				From:    "0x7B3c54e17d618CC94daDFe7671c1e2F50C4Ecc33",
+				To:      "0xc0Da02939E1441F497fd74F78cE7Decb17B66529",
+				Type:    typex.GovernanceProposal,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xddf0b009",
+				},
+				Platform: workerx.PlatformGovernor.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("1414419753104100")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.GovernanceProposal,
+						Platform: workerx.PlatformGovernor.String(),
+						From:     "0x7B3c54e17d618CC94daDFe7671c1e2F50C4Ecc33",
+						To:       "0xc0Da02939E1441F497fd74F78cE7Decb17B66529",
+						Metadata: metadatax.GovernanceProposal{
+							GovernanceProposal: metadata.GovernanceProposal{
+								ID: "329",
+							},
+							DAO: "Compound",
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1731405611,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Compound Proposal Executed",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:         common.HexToHash("0x511522a195a73d5a57d1142edc7fdfc17716d5feeb82555e8f7dc3d288aa6e93"),
+						ParentHash:   common.HexToHash("0x1e3ac66a9b97809c299ca6d548df45891d4305d4209c6e6e685a0a509dc5f982"),
+						UncleHash:    common.HexToHash("0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"),
+						Coinbase:     common.HexToAddress("0x95222290DD7278Aa3Ddd389Cc1E1d165CC4BAfe5"),
+						Number:       lo.Must(new(big.Int).SetString("21164412", 0)),
+						GasLimit:     30000000,
+						GasUsed:      15432101,
+						Timestamp:    1731578411,
+						BaseFee:      lo.Must(new(big.Int).SetString("7765432109", 0)),
+						Transactions: nil,
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0x511522a195a73d5a57d1142edc7fdfc17716d5feeb82555e8f7dc3d288aa6e93"),
+						From:      common.HexToAddress("0x7B3c54e17d618CC94daDFe7671c1e2F50C4Ecc33"),
+						Gas:       431481,
+						GasPrice:  lo.Must(new(big.Int).SetString("8765432109", 10)),
+						Hash:      common.HexToHash("0x820011740718e3cdb6335a9ae9059d11fbab23c9c611eb35a91b3dc14285319a"),
+						Input:     hexutil.MustDecode("0xfe0d94c10000000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(common.HexToAddress("0xc0Da02939E1441F497fd74F78cE7Decb17B66529")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0x511522a195a73d5a57d1142edc7fdfc17716d5feeb82555e8f7dc3d288aa6e93"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("21164412", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 8123456,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x20a75e12d"),
+						GasUsed:           287654,
+
+						Logs: []*ethereum.Log{{
+							Address: common.HexToAddress("0xc00e94Cb662C3520282E6f5717214004A7f26888"),
+							Topics: []common.Hash{
+								common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+								common.HexToHash("0x0000000000000000000000006d903f6003cca6255d85cca4d3b5e5146dc33925"),
+								common.HexToHash("0x000000000000000000000000e571dc7a558bb6d68ffe264c3d7bb98b0c6c73fc"),
+							},
+							Data:            hexutil.MustDecode("0x00000000000000000000000000000000000000000000a968163f0a57b4000000"),
+							BlockNumber:     lo.Must(new(big.Int).SetString("21164412", 0)),
+							TransactionHash: common.HexToHash("0x820011740718e3cdb6335a9ae9059d11fbab23c9c611eb35a91b3dc14285319a"),
+							Index:           100,
+							Removed:         false,
+						}, {
+							Address: common.HexToAddress("0xc0Da02939E1441F497fd74F78cE7Decb17B66529"),
+							Topics: []common.Hash{
+								common.HexToHash("0x712ae1383f79ac853f8d882153778e0260ef8f03b504e2866e0593e04d2b291f"),
+							},
+							Data:            hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000149"),
+							BlockNumber:     lo.Must(new(big.Int).SetString("21164412", 0)),
+							TransactionHash: common.HexToHash("0x820011740718e3cdb6335a9ae9059d11fbab23c9c611eb35a91b3dc14285319a"),
+							Index:           101,
+							Removed:         false,
+						}},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x820011740718e3cdb6335a9ae9059d11fbab23c9c611eb35a91b3dc14285319a"),
+						TransactionIndex: 42,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x820011740718e3cdb6335a9ae9059d11fbab23c9c611eb35a91b3dc14285319a",
+				Network: network.Ethereum,
+				Index:   42,
+				From:    "0x7B3c54e17d618CC94daDFe7671c1e2F50C4Ecc33",
+				To:      "0xc0Da02939E1441F497fd74F78cE7Decb17B66529",
+				Type:    typex.GovernanceProposal,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xfe0d94c1",
+				},
+				Platform: workerx.PlatformGovernor.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("2521411607882286")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.GovernanceProposal,
+						Platform: workerx.PlatformGovernor.String(),
+						From:     "0x7B3c54e17d618CC94daDFe7671c1e2F50C4Ecc33",
+						To:       "0xc0Da02939E1441F497fd74F78cE7Decb17B66529",
+						Metadata: metadatax.GovernanceProposal{
+							GovernanceProposal: metadata.GovernanceProposal{
+								ID: "329",
+							},
+							DAO: "Compound",
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1731578411,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Vote Cast Outside The Allow-List",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:         common.HexToHash("0x108de419fc4928a9de9178f6b9e33a036dce74e901906c586bf0dc77e2e94918"),
+						ParentHash:   common.HexToHash("0x2a1fbc7445bbee6ff5be629b3de206fcfe115a4cd6c9b918bb690a61a636a036"),
+						UncleHash:    common.HexToHash("0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"),
+						Coinbase:     common.HexToAddress("0x95222290DD7278Aa3Ddd389Cc1E1d165CC4BAfe5"),
+						Number:       lo.Must(new(big.Int).SetString("20513001", 0)),
+						GasLimit:     30000000,
+						GasUsed:      15432101,
+						Timestamp:    1723208555,
+						BaseFee:      lo.Must(new(big.Int).SetString("1412345678", 0)),
+						Transactions: nil,
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0x108de419fc4928a9de9178f6b9e33a036dce74e901906c586bf0dc77e2e94918"),
+						From:      common.HexToAddress("0x683a4F9915D6216f73d6Df50151725036bD26C02"),
+						Gas:       148147,
+						GasPrice:  lo.Must(new(big.Int).SetString("2412345678", 10)),
+						Hash:      common.HexToHash("0x127721d32ee71542280345b7b27363343c4f968fe0b79e2decb3e8c9a5258f39"),
+						Input:     hexutil.MustDecode("0x7b3c71d30000000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(common.HexToAddress("0x408ED6354d4973f66138C91495F2f2FCbd8724C3")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0x108de419fc4928a9de9178f6b9e33a036dce74e901906c586bf0dc77e2e94918"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20513001", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 8123456,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x8fc9794e"),
+						GasUsed:           98765,
+
+						Logs: []*ethereum.Log{{
+							Address: common.HexToAddress("0x408ED6354d4973f66138C91495F2f2FCbd8724C3"),
+							Topics: []common.Hash{
+								common.HexToHash("0xb8e138887d0aa13bab447e82de9d5c1777041ecd21ca36ba824ff1e6c07ddda4"),
+								common.HexToHash("0x000000000000000000000000683a4f9915d6216f73d6df50151725036bd26c02"),
+							},
+							Data:            hexutil.MustDecode("0x00000000000000000000000000000000000000000000000000000000000000420000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000021165473b9b9db8b0f34e000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000185468652062756467657420697320746f6f206c617267652e0000000000000000"),
+							BlockNumber:     lo.Must(new(big.Int).SetString("20513001", 0)),
+							TransactionHash: common.HexToHash("0x127721d32ee71542280345b7b27363343c4f968fe0b79e2decb3e8c9a5258f39"),
+							Index:           100,
+							Removed:         false,
+						}},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x127721d32ee71542280345b7b27363343c4f968fe0b79e2decb3e8c9a5258f39"),
+						TransactionIndex: 42,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+					Parameters: &config.Parameters{
+						"governors": []map[string]any{
+							{
+								"address": "0x323A76393544d5ecca80cd6ef2A560C6a395b7E3",
+							},
+						},
+					},
+				},
+			},
+			want:      nil,
+			wantError: require.NoError,
+		},
+		{
+			name: "Nouns Vote Cast",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:         common.HexToHash("0x0ecb37379b269b0d0f76495e115dd1a7be2bc5fd1ab2a925789a52b967f2ba44"),
+						ParentHash:   common.HexToHash("0x5c2bfa694d9f38186f1bd19ab757ca29410af46422866b49eb531a863da6ffc2"),
+						UncleHash:    common.HexToHash("0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"),
+						Coinbase:     common.HexToAddress("0x95222290DD7278Aa3Ddd389Cc1E1d165CC4BAfe5"),
+						Number:       lo.Must(new(big.Int).SetString("20676677", 0)),
+						GasLimit:     30000000,
+						GasUsed:      21256147,
+						Timestamp:    1725446375,
+						BaseFee:      lo.Must(new(big.Int).SetString("2033766393", 0)),
+						Transactions: nil,
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xf38432040c7487c6eb59b78d124bb3e65cfc6359f0016fa20e193357800356b1"),
+						From:      common.HexToAddress("0x0a049e014999A489b3D7174B8f70D4200b0Ce79B"),
+						Gas:       158488,
+						GasPrice:  lo.Must(new(big.Int).SetString("2533766393", 10)),
+						Hash:      common.HexToHash("0xf38432040c7487c6eb59b78d124bb3e65cfc6359f0016fa20e193357800356b1"),
+						Input:     hexutil.MustDecode("0x8136730f000000000000000000000000000000000000000000000000000000000000026e00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000080000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000000c2466f723a2033207c20416761696e73743a2031207c204162737461696e3a20300a0a2b666f7220e2809420403837626f6e65730a0a2b666f7220e280942040726f7862790a0a2b666f7220416c736f20686f70696e6720746f2073656520736f6d6520636f6f6c20e28c90e297a82de297a820696e737069726564206675726e69747572652f696e66726120696e207468652067616c6c65727920e280942040776964656579656b61726c0a0a2b616761696e737420e28094204062697862697465000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(common.HexToAddress("0x6f3E6272A167e8AcCb32072d08E0957F9c79223d")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0x0ecb37379b269b0d0f76495e115dd1a7be2bc5fd1ab2a925789a52b967f2ba44"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20676677", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 10636723,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x970634f9"),
+						GasUsed:           109385,
+
+						Logs: []*ethereum.Log{{
+							Address: common.HexToAddress("0x6f3E6272A167e8AcCb32072d08E0957F9c79223d"),
+							Topics: []common.Hash{
+								common.HexToHash("0xb8e138887d0aa13bab447e82de9d5c1777041ecd21ca36ba824ff1e6c07ddda4"),
+								common.HexToHash("0x0000000000000000000000000a049e014999a489b3d7174b8f70d4200b0ce79b"),
+							},
+							Data:            hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000026e00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000c2466f723a2033207c20416761696e73743a2031207c204162737461696e3a20300a0a2b666f7220e2809420403837626f6e65730a0a2b666f7220e280942040726f7862790a0a2b666f7220416c736f20686f70696e6720746f2073656520736f6d6520636f6f6c20e28c90e297a82de297a820696e737069726564206675726e69747572652f696e66726120696e207468652067616c6c65727920e280942040776964656579656b61726c0a0a2b616761696e737420e28094204062697862697465000000000000000000000000000000000000000000000000000000000000"),
+							BlockNumber:     lo.Must(new(big.Int).SetString("20676677", 0)),
+							TransactionHash: common.HexToHash("0xf38432040c7487c6eb59b78d124bb3e65cfc6359f0016fa20e193357800356b1"),
+							Index:           285,
+							Removed:         false,
+						}, {
+							Address: common.HexToAddress("0x6f3E6272A167e8AcCb32072d08E0957F9c79223d"),
+							Topics: []common.Hash{
+								common.HexToHash("0x651cc9d78606507fdcfc4f37ec37a744d612b1d8f5a73564190577c4f0edb0b6"),
+								common.HexToHash("0x0000000000000000000000000a049e014999a489b3d7174b8f70d4200b0ce79b"),
+								common.HexToHash("0x000000000000000000000000000000000000000000000000000000000000026e"),
+								common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000006"),
+							},
+							Data:            nil,
+							BlockNumber:     lo.Must(new(big.Int).SetString("20676677", 0)),
+							TransactionHash: common.HexToHash("0xf38432040c7487c6eb59b78d124bb3e65cfc6359f0016fa20e193357800356b1"),
+							Index:           286,
+							Removed:         false,
+						}, {
+							Address: common.HexToAddress("0x6f3E6272A167e8AcCb32072d08E0957F9c79223d"),
+							Topics: []common.Hash{
+								common.HexToHash("0xfabef36fd46c4c3a6ad676521be5367a4dfdbf3faa68d8e826003b1752d68f4f"),
+								common.HexToHash("0x0000000000000000000000000a049e014999a489b3d7174b8f70d4200b0ce79b"),
+							},
+							Data:            hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000e99bd4de8d150000000000000000000000000000000000000000000000000000000000000001"),
+							BlockNumber:     lo.Must(new(big.Int).SetString("20676677", 0)),
+							TransactionHash: common.HexToHash("0xf38432040c7487c6eb59b78d124bb3e65cfc6359f0016fa20e193357800356b1"),
+							Index:           287,
+							Removed:         false,
+						}},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0xf38432040c7487c6eb59b78d124bb3e65cfc6359f0016fa20e193357800356b1"),
+						TransactionIndex: 101,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0xf38432040c7487c6eb59b78d124bb3e65cfc6359f0016fa20e193357800356b1",
+				Network: network.Ethereum,
+				Index:   101,
+				From:    "0x0a049e014999A489b3D7174B8f70D4200b0Ce79B",
+				To:      "0x6f3E6272A167e8AcCb32072d08E0957F9c79223d",
+				Type:    typex.GovernanceVote,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x8136730f",
+				},
+				Platform: workerx.PlatformGovernor.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("277156036898305")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.GovernanceVote,
+						Platform: workerx.PlatformGovernor.String(),
+						From:     "0x0a049e014999A489b3D7174B8f70D4200b0Ce79B",
+						To:       "0x6f3E6272A167e8AcCb32072d08E0957F9c79223d",
+						Metadata: metadatax.GovernanceVote{
+							GovernanceVote: metadata.GovernanceVote{
+								Action: metadata.ActionGovernanceVoteFor,
+								Count:  2,
+								Reason: "For: 3 | Against: 1 | Abstain: 0\n\n+for — @87bones\n\n+for — @roxby\n\n+for Also hoping to see some cool ⌐◨-◨ inspired furniture/infra in the gallery — @wideeyekarl\n\n+against — @bixbite",
+								Proposal: metadata.GovernanceProposal{
+									ID: "622",
+								},
+							},
+							DAO:    "Nouns",
+							Weight: lo.Must(decimal.NewFromString("2")),
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1725446375,
+			},
+			wantError: require.NoError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			instance, err := worker.NewWorker(testcase.arguments.config)
+			require.NoError(t, err)
+
+			activity, err := instance.Transform(ctx, testcase.arguments.task)
+			testcase.wantError(t, err)
+
+			t.Log(string(lo.Must(json.MarshalIndent(activity, "", "\x20\x20"))))
+
+			require.Equal(t, testcase.want, activity)
+		})
+	}
+}
@@ -7,8 +7,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/database"
 	"github.com/rss3-network/node/internal/engine"
 	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/bridge"
 	"github.com/rss3-network/node/internal/utils"
 	"github.com/rss3-network/node/provider/ethereum"
 	"github.com/rss3-network/node/provider/ethereum/contract"
@@ -26,8 +28,8 @@ import (
 	"go.uber.org/zap"
 )
 
-// make sure worker implements engine.Worker
-var _ engine.Worker = (*worker)(nil)
+// make sure worker implements engine.MutatingWorker
+var _ engine.MutatingWorker = (*worker)(nil)
 
 type worker struct {
 	lineaZKEVMV2Filter       *linea.ZKEVMV2Filterer
@@ -35,6 +37,7 @@ type worker struct {
 	lineaL1USDCBridgeFilter  *linea.L1USDCBridgeFilterer
 	ethereumClient           ethereum.Client
 	tokenClient              token.Client
+	bridgeCorrelator         *bridge.Correlator
 }
 
 func (w *worker) Name() string {
@@ -70,6 +73,8 @@ func (w *worker) Filter() engine.DataSourceFilter {
 		LogAddresses: []common.Address{
 			linea.AddressTokenBridge,
 			linea.AddressZKEVMV2,
+			linea.AddressL2TokenBridge,
+			linea.AddressL2MessageService,
 		},
 		LogTopics: []common.Hash{
 			linea.EventHashZKEVMV2MessageSent,
@@ -82,16 +87,23 @@ func (w *worker) Filter() engine.DataSourceFilter {
 
 // Transform linea task to activity.
 func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	activity, _, err := w.TransformMutations(ctx, task)
+
+	return activity, err
+}
+
+// TransformMutations transforms the task into the activity and the mutations recording the sides of its bridge transfers.
+func (w *worker) TransformMutations(ctx context.Context, task engine.Task) (*activityx.Activity, []*engine.Mutation, error) {
 	// Cast the task to a linea task.
 	ethereumTask, ok := task.(*source.Task)
 	if !ok {
-		return nil, fmt.Errorf("invalid task type: %T", task)
+		return nil, nil, fmt.Errorf("invalid task type: %T", task)
 	}
 
 	// Build the activity.
 	activity, err := task.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
 	if err != nil {
-		return nil, fmt.Errorf("build activity: %w", err)
+		return nil, nil, fmt.Errorf("build activity: %w", err)
 	}
 
 	for _, log := range ethereumTask.Receipt.Logs {
@@ -140,10 +152,11 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 	if len(activity.Actions) == 0 {
 		zap.L().Info("no actions generated for task", zap.String("task_id", task.ID()))
 
-		return nil, nil
+		return nil, nil, nil
 	}
 
-	return activity, nil
+	// The sides of the bridge transfers are recorded after the activity is saved.
+	return activity, w.bridgeCorrelator.Mutations(activity, w.buildBridgeKeys(*ethereumTask)...), nil
 }
 
 func (w *worker) matchEthereumZKEVMV2MessageSentLog(task source.Task, log *ethereum.Log) bool {
@@ -151,7 +164,7 @@ func (w *worker) matchEthereumZKEVMV2MessageSentLog(task source.Task, log *ether
 		return false
 	}
 
-	return contract.MatchAddresses(log.Address, linea.AddressZKEVMV2) && contract.MatchAddresses(*task.Transaction.To, linea.AddressZKEVMV2)
+	return contract.MatchAddresses(log.Address, linea.AddressZKEVMV2, linea.AddressL2MessageService) && task.Transaction.To != nil && contract.MatchAddresses(*task.Transaction.To, log.Address)
 }
 
 func (w *worker) matchEthereumZKEVMV2MessageClaimedLog(_ source.Task, log *ethereum.Log) bool {
@@ -159,7 +172,7 @@ func (w *worker) matchEthereumZKEVMV2MessageClaimedLog(_ source.Task, log *ether
 		return false
 	}
 
-	return contract.MatchAddresses(log.Address, linea.AddressZKEVMV2)
+	return contract.MatchAddresses(log.Address, linea.AddressZKEVMV2, linea.AddressL2MessageService)
 }
 
 func (w *worker) matchEthereumTokenBridgeBridgingInitiatedLog(_ source.Task, log *ethereum.Log) bool {
@@ -167,7 +180,7 @@ func (w *worker) matchEthereumTokenBridgeBridgingInitiatedLog(_ source.Task, log
 		return false
 	}
 
-	return contract.MatchAddresses(log.Address, linea.AddressTokenBridge)
+	return contract.MatchAddresses(log.Address, linea.AddressTokenBridge, linea.AddressL2TokenBridge)
 }
 
 func (w *worker) matchEthereumTokenBridgeBridgingFinalizedLog(_ source.Task, log *ethereum.Log) bool {
@@ -175,7 +188,7 @@ func (w *worker) matchEthereumTokenBridgeBridgingFinalizedLog(_ source.Task, log
 		return false
 	}
 
-	return contract.MatchAddresses(log.Address, linea.AddressTokenBridge)
+	return contract.MatchAddresses(log.Address, linea.AddressTokenBridge, linea.AddressL2TokenBridge)
 }
 func (w *worker) matchEthereumL1USDCBridgeDepositedLog(_ source.Task, log *ethereum.Log) bool {
 	if !contract.MatchEventHashes(log.Topics[0], linea.EventHashL1USDCBridgeDeposited) {
@@ -205,7 +218,9 @@ func (w *worker) handleEthereumZKEVMV2MessageSentLog(ctx context.Context, task s
 		return nil, nil
 	}
 
-	action, err := w.buildEthereumTransactionBridgeAction(ctx, task.ChainID, event.From, event.To, network.Ethereum, network.Linea, metadata.ActionTransactionBridgeDeposit, nil, event.Value, log.BlockNumber)
+	sourceNetwork, targetNetwork := w.buildNetworks(task, true)
+
+	action, err := w.buildEthereumTransactionBridgeAction(ctx, task.ChainID, event.From, event.To, sourceNetwork, targetNetwork, metadata.ActionTransactionBridgeDeposit, nil, event.Value, log.BlockNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -252,7 +267,9 @@ func (w *worker) handleEthereumZKEVMV2MessageClaimedLog(ctx context.Context, tas
 		return nil, nil
 	}
 
-	action, err := w.buildEthereumTransactionBridgeAction(ctx, task.ChainID, input.From, input.To, network.Linea, network.Ethereum, metadata.ActionTransactionBridgeWithdraw, nil, input.Value, log.BlockNumber)
+	sourceNetwork, targetNetwork := w.buildNetworks(task, false)
+
+	action, err := w.buildEthereumTransactionBridgeAction(ctx, task.ChainID, input.From, input.To, sourceNetwork, targetNetwork, metadata.ActionTransactionBridgeWithdraw, nil, input.Value, log.BlockNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -272,7 +289,9 @@ func (w *worker) handleEthereumTokenBridgeBridgingInitiatedLog(ctx context.Conte
 		return nil, fmt.Errorf("parse BridgingInitiated event: %w", err)
 	}
 
-	action, err := w.buildEthereumTransactionBridgeAction(ctx, task.ChainID, event.Sender, event.Recipient, network.Ethereum, network.Linea, metadata.ActionTransactionBridgeDeposit, &event.Token, event.Amount, log.BlockNumber)
+	sourceNetwork, targetNetwork := w.buildNetworks(task, true)
+
+	action, err := w.buildEthereumTransactionBridgeAction(ctx, task.ChainID, event.Sender, event.Recipient, sourceNetwork, targetNetwork, metadata.ActionTransactionBridgeDeposit, &event.Token, event.Amount, log.BlockNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -292,7 +311,12 @@ func (w *worker) handleEthereumTokenBridgeBridgingFinalizedLog(ctx context.Conte
 		return nil, fmt.Errorf("parse Bridging event: %w", err)
 	}
 
-	action, err := w.buildEthereumTransactionBridgeAction(ctx, task.ChainID, task.Transaction.From, event.Recipient, network.Ethereum, network.Linea, metadata.ActionTransactionBridgeWithdraw, &event.NativeToken, event.Amount, log.BlockNumber)
+	sourceNetwork, targetNetwork := w.buildNetworks(task, false)
+
+	// The native token is released on Ethereum, while the bridged token is minted on Linea.
+	tokenAddress := lo.Ternary(task.Network == network.Ethereum, event.NativeToken, event.BridgedToken)
+
+	action, err := w.buildEthereumTransactionBridgeAction(ctx, task.ChainID, task.Transaction.From, event.Recipient, sourceNetwork, targetNetwork, metadata.ActionTransactionBridgeWithdraw, &tokenAddress, event.Amount, log.BlockNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -343,6 +367,52 @@ func (w *worker) handleEthereumL1USDCBridgeReceivedFromOtherLayerLog(ctx context
 	return actions, nil
 }
 
+// buildNetworks returns the source and target networks of a message sent from or claimed on the network of the task.
+func (w *worker) buildNetworks(task source.Task, sent bool) (network.Network, network.Network) {
+	counterparty := lo.Ternary(task.Network == network.Ethereum, network.Linea, network.Ethereum)
+
+	if sent {
+		return task.Network, counterparty
+	}
+
+	return counterparty, task.Network
+}
+
+// buildBridgeKeys returns the hashes of the messages sent or claimed in the transaction,
+// which are shared by the transactions of a bridge transfer on both networks.
+func (w *worker) buildBridgeKeys(task source.Task) []string {
+	keys := make([]string, 0)
+
+	for _, log := range task.Receipt.Logs {
+		if len(log.Topics) == 0 || !contract.MatchAddresses(log.Address, linea.AddressZKEVMV2, linea.AddressL2MessageService) {
+			continue
+		}
+
+		switch {
+		case contract.MatchEventHashes(log.Topics[0], linea.EventHashZKEVMV2MessageSent):
+			event, err := w.lineaZKEVMV2Filter.ParseMessageSent(log.Export())
+			if err != nil {
+				zap.L().Warn("failed to parse MessageSent event", zap.Error(err), zap.String("task", task.ID()))
+
+				continue
+			}
+
+			keys = append(keys, common.Hash(event.MessageHash).String())
+		case contract.MatchEventHashes(log.Topics[0], linea.EventHashZKEVMV2MessageClaimed):
+			event, err := w.lineaZKEVMV2Filter.ParseMessageClaimed(log.Export())
+			if err != nil {
+				zap.L().Warn("failed to parse MessageClaimed event", zap.Error(err), zap.String("task", task.ID()))
+
+				continue
+			}
+
+			keys = append(keys, common.Hash(event.MessageHash).String())
+		}
+	}
+
+	return keys
+}
+
 func (w *worker) buildEthereumTransactionBridgeAction(ctx context.Context, chainID uint64, sender, receiver common.Address, source, target network.Network, bridgeAction metadata.TransactionBridgeAction, tokenAddress *common.Address, tokenValue *big.Int, blockNumber *big.Int) (*activityx.Action, error) {
 	tokenMetadata, err := w.tokenClient.Lookup(ctx, chainID, tokenAddress, nil, blockNumber)
 	if err != nil {
@@ -369,11 +439,12 @@ func (w *worker) buildEthereumTransactionBridgeAction(ctx context.Context, chain
 }
 
 // NewWorker returns a new linea worker.
-func NewWorker(config *config.Module) (engine.Worker, error) {
+func NewWorker(config *config.Module, databaseClient database.Client) (engine.Worker, error) {
 	instance := worker{
 		lineaZKEVMV2Filter:       lo.Must(linea.NewZKEVMV2Filterer(ethereum.AddressGenesis, nil)),
 		lineaTokenBridgeFilterer: lo.Must(linea.NewTokenBridgeFilterer(ethereum.AddressGenesis, nil)),
 		lineaL1USDCBridgeFilter:  lo.Must(linea.NewL1USDCBridgeFilterer(ethereum.AddressGenesis, nil)),
+		bridgeCorrelator:         bridge.NewCorrelator(databaseClient),
 	}
 
 	var err error
@@ -364,8 +364,8 @@ func TestWorker_Linea(t *testing.T) {
 						To:       "0x9bacb15D8935D7fc93E119Cc99C711Dc59aea660",
 						Metadata: metadata.TransactionBridge{
 							Action:        metadata.ActionTransactionBridgeWithdraw,
-							SourceNetwork: network.Ethereum,
-							TargetNetwork: network.Linea,
+							SourceNetwork: network.Linea,
+							TargetNetwork: network.Ethereum,
 							Token: metadata.Token{
 								Address:  lo.ToPtr("0x1f9840a85d5aF5bf1D1762F925BDADdC4201F984"),
 								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("36628216024964374528"))),
@@ -803,7 +803,7 @@ func TestWorker_Linea(t *testing.T) {
 
 			ctx := context.Background()
 
-			instance, err := worker.NewWorker(testcase.arguments.config)
+			instance, err := worker.NewWorker(testcase.arguments.config, nil)
 			require.NoError(t, err)
 
 			activity, err := instance.Transform(ctx, testcase.arguments.task)
@@ -10,6 +10,7 @@ import (
 	"github.com/rss3-network/node/config"
 	"github.com/rss3-network/node/internal/engine"
 	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/marketplace"
 	"github.com/rss3-network/node/internal/utils"
 	"github.com/rss3-network/node/provider/ethereum"
 	"github.com/rss3-network/node/provider/ethereum/contract"
@@ -52,9 +53,15 @@ func (w *worker) Platform() string {
 	return decentralized.PlatformOpenSea.String()
 }
 
+// Network returns the networks of Seaport, which is deployed to the same addresses on all networks.
 func (w *worker) Network() []network.Network {
 	return []network.Network{
+		network.Arbitrum,
+		network.Avalanche,
+		network.Base,
 		network.Ethereum,
+		network.Optimism,
+		network.Polygon,
 	}
 }
 
@@ -81,6 +88,7 @@ func (w *worker) Filter() engine.DataSourceFilter {
 			opensea.AddressSeaportV1Dot3,
 			opensea.AddressSeaportV1Dot4,
 			opensea.AddressSeaportV1Dot5,
+			opensea.AddressSeaportV1Dot6,
 		},
 		LogTopics: []common.Hash{
 			opensea.EventHashWyvernExchangeV1OrdersMatched,
@@ -135,6 +143,9 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 			return nil, err
 		}
 
+		// Attribute the trades to the aggregator or front-end sourcing them.
+		marketplace.Attribute(ethereumTask.Transaction, actions)
+
 		// Change activity type to the first action type.
 		for _, action := range actions {
 			activity.Type = action.Type
@@ -168,6 +179,7 @@ func (w *worker) matchSeaportV1OrderFulfilled(_ *source.Task, log *ethereum.Log)
 		log.Address,
 		opensea.AddressSeaportV1Dot0, opensea.AddressSeaportV1Dot1, opensea.AddressSeaportV1Dot2,
 		opensea.AddressSeaportV1Dot3, opensea.AddressSeaportV1Dot4, opensea.AddressSeaportV1Dot5,
+		opensea.AddressSeaportV1Dot6,
 	)
 }
 
@@ -7,8 +7,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/database"
 	"github.com/rss3-network/node/internal/engine"
 	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/bridge"
 	"github.com/rss3-network/node/internal/utils"
 	"github.com/rss3-network/node/provider/ethereum"
 	"github.com/rss3-network/node/provider/ethereum/contract/optimism"
@@ -25,7 +27,7 @@ import (
 	"go.uber.org/zap"
 )
 
-var _ engine.Worker = (*worker)(nil)
+var _ engine.MutatingWorker = (*worker)(nil)
 
 type worker struct {
 	config                           *config.Module
@@ -33,6 +35,7 @@ type worker struct {
 	tokenClient                      token.Client
 	contractL1StandardBridgeFilterer *optimism.L1StandardBridgeFilterer
 	contractL2StandardBridgeFilterer *optimism.L2StandardBridgeFilterer
+	bridgeCorrelator                 *bridge.Correlator
 }
 
 func (w *worker) Name() string {
@@ -80,14 +83,21 @@ func (w *worker) Filter() engine.DataSourceFilter {
 }
 
 func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	activity, _, err := w.TransformMutations(ctx, task)
+
+	return activity, err
+}
+
+// TransformMutations transforms the task into the activity and the mutations recording the sides of its bridge transfers.
+func (w *worker) TransformMutations(ctx context.Context, task engine.Task) (*activityx.Activity, []*engine.Mutation, error) {
 	ethereumTask, ok := task.(*source.Task)
 	if !ok {
-		return nil, fmt.Errorf("invalid task type: %T", task)
+		return nil, nil, fmt.Errorf("invalid task type: %T", task)
 	}
 
 	activity, err := ethereumTask.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
 	if err != nil {
-		return nil, fmt.Errorf("build activity: %w", err)
+		return nil, nil, fmt.Errorf("build activity: %w", err)
 	}
 
 	for _, log := range ethereumTask.Receipt.Logs {
@@ -121,14 +131,15 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 		if err != nil {
 			zap.L().Error("handle ethereum log", zap.Error(err), zap.String("task", task.ID()))
 
-			return nil, err
+			return nil, nil, err
 		}
 
 		activity.Type = typex.TransactionBridge
 		activity.Actions = append(activity.Actions, actions...)
 	}
 
-	return activity, nil
+	// The sides of the bridge transfers are recorded after the activity is saved.
+	return activity, w.bridgeCorrelator.Mutations(activity, w.buildBridgeKeys(ethereumTask)...), nil
 }
 
 func (w *worker) matchL1StandardBridgeETHDepositInitiatedLog(_ *source.Task, log *ethereum.Log) bool {
@@ -263,6 +274,23 @@ func (w *worker) transformL2StandardBridgeDepositFinalizedLog(ctx context.Contex
 	return actions, nil
 }
 
+// buildBridgeKeys returns the hashes of the cross domain messages in the transaction,
+// which are shared by the transactions of a bridge transfer on both networks.
+func (w *worker) buildBridgeKeys(task *source.Task) []string {
+	messenger := lo.Ternary(task.Network == network.Ethereum, optimism.AddressL1CrossDomainMessenger, optimism.AddressL2CrossDomainMessenger)
+
+	hashes, err := optimism.CrossDomainMessageHashes(messenger, task.Receipt.Logs)
+	if err != nil {
+		zap.L().Warn("failed to build cross domain message hashes", zap.Error(err), zap.String("task", task.ID()))
+
+		return nil
+	}
+
+	return lo.Map(hashes, func(hash common.Hash, _ int) string {
+		return hash.String()
+	})
+}
+
 func (w *worker) buildTransactionBridgeAction(ctx context.Context, chainID uint64, sender, receiver common.Address, source, target network.Network, bridgeAction metadata.TransactionBridgeAction, tokenAddress *common.Address, tokenValue *big.Int, blockNumber *big.Int) (*activityx.Action, error) {
 	// Ignore L2 ETH token address.
 	if tokenAddress != nil && (*tokenAddress == optimism.AddressL1ETH || *tokenAddress == optimism.AddressL2ETH) {
@@ -293,11 +321,12 @@ func (w *worker) buildTransactionBridgeAction(ctx context.Context, chainID uint6
 }
 
 // NewWorker creates a new Optimism worker.
-func NewWorker(config *config.Module) (engine.Worker, error) {
+func NewWorker(config *config.Module, databaseClient database.Client) (engine.Worker, error) {
 	var (
 		err      error
 		instance = worker{
-			config: config,
+			config:           config,
+			bridgeCorrelator: bridge.NewCorrelator(databaseClient),
 		}
 	)
 
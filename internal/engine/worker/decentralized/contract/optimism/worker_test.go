@@ -1478,7 +1478,7 @@ func TestWorker_Ethereum(t *testing.T) {
 
 			ctx := context.Background()
 
-			instance, err := worker.NewWorker(testcase.arguments.config)
+			instance, err := worker.NewWorker(testcase.arguments.config, nil)
 			require.NoError(t, err)
 
 			activity, err := instance.Transform(ctx, testcase.arguments.task)
@@ -0,0 +1,487 @@
+package safe
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/engine"
+	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/internal/utils"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+	"github.com/rss3-network/node/provider/ethereum/contract/erc20"
+	"github.com/rss3-network/node/provider/ethereum/contract/safe"
+	"github.com/rss3-network/node/provider/ethereum/token"
+	metadatax "github.com/rss3-network/node/schema/metadata"
+	"github.com/rss3-network/node/schema/worker/decentralized"
+	"github.com/rss3-network/protocol-go/schema"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+var _ engine.Worker = (*worker)(nil)
+
+type worker struct {
+	config           *config.Module
+	ethereumClient   ethereum.Client
+	tokenClient      token.Client
+	safeFilterer     *safe.SafeFilterer
+	safeV130Filterer *safe.SafeV130Filterer
+}
+
+// call is a call executed by a Safe, which is the transaction of execTransaction or a transaction of MultiSend.
+type call struct {
+	Operation uint8
+	To        common.Address
+	Value     *big.Int
+	Data      []byte
+}
+
+func (w *worker) Name() string {
+	return decentralized.Safe.String()
+}
+
+func (w *worker) Platform() string {
+	return decentralized.PlatformSafe.String()
+}
+
+func (w *worker) Network() []network.Network {
+	return []network.Network{
+		network.Arbitrum,
+		network.Avalanche,
+		network.Base,
+		network.BinanceSmartChain,
+		network.Crossbell,
+		network.Ethereum,
+		network.Gnosis,
+		network.Linea,
+		network.Optimism,
+		network.Polygon,
+		network.SatoshiVM,
+		network.VSL,
+		network.XLayer,
+	}
+}
+
+func (w *worker) Tags() []tag.Tag {
+	return []tag.Tag{
+		tag.Transaction,
+	}
+}
+
+func (w *worker) Types() []schema.Type {
+	return []schema.Type{
+		typex.TransactionTransfer,
+		typex.TransactionApproval,
+	}
+}
+
+// Filter returns a filter of the events of Safes, which are proxies deployed at arbitrary addresses.
+func (w *worker) Filter() engine.DataSourceFilter {
+	return &source.Filter{
+		LogTopics: []common.Hash{
+			safe.EventSafeSetup,
+			safe.EventAddedOwner,
+			safe.EventRemovedOwner,
+			safe.EventChangedThreshold,
+			safe.EventExecutionSuccess,
+			safe.EventExecutionFailure,
+		},
+	}
+}
+
+func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	ethereumTask, ok := task.(*source.Task)
+	if !ok {
+		return nil, fmt.Errorf("invalid task type: %T", task)
+	}
+
+	activity, err := ethereumTask.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
+	if err != nil {
+		return nil, fmt.Errorf("build activity: %w", err)
+	}
+
+	var matched bool
+
+	for _, log := range ethereumTask.Receipt.Logs {
+		if len(log.Topics) == 0 {
+			continue
+		}
+
+		var (
+			actions []*activityx.Action
+			err     error
+		)
+
+		switch {
+		case w.matchSafeSetup(log):
+			actions, err = w.handleSafeSetup(ctx, log)
+		case w.matchAddedOwner(log):
+			actions, err = w.handleAddedOwner(ctx, log)
+		case w.matchRemovedOwner(log):
+			actions, err = w.handleRemovedOwner(ctx, log)
+		case w.matchChangedThreshold(log):
+			actions, err = w.handleChangedThreshold(ctx, log)
+		case w.matchExecution(log):
+			actions, err = w.handleExecution(ctx, ethereumTask, log, activity)
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The activity is attributed to the Safe instead of the owner who sent the transaction.
+		if !matched {
+			activity.From = log.Address.String()
+			matched = true
+		}
+
+		if activity.Type == typex.Unknown && len(actions) > 0 {
+			activity.Type = actions[0].Type
+		}
+
+		activity.Actions = append(activity.Actions, actions...)
+	}
+
+	if len(activity.Actions) == 0 {
+		zap.L().Debug("no actions generated for task", zap.String("task_id", task.ID()))
+
+		return nil, nil
+	}
+
+	return activity, nil
+}
+
+// The events are matched by the number of topics as well, as the owner and execution events of v1.3.0 have no indexed arguments.
+func (w *worker) matchSafeSetup(log *ethereum.Log) bool {
+	return len(log.Topics) == 2 && contract.MatchEventHashes(log.Topics[0], safe.EventSafeSetup)
+}
+
+func (w *worker) matchAddedOwner(log *ethereum.Log) bool {
+	return len(log.Topics) <= 2 && contract.MatchEventHashes(log.Topics[0], safe.EventAddedOwner)
+}
+
+func (w *worker) matchRemovedOwner(log *ethereum.Log) bool {
+	return len(log.Topics) <= 2 && contract.MatchEventHashes(log.Topics[0], safe.EventRemovedOwner)
+}
+
+func (w *worker) matchChangedThreshold(log *ethereum.Log) bool {
+	return len(log.Topics) == 1 && contract.MatchEventHashes(log.Topics[0], safe.EventChangedThreshold)
+}
+
+func (w *worker) matchExecution(log *ethereum.Log) bool {
+	return len(log.Topics) <= 2 && contract.MatchEventHashes(log.Topics[0], safe.EventExecutionSuccess, safe.EventExecutionFailure)
+}
+
+// handleSafeSetup returns the approval actions of the initial owners and the initial threshold.
+func (w *worker) handleSafeSetup(_ context.Context, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.safeFilterer.ParseSafeSetup(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse safe setup event: %w", err)
+	}
+
+	actions := lo.Map(event.Owners, func(owner common.Address, _ int) *activityx.Action {
+		return w.buildOwnerApprovalAction(log.Address, owner, metadata.ActionTransactionApprove)
+	})
+
+	actions = append(actions, w.buildThresholdApprovalAction(log.Address, event.Threshold))
+
+	return actions, nil
+}
+
+func (w *worker) handleAddedOwner(_ context.Context, log *ethereum.Log) ([]*activityx.Action, error) {
+	var owner common.Address
+
+	if len(log.Topics) == 2 {
+		event, err := w.safeFilterer.ParseAddedOwner(log.Export())
+		if err != nil {
+			return nil, fmt.Errorf("parse added owner event: %w", err)
+		}
+
+		owner = event.Owner
+	} else {
+		event, err := w.safeV130Filterer.ParseAddedOwner(log.Export())
+		if err != nil {
+			return nil, fmt.Errorf("parse added owner event: %w", err)
+		}
+
+		owner = event.Owner
+	}
+
+	return []*activityx.Action{w.buildOwnerApprovalAction(log.Address, owner, metadata.ActionTransactionApprove)}, nil
+}
+
+func (w *worker) handleRemovedOwner(_ context.Context, log *ethereum.Log) ([]*activityx.Action, error) {
+	var owner common.Address
+
+	if len(log.Topics) == 2 {
+		event, err := w.safeFilterer.ParseRemovedOwner(log.Export())
+		if err != nil {
+			return nil, fmt.Errorf("parse removed owner event: %w", err)
+		}
+
+		owner = event.Owner
+	} else {
+		event, err := w.safeV130Filterer.ParseRemovedOwner(log.Export())
+		if err != nil {
+			return nil, fmt.Errorf("parse removed owner event: %w", err)
+		}
+
+		owner = event.Owner
+	}
+
+	return []*activityx.Action{w.buildOwnerApprovalAction(log.Address, owner, metadata.ActionTransactionRevoke)}, nil
+}
+
+func (w *worker) handleChangedThreshold(_ context.Context, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.safeFilterer.ParseChangedThreshold(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse changed threshold event: %w", err)
+	}
+
+	return []*activityx.Action{w.buildThresholdApprovalAction(log.Address, event.Threshold)}, nil
+}
+
+// handleExecution returns the transfer actions of the calls executed by the Safe, which are decoded from the input of
+// execTransaction if the transaction is sent to the Safe directly. A failed execution marks the activity as failed.
+func (w *worker) handleExecution(ctx context.Context, task *source.Task, log *ethereum.Log, activity *activityx.Activity) ([]*activityx.Action, error) {
+	if contract.MatchEventHashes(log.Topics[0], safe.EventExecutionFailure) {
+		activity.Status = false
+
+		return nil, nil
+	}
+
+	if task.Transaction.To == nil || *task.Transaction.To != log.Address || !contract.MatchMethodIDs(task.Transaction.Input, safe.MethodIDExecTransaction) {
+		return nil, nil
+	}
+
+	calls, err := w.decodeExecTransaction(task.Transaction.Input)
+	if err != nil {
+		return nil, fmt.Errorf("decode exec transaction: %w", err)
+	}
+
+	actions := make([]*activityx.Action, 0, len(calls))
+
+	for _, call := range calls {
+		// The delegate calls are executed in the context of the Safe, which are not transfers.
+		if call.Operation != safe.OperationCall {
+			continue
+		}
+
+		if call.Value != nil && call.Value.Sign() > 0 {
+			action, err := w.buildTransactionTransferAction(ctx, task, log.Address, call.To, nil, call.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			actions = append(actions, action)
+		}
+
+		if contract.MatchMethodIDs(call.Data, erc20.MethodIDTransfer) {
+			action, err := w.handleERC20Transfer(ctx, task, log.Address, call)
+			if err != nil {
+				return nil, err
+			}
+
+			actions = append(actions, action)
+		}
+	}
+
+	return actions, nil
+}
+
+// decodeExecTransaction returns the calls of execTransaction, a delegate call to MultiSend is decoded into the calls of the batch.
+func (w *worker) decodeExecTransaction(input []byte) ([]call, error) {
+	abi, err := safe.SafeMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("load Safe ABI: %w", err)
+	}
+
+	method, err := abi.MethodById(safe.MethodIDExecTransaction[:])
+	if err != nil {
+		return nil, fmt.Errorf("load method by ID: %w", err)
+	}
+
+	values, err := method.Inputs.UnpackValues(input[4:])
+	if err != nil {
+		return nil, fmt.Errorf("unpack values: %w", err)
+	}
+
+	var execTransaction safe.ExecTransactionInput
+	if err := method.Inputs.Copy(&execTransaction, values); err != nil {
+		return nil, fmt.Errorf("copy input: %w", err)
+	}
+
+	if execTransaction.Operation != safe.OperationDelegateCall || !contract.MatchMethodIDs(execTransaction.Data, safe.MethodIDMultiSend) {
+		return []call{
+			{
+				Operation: execTransaction.Operation,
+				To:        execTransaction.To,
+				Value:     execTransaction.Value,
+				Data:      execTransaction.Data,
+			},
+		}, nil
+	}
+
+	return w.decodeMultiSend(execTransaction.Data)
+}
+
+// decodeMultiSend returns the calls of multiSend, each of which is encoded as
+// abi.encodePacked(uint8 operation, address to, uint256 value, uint256 dataLength, bytes data).
+func (w *worker) decodeMultiSend(input []byte) ([]call, error) {
+	abi, err := safe.MultiSendMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("load MultiSend ABI: %w", err)
+	}
+
+	method, err := abi.MethodById(safe.MethodIDMultiSend[:])
+	if err != nil {
+		return nil, fmt.Errorf("load method by ID: %w", err)
+	}
+
+	values, err := method.Inputs.UnpackValues(input[4:])
+	if err != nil {
+		return nil, fmt.Errorf("unpack values: %w", err)
+	}
+
+	var multiSend safe.MultiSendInput
+	if err := method.Inputs.Copy(&multiSend, values); err != nil {
+		return nil, fmt.Errorf("copy input: %w", err)
+	}
+
+	const headerLength = 1 + common.AddressLength + 32 + 32
+
+	var (
+		calls        = make([]call, 0)
+		transactions = multiSend.Transactions
+	)
+
+	for len(transactions) > 0 {
+		if len(transactions) < headerLength {
+			return nil, fmt.Errorf("invalid multi send transaction length %d", len(transactions))
+		}
+
+		dataLength := new(big.Int).SetBytes(transactions[1+common.AddressLength+32 : headerLength])
+		if !dataLength.IsUint64() || dataLength.Uint64() > uint64(len(transactions)-headerLength) {
+			return nil, fmt.Errorf("invalid multi send data length %s", dataLength)
+		}
+
+		calls = append(calls, call{
+			Operation: transactions[0],
+			To:        common.BytesToAddress(transactions[1 : 1+common.AddressLength]),
+			Value:     new(big.Int).SetBytes(transactions[1+common.AddressLength : 1+common.AddressLength+32]),
+			Data:      transactions[headerLength : headerLength+int(dataLength.Uint64())],
+		})
+
+		transactions = transactions[headerLength+int(dataLength.Uint64()):]
+	}
+
+	return calls, nil
+}
+
+func (w *worker) handleERC20Transfer(ctx context.Context, task *source.Task, safeAddress common.Address, call call) (*activityx.Action, error) {
+	abi, err := erc20.ERC20MetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("load ERC20 ABI: %w", err)
+	}
+
+	method, err := abi.MethodById(erc20.MethodIDTransfer[:])
+	if err != nil {
+		return nil, fmt.Errorf("load method by ID: %w", err)
+	}
+
+	values, err := method.Inputs.UnpackValues(call.Data[4:])
+	if err != nil {
+		return nil, fmt.Errorf("unpack values: %w", err)
+	}
+
+	var input struct {
+		To    common.Address
+		Value *big.Int
+	}
+
+	if err := method.Inputs.Copy(&input, values); err != nil {
+		return nil, fmt.Errorf("copy input: %w", err)
+	}
+
+	return w.buildTransactionTransferAction(ctx, task, safeAddress, input.To, &call.To, input.Value)
+}
+
+func (w *worker) buildTransactionTransferAction(ctx context.Context, task *source.Task, from, to common.Address, tokenAddress *common.Address, amount *big.Int) (*activityx.Action, error) {
+	tokenMetadata, err := w.tokenClient.Lookup(ctx, task.ChainID, tokenAddress, nil, task.Header.Number)
+	if err != nil {
+		return nil, fmt.Errorf("lookup token metadata: %w", err)
+	}
+
+	tokenMetadata.Value = lo.ToPtr(decimal.NewFromBigInt(utils.GetBigInt(amount), 0))
+
+	return &activityx.Action{
+		Type:     typex.TransactionTransfer,
+		Platform: w.Platform(),
+		From:     from.String(),
+		To:       to.String(),
+		Metadata: metadata.TransactionTransfer(*tokenMetadata),
+	}, nil
+}
+
+// buildOwnerApprovalAction returns an approval action of an owner of the Safe, as the owners approve the transactions of the Safe.
+func (w *worker) buildOwnerApprovalAction(safeAddress, owner common.Address, action metadata.TransactionApprovalAction) *activityx.Action {
+	return &activityx.Action{
+		Type:     typex.TransactionApproval,
+		Platform: w.Platform(),
+		From:     safeAddress.String(),
+		To:       owner.String(),
+		Metadata: metadatax.SafeApproval{
+			Action: action,
+			Owner:  lo.ToPtr(owner),
+		},
+	}
+}
+
+// buildThresholdApprovalAction returns an approval action of the threshold of the Safe.
+func (w *worker) buildThresholdApprovalAction(safeAddress common.Address, threshold *big.Int) *activityx.Action {
+	return &activityx.Action{
+		Type:     typex.TransactionApproval,
+		Platform: w.Platform(),
+		From:     safeAddress.String(),
+		To:       safeAddress.String(),
+		Metadata: metadatax.SafeApproval{
+			Action:    metadata.ActionTransactionApprove,
+			Threshold: lo.ToPtr(threshold.Uint64()),
+		},
+	}
+}
+
+// NewWorker creates a new Safe worker.
+func NewWorker(config *config.Module) (engine.Worker, error) {
+	var (
+		instance = worker{
+			config: config,
+		}
+
+		err error
+	)
+
+	if instance.ethereumClient, err = ethereum.Dial(context.Background(), config.Endpoint.URL, config.Endpoint.BuildEthereumOptions()...); err != nil {
+		return nil, fmt.Errorf("initialize ethereum client: %w", err)
+	}
+
+	instance.tokenClient = token.NewClient(instance.ethereumClient)
+
+	// Initialize contract filterers.
+	instance.safeFilterer = lo.Must(safe.NewSafeFilterer(ethereum.AddressGenesis, nil))
+	instance.safeV130Filterer = lo.Must(safe.NewSafeV130Filterer(ethereum.AddressGenesis, nil))
+
+	return &instance, nil
+}
@@ -0,0 +1,581 @@
+package safe_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rss3-network/node/config"
+	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	worker "github.com/rss3-network/node/internal/engine/worker/decentralized/contract/safe"
+	"github.com/rss3-network/node/internal/testsuite"
+	"github.com/rss3-network/node/provider/ethereum"
+	metadatax "github.com/rss3-network/node/schema/metadata"
+	workerx "github.com/rss3-network/node/schema/worker/decentralized"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorker_Ethereum(t *testing.T) {
+	t.Parallel()
+
+	// The transfers of the Safes are native tokens, which are looked up without calling the contracts.
+	endpointURL := testsuite.NewEthereumRPCServer(t, func(to common.Address, input []byte) ([]byte, error) {
+		return nil, fmt.Errorf("unexpected call %s of %s", hexutil.Encode(input), to)
+	})
+
+	type arguments struct {
+		task   *source.Task
+		config *config.Module
+	}
+
+	testcases := []struct {
+		name      string
+		arguments arguments
+		want      *activityx.Activity
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name: "Safe Setup",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						ParentHash: common.HexToHash("0xbabababababababababababababababababababababababababababababababa"),
+						Number:     lo.Must(new(big.Int).SetString("20500000", 0)),
+						GasLimit:   30000000,
+						GasUsed:    12345678,
+						Timestamp:  1723190411,
+						BaseFee:    lo.Must(new(big.Int).SetString("1000000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						From:      common.HexToAddress("0x9E6a2B3C4D5E6f708192a3B4C5d6E7f8091a2b3c"),
+						Gas:       200000,
+						GasPrice:  lo.Must(new(big.Int).SetString("1500000000", 10)),
+						Hash:      common.HexToHash("0x0101010101010101010101010101010101010101010101010101010101010101"),
+						Input:     hexutil.MustDecode("0x1688f0b9"),
+						To:        lo.ToPtr(common.HexToAddress("0x4e1DCf7AD4e460CfD30791CCC4F9c8a4f820ec67")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20500000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x3b9aca00"),
+						GasUsed:           100000,
+
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b"),
+								Topics: []common.Hash{
+									common.HexToHash("0x141df868a6331af528e38c83b7aa03edc19be66e37ae67f9285bf4f8e3c6a1a8"),
+									common.HexToHash("0x0000000000000000000000004e1dcf7ad4e460cfd30791ccc4f9c8a4f820ec67"),
+								},
+								Data:            hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000000000000000000000000000fd0732dc9e303f09fcef3a7388ad10a83459ec9900000000000000000000000000000000000000000000000000000000000000020000000000000000000000001a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d0000000000000000000000002b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20500000", 0)),
+								TransactionHash: common.HexToHash("0x0101010101010101010101010101010101010101010101010101010101010101"),
+								Index:           10,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0101010101010101010101010101010101010101010101010101010101010101"),
+						TransactionIndex: 7,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+					Endpoint: config.Endpoint{
+						URL: endpointURL,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0101010101010101010101010101010101010101010101010101010101010101",
+				Network: network.Ethereum,
+				Index:   7,
+				From:    "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+				To:      "0x4e1DCf7AD4e460CfD30791CCC4F9c8a4f820ec67",
+				Type:    typex.TransactionApproval,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x1688f0b9",
+				},
+				Platform: workerx.PlatformSafe.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("100000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.TransactionApproval,
+						Platform: workerx.PlatformSafe.String(),
+						From:     "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+						To:       "0x1a2B3c4d5E6f708192a3B4c5D6E7F8091A2b3C4d",
+						Metadata: metadatax.SafeApproval{
+							Action: metadata.ActionTransactionApprove,
+							Owner:  lo.ToPtr(common.HexToAddress("0x1a2B3c4d5E6f708192a3B4c5D6E7F8091A2b3C4d")),
+						},
+					},
+					{
+						Type:     typex.TransactionApproval,
+						Platform: workerx.PlatformSafe.String(),
+						From:     "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+						To:       "0x2B3c4d5e6f708192A3b4C5d6e7f8091A2B3c4d5e",
+						Metadata: metadatax.SafeApproval{
+							Action: metadata.ActionTransactionApprove,
+							Owner:  lo.ToPtr(common.HexToAddress("0x2B3c4d5e6f708192A3b4C5d6e7f8091A2B3c4d5e")),
+						},
+					},
+					{
+						Type:     typex.TransactionApproval,
+						Platform: workerx.PlatformSafe.String(),
+						From:     "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+						To:       "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+						Metadata: metadatax.SafeApproval{
+							Action:    metadata.ActionTransactionApprove,
+							Threshold: lo.ToPtr(uint64(2)),
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1723190411,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Added Owner And Changed Threshold",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						ParentHash: common.HexToHash("0xbabababababababababababababababababababababababababababababababa"),
+						Number:     lo.Must(new(big.Int).SetString("20500000", 0)),
+						GasLimit:   30000000,
+						GasUsed:    12345678,
+						Timestamp:  1723190411,
+						BaseFee:    lo.Must(new(big.Int).SetString("1000000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						From:      common.HexToAddress("0x9E6a2B3C4D5E6f708192a3B4C5d6E7f8091a2b3c"),
+						Gas:       200000,
+						GasPrice:  lo.Must(new(big.Int).SetString("1500000000", 10)),
+						Hash:      common.HexToHash("0x0202020202020202020202020202020202020202020202020202020202020202"),
+						Input:     hexutil.MustDecode("0x6a7612020000000000000000000000006d3b0b5b6b1fd1a2bf0d7a0a8d3f0f4c2fdf6e8b0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000014000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001c000000000000000000000000000000000000000000000000000000000000000440d582f130000000000000000000000003c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(common.HexToAddress("0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20500000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x3b9aca00"),
+						GasUsed:           100000,
+
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b"),
+								Topics: []common.Hash{
+									common.HexToHash("0x9465fa0c962cc76958e6373a993326400c1c94f8be2fe3a952adfa7f60b2ea26"),
+									common.HexToHash("0x0000000000000000000000003c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f"),
+								},
+								Data:            hexutil.MustDecode("0x"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20500000", 0)),
+								TransactionHash: common.HexToHash("0x0202020202020202020202020202020202020202020202020202020202020202"),
+								Index:           20,
+								Removed:         false,
+							},
+							{
+								Address: common.HexToAddress("0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b"),
+								Topics: []common.Hash{
+									common.HexToHash("0x610f7ff2b304ae8903c3de74c60c6ab1f7d6226b3f52c5161905bb5ad4039c93"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000002"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20500000", 0)),
+								TransactionHash: common.HexToHash("0x0202020202020202020202020202020202020202020202020202020202020202"),
+								Index:           21,
+								Removed:         false,
+							},
+							{
+								Address: common.HexToAddress("0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b"),
+								Topics: []common.Hash{
+									common.HexToHash("0x442e715f626346e8c54381002da614f62bee8d27386535b2521ec8540898556e"),
+									common.HexToHash("0x7777777777777777777777777777777777777777777777777777777777777777"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000000"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20500000", 0)),
+								TransactionHash: common.HexToHash("0x0202020202020202020202020202020202020202020202020202020202020202"),
+								Index:           22,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0202020202020202020202020202020202020202020202020202020202020202"),
+						TransactionIndex: 7,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+					Endpoint: config.Endpoint{
+						URL: endpointURL,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0202020202020202020202020202020202020202020202020202020202020202",
+				Network: network.Ethereum,
+				Index:   7,
+				From:    "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+				To:      "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+				Type:    typex.TransactionApproval,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x6a761202",
+				},
+				Platform: workerx.PlatformSafe.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("100000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.TransactionApproval,
+						Platform: workerx.PlatformSafe.String(),
+						From:     "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+						To:       "0x3C4d5E6f708192a3B4c5D6e7F8091a2B3C4D5E6F",
+						Metadata: metadatax.SafeApproval{
+							Action: metadata.ActionTransactionApprove,
+							Owner:  lo.ToPtr(common.HexToAddress("0x3C4d5E6f708192a3B4c5D6e7F8091a2B3C4D5E6F")),
+						},
+					},
+					{
+						Type:     typex.TransactionApproval,
+						Platform: workerx.PlatformSafe.String(),
+						From:     "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+						To:       "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+						Metadata: metadatax.SafeApproval{
+							Action:    metadata.ActionTransactionApprove,
+							Threshold: lo.ToPtr(uint64(2)),
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1723190411,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Removed Owner v1.3.0",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						ParentHash: common.HexToHash("0xbabababababababababababababababababababababababababababababababa"),
+						Number:     lo.Must(new(big.Int).SetString("20500000", 0)),
+						GasLimit:   30000000,
+						GasUsed:    12345678,
+						Timestamp:  1723190411,
+						BaseFee:    lo.Must(new(big.Int).SetString("1000000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						From:      common.HexToAddress("0x9E6a2B3C4D5E6f708192a3B4C5d6E7f8091a2b3c"),
+						Gas:       200000,
+						GasPrice:  lo.Must(new(big.Int).SetString("1500000000", 10)),
+						Hash:      common.HexToHash("0x0303030303030303030303030303030303030303030303030303030303030303"),
+						Input:     hexutil.MustDecode("0x6a7612020000000000000000000000006d3b0b5b6b1fd1a2bf0d7a0a8d3f0f4c2fdf6e8b0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000014000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001e00000000000000000000000000000000000000000000000000000000000000064f8dc5dd90000000000000000000000001a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d0000000000000000000000003c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(common.HexToAddress("0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20500000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x3b9aca00"),
+						GasUsed:           100000,
+
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b"),
+								Topics: []common.Hash{
+									common.HexToHash("0xf8d49fc529812e9a7c5c50e69c20f0dccc0db8fa95c98bc58cc9a4f1c1299eaf"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000003c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20500000", 0)),
+								TransactionHash: common.HexToHash("0x0303030303030303030303030303030303030303030303030303030303030303"),
+								Index:           30,
+								Removed:         false,
+							},
+							{
+								Address: common.HexToAddress("0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b"),
+								Topics: []common.Hash{
+									common.HexToHash("0x442e715f626346e8c54381002da614f62bee8d27386535b2521ec8540898556e"),
+								},
+								Data:            hexutil.MustDecode("0x77777777777777777777777777777777777777777777777777777777777777770000000000000000000000000000000000000000000000000000000000000000"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20500000", 0)),
+								TransactionHash: common.HexToHash("0x0303030303030303030303030303030303030303030303030303030303030303"),
+								Index:           31,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0303030303030303030303030303030303030303030303030303030303030303"),
+						TransactionIndex: 7,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+					Endpoint: config.Endpoint{
+						URL: endpointURL,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0303030303030303030303030303030303030303030303030303030303030303",
+				Network: network.Ethereum,
+				Index:   7,
+				From:    "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+				To:      "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+				Type:    typex.TransactionApproval,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x6a761202",
+				},
+				Platform: workerx.PlatformSafe.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("100000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.TransactionApproval,
+						Platform: workerx.PlatformSafe.String(),
+						From:     "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+						To:       "0x3C4d5E6f708192a3B4c5D6e7F8091a2B3C4D5E6F",
+						Metadata: metadatax.SafeApproval{
+							Action: metadata.ActionTransactionRevoke,
+							Owner:  lo.ToPtr(common.HexToAddress("0x3C4d5E6f708192a3B4c5D6e7F8091a2B3C4D5E6F")),
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1723190411,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "MultiSend Transfers",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						ParentHash: common.HexToHash("0xbabababababababababababababababababababababababababababababababa"),
+						Number:     lo.Must(new(big.Int).SetString("20500000", 0)),
+						GasLimit:   30000000,
+						GasUsed:    12345678,
+						Timestamp:  1723190411,
+						BaseFee:    lo.Must(new(big.Int).SetString("1000000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						From:      common.HexToAddress("0x9E6a2B3C4D5E6f708192a3B4C5d6E7f8091a2b3c"),
+						Gas:       200000,
+						GasPrice:  lo.Must(new(big.Int).SetString("1500000000", 10)),
+						Hash:      common.HexToHash("0x0404040404040404040404040404040404040404040404040404040404040404"),
+						Input:     hexutil.MustDecode("0x6a76120200000000000000000000000038869bf66a61cf6bdb996a6ae40d5853fd43b52600000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000140000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000028000000000000000000000000000000000000000000000000000000000000001048d80ff0a000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000aa004d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f70000000000000000000000000000000000000000000000000016345785d8a00000000000000000000000000000000000000000000000000000000000000000000005e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708100000000000000000000000000000000000000000000000002c68af0bb140000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(common.HexToAddress("0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20500000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x3b9aca00"),
+						GasUsed:           100000,
+
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b"),
+								Topics: []common.Hash{
+									common.HexToHash("0x442e715f626346e8c54381002da614f62bee8d27386535b2521ec8540898556e"),
+									common.HexToHash("0x7878787878787878787878787878787878787878787878787878787878787878"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000000"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20500000", 0)),
+								TransactionHash: common.HexToHash("0x0404040404040404040404040404040404040404040404040404040404040404"),
+								Index:           40,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0404040404040404040404040404040404040404040404040404040404040404"),
+						TransactionIndex: 7,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+					Endpoint: config.Endpoint{
+						URL: endpointURL,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0404040404040404040404040404040404040404040404040404040404040404",
+				Network: network.Ethereum,
+				Index:   7,
+				From:    "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+				To:      "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+				Type:    typex.TransactionTransfer,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x6a761202",
+				},
+				Platform: workerx.PlatformSafe.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("100000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.TransactionTransfer,
+						Platform: workerx.PlatformSafe.String(),
+						From:     "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+						To:       "0x4d5e6f708192A3B4C5D6e7f8091a2b3C4D5e6f70",
+						Metadata: metadata.TransactionTransfer{
+							Name:     "Ethereum",
+							Symbol:   "ETH",
+							Decimals: 18,
+							Value:    lo.ToPtr(lo.Must(decimal.NewFromString("100000000000000000"))),
+						},
+					},
+					{
+						Type:     typex.TransactionTransfer,
+						Platform: workerx.PlatformSafe.String(),
+						From:     "0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b",
+						To:       "0x5E6F708192A3b4C5D6e7F8091a2B3c4d5e6f7081",
+						Metadata: metadata.TransactionTransfer{
+							Name:     "Ethereum",
+							Symbol:   "ETH",
+							Decimals: 18,
+							Value:    lo.ToPtr(lo.Must(decimal.NewFromString("200000000000000000"))),
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1723190411,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Execution Failure",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						Hash:       common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						ParentHash: common.HexToHash("0xbabababababababababababababababababababababababababababababababa"),
+						Number:     lo.Must(new(big.Int).SetString("20500000", 0)),
+						GasLimit:   30000000,
+						GasUsed:    12345678,
+						Timestamp:  1723190411,
+						BaseFee:    lo.Must(new(big.Int).SetString("1000000000", 0)),
+					},
+					Transaction: &ethereum.Transaction{
+						BlockHash: common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						From:      common.HexToAddress("0x9E6a2B3C4D5E6f708192a3B4C5d6E7f8091a2b3c"),
+						Gas:       200000,
+						GasPrice:  lo.Must(new(big.Int).SetString("1500000000", 10)),
+						Hash:      common.HexToHash("0x0505050505050505050505050505050505050505050505050505050505050505"),
+						Input:     hexutil.MustDecode("0x6a76120200000000000000000000000038869bf66a61cf6bdb996a6ae40d5853fd43b52600000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000140000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000028000000000000000000000000000000000000000000000000000000000000001048d80ff0a000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000aa004d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f70000000000000000000000000000000000000000000000000016345785d8a00000000000000000000000000000000000000000000000000000000000000000000005e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708100000000000000000000000000000000000000000000000002c68af0bb140000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+						To:        lo.ToPtr(common.HexToAddress("0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b")),
+						Value:     lo.Must(new(big.Int).SetString("0", 0)),
+						Type:      2,
+						ChainID:   lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockHash:         common.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+						BlockNumber:       lo.Must(new(big.Int).SetString("20500000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x3b9aca00"),
+						GasUsed:           100000,
+
+						Logs: []*ethereum.Log{
+							{
+								Address: common.HexToAddress("0x6d3B0B5b6B1Fd1a2Bf0D7a0A8D3F0F4C2fDF6E8b"),
+								Topics: []common.Hash{
+									common.HexToHash("0x23428b18acfb3ea64b08dc0c1d296ea9c09702c09083ca5272e64d115b687d23"),
+									common.HexToHash("0x7979797979797979797979797979797979797979797979797979797979797979"),
+								},
+								Data:            hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000000"),
+								BlockNumber:     lo.Must(new(big.Int).SetString("20500000", 0)),
+								TransactionHash: common.HexToHash("0x0505050505050505050505050505050505050505050505050505050505050505"),
+								Index:           50,
+								Removed:         false,
+							},
+						},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0505050505050505050505050505050505050505050505050505050505050505"),
+						TransactionIndex: 7,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+					Endpoint: config.Endpoint{
+						URL: endpointURL,
+					},
+				},
+			},
+			want:      nil,
+			wantError: require.NoError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			instance, err := worker.NewWorker(testcase.arguments.config)
+			require.NoError(t, err)
+
+			activity, err := instance.Transform(ctx, testcase.arguments.task)
+			testcase.wantError(t, err)
+
+			t.Log(string(lo.Must(json.MarshalIndent(activity, "", "\x20\x20"))))
+
+			require.Equal(t, testcase.want, activity)
+		})
+	}
+}
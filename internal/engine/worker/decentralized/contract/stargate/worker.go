@@ -8,8 +8,10 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/database"
 	"github.com/rss3-network/node/internal/engine"
 	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/bridge"
 	"github.com/rss3-network/node/internal/utils"
 	"github.com/rss3-network/node/provider/ethereum"
 	"github.com/rss3-network/node/provider/ethereum/contract"
@@ -28,13 +30,14 @@ import (
 	"go.uber.org/zap"
 )
 
-var _ engine.Worker = (*worker)(nil)
+var _ engine.MutatingWorker = (*worker)(nil)
 
 type worker struct {
 	config               *config.Module
 	ethereumClient       ethereum.Client
 	tokenClient          token.Client
 	stargatePoolFilterer *stargate.PoolFilterer
+	bridgeCorrelator     *bridge.Correlator
 }
 
 func (w *worker) Name() string {
@@ -76,26 +79,33 @@ func (w *worker) Filter() engine.DataSourceFilter {
 }
 
 func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	activity, _, err := w.TransformMutations(ctx, task)
+
+	return activity, err
+}
+
+// TransformMutations transforms the task into the activity and the mutations recording the sides of its bridge transfers.
+func (w *worker) TransformMutations(ctx context.Context, task engine.Task) (*activityx.Activity, []*engine.Mutation, error) {
 	matched, err := w.matchStargateTransaction(ctx, task)
 	if err != nil {
 		zap.L().Error("match task", zap.String("task.id", task.ID()), zap.Error(err))
 
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	// If the task does not meet the filter conditions, it will be discarded.
 	if !matched {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	ethereumTask, ok := task.(*source.Task)
 	if !ok {
-		return nil, fmt.Errorf("invalid task type: %T", task)
+		return nil, nil, fmt.Errorf("invalid task type: %T", task)
 	}
 
 	activity, err := ethereumTask.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
 	if err != nil {
-		return nil, fmt.Errorf("build activity: %w", err)
+		return nil, nil, fmt.Errorf("build activity: %w", err)
 	}
 
 	for _, log := range ethereumTask.Receipt.Logs {
@@ -119,14 +129,15 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 		}
 
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		activity.Type = typex.TransactionBridge
 		activity.Actions = append(activity.Actions, actions...)
 	}
 
-	return activity, nil
+	// The sides of the bridge transfers are recorded after the activity is saved.
+	return activity, w.bridgeCorrelator.Mutations(activity, w.buildBridgeKeys(ethereumTask)...), nil
 }
 
 // matchStargateTransaction matches the Stargate contract.
@@ -311,6 +322,52 @@ func (w *worker) transformLPoolSwapRemoteLog(ctx context.Context, task *source.T
 	return actions, nil
 }
 
+// buildBridgeKeys returns the keys of the LayerZero packets sent or received in the transaction,
+// which are shared by the transactions of a bridge transfer on the source and destination chains.
+func (w *worker) buildBridgeKeys(task *source.Task) []string {
+	ultraLightNodeAddress, exists := layerzero.UltraLightNodeAddress(task.Network)
+	if !exists {
+		return nil
+	}
+
+	chainID, exists := stargate.ChainID(task.Network)
+	if !exists {
+		return nil
+	}
+
+	keys := make([]string, 0)
+
+	for _, log := range task.Receipt.Logs {
+		if len(log.Topics) == 0 || log.Address != ultraLightNodeAddress {
+			continue
+		}
+
+		var (
+			packet *layerzero.Packet
+			err    error
+		)
+
+		switch log.Topics[0] {
+		case layerzero.EventHashUltraLightNodePacket:
+			packet, err = layerzero.DecodePacket(log)
+		case layerzero.EventHashUltraLightNodePacketReceived:
+			packet, err = layerzero.DecodePacketReceived(log, chainID)
+		default:
+			continue
+		}
+
+		if err != nil {
+			zap.L().Warn("failed to decode LayerZero packet", zap.Error(err), zap.String("task", task.ID()))
+
+			continue
+		}
+
+		keys = append(keys, packet.Key())
+	}
+
+	return keys
+}
+
 // buildTransactionBridgeAction builds the transaction bridge action.
 func (w *worker) buildTransactionBridgeAction(ctx context.Context, chainID uint64, sender, receiver common.Address, source, target network.Network, bridgeAction metadata.TransactionBridgeAction, tokenAddress *common.Address, tokenValue *big.Int, blockNumber *big.Int) (*activityx.Action, error) {
 	tokenMetadata, err := w.tokenClient.Lookup(ctx, chainID, tokenAddress, nil, blockNumber)
@@ -356,11 +413,12 @@ func (w *worker) buildTransactionTransferAction(ctx context.Context, task *sourc
 }
 
 // NewWorker creates a new Stargate worker.
-func NewWorker(config *config.Module) (engine.Worker, error) {
+func NewWorker(config *config.Module, databaseClient database.Client) (engine.Worker, error) {
 	var (
 		err      error
 		instance = worker{
-			config: config,
+			config:           config,
+			bridgeCorrelator: bridge.NewCorrelator(databaseClient),
 		}
 	)
 
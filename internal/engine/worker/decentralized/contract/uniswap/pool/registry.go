@@ -0,0 +1,193 @@
+package pool
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract/uniswap"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+)
+
+// keyCacheSize is the number of the pool keys cached in the memory.
+const keyCacheSize = 8192
+
+// ErrorKeyNotFound means the pool key is neither cached, known to the position manager nor initialized in the pool manager.
+var ErrorKeyNotFound = errors.New("pool key not found")
+
+// Registry is a registry for the keys of the Uniswap v4 pools.
+type Registry interface {
+	Save(ctx context.Context, network network.Network, key *Key) error
+	Lookup(ctx context.Context, network network.Network, positionManager, poolManager common.Address, id common.Hash, blockNumber *big.Int) (*Key, error)
+}
+
+var _ Registry = (*registry)(nil)
+
+// registry caches the pool keys in the memory and the redis, the keys of a pool never change once it is initialized.
+type registry struct {
+	redisClient         rueidis.Client
+	ethereumClient      ethereum.Client
+	poolManagerFilterer *uniswap.V4PoolManagerFilterer
+	keys                *lru.Cache[string, *Key]
+}
+
+// Save stores the pool key in the memory and the redis.
+func (r *registry) Save(ctx context.Context, network network.Network, key *Key) error {
+	r.keys.Add(r.formatKey(network, key.ID), key)
+
+	if r.redisClient == nil {
+		return nil
+	}
+
+	value, err := json.Marshal(key)
+	if err != nil {
+		return fmt.Errorf("marshal pool key: %w", err)
+	}
+
+	command := r.redisClient.B().Set().Key(r.formatKey(network, key.ID)).Value(string(value)).Build()
+
+	if err := r.redisClient.Do(ctx, command).Error(); err != nil {
+		return fmt.Errorf("redis result: %w", err)
+	}
+
+	return nil
+}
+
+// Lookup returns the pool key of the ID from the caches, or from the position manager if it is not cached.
+// The keys of the pools initialized without the position manager are backfilled from the Initialize event of the pool manager.
+func (r *registry) Lookup(ctx context.Context, network network.Network, positionManager, poolManager common.Address, id common.Hash, blockNumber *big.Int) (*Key, error) {
+	if key, found := r.keys.Get(r.formatKey(network, id)); found {
+		return key, nil
+	}
+
+	if r.redisClient != nil {
+		command := r.redisClient.B().Get().Key(r.formatKey(network, id)).Build()
+
+		value, err := r.redisClient.Do(ctx, command).ToString()
+
+		switch {
+		case err == nil:
+			var key Key
+
+			if err := json.Unmarshal([]byte(value), &key); err != nil {
+				return nil, fmt.Errorf("unmarshal pool key: %w", err)
+			}
+
+			r.keys.Add(r.formatKey(network, id), &key)
+
+			return &key, nil
+		case !rueidis.IsRedisNil(err):
+			return nil, fmt.Errorf("redis result: %w", err)
+		}
+	}
+
+	key, err := r.lookupByPositionManager(ctx, positionManager, id, blockNumber)
+	if errors.Is(err, ErrorKeyNotFound) {
+		key, err = r.lookupByPoolManager(ctx, poolManager, id, blockNumber)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.Save(ctx, network, key); err != nil {
+		return nil, fmt.Errorf("save pool key %s: %w", id, err)
+	}
+
+	return key, nil
+}
+
+// lookupByPositionManager returns the pool key of the ID known to the position manager at the block.
+func (r *registry) lookupByPositionManager(ctx context.Context, positionManager common.Address, id common.Hash, blockNumber *big.Int) (*Key, error) {
+	caller, err := uniswap.NewV4PositionManagerCaller(positionManager, r.ethereumClient)
+	if err != nil {
+		return nil, fmt.Errorf("load position manager: %w", err)
+	}
+
+	// The position manager indexes the pool keys by the first 25 bytes of the pool ID.
+	var poolID [25]byte
+
+	copy(poolID[:], id[:len(poolID)])
+
+	result, err := caller.PoolKeys(&bind.CallOpts{Context: ctx, BlockNumber: blockNumber}, poolID)
+	if err != nil {
+		return nil, fmt.Errorf("get pool key %s: %w", id, err)
+	}
+
+	// The currency 1 is never the native token, it is the zero address only if the pool is unknown to the position manager.
+	if result.Currency1 == ethereum.AddressGenesis {
+		return nil, fmt.Errorf("%w: %s", ErrorKeyNotFound, id)
+	}
+
+	key := Key{
+		ID:          id,
+		Currency0:   result.Currency0,
+		Currency1:   result.Currency1,
+		Fee:         result.Fee.Uint64(),
+		TickSpacing: result.TickSpacing.Int64(),
+		Hooks:       result.Hooks,
+	}
+
+	return &key, nil
+}
+
+// lookupByPoolManager returns the pool key of the ID from the Initialize event of the pool manager until the block,
+// the pool ID is the first indexed topic of the event.
+func (r *registry) lookupByPoolManager(ctx context.Context, poolManager common.Address, id common.Hash, blockNumber *big.Int) (*Key, error) {
+	filter := ethereum.Filter{
+		ToBlock:   blockNumber,
+		Addresses: []common.Address{poolManager},
+		Topics: [][]common.Hash{
+			{uniswap.EventHashV4PoolManagerInitialize},
+			{id},
+		},
+	}
+
+	logs, err := r.ethereumClient.FilterLogs(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("filter Initialize logs of pool %s: %w", id, err)
+	}
+
+	if len(logs) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrorKeyNotFound, id)
+	}
+
+	event, err := r.poolManagerFilterer.ParseInitialize(logs[0].Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse Initialize event: %w", err)
+	}
+
+	key := Key{
+		ID:          event.Id,
+		Currency0:   event.Currency0,
+		Currency1:   event.Currency1,
+		Fee:         event.Fee.Uint64(),
+		TickSpacing: event.TickSpacing.Int64(),
+		Hooks:       event.Hooks,
+	}
+
+	return &key, nil
+}
+
+// formatKey formats the key of the pool in the caches.
+func (r *registry) formatKey(network network.Network, id common.Hash) string {
+	return fmt.Sprintf("uniswap:v4:%s:pool:%s", network, id)
+}
+
+// NewRegistry creates a new registry, the pool keys are only cached in the memory if the redis client is nil.
+func NewRegistry(redisClient rueidis.Client, ethereumClient ethereum.Client) Registry {
+	return &registry{
+		redisClient:         redisClient,
+		ethereumClient:      ethereumClient,
+		poolManagerFilterer: lo.Must(uniswap.NewV4PoolManagerFilterer(ethereum.AddressGenesis, nil)),
+		keys:                lo.Must(lru.New[string, *Key](keyCacheSize)),
+	}
+}
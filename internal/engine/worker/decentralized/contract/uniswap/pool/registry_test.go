@@ -0,0 +1,166 @@
+package pool_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/uniswap/pool"
+	"github.com/rss3-network/node/internal/testsuite"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract/uniswap"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	addressUSDC = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	addressHook = common.HexToAddress("0x0000000000000000000000000000000000001040")
+
+	poolKey = pool.Key{
+		ID:          common.HexToHash("0x21c67e77068de97969ba93d4aab21826d33ca12bb9f565d8496e8fda8a82ca27"),
+		Currency0:   ethereum.AddressGenesis,
+		Currency1:   addressUSDC,
+		Fee:         500,
+		TickSpacing: 10,
+		Hooks:       addressHook,
+	}
+)
+
+// newRPCServer starts a local JSON-RPC server serving the poolKeys method of the position manager
+// and the Initialize logs of the pool manager, the number of the requests is counted.
+func newRPCServer(t *testing.T, known, initialized bool) (string, *atomic.Int64) {
+	t.Helper()
+
+	var (
+		requests           atomic.Int64
+		positionManagerABI = lo.Must(uniswap.V4PositionManagerMetaData.GetAbi())
+		poolManagerABI     = lo.Must(uniswap.V4PoolManagerMetaData.GetAbi())
+	)
+
+	callHandler := testsuite.EthereumCallHandler(func(to common.Address, input []byte) ([]byte, error) {
+		if to != uniswap.AddressV4PositionManager {
+			return nil, fmt.Errorf("unsupported call of %s", to)
+		}
+
+		// The position manager returns the empty key of the pools unknown to it.
+		if !known {
+			return positionManagerABI.Methods["poolKeys"].Outputs.Pack(ethereum.AddressGenesis, ethereum.AddressGenesis, big.NewInt(0), big.NewInt(0), ethereum.AddressGenesis)
+		}
+
+		return positionManagerABI.Methods["poolKeys"].Outputs.Pack(poolKey.Currency0, poolKey.Currency1, big.NewInt(int64(poolKey.Fee)), big.NewInt(poolKey.TickSpacing), poolKey.Hooks)
+	})
+
+	endpointURL := testsuite.NewRPCServer(t, func(method string, params []json.RawMessage) (any, error) {
+		requests.Add(1)
+
+		if method != "eth_getLogs" {
+			return callHandler(method, params)
+		}
+
+		var filter struct {
+			Address []common.Address `json:"address"`
+			Topics  [][]common.Hash  `json:"topics"`
+		}
+
+		if err := json.Unmarshal(params[0], &filter); err != nil {
+			return nil, fmt.Errorf("invalid filter: %w", err)
+		}
+
+		if !initialized || len(filter.Topics) < 2 || filter.Topics[1][0] != poolKey.ID {
+			return []*ethereum.Log{}, nil
+		}
+
+		data := lo.Must(poolManagerABI.Events["Initialize"].Inputs.NonIndexed().Pack(
+			big.NewInt(int64(poolKey.Fee)), big.NewInt(poolKey.TickSpacing), poolKey.Hooks, big.NewInt(0), big.NewInt(0),
+		))
+
+		logs := []*ethereum.Log{
+			{
+				Address: filter.Address[0],
+				Topics: []common.Hash{
+					uniswap.EventHashV4PoolManagerInitialize,
+					poolKey.ID,
+					common.BytesToHash(poolKey.Currency0.Bytes()),
+					common.BytesToHash(poolKey.Currency1.Bytes()),
+				},
+				Data:        data,
+				BlockNumber: big.NewInt(21700000),
+			},
+		}
+
+		return logs, nil
+	})
+
+	return endpointURL, &requests
+}
+
+func TestRegistry_Lookup(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		known       bool
+		initialized bool
+		want        *pool.Key
+		wantError   require.ErrorAssertionFunc
+	}{
+		{
+			name:        "Known To The Position Manager",
+			known:       true,
+			initialized: true,
+			want:        &poolKey,
+			wantError:   require.NoError,
+		},
+		{
+			name:        "Initialized Without The Position Manager",
+			initialized: true,
+			want:        &poolKey,
+			wantError:   require.NoError,
+		},
+		{
+			name: "Unknown Pool",
+			wantError: func(t require.TestingT, err error, _ ...interface{}) {
+				require.ErrorIs(t, err, pool.ErrorKeyNotFound)
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			endpointURL, requests := newRPCServer(t, testcase.known, testcase.initialized)
+
+			ethereumClient, err := ethereum.Dial(ctx, endpointURL)
+			require.NoError(t, err)
+
+			registry := pool.NewRegistry(nil, ethereumClient)
+
+			key, err := registry.Lookup(ctx, network.Ethereum, uniswap.AddressV4PositionManager, uniswap.AddressV4PoolManager, poolKey.ID, big.NewInt(21800000))
+			testcase.wantError(t, err)
+			require.Equal(t, testcase.want, key)
+
+			if testcase.want == nil {
+				return
+			}
+
+			// The pool key is cached in the memory without the redis.
+			count := requests.Load()
+
+			key, err = registry.Lookup(ctx, network.Ethereum, uniswap.AddressV4PositionManager, uniswap.AddressV4PoolManager, poolKey.ID, big.NewInt(21800000))
+			require.NoError(t, err)
+			require.Equal(t, testcase.want, key)
+			require.Equal(t, count, requests.Load())
+		})
+	}
+}
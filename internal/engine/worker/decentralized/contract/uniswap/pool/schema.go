@@ -0,0 +1,15 @@
+package pool
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Key is the key of a Uniswap v4 pool, the ID of the pool is the hash of the key.
+type Key struct {
+	ID          common.Hash    `json:"id"`
+	Currency0   common.Address `json:"currency0"`
+	Currency1   common.Address `json:"currency1"`
+	Fee         uint64         `json:"fee"`
+	TickSpacing int64          `json:"tick_spacing"`
+	Hooks       common.Address `json:"hooks"`
+}
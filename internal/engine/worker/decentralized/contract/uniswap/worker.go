@@ -7,13 +7,18 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/redis/rueidis"
 	"github.com/rss3-network/node/config"
 	"github.com/rss3-network/node/internal/engine"
 	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/uniswap/pool"
 	"github.com/rss3-network/node/internal/utils"
 	"github.com/rss3-network/node/provider/ethereum"
 	"github.com/rss3-network/node/provider/ethereum/contract"
+	"github.com/rss3-network/node/provider/ethereum/contract/aerodrome"
+	"github.com/rss3-network/node/provider/ethereum/contract/erc20"
 	"github.com/rss3-network/node/provider/ethereum/contract/erc721"
+	"github.com/rss3-network/node/provider/ethereum/contract/pancakeswap"
 	"github.com/rss3-network/node/provider/ethereum/contract/uniswap"
 	"github.com/rss3-network/node/provider/ethereum/contract/weth"
 	"github.com/rss3-network/node/provider/ethereum/token"
@@ -39,6 +44,11 @@ type worker struct {
 	uniswapV2PairFilterer                     *uniswap.V2PairFilterer
 	uniswapV3PoolFilterer                     *uniswap.V3PoolFilterer
 	uniswapNonfungiblePositionManagerFilterer *uniswap.NonfungiblePositionManagerFilterer
+	uniswapV4PoolManagerFilterer              *uniswap.V4PoolManagerFilterer
+	uniswapV4PoolRegistry                     pool.Registry
+	pancakeswapV3PoolFilterer                 *pancakeswap.V3PoolFilterer
+	aerodromePoolFilterer                     *aerodrome.PoolFilterer
+	erc20Filterer                             *erc20.ERC20Filterer
 	wethWETH9Filterer                         *weth.WETH9Filterer
 }
 
@@ -111,6 +121,11 @@ func (w *worker) Filter() engine.DataSourceFilter {
 			uniswap.EventHashV2PairMint,
 			uniswap.EventHashV2PairBurn,
 			uniswap.EventHashNonfungiblePositionManagerCollect,
+			uniswap.EventHashV4PoolManagerInitialize,
+			uniswap.EventHashV4PoolManagerModifyLiquidity,
+			uniswap.EventHashV4PoolManagerSwap,
+			pancakeswap.EventHashV3PoolSwap,
+			aerodrome.EventHashPoolSwap,
 		},
 	}
 }
@@ -126,6 +141,10 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 		return nil, fmt.Errorf("build activity: %w", err)
 	}
 
+	// The pool keys are cached from the Initialize events of any transaction,
+	// since the position manager only knows the pools initialized through it.
+	w.saveV4PoolKeys(ctx, ethereumTask)
+
 	switch {
 	case w.matchSwapTransaction(ethereumTask, ethereumTask.Transaction):
 		activity.Type = typex.ExchangeSwap
@@ -137,6 +156,15 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 		return nil, nil
 	}
 
+	if len(activity.Actions) == 0 {
+		zap.L().Debug("no actions generated for task", zap.String("task_id", task.ID()))
+
+		return nil, nil
+	}
+
+	// The activity is attributed to the fork of the router or the position manager.
+	activity.Platform = w.getPlatform(ethereumTask).String()
+
 	return activity, nil
 }
 
@@ -163,7 +191,21 @@ func (w *worker) matchSwapTransaction(task *source.Task, transaction *ethereum.T
 		uniswap.AddressUniversalRouter01Base,
 		uniswap.AddressUniversalRouter01BinanceSmartChain,
 		uniswap.AddressUniversalRouter01Avalanche,
-		uniswap.AddressUniversalRouter02:
+		uniswap.AddressUniversalRouter02,
+		// Uniswap V4
+		uniswap.AddressUniversalRouterV4,
+		uniswap.AddressUniversalRouterV4Arbitrum,
+		uniswap.AddressUniversalRouterV4Optimism,
+		uniswap.AddressUniversalRouterV4Polygon,
+		uniswap.AddressUniversalRouterV4Base,
+		uniswap.AddressUniversalRouterV4BinanceSmartChain,
+		uniswap.AddressUniversalRouterV4Avalanche,
+		// Forks
+		aerodrome.AddressRouter,
+		aerodrome.AddressCLSwapRouter,
+		aerodrome.AddressVelodromeRouter,
+		aerodrome.AddressVelodromeCLSwapRouter,
+		pancakeswap.AddressV3SmartRouter:
 		return true
 	case // Uniswap V2
 		uniswap.AddressV2SwapRouter01,
@@ -212,7 +254,19 @@ func (w *worker) matchLiquidityTransaction(task *source.Task, transaction *ether
 		uniswap.AddressNonfungiblePositionManagerBase,
 		uniswap.AddressNonfungiblePositionManagerBinanceSmartChain,
 		uniswap.AddressNonfungiblePositionManagerAvalanche,
-		uniswap.AddressNonfungiblePositionManagerLinea:
+		uniswap.AddressNonfungiblePositionManagerLinea,
+		// Uniswap V4
+		uniswap.AddressV4PositionManager,
+		uniswap.AddressV4PositionManagerArbitrum,
+		uniswap.AddressV4PositionManagerOptimism,
+		uniswap.AddressV4PositionManagerPolygon,
+		uniswap.AddressV4PositionManagerBase,
+		uniswap.AddressV4PositionManagerBinanceSmartChain,
+		uniswap.AddressV4PositionManagerAvalanche,
+		// Forks
+		aerodrome.AddressCLNonfungiblePositionManager,
+		aerodrome.AddressVelodromeCLNonfungiblePositionManager,
+		pancakeswap.AddressNonfungiblePositionManager:
 		return true
 	case // Uniswap V2
 		uniswap.AddressV2SwapRouter01,
@@ -246,12 +300,11 @@ func (w *worker) matchLiquidityTransaction(task *source.Task, transaction *ether
 }
 
 func (w *worker) matchNonfungiblePositionManagerTransferLog(task *source.Task, log *ethereum.Log) bool {
-	managerAddress, err := w.getV3NonfungiblePositionManagerAddress(task.Network)
-	if err != nil {
+	if _, err := w.getNonfungiblePositionManagerPlatform(task.Network, log.Address); err != nil {
 		return false
 	}
 
-	if log.Address != managerAddress || len(log.Topics) == 0 || log.Topics[0] != erc721.EventHashTransfer {
+	if len(log.Topics) == 0 || log.Topics[0] != erc721.EventHashTransfer {
 		return false
 	}
 
@@ -265,7 +318,10 @@ func (w *worker) matchNonfungiblePositionManagerTransferLog(task *source.Task, l
 }
 
 func (w *worker) transformSwapTransaction(ctx context.Context, task *source.Task, _ *ethereum.Transaction) (actions []*activityx.Action) {
-	var err error
+	var (
+		err      error
+		platform = w.getPlatform(task)
+	)
 
 	for _, log := range task.Receipt.Logs {
 		if len(log.Topics) == 0 {
@@ -280,13 +336,19 @@ func (w *worker) transformSwapTransaction(ctx context.Context, task *source.Task
 		case uniswap.EventHashV1ExchangeEthPurchase:
 			buffer, err = w.transformV1EthPurchaseLog(ctx, task, log)
 		case uniswap.EventHashV2PairSwap:
-			buffer, err = w.transformV2SwapLog(ctx, task, log)
+			buffer, err = w.transformV2SwapLog(ctx, task, platform, log)
 		case uniswap.EventHashV3PoolSwap:
 			buffer, err = w.transformV3SwapLog(ctx, task, log)
+		case uniswap.EventHashV4PoolManagerSwap:
+			buffer, err = w.transformV4SwapLog(ctx, task, log)
+		case pancakeswap.EventHashV3PoolSwap:
+			buffer, err = w.transformPancakeSwapV3SwapLog(ctx, task, log)
+		case aerodrome.EventHashPoolSwap:
+			buffer, err = w.transformAerodromeSwapLog(ctx, task, log)
 		case weth.EventHashDeposit:
-			buffer, err = w.transformWETHDepositLog(ctx, task, log)
+			buffer, err = w.transformWETHDepositLog(ctx, task, platform, log)
 		case weth.EventHashWithdrawal:
-			buffer, err = w.transformWETHWithdrawalLog(ctx, task, log)
+			buffer, err = w.transformWETHWithdrawalLog(ctx, task, platform, log)
 		default:
 			continue
 		}
@@ -328,6 +390,8 @@ func (w *worker) transformLiquidityTransaction(ctx context.Context, task *source
 			buffer, err = w.transformNonfungiblePositionManagerDecreaseLiquidityLog(ctx, task, log)
 		case uniswap.EventHashNonfungiblePositionManagerCollect:
 			buffer, err = w.transformNonfungiblePositionManagerCollectLog(ctx, task, log)
+		case uniswap.EventHashV4PoolManagerModifyLiquidity:
+			buffer, err = w.transformV4ModifyLiquidityLog(ctx, task, log)
 		case erc721.EventHashTransfer:
 			if !w.matchNonfungiblePositionManagerTransferLog(task, log) {
 				continue
@@ -370,7 +434,7 @@ func (w *worker) transformV1TokenPurchaseLog(ctx context.Context, task *source.T
 		return nil, fmt.Errorf("unofficial v1 exchange: %s", event.Raw.Address)
 	}
 
-	action, err := w.buildExchangeSwapAction(ctx, task, task.Transaction.From, event.Buyer, nil, &tokenAddr, event.EthSold, event.TokensBought)
+	action, err := w.buildExchangeSwapAction(ctx, task, decentralized.PlatformUniswap, task.Transaction.From, event.Buyer, nil, &tokenAddr, event.EthSold, event.TokensBought)
 	if err != nil {
 		return nil, fmt.Errorf("build exchange swap action: %w", err)
 	}
@@ -402,7 +466,7 @@ func (w *worker) transformV1EthPurchaseLog(ctx context.Context, task *source.Tas
 		return nil, fmt.Errorf("unofficial v1 exhcange: %s", event.Raw.Address)
 	}
 
-	action, err := w.buildExchangeSwapAction(ctx, task, task.Transaction.From, event.Buyer, &tokenAddr, nil, event.TokensSold, event.EthBought)
+	action, err := w.buildExchangeSwapAction(ctx, task, decentralized.PlatformUniswap, task.Transaction.From, event.Buyer, &tokenAddr, nil, event.TokensSold, event.EthBought)
 	if err != nil {
 		return nil, fmt.Errorf("build exchange swap action: %w", err)
 	}
@@ -444,7 +508,7 @@ func (w *worker) transformV1ExchangeAddLiquidityLog(ctx context.Context, task *s
 		},
 	}
 
-	action, err := w.buildExchangeLiquidityAction(ctx, task, event.Provider, event.Raw.Address, metadata.ActionExchangeLiquidityAdd, tokens)
+	action, err := w.buildExchangeLiquidityAction(ctx, task, decentralized.PlatformUniswap, event.Provider, event.Raw.Address, metadata.ActionExchangeLiquidityAdd, tokens)
 	if err != nil {
 		return nil, fmt.Errorf("build exchange liquidity action: %w", err)
 	}
@@ -488,7 +552,7 @@ func (w *worker) transformV1ExchangeRemoveLiquidityLog(ctx context.Context, task
 		},
 	}
 
-	action, err := w.buildExchangeLiquidityAction(ctx, task, event.Raw.Address, event.Provider, metadata.ActionExchangeLiquidityRemove, tokens)
+	action, err := w.buildExchangeLiquidityAction(ctx, task, decentralized.PlatformUniswap, event.Raw.Address, event.Provider, metadata.ActionExchangeLiquidityRemove, tokens)
 	if err != nil {
 		return nil, fmt.Errorf("build exchange liquidity action: %w", err)
 	}
@@ -500,7 +564,7 @@ func (w *worker) transformV1ExchangeRemoveLiquidityLog(ctx context.Context, task
 	return actions, nil
 }
 
-func (w *worker) transformV2SwapLog(ctx context.Context, task *source.Task, log *ethereum.Log) ([]*activityx.Action, error) {
+func (w *worker) transformV2SwapLog(ctx context.Context, task *source.Task, platform decentralized.Platform, log *ethereum.Log) ([]*activityx.Action, error) {
 	event, err := w.uniswapV2PairFilterer.ParseSwap(log.Export())
 	if err != nil {
 		return nil, fmt.Errorf("parse Swap event: %w", err)
@@ -537,14 +601,14 @@ func (w *worker) transformV2SwapLog(ctx context.Context, task *source.Task, log
 
 	// nolint:revive // False positive
 	if event.Amount1Out.Sign() == 1 {
-		action, err := w.buildExchangeSwapAction(ctx, task, sender, receiver, &tokenLeft, &tokenRight, event.Amount0In, event.Amount1Out)
+		action, err := w.buildExchangeSwapAction(ctx, task, platform, sender, receiver, &tokenLeft, &tokenRight, event.Amount0In, event.Amount1Out)
 		if err != nil {
 			return nil, fmt.Errorf("build exchange swap action: %w", err)
 		}
 
 		actions = append(actions, action)
 	} else {
-		action, err := w.buildExchangeSwapAction(ctx, task, sender, receiver, &tokenRight, &tokenLeft, event.Amount1In, event.Amount0Out)
+		action, err := w.buildExchangeSwapAction(ctx, task, platform, sender, receiver, &tokenRight, &tokenLeft, event.Amount1In, event.Amount0Out)
 		if err != nil {
 			return nil, fmt.Errorf("build exchange swap action: %w", err)
 		}
@@ -606,7 +670,7 @@ func (w *worker) transformV2PairMintLog(ctx context.Context, task *source.Task,
 		},
 	}
 
-	action, err := w.buildExchangeLiquidityAction(ctx, task, event.Sender, event.Raw.Address, metadata.ActionExchangeLiquidityAdd, tokens)
+	action, err := w.buildExchangeLiquidityAction(ctx, task, decentralized.PlatformUniswap, event.Sender, event.Raw.Address, metadata.ActionExchangeLiquidityAdd, tokens)
 	if err != nil {
 		return nil, fmt.Errorf("build exchange liquidity action: %w", err)
 	}
@@ -669,7 +733,7 @@ func (w *worker) transformV2PairBurnLog(ctx context.Context, task *source.Task,
 		},
 	}
 
-	action, err := w.buildExchangeLiquidityAction(ctx, task, event.Raw.Address, task.Transaction.From, metadata.ActionExchangeLiquidityRemove, tokens)
+	action, err := w.buildExchangeLiquidityAction(ctx, task, decentralized.PlatformUniswap, event.Raw.Address, task.Transaction.From, metadata.ActionExchangeLiquidityRemove, tokens)
 	if err != nil {
 		return nil, fmt.Errorf("build exchange liquidity action: %w", err)
 	}
@@ -702,22 +766,139 @@ func (w *worker) transformV3SwapLog(ctx context.Context, task *source.Task, log
 		return nil, fmt.Errorf("get token 1 from pool: %w", err)
 	}
 
+	platform, err := w.validateV3Pool(task.Network, event.Raw.Address, tokenLeft, tokenRight)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, receiver := w.getSwapParticipants(task, uniswap.EventHashV3PoolSwap, event.Sender, event.Recipient, event.Raw.Index)
+
+	var actions []*activityx.Action
+
+	// nolint:revive // False positive
+	if event.Amount0.Sign() == 1 { // Amount0 is positive
+		action, err := w.buildExchangeSwapAction(ctx, task, platform, sender, receiver, &tokenLeft, &tokenRight, event.Amount0, event.Amount1)
+		if err != nil {
+			return nil, fmt.Errorf("build exchange swap action: %w", err)
+		}
+
+		actions = append(actions, action)
+	} else {
+		action, err := w.buildExchangeSwapAction(ctx, task, platform, sender, receiver, &tokenRight, &tokenLeft, event.Amount1, event.Amount0)
+
+		if err != nil {
+			return nil, fmt.Errorf("build exchange swap action: %w", err)
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions, nil
+}
+
+func (w *worker) transformPancakeSwapV3SwapLog(ctx context.Context, task *source.Task, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.pancakeswapV3PoolFilterer.ParseSwap(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse Swap event: %w", err)
+	}
+
+	pool, err := pancakeswap.NewV3PoolCaller(event.Raw.Address, w.ethereumClient)
+	if err != nil {
+		return nil, fmt.Errorf("load pool: %w", err)
+	}
+
+	tokenLeft, err := pool.Token0(nil)
+	if err != nil {
+		return nil, fmt.Errorf("get token 0 from pool: %w", err)
+	}
+
+	tokenRight, err := pool.Token1(nil)
+	if err != nil {
+		return nil, fmt.Errorf("get token 1 from pool: %w", err)
+	}
+
 	fee, err := pool.Fee(nil)
 	if err != nil {
 		return nil, fmt.Errorf("get fee from pool: %w", err)
 	}
 
-	factoryAddress, err := w.getV3FactoryAddress(task.Network)
+	factory, err := pancakeswap.NewV3FactoryCaller(pancakeswap.AddressV3Factory, w.ethereumClient)
 	if err != nil {
-		return nil, fmt.Errorf("get factory address: %w", err)
+		return nil, fmt.Errorf("load factory: %w", err)
+	}
+
+	poolAddress, err := factory.GetPool(nil, tokenLeft, tokenRight, fee)
+	if err != nil {
+		return nil, fmt.Errorf("get pool address from factory: %w", err)
+	}
+
+	if poolAddress != event.Raw.Address {
+		return nil, fmt.Errorf("unofficial pool address: %s", event.Raw.Address)
+	}
+
+	sender, receiver := w.getSwapParticipants(task, pancakeswap.EventHashV3PoolSwap, event.Sender, event.Recipient, event.Raw.Index)
+
+	// The positive amount is paid to the pool.
+	tokenIn, tokenOut, amountIn, amountOut := &tokenLeft, &tokenRight, event.Amount0, event.Amount1
+
+	if event.Amount0.Sign() != 1 {
+		tokenIn, tokenOut, amountIn, amountOut = &tokenRight, &tokenLeft, event.Amount1, event.Amount0
 	}
 
-	factory, err := uniswap.NewV3FactoryCaller(factoryAddress, w.ethereumClient)
+	action, err := w.buildExchangeSwapAction(ctx, task, decentralized.PlatformPancakeSwap, sender, receiver, tokenIn, tokenOut, amountIn, amountOut)
+	if err != nil {
+		return nil, fmt.Errorf("build exchange swap action: %w", err)
+	}
+
+	actions := []*activityx.Action{
+		action,
+	}
+
+	return actions, nil
+}
+
+func (w *worker) transformAerodromeSwapLog(ctx context.Context, task *source.Task, log *ethereum.Log) ([]*activityx.Action, error) {
+	event, err := w.aerodromePoolFilterer.ParseSwap(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse Swap event: %w", err)
+	}
+
+	pool, err := aerodrome.NewPoolCaller(event.Raw.Address, w.ethereumClient)
+	if err != nil {
+		return nil, fmt.Errorf("load pool: %w", err)
+	}
+
+	factoryAddress, err := pool.Factory(nil)
+	if err != nil {
+		return nil, fmt.Errorf("get factory from pool: %w", err)
+	}
+
+	platform, ok := w.getPoolFactoryPlatform(task.Network, factoryAddress)
+	if !ok {
+		return nil, fmt.Errorf("unofficial pool address: %s", event.Raw.Address)
+	}
+
+	tokenLeft, err := pool.Token0(nil)
+	if err != nil {
+		return nil, fmt.Errorf("get token 0 from pool: %w", err)
+	}
+
+	tokenRight, err := pool.Token1(nil)
+	if err != nil {
+		return nil, fmt.Errorf("get token 1 from pool: %w", err)
+	}
+
+	stable, err := pool.Stable(nil)
+	if err != nil {
+		return nil, fmt.Errorf("get stable from pool: %w", err)
+	}
+
+	factory, err := aerodrome.NewPoolFactoryCaller(factoryAddress, w.ethereumClient)
 	if err != nil {
 		return nil, fmt.Errorf("load factory: %w", err)
 	}
 
-	poolAddress, err := factory.GetPool(nil, tokenLeft, tokenRight, fee)
+	poolAddress, err := factory.GetPool(nil, tokenLeft, tokenRight, stable)
 	if err != nil {
 		return nil, fmt.Errorf("get pool address from factory: %w", err)
 	}
@@ -726,39 +907,164 @@ func (w *worker) transformV3SwapLog(ctx context.Context, task *source.Task, log
 		return nil, fmt.Errorf("unofficial pool address: %s", event.Raw.Address)
 	}
 
-	sender, receiver := event.Sender, event.Recipient
+	sender, receiver := w.getSwapParticipants(task, aerodrome.EventHashPoolSwap, event.Sender, event.To, event.Raw.Index)
 
-	switch {
-	case event.Sender == *task.Transaction.To && event.Recipient == *task.Transaction.To:
-		isNotFirstSwapEvent := lo.ContainsBy(task.Receipt.Logs, func(log *ethereum.Log) bool {
-			return len(log.Topics) != 0 && contract.MatchEventHashes(log.Topics[0], uniswap.EventHashV3PoolSwap) && log.Index < event.Raw.Index
-		})
+	tokenIn, tokenOut, amountIn, amountOut := &tokenLeft, &tokenRight, event.Amount0In, event.Amount1Out
 
-		if !isNotFirstSwapEvent {
-			sender = task.Transaction.From
-		}
-	case event.Sender == *task.Transaction.To:
-		sender = task.Transaction.From
+	if event.Amount1Out.Sign() != 1 {
+		tokenIn, tokenOut, amountIn, amountOut = &tokenRight, &tokenLeft, event.Amount1In, event.Amount0Out
 	}
 
-	var actions []*activityx.Action
+	action, err := w.buildExchangeSwapAction(ctx, task, platform, sender, receiver, tokenIn, tokenOut, amountIn, amountOut)
+	if err != nil {
+		return nil, fmt.Errorf("build exchange swap action: %w", err)
+	}
 
-	// nolint:revive // False positive
-	if event.Amount0.Sign() == 1 { // Amount0 is positive
-		action, err := w.buildExchangeSwapAction(ctx, task, sender, receiver, &tokenLeft, &tokenRight, event.Amount0, event.Amount1)
-		if err != nil {
-			return nil, fmt.Errorf("build exchange swap action: %w", err)
+	actions := []*activityx.Action{
+		action,
+	}
+
+	return actions, nil
+}
+
+func (w *worker) transformV4SwapLog(ctx context.Context, task *source.Task, log *ethereum.Log) ([]*activityx.Action, error) {
+	if err := w.validateV4PoolManager(task.Network, log.Address); err != nil {
+		return nil, err
+	}
+
+	event, err := w.uniswapV4PoolManagerFilterer.ParseSwap(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse Swap event: %w", err)
+	}
+
+	poolKey, err := w.lookupV4PoolKey(ctx, task, event.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	currencyLeft, currencyRight := w.getV4Currency(poolKey.Currency0), w.getV4Currency(poolKey.Currency1)
+
+	// The amounts are the balance deltas of the swapper, the negative amount is paid to the pool.
+	tokenIn, tokenOut, amountIn, amountOut := currencyLeft, currencyRight, event.Amount0, event.Amount1
+
+	if event.Amount0.Sign() != -1 {
+		tokenIn, tokenOut, amountIn, amountOut = currencyRight, currencyLeft, event.Amount1, event.Amount0
+	}
+
+	// The sender of the event is the router, which settles the currencies with the transaction sender.
+	action, err := w.buildExchangeSwapAction(ctx, task, decentralized.PlatformUniswap, task.Transaction.From, task.Transaction.From, tokenIn, tokenOut, amountIn, amountOut)
+	if err != nil {
+		return nil, fmt.Errorf("build exchange swap action: %w", err)
+	}
+
+	actions := []*activityx.Action{
+		action,
+	}
+
+	return actions, nil
+}
+
+// saveV4PoolKeys saves the pool keys of the Initialize events for the swaps and the liquidity of the pools.
+func (w *worker) saveV4PoolKeys(ctx context.Context, task *source.Task) {
+	for _, log := range task.Receipt.Logs {
+		if len(log.Topics) == 0 || log.Topics[0] != uniswap.EventHashV4PoolManagerInitialize {
+			continue
 		}
 
-		actions = append(actions, action)
-	} else {
-		action, err := w.buildExchangeSwapAction(ctx, task, sender, receiver, &tokenRight, &tokenLeft, event.Amount1, event.Amount0)
+		if err := w.transformV4InitializeLog(ctx, task, log); err != nil {
+			zap.L().Error("handle ethereum pool initialization", zap.Error(err), zap.String("task", task.ID()))
+		}
+	}
+}
 
-		if err != nil {
-			return nil, fmt.Errorf("build exchange swap action: %w", err)
+// transformV4InitializeLog saves the pool key for the swaps and the liquidity of the pool, the initialization has no action.
+func (w *worker) transformV4InitializeLog(ctx context.Context, task *source.Task, log *ethereum.Log) error {
+	poolKey, err := w.parseV4InitializeLog(task, log)
+	if err != nil {
+		return err
+	}
+
+	if err := w.uniswapV4PoolRegistry.Save(ctx, task.Network, poolKey); err != nil {
+		return fmt.Errorf("save pool key %s: %w", poolKey.ID, err)
+	}
+
+	return nil
+}
+
+// parseV4InitializeLog returns the pool key of the Initialize event.
+func (w *worker) parseV4InitializeLog(task *source.Task, log *ethereum.Log) (*pool.Key, error) {
+	if err := w.validateV4PoolManager(task.Network, log.Address); err != nil {
+		return nil, err
+	}
+
+	event, err := w.uniswapV4PoolManagerFilterer.ParseInitialize(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse Initialize event: %w", err)
+	}
+
+	poolKey := pool.Key{
+		ID:          event.Id,
+		Currency0:   event.Currency0,
+		Currency1:   event.Currency1,
+		Fee:         event.Fee.Uint64(),
+		TickSpacing: event.TickSpacing.Int64(),
+		Hooks:       event.Hooks,
+	}
+
+	return &poolKey, nil
+}
+
+func (w *worker) transformV4ModifyLiquidityLog(ctx context.Context, task *source.Task, log *ethereum.Log) ([]*activityx.Action, error) {
+	if err := w.validateV4PoolManager(task.Network, log.Address); err != nil {
+		return nil, err
+	}
+
+	event, err := w.uniswapV4PoolManagerFilterer.ParseModifyLiquidity(log.Export())
+	if err != nil {
+		return nil, fmt.Errorf("parse ModifyLiquidity event: %w", err)
+	}
+
+	poolKey, err := w.lookupV4PoolKey(ctx, task, event.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		liquidityAction  metadata.ExchangeLiquidityAction
+		sender, receiver = event.Raw.Address, task.Transaction.From
+	)
+
+	switch event.LiquidityDelta.Sign() {
+	case 1:
+		liquidityAction = metadata.ActionExchangeLiquidityAdd
+		sender, receiver = task.Transaction.From, event.Raw.Address
+	case -1:
+		liquidityAction = metadata.ActionExchangeLiquidityRemove
+	default: // The fees are collected by modifying the position with zero liquidity.
+		liquidityAction = metadata.ActionExchangeLiquidityCollect
+	}
+
+	tokens := make([]metadata.Token, 0, 2)
+
+	for _, currency := range []common.Address{poolKey.Currency0, poolKey.Currency1} {
+		token := metadata.Token{
+			Value: w.sumV4Transfers(task, event.Raw.Address, currency, liquidityAction == metadata.ActionExchangeLiquidityAdd),
 		}
 
-		actions = append(actions, action)
+		if address := w.getV4Currency(currency); address != nil {
+			token.Address = lo.ToPtr(address.String())
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	action, err := w.buildExchangeLiquidityAction(ctx, task, decentralized.PlatformUniswap, sender, receiver, liquidityAction, tokens)
+	if err != nil {
+		return nil, fmt.Errorf("build exchange liquidity action: %w", err)
+	}
+
+	actions := []*activityx.Action{
+		action,
 	}
 
 	return actions, nil
@@ -770,12 +1076,12 @@ func (w *worker) transformNonfungiblePositionManagerIncreaseLiquidityLog(ctx con
 		return nil, fmt.Errorf("parse IncreaseLiquidity event: %w", err)
 	}
 
-	managerAddress, err := w.getV3NonfungiblePositionManagerAddress(task.Network)
+	platform, err := w.getNonfungiblePositionManagerPlatform(task.Network, event.Raw.Address)
 	if err != nil {
-		return nil, fmt.Errorf("get nonfungible position manager address: %w", err)
+		return nil, fmt.Errorf("get nonfungible position manager platform: %w", err)
 	}
 
-	nonfungiblePositionManager, err := uniswap.NewNonfungiblePositionManagerCaller(managerAddress, w.ethereumClient)
+	nonfungiblePositionManager, err := uniswap.NewNonfungiblePositionManagerCaller(event.Raw.Address, w.ethereumClient)
 	if err != nil {
 		return nil, fmt.Errorf("load nonfungible position manager: %w", err)
 	}
@@ -796,7 +1102,7 @@ func (w *worker) transformNonfungiblePositionManagerIncreaseLiquidityLog(ctx con
 		},
 	}
 
-	action, err := w.buildExchangeLiquidityAction(ctx, task, task.Transaction.From, event.Raw.Address, metadata.ActionExchangeLiquidityAdd, tokens)
+	action, err := w.buildExchangeLiquidityAction(ctx, task, platform, task.Transaction.From, event.Raw.Address, metadata.ActionExchangeLiquidityAdd, tokens)
 	if err != nil {
 		return nil, fmt.Errorf("build exchange liquidity action: %w", err)
 	}
@@ -814,12 +1120,12 @@ func (w *worker) transformNonfungiblePositionManagerDecreaseLiquidityLog(ctx con
 		return nil, fmt.Errorf("parse DecreaseLiquidity event: %w", err)
 	}
 
-	managerAddress, err := w.getV3NonfungiblePositionManagerAddress(task.Network)
+	platform, err := w.getNonfungiblePositionManagerPlatform(task.Network, event.Raw.Address)
 	if err != nil {
-		return nil, fmt.Errorf("get nonfungible position manager address: %w", err)
+		return nil, fmt.Errorf("get nonfungible position manager platform: %w", err)
 	}
 
-	nonfungiblePositionManager, err := uniswap.NewNonfungiblePositionManagerCaller(managerAddress, w.ethereumClient)
+	nonfungiblePositionManager, err := uniswap.NewNonfungiblePositionManagerCaller(event.Raw.Address, w.ethereumClient)
 	if err != nil {
 		return nil, fmt.Errorf("load nonfungible position manager: %w", err)
 	}
@@ -840,7 +1146,7 @@ func (w *worker) transformNonfungiblePositionManagerDecreaseLiquidityLog(ctx con
 		},
 	}
 
-	action, err := w.buildExchangeLiquidityAction(ctx, task, event.Raw.Address, task.Transaction.From, metadata.ActionExchangeLiquidityRemove, tokens)
+	action, err := w.buildExchangeLiquidityAction(ctx, task, platform, event.Raw.Address, task.Transaction.From, metadata.ActionExchangeLiquidityRemove, tokens)
 	if err != nil {
 		return nil, fmt.Errorf("build exchange liquidity action: %w", err)
 	}
@@ -858,12 +1164,12 @@ func (w *worker) transformNonfungiblePositionManagerCollectLog(ctx context.Conte
 		return nil, fmt.Errorf("parse Collect event: %w", err)
 	}
 
-	managerAddress, err := w.getV3NonfungiblePositionManagerAddress(task.Network)
+	platform, err := w.getNonfungiblePositionManagerPlatform(task.Network, event.Raw.Address)
 	if err != nil {
-		return nil, fmt.Errorf("get nonfungible position manager address: %w", err)
+		return nil, fmt.Errorf("get nonfungible position manager platform: %w", err)
 	}
 
-	nonfungiblePositionManager, err := uniswap.NewNonfungiblePositionManagerCaller(managerAddress, w.ethereumClient)
+	nonfungiblePositionManager, err := uniswap.NewNonfungiblePositionManagerCaller(event.Raw.Address, w.ethereumClient)
 	if err != nil {
 		return nil, fmt.Errorf("load nonfungible position manager: %w", err)
 	}
@@ -884,7 +1190,7 @@ func (w *worker) transformNonfungiblePositionManagerCollectLog(ctx context.Conte
 		},
 	}
 
-	action, err := w.buildExchangeLiquidityAction(ctx, task, event.Raw.Address, task.Transaction.From, metadata.ActionExchangeLiquidityCollect, tokens)
+	action, err := w.buildExchangeLiquidityAction(ctx, task, platform, event.Raw.Address, task.Transaction.From, metadata.ActionExchangeLiquidityCollect, tokens)
 	if err != nil {
 		return nil, fmt.Errorf("build exchange liquidity action: %w", err)
 	}
@@ -902,7 +1208,12 @@ func (w *worker) transformNonfungiblePositionManagerTransferLog(ctx context.Cont
 		return nil, fmt.Errorf("parse Transfer event: %w", err)
 	}
 
-	action, err := w.buildTransactionMintAction(ctx, task, event.From, event.To, event.Raw.Address, event.TokenId)
+	platform, err := w.getNonfungiblePositionManagerPlatform(task.Network, event.Raw.Address)
+	if err != nil {
+		return nil, fmt.Errorf("get nonfungible position manager platform: %w", err)
+	}
+
+	action, err := w.buildTransactionMintAction(ctx, task, platform, event.From, event.To, event.Raw.Address, event.TokenId)
 	if err != nil {
 		return nil, fmt.Errorf("build transaction mint action: %w", err)
 	}
@@ -914,7 +1225,7 @@ func (w *worker) transformNonfungiblePositionManagerTransferLog(ctx context.Cont
 	return actions, nil
 }
 
-func (w *worker) buildExchangeSwapAction(ctx context.Context, task *source.Task, sender, receipt common.Address, tokenIn, tokenOut *common.Address, amountIn, amountOut *big.Int) (*activityx.Action, error) {
+func (w *worker) buildExchangeSwapAction(ctx context.Context, task *source.Task, platform decentralized.Platform, sender, receipt common.Address, tokenIn, tokenOut *common.Address, amountIn, amountOut *big.Int) (*activityx.Action, error) {
 	tokenInMetadata, err := w.tokenClient.Lookup(ctx, task.ChainID, tokenIn, nil, task.Header.Number)
 	if err != nil {
 		return nil, fmt.Errorf("lookup token metadata %s: %w", tokenIn, err)
@@ -931,7 +1242,7 @@ func (w *worker) buildExchangeSwapAction(ctx context.Context, task *source.Task,
 
 	action := activityx.Action{
 		Type:     typex.ExchangeSwap,
-		Platform: w.Platform(),
+		Platform: platform.String(),
 		From:     sender.String(),
 		To:       receipt.String(),
 		Metadata: metadata.ExchangeSwap{
@@ -943,7 +1254,7 @@ func (w *worker) buildExchangeSwapAction(ctx context.Context, task *source.Task,
 	return &action, nil
 }
 
-func (w *worker) transformWETHDepositLog(ctx context.Context, task *source.Task, log *ethereum.Log) ([]*activityx.Action, error) {
+func (w *worker) transformWETHDepositLog(ctx context.Context, task *source.Task, platform decentralized.Platform, log *ethereum.Log) ([]*activityx.Action, error) {
 	if !weth.IsWETH(task.ChainID, log.Address) {
 		return nil, fmt.Errorf("unofficial weth contract: %s", log.Address)
 	}
@@ -953,7 +1264,7 @@ func (w *worker) transformWETHDepositLog(ctx context.Context, task *source.Task,
 		return nil, fmt.Errorf("parse Deposit event: %w", err)
 	}
 
-	action, err := w.buildExchangeSwapAction(ctx, task, task.Transaction.From, event.Dst, nil, &log.Address, event.Wad, event.Wad)
+	action, err := w.buildExchangeSwapAction(ctx, task, platform, task.Transaction.From, event.Dst, nil, &log.Address, event.Wad, event.Wad)
 	if err != nil {
 		return nil, fmt.Errorf("build exchange swap action: %w", err)
 	}
@@ -965,7 +1276,7 @@ func (w *worker) transformWETHDepositLog(ctx context.Context, task *source.Task,
 	return actions, nil
 }
 
-func (w *worker) transformWETHWithdrawalLog(ctx context.Context, task *source.Task, log *ethereum.Log) ([]*activityx.Action, error) {
+func (w *worker) transformWETHWithdrawalLog(ctx context.Context, task *source.Task, platform decentralized.Platform, log *ethereum.Log) ([]*activityx.Action, error) {
 	if !weth.IsWETH(task.ChainID, log.Address) {
 		return nil, fmt.Errorf("unofficial weth contract: %s", log.Address)
 	}
@@ -975,7 +1286,7 @@ func (w *worker) transformWETHWithdrawalLog(ctx context.Context, task *source.Ta
 		return nil, fmt.Errorf("parse Withdrawal event: %w", err)
 	}
 
-	action, err := w.buildExchangeSwapAction(ctx, task, event.Src, task.Transaction.From, &log.Address, nil, event.Wad, event.Wad)
+	action, err := w.buildExchangeSwapAction(ctx, task, platform, event.Src, task.Transaction.From, &log.Address, nil, event.Wad, event.Wad)
 	if err != nil {
 		return nil, fmt.Errorf("build exchange swap action: %w", err)
 	}
@@ -987,7 +1298,7 @@ func (w *worker) transformWETHWithdrawalLog(ctx context.Context, task *source.Ta
 	return actions, nil
 }
 
-func (w *worker) buildExchangeLiquidityAction(ctx context.Context, task *source.Task, sender, receipt common.Address, liquidityAction metadata.ExchangeLiquidityAction, tokens []metadata.Token) (*activityx.Action, error) {
+func (w *worker) buildExchangeLiquidityAction(ctx context.Context, task *source.Task, platform decentralized.Platform, sender, receipt common.Address, liquidityAction metadata.ExchangeLiquidityAction, tokens []metadata.Token) (*activityx.Action, error) {
 	tokenMetadataSlice := make([]metadata.Token, 0, len(tokens))
 
 	for _, t := range tokens {
@@ -1008,7 +1319,7 @@ func (w *worker) buildExchangeLiquidityAction(ctx context.Context, task *source.
 
 	action := activityx.Action{
 		Type:     typex.ExchangeLiquidity,
-		Platform: w.Platform(),
+		Platform: platform.String(),
 		From:     sender.String(),
 		To:       receipt.String(),
 		Metadata: metadata.ExchangeLiquidity{
@@ -1020,7 +1331,7 @@ func (w *worker) buildExchangeLiquidityAction(ctx context.Context, task *source.
 	return &action, nil
 }
 
-func (w *worker) buildTransactionMintAction(ctx context.Context, task *source.Task, sender, receipt, tokenAddress common.Address, tokenID *big.Int) (*activityx.Action, error) {
+func (w *worker) buildTransactionMintAction(ctx context.Context, task *source.Task, platform decentralized.Platform, sender, receipt, tokenAddress common.Address, tokenID *big.Int) (*activityx.Action, error) {
 	tokenMetadata, err := w.tokenClient.Lookup(ctx, task.ChainID, &tokenAddress, tokenID, task.Header.Number)
 	if err != nil {
 		return nil, fmt.Errorf("lookup token metadata %s %d: %w", tokenAddress, tokenID, err)
@@ -1031,7 +1342,7 @@ func (w *worker) buildTransactionMintAction(ctx context.Context, task *source.Ta
 
 	action := activityx.Action{
 		Type:     typex.TransactionMint,
-		Platform: w.Platform(),
+		Platform: platform.String(),
 		From:     sender.String(),
 		To:       receipt.String(),
 		Metadata: metadata.TransactionTransfer(*tokenMetadata),
@@ -1040,6 +1351,246 @@ func (w *worker) buildTransactionMintAction(ctx context.Context, task *source.Ta
 	return &action, nil
 }
 
+// getPlatform returns the platform of the router or the position manager the transaction is sent to.
+func (w *worker) getPlatform(task *source.Task) decentralized.Platform {
+	if task.Transaction.To == nil {
+		return decentralized.PlatformUniswap
+	}
+
+	switch {
+	case task.Network == network.Base && lo.Contains([]common.Address{
+		aerodrome.AddressRouter,
+		aerodrome.AddressCLSwapRouter,
+		aerodrome.AddressCLNonfungiblePositionManager,
+	}, *task.Transaction.To):
+		return decentralized.PlatformAerodrome
+	case task.Network == network.Optimism && lo.Contains([]common.Address{
+		aerodrome.AddressVelodromeRouter,
+		aerodrome.AddressVelodromeCLSwapRouter,
+		aerodrome.AddressVelodromeCLNonfungiblePositionManager,
+	}, *task.Transaction.To):
+		return decentralized.PlatformVelodrome
+	case lo.Contains([]common.Address{
+		pancakeswap.AddressV3SmartRouter,
+		pancakeswap.AddressNonfungiblePositionManager,
+	}, *task.Transaction.To):
+		return decentralized.PlatformPancakeSwap
+	default:
+		return decentralized.PlatformUniswap
+	}
+}
+
+// getSwapParticipants returns the sender and the receiver of a swap, the router is replaced with the transaction sender.
+func (w *worker) getSwapParticipants(task *source.Task, eventHash common.Hash, sender, receiver common.Address, index uint) (common.Address, common.Address) {
+	switch {
+	case sender == *task.Transaction.To && receiver == *task.Transaction.To:
+		isNotFirstSwapEvent := lo.ContainsBy(task.Receipt.Logs, func(log *ethereum.Log) bool {
+			return len(log.Topics) != 0 && contract.MatchEventHashes(log.Topics[0], eventHash) && log.Index < index
+		})
+
+		if !isNotFirstSwapEvent {
+			sender = task.Transaction.From
+		}
+	case sender == *task.Transaction.To:
+		sender = task.Transaction.From
+	}
+
+	return sender, receiver
+}
+
+// validateV3Pool returns the platform of the pool, the Slipstream pools of Aerodrome and Velodrome share the events of Uniswap v3.
+func (w *worker) validateV3Pool(n network.Network, poolAddress, tokenLeft, tokenRight common.Address) (decentralized.Platform, error) {
+	pool, err := uniswap.NewV3PoolCaller(poolAddress, w.ethereumClient)
+	if err != nil {
+		return decentralized.PlatformUnknown, fmt.Errorf("load pool: %w", err)
+	}
+
+	factoryAddress, err := pool.Factory(nil)
+	if err != nil {
+		return decentralized.PlatformUnknown, fmt.Errorf("get factory from pool: %w", err)
+	}
+
+	if platform, ok := w.getCLFactoryPlatform(n, factoryAddress); ok {
+		clPool, err := aerodrome.NewCLPoolCaller(poolAddress, w.ethereumClient)
+		if err != nil {
+			return decentralized.PlatformUnknown, fmt.Errorf("load cl pool: %w", err)
+		}
+
+		tickSpacing, err := clPool.TickSpacing(nil)
+		if err != nil {
+			return decentralized.PlatformUnknown, fmt.Errorf("get tick spacing from pool: %w", err)
+		}
+
+		factory, err := aerodrome.NewCLFactoryCaller(factoryAddress, w.ethereumClient)
+		if err != nil {
+			return decentralized.PlatformUnknown, fmt.Errorf("load cl factory: %w", err)
+		}
+
+		address, err := factory.GetPool(nil, tokenLeft, tokenRight, tickSpacing)
+		if err != nil {
+			return decentralized.PlatformUnknown, fmt.Errorf("get pool address from cl factory: %w", err)
+		}
+
+		if address != poolAddress {
+			return decentralized.PlatformUnknown, fmt.Errorf("unofficial pool address: %s", poolAddress)
+		}
+
+		return platform, nil
+	}
+
+	fee, err := pool.Fee(nil)
+	if err != nil {
+		return decentralized.PlatformUnknown, fmt.Errorf("get fee from pool: %w", err)
+	}
+
+	officialFactoryAddress, err := w.getV3FactoryAddress(n)
+	if err != nil {
+		return decentralized.PlatformUnknown, fmt.Errorf("get factory address: %w", err)
+	}
+
+	factory, err := uniswap.NewV3FactoryCaller(officialFactoryAddress, w.ethereumClient)
+	if err != nil {
+		return decentralized.PlatformUnknown, fmt.Errorf("load factory: %w", err)
+	}
+
+	address, err := factory.GetPool(nil, tokenLeft, tokenRight, fee)
+	if err != nil {
+		return decentralized.PlatformUnknown, fmt.Errorf("get pool address from factory: %w", err)
+	}
+
+	if address != poolAddress {
+		return decentralized.PlatformUnknown, fmt.Errorf("unofficial pool address: %s", poolAddress)
+	}
+
+	return decentralized.PlatformUniswap, nil
+}
+
+// validateV4PoolManager checks if the address is the singleton pool manager of the network.
+func (w *worker) validateV4PoolManager(n network.Network, address common.Address) error {
+	poolManagerAddress, err := w.getV4PoolManagerAddress(n)
+	if err != nil {
+		return fmt.Errorf("get pool manager address: %w", err)
+	}
+
+	if address != poolManagerAddress {
+		return fmt.Errorf("unofficial pool manager address: %s", address)
+	}
+
+	return nil
+}
+
+// lookupV4PoolKey returns the pool key of the Initialize event in the same transaction, or from the registry.
+func (w *worker) lookupV4PoolKey(ctx context.Context, task *source.Task, id common.Hash) (*pool.Key, error) {
+	for _, log := range task.Receipt.Logs {
+		if len(log.Topics) < 2 || log.Topics[0] != uniswap.EventHashV4PoolManagerInitialize || log.Topics[1] != id {
+			continue
+		}
+
+		return w.parseV4InitializeLog(task, log)
+	}
+
+	positionManagerAddress, err := w.getV4PositionManagerAddress(task.Network)
+	if err != nil {
+		return nil, fmt.Errorf("get position manager address: %w", err)
+	}
+
+	poolManagerAddress, err := w.getV4PoolManagerAddress(task.Network)
+	if err != nil {
+		return nil, fmt.Errorf("get pool manager address: %w", err)
+	}
+
+	poolKey, err := w.uniswapV4PoolRegistry.Lookup(ctx, task.Network, positionManagerAddress, poolManagerAddress, id, task.Header.Number)
+	if err != nil {
+		return nil, fmt.Errorf("lookup pool key %s: %w", id, err)
+	}
+
+	return poolKey, nil
+}
+
+// getV4Currency returns the address of the currency, the native token is represented by the zero address in Uniswap v4.
+func (w *worker) getV4Currency(currency common.Address) *common.Address {
+	if currency == ethereum.AddressGenesis {
+		return nil
+	}
+
+	return &currency
+}
+
+// sumV4Transfers returns the amount of the currency transferred to or from the pool manager,
+// the native token is transferred without logs, so the amount of it is unknown.
+func (w *worker) sumV4Transfers(task *source.Task, poolManager, currency common.Address, deposit bool) *decimal.Decimal {
+	if currency == ethereum.AddressGenesis {
+		return nil
+	}
+
+	amount := decimal.Zero
+
+	for _, log := range task.Receipt.Logs {
+		if log.Address != currency || len(log.Topics) != 3 || !contract.MatchEventHashes(log.Topics[0], erc20.EventHashTransfer) {
+			continue
+		}
+
+		event, err := w.erc20Filterer.ParseTransfer(log.Export())
+		if err != nil {
+			continue
+		}
+
+		if (deposit && event.To == poolManager) || (!deposit && event.From == poolManager) {
+			amount = amount.Add(decimal.NewFromBigInt(event.Value, 0))
+		}
+	}
+
+	return &amount
+}
+
+// getNonfungiblePositionManagerPlatform returns the platform of the nonfungible position manager,
+// the position managers of PancakeSwap v3 and Slipstream share the events and the positions of Uniswap v3.
+func (w *worker) getNonfungiblePositionManagerPlatform(n network.Network, address common.Address) (decentralized.Platform, error) {
+	if managerAddress, err := w.getV3NonfungiblePositionManagerAddress(n); err == nil && address == managerAddress {
+		return decentralized.PlatformUniswap, nil
+	}
+
+	// The position manager of Uniswap v4 only mints the positions.
+	if managerAddress, err := w.getV4PositionManagerAddress(n); err == nil && address == managerAddress {
+		return decentralized.PlatformUniswap, nil
+	}
+
+	switch {
+	case n == network.Base && address == aerodrome.AddressCLNonfungiblePositionManager:
+		return decentralized.PlatformAerodrome, nil
+	case n == network.Optimism && address == aerodrome.AddressVelodromeCLNonfungiblePositionManager:
+		return decentralized.PlatformVelodrome, nil
+	case address == pancakeswap.AddressNonfungiblePositionManager:
+		return decentralized.PlatformPancakeSwap, nil
+	default:
+		return decentralized.PlatformUnknown, fmt.Errorf("unofficial nonfungible position manager: %s", address)
+	}
+}
+
+// getCLFactoryPlatform returns the platform of the Slipstream factory.
+func (w *worker) getCLFactoryPlatform(n network.Network, address common.Address) (decentralized.Platform, bool) {
+	switch {
+	case n == network.Base && address == aerodrome.AddressCLFactory:
+		return decentralized.PlatformAerodrome, true
+	case n == network.Optimism && address == aerodrome.AddressVelodromeCLFactory:
+		return decentralized.PlatformVelodrome, true
+	default:
+		return decentralized.PlatformUnknown, false
+	}
+}
+
+// getPoolFactoryPlatform returns the platform of the factory of the stable and volatile pools.
+func (w *worker) getPoolFactoryPlatform(n network.Network, address common.Address) (decentralized.Platform, bool) {
+	switch {
+	case n == network.Base && address == aerodrome.AddressPoolFactory:
+		return decentralized.PlatformAerodrome, true
+	case n == network.Optimism && address == aerodrome.AddressVelodromePoolFactory:
+		return decentralized.PlatformVelodrome, true
+	default:
+		return decentralized.PlatformUnknown, false
+	}
+}
+
 func (w *worker) getV3NonfungiblePositionManagerAddress(n network.Network) (common.Address, error) {
 	switch n {
 	case network.Ethereum:
@@ -1097,12 +1648,58 @@ func (w *worker) getV2FactoryAddress(n network.Network) (common.Address, error)
 	}
 }
 
-func NewWorker(config *config.Module) (engine.Worker, error) {
+func (w *worker) getV4PoolManagerAddress(n network.Network) (common.Address, error) {
+	switch n {
+	case network.Ethereum:
+		return uniswap.AddressV4PoolManager, nil
+	case network.Arbitrum:
+		return uniswap.AddressV4PoolManagerArbitrum, nil
+	case network.Optimism:
+		return uniswap.AddressV4PoolManagerOptimism, nil
+	case network.Polygon:
+		return uniswap.AddressV4PoolManagerPolygon, nil
+	case network.Base:
+		return uniswap.AddressV4PoolManagerBase, nil
+	case network.BinanceSmartChain:
+		return uniswap.AddressV4PoolManagerBinanceSmartChain, nil
+	case network.Avalanche:
+		return uniswap.AddressV4PoolManagerAvalanche, nil
+	default:
+		return ethereum.AddressGenesis, fmt.Errorf("unsupported network: %s", n)
+	}
+}
+
+func (w *worker) getV4PositionManagerAddress(n network.Network) (common.Address, error) {
+	switch n {
+	case network.Ethereum:
+		return uniswap.AddressV4PositionManager, nil
+	case network.Arbitrum:
+		return uniswap.AddressV4PositionManagerArbitrum, nil
+	case network.Optimism:
+		return uniswap.AddressV4PositionManagerOptimism, nil
+	case network.Polygon:
+		return uniswap.AddressV4PositionManagerPolygon, nil
+	case network.Base:
+		return uniswap.AddressV4PositionManagerBase, nil
+	case network.BinanceSmartChain:
+		return uniswap.AddressV4PositionManagerBinanceSmartChain, nil
+	case network.Avalanche:
+		return uniswap.AddressV4PositionManagerAvalanche, nil
+	default:
+		return ethereum.AddressGenesis, fmt.Errorf("unsupported network: %s", n)
+	}
+}
+
+func NewWorker(config *config.Module, redisClient rueidis.Client) (engine.Worker, error) {
 	instance := worker{
 		uniswapV1ExchangeFilterer:                 lo.Must(uniswap.NewV1ExchangeFilterer(ethereum.AddressGenesis, nil)),
 		uniswapV2PairFilterer:                     lo.Must(uniswap.NewV2PairFilterer(ethereum.AddressGenesis, nil)),
 		uniswapV3PoolFilterer:                     lo.Must(uniswap.NewV3PoolFilterer(ethereum.AddressGenesis, nil)),
 		uniswapNonfungiblePositionManagerFilterer: lo.Must(uniswap.NewNonfungiblePositionManagerFilterer(ethereum.AddressGenesis, nil)),
+		uniswapV4PoolManagerFilterer:              lo.Must(uniswap.NewV4PoolManagerFilterer(ethereum.AddressGenesis, nil)),
+		pancakeswapV3PoolFilterer:                 lo.Must(pancakeswap.NewV3PoolFilterer(ethereum.AddressGenesis, nil)),
+		aerodromePoolFilterer:                     lo.Must(aerodrome.NewPoolFilterer(ethereum.AddressGenesis, nil)),
+		erc20Filterer:                             lo.Must(erc20.NewERC20Filterer(ethereum.AddressGenesis, nil)),
 		wethWETH9Filterer:                         lo.Must(weth.NewWETH9Filterer(ethereum.AddressGenesis, nil)),
 	}
 
@@ -1113,6 +1710,7 @@ func NewWorker(config *config.Module) (engine.Worker, error) {
 	}
 
 	instance.tokenClient = token.NewClient(instance.ethereumClient)
+	instance.uniswapV4PoolRegistry = pool.NewRegistry(redisClient, instance.ethereumClient)
 
 	return &instance, nil
 }
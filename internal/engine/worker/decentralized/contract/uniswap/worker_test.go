@@ -2,15 +2,21 @@ package uniswap_test
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/rss3-network/node/config"
 	source "github.com/rss3-network/node/internal/engine/protocol/ethereum"
 	worker "github.com/rss3-network/node/internal/engine/worker/decentralized/contract/uniswap"
+	"github.com/rss3-network/node/internal/testsuite"
 	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract/aerodrome"
+	"github.com/rss3-network/node/provider/ethereum/contract/erc20"
+	"github.com/rss3-network/node/provider/ethereum/contract/pancakeswap"
 	"github.com/rss3-network/node/provider/ethereum/contract/uniswap"
 	"github.com/rss3-network/node/provider/ethereum/endpoint"
 	workerx "github.com/rss3-network/node/schema/worker/decentralized"
@@ -23,6 +29,95 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// The transactions of the Uniswap v4, PancakeSwap v3 and Aerodrome test cases are built for the tests,
+// and the calls of the pools and the tokens are served by a local JSON-RPC server.
+var (
+	addressPancakeSwapPool = common.HexToAddress("0x36696169C63e42cd08ce11f5deeBbCeBae652050")
+	addressAerodromePool   = common.HexToAddress("0xcDAC0d6c6C59727a65F871236188350531885C43")
+
+	addressUSDC     = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	addressUSDCBase = common.HexToAddress("0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913")
+	addressUSDTBSC  = common.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+	addressWBNB     = common.HexToAddress("0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c")
+	addressWETHBase = common.HexToAddress("0x4200000000000000000000000000000000000006")
+)
+
+// buildContractCalls returns the results of the contract calls by the contract address and the method name.
+func buildContractCalls() map[common.Address]map[string][]any {
+	calls := map[common.Address]map[string][]any{
+		uniswap.AddressV4PositionManager: {
+			"poolKeys": {ethereum.AddressGenesis, addressUSDC, big.NewInt(500), big.NewInt(10), ethereum.AddressGenesis},
+		},
+		addressPancakeSwapPool: {
+			"token0": {addressUSDTBSC},
+			"token1": {addressWBNB},
+			"fee":    {big.NewInt(500)},
+		},
+		pancakeswap.AddressV3Factory: {
+			"getPool": {addressPancakeSwapPool},
+		},
+		addressAerodromePool: {
+			"factory": {aerodrome.AddressPoolFactory},
+			"token0":  {addressWETHBase},
+			"token1":  {addressUSDCBase},
+			"stable":  {false},
+		},
+		aerodrome.AddressPoolFactory: {
+			"getPool": {addressAerodromePool},
+		},
+	}
+
+	tokens := map[common.Address][]any{
+		addressUSDC:     {"USD Coin", "USDC", uint8(6)},
+		addressUSDCBase: {"USD Coin", "USDC", uint8(6)},
+		addressUSDTBSC:  {"Tether USD", "USDT", uint8(18)},
+		addressWBNB:     {"Wrapped BNB", "WBNB", uint8(18)},
+		addressWETHBase: {"Wrapped Ether", "WETH", uint8(18)},
+	}
+
+	for address, token := range tokens {
+		calls[address] = map[string][]any{
+			"name":     {token[0]},
+			"symbol":   {token[1]},
+			"decimals": {token[2]},
+		}
+	}
+
+	return calls
+}
+
+// callContract serves the calls of the pools and the tokens with the results of the contract calls.
+func callContract(to common.Address, input []byte) ([]byte, error) {
+	var (
+		contractCalls = buildContractCalls()
+		contractABIs  = []*abi.ABI{
+			lo.Must(erc20.ERC20MetaData.GetAbi()),
+			lo.Must(uniswap.V4PositionManagerMetaData.GetAbi()),
+			lo.Must(pancakeswap.V3PoolMetaData.GetAbi()),
+			lo.Must(pancakeswap.V3FactoryMetaData.GetAbi()),
+			lo.Must(aerodrome.PoolMetaData.GetAbi()),
+			lo.Must(aerodrome.PoolFactoryMetaData.GetAbi()),
+		}
+	)
+
+	if len(input) < 4 {
+		return nil, fmt.Errorf("invalid input %s", hexutil.Encode(input))
+	}
+
+	for _, contractABI := range contractABIs {
+		method, err := contractABI.MethodById(input[:4])
+		if err != nil {
+			continue
+		}
+
+		if result, exists := contractCalls[to][method.Name]; exists {
+			return method.Outputs.Pack(result...)
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported call %s of %s", hexutil.Encode(input[:4]), to)
+}
+
 func TestWorker_Ethereum(t *testing.T) {
 	t.Parallel()
 
@@ -2121,8 +2216,418 @@ func TestWorker_Ethereum(t *testing.T) {
 			},
 			wantError: require.NoError,
 		},
+		{
+			name: "Swap ETH for USDC on Uniswap V4 Pool Manager",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						UncleHash:    common.HexToHash("0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"),
+						Number:       lo.Must(new(big.Int).SetString("21700000", 0)),
+						GasLimit:     30000000,
+						GasUsed:      15000000,
+						Timestamp:    1737500000,
+						BaseFee:      lo.Must(new(big.Int).SetString("1000000", 0)),
+						Transactions: nil,
+					},
+					Transaction: &ethereum.Transaction{
+						From:    common.HexToAddress("0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91"),
+						Gas:     300000,
+						Hash:    common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+						Input:   hexutil.MustDecode("0x3593564c0000000000000000000000000000000000000000000000000000000000000000"),
+						To:      lo.ToPtr(uniswap.AddressUniversalRouterV4),
+						Value:   lo.Must(new(big.Int).SetString("1000000000000000000", 0)),
+						Type:    2,
+						ChainID: lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockNumber:       lo.Must(new(big.Int).SetString("21700000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x2540be400"),
+						GasUsed:           150000,
+						Logs: []*ethereum.Log{{
+							Address: uniswap.AddressV4PoolManager,
+							Topics: []common.Hash{
+								common.HexToHash("0x40e9cecb9f5f1f1c5b9c97dec2917b7ee92e57ba5563708daca94dd84ad7112f"),
+								common.HexToHash("0x21c67e77068de97969ba93d4aab21826d33ca12bb9f565d8496e8fda8a82ca27"),
+								common.HexToHash("0x00000000000000000000000066a9893cc07d91d95644aedd05d03f95e1dba8af"),
+							},
+							Data:            hexutil.MustDecode("0xfffffffffffffffffffffffffffffffffffffffffffffffff21f494c589c00000000000000000000000000000000000000000000000000000000000095bf5a4e0000000000000000000000000000000000000000000348f4175cb36d6efb4fcf0000000000000000000000000000000000000000000000001ea20dbab13f190ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffcf8e900000000000000000000000000000000000000000000000000000000000001f4"),
+							BlockNumber:     lo.Must(new(big.Int).SetString("21700000", 0)),
+							TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+							Index:           100,
+							Removed:         false,
+						}},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+						TransactionIndex: 42,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0000000000000000000000000000000000000000000000000000000000000001",
+				Network: network.Ethereum,
+				Index:   42,
+				From:    "0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91",
+				To:      uniswap.AddressUniversalRouterV4.String(),
+				Type:    typex.ExchangeSwap,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x3593564c",
+				},
+				Platform: workerx.PlatformUniswap.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("1500000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeSwap,
+						Platform: workerx.PlatformUniswap.String(),
+						From:     "0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91",
+						To:       "0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91",
+						Metadata: metadata.ExchangeSwap{
+							From: metadata.Token{
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("1000000000000000000"))),
+								Name:     "Ethereum",
+								Symbol:   "ETH",
+								Decimals: 18,
+							},
+							To: metadata.Token{
+								Address:  lo.ToPtr("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("2512345678"))),
+								Name:     "USD Coin",
+								Symbol:   "USDC",
+								Decimals: 6,
+								Standard: metadata.StandardERC20,
+							},
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1737500000,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Add ETH and USDC liquidity to Uniswap V4 Pool Manager",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Ethereum,
+					ChainID: 1,
+					Header: &ethereum.Header{
+						UncleHash:    common.HexToHash("0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"),
+						Number:       lo.Must(new(big.Int).SetString("21700100", 0)),
+						GasLimit:     30000000,
+						GasUsed:      15000000,
+						Timestamp:    1737501200,
+						BaseFee:      lo.Must(new(big.Int).SetString("1000000", 0)),
+						Transactions: nil,
+					},
+					Transaction: &ethereum.Transaction{
+						From:    common.HexToAddress("0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91"),
+						Gas:     300000,
+						Hash:    common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+						Input:   hexutil.MustDecode("0xdd46508f0000000000000000000000000000000000000000000000000000000000000000"),
+						To:      lo.ToPtr(uniswap.AddressV4PositionManager),
+						Value:   lo.Must(new(big.Int).SetString("1000000000000000000", 0)),
+						Type:    2,
+						ChainID: lo.Must(new(big.Int).SetString("1", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockNumber:       lo.Must(new(big.Int).SetString("21700100", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x2540be400"),
+						GasUsed:           150000,
+						Logs: []*ethereum.Log{{
+							Address: common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+							Topics: []common.Hash{
+								common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+								common.HexToHash("0x0000000000000000000000003a0c5d1c4ea3d2b4cf4f6d63e42cd3e5aa7e2b91"),
+								common.HexToHash("0x000000000000000000000000000000000004444c5dc75cb358380d2e3de08a90"),
+							},
+							Data:            hexutil.MustDecode("0x000000000000000000000000000000000000000000000000000000009502f900"),
+							BlockNumber:     lo.Must(new(big.Int).SetString("21700100", 0)),
+							TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+							Index:           100,
+							Removed:         false,
+						}, {
+							Address: uniswap.AddressV4PoolManager,
+							Topics: []common.Hash{
+								common.HexToHash("0xf208f4912782fd25c7f114ca3723a2d5dd6f3bcc3ac8db5af63baa85f711d5ec"),
+								common.HexToHash("0x21c67e77068de97969ba93d4aab21826d33ca12bb9f565d8496e8fda8a82ca27"),
+								common.HexToHash("0x000000000000000000000000bd216513d74c8cf14cf4747e6aaa6420ff64ee9e"),
+							},
+							Data:            hexutil.MustDecode("0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffffffcf6a8fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffcfe78000000000000000000000000000000000000000000000000007058bf6e27a751000000000000000000000000000000000000000000000000000000000000002a"),
+							BlockNumber:     lo.Must(new(big.Int).SetString("21700100", 0)),
+							TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+							Index:           101,
+							Removed:         false,
+						}},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+						TransactionIndex: 42,
+					},
+				},
+				config: &config.Module{
+					Network: network.Ethereum,
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0000000000000000000000000000000000000000000000000000000000000002",
+				Network: network.Ethereum,
+				Index:   42,
+				From:    "0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91",
+				To:      uniswap.AddressV4PositionManager.String(),
+				Type:    typex.ExchangeLiquidity,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xdd46508f",
+				},
+				Platform: workerx.PlatformUniswap.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("1500000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeLiquidity,
+						Platform: workerx.PlatformUniswap.String(),
+						From:     "0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91",
+						To:       uniswap.AddressV4PoolManager.String(),
+						Metadata: metadata.ExchangeLiquidity{
+							Action: metadata.ActionExchangeLiquidityAdd,
+							Tokens: []metadata.Token{
+								{
+									Name:     "Ethereum",
+									Symbol:   "ETH",
+									Decimals: 18,
+								},
+								{
+									Address:  lo.ToPtr("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+									Value:    lo.ToPtr(lo.Must(decimal.NewFromString("2500000000"))),
+									Name:     "USD Coin",
+									Symbol:   "USDC",
+									Decimals: 6,
+									Standard: metadata.StandardERC20,
+								},
+							},
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1737501200,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Swap WBNB for USDT on PancakeSwap V3 Pool",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.BinanceSmartChain,
+					ChainID: 56,
+					Header: &ethereum.Header{
+						UncleHash:    common.HexToHash("0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"),
+						Number:       lo.Must(new(big.Int).SetString("45600000", 0)),
+						GasLimit:     30000000,
+						GasUsed:      15000000,
+						Timestamp:    1737500000,
+						BaseFee:      lo.Must(new(big.Int).SetString("1000000", 0)),
+						Transactions: nil,
+					},
+					Transaction: &ethereum.Transaction{
+						From:     common.HexToAddress("0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91"),
+						Gas:      300000,
+						GasPrice: lo.Must(new(big.Int).SetString("1000000000", 0)),
+						Hash:     common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000003"),
+						Input:    hexutil.MustDecode("0x04e45aaf0000000000000000000000000000000000000000000000000000000000000000"),
+						To:       lo.ToPtr(pancakeswap.AddressV3SmartRouter),
+						Value:    lo.Must(new(big.Int).SetString("0", 0)),
+						Type:     0,
+						ChainID:  lo.Must(new(big.Int).SetString("56", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockNumber:       lo.Must(new(big.Int).SetString("45600000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x3b9aca00"),
+						GasUsed:           150000,
+						Logs: []*ethereum.Log{{
+							Address: common.HexToAddress("0x36696169C63e42cd08ce11f5deeBbCeBae652050"),
+							Topics: []common.Hash{
+								common.HexToHash("0x19b47279256b2a23a1665c810c8d55a1758940ee09377d4f8d26497a3577dc83"),
+								common.HexToHash("0x00000000000000000000000013f4ea83d0bd40e75c8222255bc855a974568dd4"),
+								common.HexToHash("0x0000000000000000000000003a0c5d1c4ea3d2b4cf4f6d63e42cd3e5aa7e2b91"),
+							},
+							Data:            hexutil.MustDecode("0xfffffffffffffffffffffffffffffffffffffffffffffeba11f143cfdd20f52e0000000000000000000000000000000000000000000000008ac7230489e8000000000000000000000000000000000000000000000a76424ae479d2b73b9ee37a00000000000000000000000000000000000000000000137772fe430077cc62e3ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0c0d000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000012309ce540000"),
+							BlockNumber:     lo.Must(new(big.Int).SetString("45600000", 0)),
+							TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000003"),
+							Index:           100,
+							Removed:         false,
+						}},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000003"),
+						TransactionIndex: 42,
+					},
+				},
+				config: &config.Module{
+					Network: network.BinanceSmartChain,
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0000000000000000000000000000000000000000000000000000000000000003",
+				Network: network.BinanceSmartChain,
+				Index:   42,
+				From:    "0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91",
+				To:      pancakeswap.AddressV3SmartRouter.String(),
+				Type:    typex.ExchangeSwap,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0x04e45aaf",
+				},
+				Platform: workerx.PlatformPancakeSwap.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("150000000000000")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeSwap,
+						Platform: workerx.PlatformPancakeSwap.String(),
+						From:     "0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91",
+						To:       "0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91",
+						Metadata: metadata.ExchangeSwap{
+							From: metadata.Token{
+								Address:  lo.ToPtr("0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c"),
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("10000000000000000000"))),
+								Name:     "Wrapped BNB",
+								Symbol:   "WBNB",
+								Decimals: 18,
+								Standard: metadata.StandardERC20,
+							},
+							To: metadata.Token{
+								Address:  lo.ToPtr("0x55d398326f99059fF775485246999027B3197955"),
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("6012345678901234567890"))),
+								Name:     "Tether USD",
+								Symbol:   "USDT",
+								Decimals: 18,
+								Standard: metadata.StandardERC20,
+							},
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1737500000,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Swap WETH for USDC on Aerodrome Pool",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Base,
+					ChainID: 8453,
+					Header: &ethereum.Header{
+						UncleHash:    common.HexToHash("0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"),
+						Number:       lo.Must(new(big.Int).SetString("25000000", 0)),
+						GasLimit:     30000000,
+						GasUsed:      15000000,
+						Timestamp:    1737500000,
+						BaseFee:      lo.Must(new(big.Int).SetString("1000000", 0)),
+						Transactions: nil,
+					},
+					Transaction: &ethereum.Transaction{
+						From:    common.HexToAddress("0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91"),
+						Gas:     300000,
+						Hash:    common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000004"),
+						Input:   hexutil.MustDecode("0xcac88ea90000000000000000000000000000000000000000000000000000000000000000"),
+						To:      lo.ToPtr(aerodrome.AddressRouter),
+						Value:   lo.Must(new(big.Int).SetString("0", 0)),
+						Type:    2,
+						ChainID: lo.Must(new(big.Int).SetString("8453", 0)),
+					},
+					Receipt: &ethereum.Receipt{
+						BlockNumber:       lo.Must(new(big.Int).SetString("25000000", 0)),
+						ContractAddress:   nil,
+						CumulativeGasUsed: 5000000,
+						EffectiveGasPrice: hexutil.MustDecodeBig("0x989680"),
+						L1Fee:             lo.Must(new(big.Int).SetString("1234567890", 0)),
+						GasUsed:           150000,
+						Logs: []*ethereum.Log{{
+							Address: common.HexToAddress("0xcDAC0d6c6C59727a65F871236188350531885C43"),
+							Topics: []common.Hash{
+								common.HexToHash("0xb3e2773606abfd36b5bd91394b3a54d1398336c65005baf7bf7a05efeffaf75b"),
+								common.HexToHash("0x000000000000000000000000cf77a3ba9a5ca399b7c97c74d54e5b1beb874e43"),
+								common.HexToHash("0x0000000000000000000000003a0c5d1c4ea3d2b4cf4f6d63e42cd3e5aa7e2b91"),
+							},
+							Data:            hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000de0b6b3a764000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000009599ad3f"),
+							BlockNumber:     lo.Must(new(big.Int).SetString("25000000", 0)),
+							TransactionHash: common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000004"),
+							Index:           100,
+							Removed:         false,
+						}},
+						Status:           1,
+						TransactionHash:  common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000004"),
+						TransactionIndex: 42,
+					},
+				},
+				config: &config.Module{
+					Network: network.Base,
+				},
+			},
+			want: &activityx.Activity{
+				ID:      "0x0000000000000000000000000000000000000000000000000000000000000004",
+				Network: network.Base,
+				Index:   42,
+				From:    "0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91",
+				To:      aerodrome.AddressRouter.String(),
+				Type:    typex.ExchangeSwap,
+				Calldata: &activityx.Calldata{
+					FunctionHash: "0xcac88ea9",
+				},
+				Platform: workerx.PlatformAerodrome.String(),
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("1501234567890")),
+					Decimal: 18,
+				},
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.ExchangeSwap,
+						Platform: workerx.PlatformAerodrome.String(),
+						From:     "0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91",
+						To:       "0x3A0C5D1C4eA3d2b4cF4F6d63e42cd3E5aA7E2b91",
+						Metadata: metadata.ExchangeSwap{
+							From: metadata.Token{
+								Address:  lo.ToPtr("0x4200000000000000000000000000000000000006"),
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("1000000000000000000"))),
+								Name:     "Wrapped Ether",
+								Symbol:   "WETH",
+								Decimals: 18,
+								Standard: metadata.StandardERC20,
+							},
+							To: metadata.Token{
+								Address:  lo.ToPtr("0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913"),
+								Value:    lo.ToPtr(lo.Must(decimal.NewFromString("2509876543"))),
+								Name:     "USD Coin",
+								Symbol:   "USDC",
+								Decimals: 6,
+								Standard: metadata.StandardERC20,
+							},
+						},
+					},
+				},
+				Status:    true,
+				Timestamp: 1737500000,
+			},
+			wantError: require.NoError,
+		},
 	}
 
+	endpointURL := testsuite.NewEthereumRPCServer(t, callContract)
+
 	for _, testcase := range testcases {
 		testcase := testcase
 
@@ -2131,7 +2636,13 @@ func TestWorker_Ethereum(t *testing.T) {
 
 			ctx := context.Background()
 
-			instance, err := worker.NewWorker(testcase.arguments.config)
+			// The test cases without an endpoint are served by the local JSON-RPC server.
+			module := *testcase.arguments.config
+			if module.Endpoint.URL == "" {
+				module.Endpoint = config.Endpoint{URL: endpointURL}
+			}
+
+			instance, err := worker.NewWorker(&module, nil)
 			require.NoError(t, err)
 
 			activity, err := instance.Transform(ctx, testcase.arguments.task)
@@ -0,0 +1,294 @@
+package bitcoin
+
+import (
+	"context"
+	"fmt"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/engine"
+	source "github.com/rss3-network/node/internal/engine/protocol/bitcoin"
+	"github.com/rss3-network/node/provider/bitcoin"
+	workerx "github.com/rss3-network/node/schema/worker/decentralized"
+	"github.com/rss3-network/protocol-go/schema"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+const (
+	// transactionCacheSize is the number of transactions cached to resolve the prevouts, outputs are usually spent soon.
+	transactionCacheSize = 10_000
+	// inscriptionURLPrefix is the prefix of the URL of the content of an inscription.
+	inscriptionURLPrefix = "https://ordinals.com/content/"
+)
+
+var _ engine.Worker = (*worker)(nil)
+
+type worker struct {
+	config        *config.Module
+	bitcoinClient bitcoin.Client
+	transactions  *lru.Cache[string, *bitcoin.Transaction]
+}
+
+func (w *worker) Name() string {
+	return workerx.Core.String()
+}
+
+func (w *worker) Platform() string {
+	return ""
+}
+
+func (w *worker) Network() []network.Network {
+	return []network.Network{
+		network.Bitcoin,
+	}
+}
+
+func (w *worker) Tags() []tag.Tag {
+	return []tag.Tag{
+		tag.Transaction,
+		tag.Collectible,
+	}
+}
+
+func (w *worker) Types() []schema.Type {
+	return []schema.Type{
+		typex.TransactionTransfer,
+		typex.CollectibleMint,
+	}
+}
+
+// Filter returns a protocol filter.
+func (w *worker) Filter() engine.DataSourceFilter {
+	return nil
+}
+
+// Transform returns an activity with the actions of the task.
+func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	// Cast the task to a Bitcoin task.
+	bitcoinTask, ok := task.(*source.Task)
+	if !ok {
+		return nil, fmt.Errorf("invalid task type: %T", task)
+	}
+
+	// Build the activity.
+	activity, err := task.BuildActivity()
+	if err != nil {
+		return nil, fmt.Errorf("build activity: %w", err)
+	}
+
+	transaction := bitcoinTask.Transaction
+
+	prevouts, err := w.resolvePrevouts(ctx, transaction)
+	if err != nil {
+		return nil, fmt.Errorf("resolve prevouts of transaction %s: %w", transaction.TxID, err)
+	}
+
+	spent, received := sumValue(prevouts), sumValue(transaction.Outputs)
+
+	activity.Fee = &activityx.Fee{
+		Amount:  decimal.NewFromUint64(spent - min(spent, received)),
+		Decimal: bitcoin.Decimals,
+	}
+
+	actions := w.handleTransfers(ctx, activity, prevouts, transaction.Outputs)
+
+	inscriptionActions, err := w.handleInscriptions(ctx, transaction, prevouts)
+	if err != nil {
+		return nil, fmt.Errorf("handle inscriptions: %w", err)
+	}
+
+	actions = append(actions, inscriptionActions...)
+
+	if len(actions) == 0 {
+		zap.L().Debug("skip transaction without actions", zap.String("txid", transaction.TxID))
+
+		return nil, nil
+	}
+
+	activity.Type = actions[0].Type
+	activity.Actions = append(activity.Actions, actions...)
+
+	return activity, nil
+}
+
+// resolvePrevouts returns the outputs spent by the inputs, which are fetched by the previous transactions if not returned by the API.
+func (w *worker) resolvePrevouts(ctx context.Context, transaction bitcoin.Transaction) ([]bitcoin.Output, error) {
+	prevouts := make([]bitcoin.Output, 0, len(transaction.Inputs))
+
+	for _, input := range transaction.Inputs {
+		if input.Prevout != nil {
+			prevouts = append(prevouts, *input.Prevout)
+
+			continue
+		}
+
+		previous, found := w.transactions.Get(input.TxID)
+		if !found {
+			var err error
+
+			if previous, err = w.bitcoinClient.GetTransaction(ctx, input.TxID); err != nil {
+				return nil, fmt.Errorf("get transaction %s: %w", input.TxID, err)
+			}
+
+			w.transactions.Add(input.TxID, previous)
+		}
+
+		if int(input.Vout) >= len(previous.Outputs) {
+			return nil, fmt.Errorf("output %d not found in transaction %s", input.Vout, input.TxID)
+		}
+
+		prevouts = append(prevouts, previous.Outputs[input.Vout])
+	}
+
+	return prevouts, nil
+}
+
+// handleTransfers returns the transfers by the net changes of the addresses, so the change returned to senders is not a transfer.
+// The address spending the most is the sender of all the transfers, as the inputs are not linked to the outputs.
+func (w *worker) handleTransfers(ctx context.Context, activity *activityx.Activity, prevouts, outputs []bitcoin.Output) []*activityx.Action {
+	var (
+		addresses []string
+		changes   = make(map[string]int64)
+	)
+
+	for _, prevout := range prevouts {
+		if prevout.Address == "" {
+			continue
+		}
+
+		if _, exists := changes[prevout.Address]; !exists {
+			addresses = append(addresses, prevout.Address)
+		}
+
+		changes[prevout.Address] -= int64(prevout.Value)
+	}
+
+	// The senders are the addresses of the inputs, which come before the recipients
+	senders := len(addresses)
+
+	for _, output := range outputs {
+		if output.Address == "" {
+			continue
+		}
+
+		if _, exists := changes[output.Address]; !exists {
+			addresses = append(addresses, output.Address)
+		}
+
+		changes[output.Address] += int64(output.Value)
+	}
+
+	from := lo.MinBy(addresses[:senders], func(a, b string) bool {
+		return changes[a] < changes[b]
+	})
+
+	activity.From = from
+
+	var actions []*activityx.Action
+
+	for _, address := range addresses {
+		if changes[address] <= 0 || from == "" {
+			continue
+		}
+
+		actions = append(actions, w.buildBitcoinTransactionTransferAction(ctx, from, address, uint64(changes[address])))
+	}
+
+	if len(actions) > 0 {
+		activity.To = actions[0].To
+	}
+
+	return actions
+}
+
+// handleInscriptions returns the mints of the Ordinals inscriptions revealed by the inputs,
+// an inscription is on the first sat of the transaction, which goes to the first output.
+func (w *worker) handleInscriptions(ctx context.Context, transaction bitcoin.Transaction, prevouts []bitcoin.Output) ([]*activityx.Action, error) {
+	if len(transaction.Outputs) == 0 {
+		return nil, nil
+	}
+
+	var (
+		actions []*activityx.Action
+		index   int
+	)
+
+	for inputIndex, input := range transaction.Inputs {
+		inscriptions, err := bitcoin.ParseInscriptions(input.Witness)
+		if err != nil {
+			return nil, fmt.Errorf("parse inscriptions of input %d: %w", inputIndex, err)
+		}
+
+		for range inscriptions {
+			actions = append(actions, w.buildInscriptionMintAction(ctx, prevouts[inputIndex].Address, transaction.Outputs[0].Address, bitcoin.InscriptionID(transaction.TxID, index)))
+
+			index++
+		}
+	}
+
+	return actions, nil
+}
+
+// buildBitcoinTransactionTransferAction returns the native transfer transaction action.
+func (w *worker) buildBitcoinTransactionTransferAction(_ context.Context, from, to string, value uint64) *activityx.Action {
+	return &activityx.Action{
+		Type: typex.TransactionTransfer,
+		From: from,
+		To:   to,
+		Metadata: metadata.TransactionTransfer{
+			Name:     "Bitcoin",
+			Symbol:   "BTC",
+			Decimals: bitcoin.Decimals,
+			Value:    lo.ToPtr(decimal.NewFromUint64(value)),
+		},
+	}
+}
+
+// buildInscriptionMintAction returns the collectible mint action of an inscription.
+func (w *worker) buildInscriptionMintAction(_ context.Context, from, to, inscriptionID string) *activityx.Action {
+	return &activityx.Action{
+		Type: typex.CollectibleMint,
+		From: from,
+		To:   to,
+		Metadata: metadata.CollectibleMint{
+			Name:  fmt.Sprintf("Inscription %s", inscriptionID),
+			URI:   inscriptionURLPrefix + inscriptionID,
+			Value: lo.ToPtr(decimal.NewFromInt(1)),
+		},
+	}
+}
+
+func sumValue(outputs []bitcoin.Output) uint64 {
+	return lo.SumBy(outputs, func(output bitcoin.Output) uint64 {
+		return output.Value
+	})
+}
+
+// NewWorker returns a new Bitcoin worker.
+func NewWorker(config *config.Module) (engine.Worker, error) {
+	var instance = worker{
+		config: config,
+	}
+
+	option, err := source.NewOption(config.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("parse config: %w", err)
+	}
+
+	if instance.bitcoinClient, err = bitcoin.Dial(context.Background(), config.Endpoint.URL, option.API); err != nil {
+		return nil, fmt.Errorf("initialize bitcoin client: %w", err)
+	}
+
+	if instance.transactions, err = lru.New[string, *bitcoin.Transaction](transactionCacheSize); err != nil {
+		return nil, fmt.Errorf("new transaction cache: %w", err)
+	}
+
+	return &instance, nil
+}
@@ -0,0 +1,202 @@
+package bitcoin_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rss3-network/node/config"
+	source "github.com/rss3-network/node/internal/engine/protocol/bitcoin"
+	worker "github.com/rss3-network/node/internal/engine/worker/decentralized/core/bitcoin"
+	"github.com/rss3-network/node/internal/testsuite"
+	"github.com/rss3-network/node/provider/bitcoin"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// getRawTransaction serves the previous transactions recorded from the getrawtransaction method of Bitcoin Core.
+func getRawTransaction(method string, params []json.RawMessage) (any, error) {
+	var txid string
+
+	if method != "getrawtransaction" || len(params) == 0 || json.Unmarshal(params[0], &txid) != nil {
+		return nil, fmt.Errorf("unsupported method %s", method)
+	}
+
+	data, err := os.ReadFile(filepath.Join("testdata", "getrawtransaction_"+filepath.Base(txid)+".json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Result json.RawMessage `json:"result"`
+	}
+
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return response.Result, nil
+}
+
+// loadTask loads the task of a normalized transaction.
+func loadTask(t *testing.T, name string) *source.Task {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	require.NoError(t, err)
+
+	var transaction bitcoin.Transaction
+	require.NoError(t, json.Unmarshal(data, &transaction))
+
+	return &source.Task{
+		Network:     network.Bitcoin,
+		BlockHeight: 870000,
+		BlockTime:   1731405600,
+		Transaction: transaction,
+	}
+}
+
+func TestWorker_Bitcoin(t *testing.T) {
+	t.Parallel()
+
+	const (
+		alice     = "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq"
+		bob       = "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh"
+		charlie   = "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"
+		commit    = "bc1p5d7rjq7g6rdk2yhzks9smlaqtedr4dekq08ge8ztwac72sfr9rusxg3297"
+		recipient = "bc1pqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqsyjer9e"
+	)
+
+	transfer := func(from, to string, value int64) *activityx.Action {
+		return &activityx.Action{
+			Type: typex.TransactionTransfer,
+			From: from,
+			To:   to,
+			Metadata: metadata.TransactionTransfer{
+				Name:     "Bitcoin",
+				Symbol:   "BTC",
+				Decimals: bitcoin.Decimals,
+				Value:    lo.ToPtr(decimal.NewFromInt(value)),
+			},
+		}
+	}
+
+	testcases := []struct {
+		name      string
+		fixture   string
+		want      *activityx.Activity
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name:    "Payment With Change",
+			fixture: "payment",
+			want: &activityx.Activity{
+				ID:        "a1075db55d416d3ca199f55b6084e2115b9345e16c5cf302fc80e9d5fbf5d48d",
+				Network:   network.Bitcoin,
+				From:      alice,
+				To:        bob,
+				Type:      typex.TransactionTransfer,
+				Timestamp: 1731405600,
+				Fee: &activityx.Fee{
+					Amount:  decimal.NewFromInt(10000),
+					Decimal: bitcoin.Decimals,
+				},
+				Status: true,
+				Actions: []*activityx.Action{
+					transfer(alice, bob, 30000000),
+				},
+			},
+			wantError: require.NoError,
+		},
+		{
+			name:    "Batch Payout",
+			fixture: "batch_payout",
+			want: &activityx.Activity{
+				ID:        "6e9b4e5a1f2d3c4b5a69788796a5b4c3d2e1f00112233445566778899aabbccdd",
+				Network:   network.Bitcoin,
+				From:      alice,
+				To:        bob,
+				Type:      typex.TransactionTransfer,
+				Timestamp: 1731405600,
+				Fee: &activityx.Fee{
+					Amount:  decimal.NewFromInt(10000),
+					Decimal: bitcoin.Decimals,
+				},
+				Status: true,
+				Actions: []*activityx.Action{
+					transfer(alice, bob, 2500000),
+					transfer(alice, charlie, 1500000),
+				},
+			},
+			wantError: require.NoError,
+		},
+		{
+			name:    "Inscription Reveal",
+			fixture: "inscription",
+			want: &activityx.Activity{
+				ID:        "6fb976ab49dcec017f1e201e84395983204ae1a7c2abf7ced0a85d692e442799",
+				Network:   network.Bitcoin,
+				From:      commit,
+				To:        recipient,
+				Type:      typex.TransactionTransfer,
+				Timestamp: 1731405600,
+				Fee: &activityx.Fee{
+					Amount:  decimal.NewFromInt(1454),
+					Decimal: bitcoin.Decimals,
+				},
+				Status: true,
+				Actions: []*activityx.Action{
+					transfer(commit, recipient, 546),
+					{
+						Type: typex.CollectibleMint,
+						From: commit,
+						To:   recipient,
+						Metadata: metadata.CollectibleMint{
+							Name:  "Inscription 6fb976ab49dcec017f1e201e84395983204ae1a7c2abf7ced0a85d692e442799i0",
+							URI:   "https://ordinals.com/content/6fb976ab49dcec017f1e201e84395983204ae1a7c2abf7ced0a85d692e442799i0",
+							Value: lo.ToPtr(decimal.NewFromInt(1)),
+						},
+					},
+				},
+			},
+			wantError: require.NoError,
+		},
+		{
+			name:      "Consolidation",
+			fixture:   "consolidation",
+			want:      nil,
+			wantError: require.NoError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			instance, err := worker.NewWorker(&config.Module{
+				Network: network.Bitcoin,
+				Endpoint: config.Endpoint{
+					URL: testsuite.NewRPCServer(t, getRawTransaction),
+				},
+			})
+			require.NoError(t, err)
+
+			activity, err := instance.Transform(ctx, loadTask(t, testcase.fixture))
+			testcase.wantError(t, err)
+
+			require.Equal(t, testcase.want, activity)
+		})
+	}
+}
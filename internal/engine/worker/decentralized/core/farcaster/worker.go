@@ -12,6 +12,7 @@ import (
 	source "github.com/rss3-network/node/internal/engine/protocol/farcaster"
 	"github.com/rss3-network/node/provider/farcaster"
 	"github.com/rss3-network/node/provider/httpx"
+	workerx "github.com/rss3-network/node/schema/worker"
 	"github.com/rss3-network/node/schema/worker/decentralized"
 	"github.com/rss3-network/protocol-go/schema"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
@@ -26,6 +27,16 @@ import (
 
 var _ engine.Worker = (*worker)(nil)
 
+// Keys of the social profile updates of address verifications and links in profiles.
+const (
+	ProfileKeyVerify   = "verify"
+	ProfileKeyUnverify = "unverify"
+	ProfileKeyURL      = "url"
+)
+
+// channelURLPrefix is the prefix of the parent URLs of casts in Warpcast channels.
+const channelURLPrefix = "https://warpcast.com/~/channel/"
+
 type worker struct {
 	httpClient httpx.Client
 }
@@ -53,7 +64,9 @@ func (w *worker) Tags() []tag.Tag {
 func (w *worker) Types() []schema.Type {
 	return []schema.Type{
 		typex.SocialComment,
+		typex.SocialLike,
 		typex.SocialPost,
+		typex.SocialProfile,
 		typex.SocialShare,
 	}
 }
@@ -79,7 +92,20 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 	case farcaster.MessageTypeCastAdd.String():
 		w.handleFarcasterAddCast(ctx, farcasterTask.Message, activity)
 	case farcaster.MessageTypeReactionAdd.String():
-		w.handleFarcasterRecastReaction(ctx, farcasterTask.Message, activity)
+		switch farcasterTask.Message.Data.ReactionBody.Type {
+		case farcaster.ReactionTypeRecast.String():
+			w.handleFarcasterReaction(ctx, farcasterTask.Message, activity, typex.SocialShare)
+		case farcaster.ReactionTypeLike.String():
+			w.handleFarcasterReaction(ctx, farcasterTask.Message, activity, typex.SocialLike)
+		}
+	case farcaster.MessageTypeLinkAdd.String():
+		w.handleFarcasterLink(ctx, farcasterTask.Message, activity, workerx.SocialRelationFollow)
+	case farcaster.MessageTypeLinkRemove.String():
+		w.handleFarcasterLink(ctx, farcasterTask.Message, activity, workerx.SocialRelationUnfollow)
+	case farcaster.MessageTypeUserDataAdd.String():
+		w.handleFarcasterUserData(ctx, farcasterTask.Message, activity)
+	case farcaster.MessageTypeVerificationAddEthAddress.String(), farcaster.MessageTypeVerificationRemove.String():
+		w.handleFarcasterVerification(ctx, farcasterTask.Message, activity)
 	default:
 		zap.L().Debug("unsupported farcaster message type", zap.String("type", farcasterTask.Message.Data.Type))
 	}
@@ -140,13 +166,18 @@ func (w *worker) handleFarcasterAddCast(ctx context.Context, message farcaster.M
 	w.buildPostActions(ctx, message.Data.Profile.EthAddresses, activity, post, activity.Type)
 }
 
-// handleFarcasterRecastReaction handles farcaster recast reaction message.
-func (w *worker) handleFarcasterRecastReaction(ctx context.Context, message farcaster.Message, activity *activityx.Activity) {
+// handleFarcasterReaction handles farcaster recast and like reaction messages.
+func (w *worker) handleFarcasterReaction(ctx context.Context, message farcaster.Message, activity *activityx.Activity, socialType schema.Type) {
+	// Reactions to URLs of channels are not indexed
+	if message.Data.ReactionBody.TargetCast == nil {
+		return
+	}
+
 	fid := int64(message.Data.Fid)
 	post := w.buildPost(ctx, int64(message.Data.Fid), message.Hash, nil, farcaster.CovertFarcasterTimeToTimestamp(int64(message.Data.Timestamp)))
 	post.Handle = message.Data.Profile.Username
 	activity.From = message.Data.Profile.CustodyAddress
-	activity.Type = typex.SocialShare
+	activity.Type = socialType
 	targetFid := int64(message.Data.ReactionBody.TargetCastID.Fid)
 	targetMessage := message.Data.ReactionBody.TargetCast
 	post.Target = w.buildPost(ctx, targetFid, targetMessage.Hash, targetMessage.Data.CastAddBody, farcaster.CovertFarcasterTimeToTimestamp(int64(targetMessage.Data.Timestamp)))
@@ -165,7 +196,7 @@ func (w *worker) handleFarcasterRecastReaction(ctx context.Context, message farc
 	for _, from := range message.Data.Profile.EthAddresses {
 		for _, to := range targetMessage.Data.Profile.EthAddresses {
 			action := activityx.Action{
-				Type:     typex.SocialShare,
+				Type:     socialType,
 				Platform: w.Platform(),
 				From:     from,
 				To:       to,
@@ -176,6 +207,133 @@ func (w *worker) handleFarcasterRecastReaction(ctx context.Context, message farc
 	}
 }
 
+// handleFarcasterLink handles farcaster follow and unfollow link messages.
+func (w *worker) handleFarcasterLink(_ context.Context, message farcaster.Message, activity *activityx.Activity, relation string) {
+	if message.Data.LinkBody == nil || message.Data.LinkBody.TargetProfile == nil {
+		return
+	}
+
+	targetProfile := message.Data.LinkBody.TargetProfile
+
+	activity.Type = typex.SocialProfile
+	activity.From = message.Data.Profile.CustodyAddress
+	activity.To = targetProfile.CustodyAddress
+
+	profile := metadata.SocialProfile{
+		Action:    metadata.ActionSocialProfileUpdate,
+		ProfileID: strconv.FormatUint(message.Data.Fid, 10),
+		Handle:    message.Data.Profile.Username,
+		Key:       relation,
+		Value:     lo.CoalesceOrEmpty(targetProfile.Username, strconv.FormatUint(message.Data.LinkBody.TargetFid, 10)),
+	}
+
+	for _, from := range message.Data.Profile.EthAddresses {
+		for _, to := range targetProfile.EthAddresses {
+			action := activityx.Action{
+				Type:     typex.SocialProfile,
+				Platform: w.Platform(),
+				From:     from,
+				To:       to,
+				Metadata: profile,
+			}
+			activity.Actions = append(activity.Actions, &action)
+		}
+	}
+}
+
+// handleFarcasterUserData handles farcaster user data messages, which update the pfp, display name, bio, url or username.
+func (w *worker) handleFarcasterUserData(_ context.Context, message farcaster.Message, activity *activityx.Activity) {
+	if message.Data.UserDataBody == nil {
+		return
+	}
+
+	profile := metadata.SocialProfile{
+		Action:    metadata.ActionSocialProfileUpdate,
+		ProfileID: strconv.FormatUint(message.Data.Fid, 10),
+		Handle:    message.Data.Profile.Username,
+	}
+
+	value := message.Data.UserDataBody.Value
+
+	switch message.Data.UserDataBody.Type {
+	case farcaster.UserDataTypePfp.String():
+		profile.ImageURI = value
+	case farcaster.UserDataTypeDisplay.String():
+		profile.Name = value
+	case farcaster.UserDataTypeBio.String():
+		profile.Bio = value
+	case farcaster.UserDataTypeURL.String():
+		profile.Key, profile.Value = ProfileKeyURL, value
+	case farcaster.UserDataTypeUsername.String():
+		profile.Handle = value
+	default:
+		zap.L().Debug("unsupported farcaster user data type", zap.String("type", message.Data.UserDataBody.Type))
+
+		return
+	}
+
+	activity.Type = typex.SocialProfile
+	activity.From = message.Data.Profile.CustodyAddress
+	activity.To = activity.From
+
+	w.buildProfileActions(message.Data.Profile.EthAddresses, activity, profile)
+}
+
+// handleFarcasterVerification handles farcaster messages adding and removing verified eth addresses.
+func (w *worker) handleFarcasterVerification(_ context.Context, message farcaster.Message, activity *activityx.Activity) {
+	var key, address string
+
+	switch {
+	case message.Data.VerificationAddEthAddressBody != nil:
+		if message.Data.VerificationAddEthAddressBody.Protocol != farcaster.ProtocolEthereum.String() {
+			return
+		}
+
+		key, address = ProfileKeyVerify, message.Data.VerificationAddEthAddressBody.Address
+	case message.Data.VerificationRemoveBody != nil:
+		key, address = ProfileKeyUnverify, message.Data.VerificationRemoveBody.Address
+	default:
+		return
+	}
+
+	if !common.IsHexAddress(address) {
+		return
+	}
+
+	verified := common.HexToAddress(address)
+
+	activity.Type = typex.SocialProfile
+	activity.From = message.Data.Profile.CustodyAddress
+	activity.To = activity.From
+
+	profile := metadata.SocialProfile{
+		Action:    metadata.ActionSocialProfileUpdate,
+		ProfileID: strconv.FormatUint(message.Data.Fid, 10),
+		Address:   verified,
+		Handle:    message.Data.Profile.Username,
+		Key:       key,
+		Value:     verified.String(),
+	}
+
+	// The removed address is no longer one of the eth addresses of the profile
+	w.buildProfileActions(lo.Uniq(append(message.Data.Profile.EthAddresses, verified.String())), activity, profile)
+}
+
+// buildProfileActions builds profile actions from message.
+func (w *worker) buildProfileActions(ethAddresses []string, activity *activityx.Activity, profile metadata.SocialProfile) {
+	for _, from := range ethAddresses {
+		action := activityx.Action{
+			Type:     typex.SocialProfile,
+			Platform: w.Platform(),
+			From:     from,
+			To:       from,
+			Metadata: profile,
+		}
+
+		activity.Actions = append(activity.Actions, &action)
+	}
+}
+
 // buildPostActions builds post actions from message.
 func (w *worker) buildPostActions(_ context.Context, ethAddresses []string, activity *activityx.Activity, post *metadata.SocialPost, socialType schema.Type) {
 	for _, from := range ethAddresses {
@@ -214,11 +372,24 @@ func (w *worker) buildPost(ctx context.Context, fid int64, hash string, body *fa
 		Timestamp:     uint64(timestamp),
 	}
 
+	if body != nil && body.ParentURL != "" {
+		post.Tags = []string{buildChannel(body.ParentURL)}
+	}
+
 	w.buildPostMedia(ctx, post, embeds)
 
 	return post
 }
 
+// buildChannel builds the channel of a cast from its parent URL, the channels of Warpcast are named as /<name>.
+func buildChannel(parentURL string) string {
+	if name, found := strings.CutPrefix(parentURL, channelURLPrefix); found && name != "" {
+		return "/" + name
+	}
+
+	return parentURL
+}
+
 // castToString completes the body based on the username in mentions.
 func (w *worker) castToString(cast *farcaster.CastAddBody) string {
 	text := cast.Text
@@ -418,6 +418,252 @@ func TestWorker(t *testing.T) {
 			},
 			wantError: require.NoError,
 		},
+		{
+			name: "Like A Cast In A Channel",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Farcaster,
+					Message: message.Message{
+						Data: message.MessageData{
+							Type: message.MessageTypeReactionAdd.String(),
+							Fid:  14142,
+							Profile: &model.Profile{
+								Fid:            14142,
+								Username:       "brucexc.eth",
+								CustodyAddress: "0xe5d6216F0085a7F6B9b692e06cf5856e6fA41B55",
+								EthAddresses:   []string{"0x8888888198FbdC8c017870cC5d3c96D0cf15C4F0"},
+							},
+							Timestamp: 90547939,
+							Network:   "FARCASTER_NETWORK_MAINNET",
+							ReactionBody: &message.ReactionBody{
+								Type: message.ReactionTypeLike.String(),
+								TargetCastID: message.CastID{
+									Fid:  23901,
+									Hash: "0x1d4b3c5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c",
+								},
+								TargetCast: &message.Message{
+									Data: message.MessageData{
+										Type: message.MessageTypeCastAdd.String(),
+										Fid:  23901,
+										Profile: &model.Profile{
+											Fid:            23901,
+											Username:       "henryqw",
+											CustodyAddress: "0xe25228a6525A2090be824d66Bdf6DB8836eCc90C",
+											EthAddresses:   []string{"0x827431510a5D249cE4fdB7F00C83a3353F471848"},
+										},
+										Timestamp: 90537875,
+										Network:   "FARCASTER_NETWORK_MAINNET",
+										CastAddBody: &message.CastAddBody{
+											ParentURL: "https://warpcast.com/~/channel/rss3",
+											Text:      "The RSS3 Network is live.",
+										},
+									},
+									Hash: "0x1d4b3c5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c",
+								},
+							},
+						},
+						Hash: "0x6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b",
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x0000000000000000000000006a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b",
+				Network:  network.Farcaster,
+				From:     common.HexToAddress("0xe5d6216F0085a7F6B9b692e06cf5856e6fA41B55").String(),
+				To:       common.HexToAddress("0xe25228a6525A2090be824d66Bdf6DB8836eCc90C").String(),
+				Type:     typex.SocialLike,
+				Status:   true,
+				Platform: workerx.PlatformFarcaster.String(),
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialLike,
+						Platform: workerx.PlatformFarcaster.String(),
+						From:     common.HexToAddress("0x8888888198FbdC8c017870cC5d3c96D0cf15C4F0").String(),
+						To:       common.HexToAddress("0x827431510a5D249cE4fdB7F00C83a3353F471848").String(),
+						Metadata: metadata.SocialPost{
+							Handle:        "brucexc.eth",
+							ProfileID:     "14142",
+							PublicationID: common.HexToAddress("0x6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b").String(),
+							Timestamp:     1700007139,
+							Target: &metadata.SocialPost{
+								Handle:        "henryqw",
+								Body:          "The RSS3 Network is live.",
+								ProfileID:     "23901",
+								PublicationID: common.HexToAddress("0x1d4b3c5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c").String(),
+								Tags:          []string{"/rss3"},
+								Timestamp:     1699997075,
+							},
+						},
+					},
+				},
+				Timestamp: 1700007139,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Follow A User",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Farcaster,
+					Message: message.Message{
+						Data: message.MessageData{
+							Type: message.MessageTypeLinkAdd.String(),
+							Fid:  14142,
+							Profile: &model.Profile{
+								Fid:            14142,
+								Username:       "brucexc.eth",
+								CustodyAddress: "0xe5d6216F0085a7F6B9b692e06cf5856e6fA41B55",
+								EthAddresses:   []string{"0x8888888198FbdC8c017870cC5d3c96D0cf15C4F0"},
+							},
+							Timestamp: 90547939,
+							Network:   "FARCASTER_NETWORK_MAINNET",
+							LinkBody: &message.LinkBody{
+								Type:      message.LinkTypeFollow,
+								TargetFid: 23901,
+								TargetProfile: &model.Profile{
+									Fid:            23901,
+									Username:       "henryqw",
+									CustodyAddress: "0xe25228a6525A2090be824d66Bdf6DB8836eCc90C",
+									EthAddresses:   []string{"0x827431510a5D249cE4fdB7F00C83a3353F471848"},
+								},
+							},
+						},
+						Hash: "0x7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c",
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x0000000000000000000000007b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c",
+				Network:  network.Farcaster,
+				From:     common.HexToAddress("0xe5d6216F0085a7F6B9b692e06cf5856e6fA41B55").String(),
+				To:       common.HexToAddress("0xe25228a6525A2090be824d66Bdf6DB8836eCc90C").String(),
+				Type:     typex.SocialProfile,
+				Status:   true,
+				Platform: workerx.PlatformFarcaster.String(),
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialProfile,
+						Platform: workerx.PlatformFarcaster.String(),
+						From:     common.HexToAddress("0x8888888198FbdC8c017870cC5d3c96D0cf15C4F0").String(),
+						To:       common.HexToAddress("0x827431510a5D249cE4fdB7F00C83a3353F471848").String(),
+						Metadata: metadata.SocialProfile{
+							Action:    metadata.ActionSocialProfileUpdate,
+							ProfileID: "14142",
+							Handle:    "brucexc.eth",
+							Key:       "follow",
+							Value:     "henryqw",
+						},
+					},
+				},
+				Timestamp: 1700007139,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Update Profile Bio",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Farcaster,
+					Message: message.Message{
+						Data: message.MessageData{
+							Type: message.MessageTypeUserDataAdd.String(),
+							Fid:  14142,
+							Profile: &model.Profile{
+								Fid:            14142,
+								Username:       "brucexc.eth",
+								CustodyAddress: "0xe5d6216F0085a7F6B9b692e06cf5856e6fA41B55",
+								EthAddresses:   []string{"0x8888888198FbdC8c017870cC5d3c96D0cf15C4F0"},
+							},
+							Timestamp: 90547939,
+							Network:   "FARCASTER_NETWORK_MAINNET",
+							UserDataBody: &message.UserDataBody{
+								Type:  message.UserDataTypeBio.String(),
+								Value: "Building the RSS3 Network",
+							},
+						},
+						Hash: "0x8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d",
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x0000000000000000000000008c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d",
+				Network:  network.Farcaster,
+				From:     common.HexToAddress("0xe5d6216F0085a7F6B9b692e06cf5856e6fA41B55").String(),
+				To:       common.HexToAddress("0xe5d6216F0085a7F6B9b692e06cf5856e6fA41B55").String(),
+				Type:     typex.SocialProfile,
+				Status:   true,
+				Platform: workerx.PlatformFarcaster.String(),
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialProfile,
+						Platform: workerx.PlatformFarcaster.String(),
+						From:     common.HexToAddress("0x8888888198FbdC8c017870cC5d3c96D0cf15C4F0").String(),
+						To:       common.HexToAddress("0x8888888198FbdC8c017870cC5d3c96D0cf15C4F0").String(),
+						Metadata: metadata.SocialProfile{
+							Action:    metadata.ActionSocialProfileUpdate,
+							ProfileID: "14142",
+							Handle:    "brucexc.eth",
+							Bio:       "Building the RSS3 Network",
+						},
+					},
+				},
+				Timestamp: 1700007139,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Remove A Verified Address",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Farcaster,
+					Message: message.Message{
+						Data: message.MessageData{
+							Type: message.MessageTypeVerificationRemove.String(),
+							Fid:  14142,
+							Profile: &model.Profile{
+								Fid:            14142,
+								Username:       "brucexc.eth",
+								CustodyAddress: "0xe5d6216F0085a7F6B9b692e06cf5856e6fA41B55",
+								EthAddresses:   []string{},
+							},
+							Timestamp: 90547939,
+							Network:   "FARCASTER_NETWORK_MAINNET",
+							VerificationRemoveBody: &message.VerificationRemoveBody{
+								Address: "0x8888888198fbdc8c017870cc5d3c96d0cf15c4f0",
+							},
+						},
+						Hash: "0x9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e",
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:       "0x0000000000000000000000009d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e",
+				Network:  network.Farcaster,
+				From:     common.HexToAddress("0xe5d6216F0085a7F6B9b692e06cf5856e6fA41B55").String(),
+				To:       common.HexToAddress("0xe5d6216F0085a7F6B9b692e06cf5856e6fA41B55").String(),
+				Type:     typex.SocialProfile,
+				Status:   true,
+				Platform: workerx.PlatformFarcaster.String(),
+				Actions: []*activityx.Action{
+					{
+						Type:     typex.SocialProfile,
+						Platform: workerx.PlatformFarcaster.String(),
+						From:     common.HexToAddress("0x8888888198FbdC8c017870cC5d3c96D0cf15C4F0").String(),
+						To:       common.HexToAddress("0x8888888198FbdC8c017870cC5d3c96D0cf15C4F0").String(),
+						Metadata: metadata.SocialProfile{
+							Action:    metadata.ActionSocialProfileUpdate,
+							ProfileID: "14142",
+							Address:   common.HexToAddress("0x8888888198FbdC8c017870cC5d3c96D0cf15C4F0"),
+							Handle:    "brucexc.eth",
+							Key:       "unverify",
+							Value:     common.HexToAddress("0x8888888198FbdC8c017870cC5d3c96D0cf15C4F0").String(),
+						},
+					},
+				},
+				Timestamp: 1700007139,
+			},
+			wantError: require.NoError,
+		},
 	}
 
 	for _, testcase := range testcases {
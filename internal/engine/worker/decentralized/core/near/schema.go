@@ -0,0 +1,8 @@
+package near
+
+// TransferArgs is the arguments of the ft_transfer, ft_transfer_call, nft_transfer and nft_transfer_call methods.
+type TransferArgs struct {
+	ReceiverID string `json:"receiver_id"`
+	Amount     string `json:"amount"`
+	TokenID    string `json:"token_id"`
+}
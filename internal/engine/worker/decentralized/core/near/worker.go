@@ -2,14 +2,17 @@ package near
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 
+	"github.com/redis/rueidis"
 	"github.com/rss3-network/node/config"
 	"github.com/rss3-network/node/internal/engine"
 	source "github.com/rss3-network/node/internal/engine/protocol/near"
 	"github.com/rss3-network/node/internal/utils"
 	"github.com/rss3-network/node/provider/near"
+	"github.com/rss3-network/node/provider/near/token"
 	workerx "github.com/rss3-network/node/schema/worker/decentralized"
 	"github.com/rss3-network/protocol-go/schema"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
@@ -19,12 +22,15 @@ import (
 	"github.com/rss3-network/protocol-go/schema/typex"
 	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
 )
 
 var _ engine.Worker = (*worker)(nil)
 
 type worker struct {
-	config *config.Module
+	config      *config.Module
+	nearClient  near.Client
+	tokenClient token.Client
 }
 
 func (w *worker) Name() string {
@@ -45,6 +51,7 @@ func (w *worker) Tags() []tag.Tag {
 	return []tag.Tag{
 		tag.Unknown,
 		tag.Transaction,
+		tag.Collectible,
 	}
 }
 
@@ -52,9 +59,21 @@ func (w *worker) Types() []schema.Type {
 	return []schema.Type{
 		typex.Unknown,
 		typex.TransactionTransfer,
+		typex.TransactionMint,
+		typex.TransactionBurn,
+		typex.CollectibleTransfer,
+		typex.CollectibleMint,
+		typex.CollectibleBurn,
 	}
 }
 
+const (
+	methodFTTransfer      = "ft_transfer"
+	methodFTTransferCall  = "ft_transfer_call"
+	methodNFTTransfer     = "nft_transfer"
+	methodNFTTransferCall = "nft_transfer_call"
+)
+
 // Filter returns a protocol filter.
 func (w *worker) Filter() engine.DataSourceFilter {
 	return nil
@@ -91,6 +110,7 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 }
 
 // handleNearActions processes all actions in the Near transaction and returns a slice of activityx.Action.
+// Native transfers come first, followed by the token events of the receipts in the order of execution.
 func (w *worker) handleNearActions(ctx context.Context, task *source.Task) ([]*activityx.Action, error) {
 	var actions []*activityx.Action
 
@@ -107,6 +127,31 @@ func (w *worker) handleNearActions(ctx context.Context, task *source.Task) ([]*a
 		}
 	}
 
+	eventActions, emitters, err := w.handleReceiptEvents(ctx, task)
+	if err != nil {
+		return nil, fmt.Errorf("handle receipt events: %w", err)
+	}
+
+	actions = append(actions, eventActions...)
+
+	// Contracts implemented before NEP-297 do not emit events, fall back to the token transfer calls.
+	if len(task.Transaction.Status.Failure) == 0 && !emitters[task.Transaction.Transaction.ReceiverID] {
+		for _, action := range task.Transaction.Transaction.Actions {
+			if action.FunctionCall == nil {
+				continue
+			}
+
+			callAction, err := w.handleTokenTransferCall(ctx, task.Transaction.Transaction.SignerID, task.Transaction.Transaction.ReceiverID, action.FunctionCall)
+			if err != nil {
+				return nil, fmt.Errorf("handle function call %s: %w", action.FunctionCall.MethodName, err)
+			}
+
+			if callAction != nil {
+				actions = append(actions, callAction)
+			}
+		}
+	}
+
 	return actions, nil
 }
 
@@ -124,6 +169,174 @@ func (w *worker) handleNearTransferAction(ctx context.Context, from, to string,
 	return w.buildNearTransactionTransferAction(ctx, from, to, value.BigInt())
 }
 
+// handleReceiptEvents processes the NEP-141 and NEP-171 events of the successful receipts,
+// it also returns the contracts that emitted token events.
+func (w *worker) handleReceiptEvents(ctx context.Context, task *source.Task) ([]*activityx.Action, map[string]bool, error) {
+	var (
+		actions  []*activityx.Action
+		emitters = make(map[string]bool)
+	)
+
+	for _, receipt := range task.Transaction.ReceiptsOutcome {
+		// The state changes of a failed receipt are reverted even though its logs are kept.
+		if len(receipt.Outcome.Status.Failure) > 0 {
+			continue
+		}
+
+		contractID := receipt.Outcome.ExecutorID
+
+		for _, log := range receipt.Outcome.Logs {
+			event, err := near.ParseEvent(log)
+			if err != nil {
+				zap.L().Warn("skip invalid event log",
+					zap.String("receipt_id", receipt.ID),
+					zap.String("log", log),
+					zap.Error(err))
+
+				continue
+			}
+
+			if event == nil {
+				continue
+			}
+
+			var eventActions []*activityx.Action
+
+			switch event.Standard {
+			case near.StandardNEP141:
+				eventActions, err = w.handleFungibleTokenEvent(ctx, contractID, event)
+			case near.StandardNEP171:
+				eventActions, err = w.handleNonFungibleTokenEvent(ctx, contractID, event)
+			default:
+				continue
+			}
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("handle %s event %s of %s: %w", event.Standard, event.Event, contractID, err)
+			}
+
+			emitters[contractID] = true
+
+			actions = append(actions, eventActions...)
+		}
+	}
+
+	return actions, emitters, nil
+}
+
+// handleFungibleTokenEvent processes a NEP-141 event and returns the transfer, mint or burn actions.
+func (w *worker) handleFungibleTokenEvent(ctx context.Context, contractID string, event *near.Event) ([]*activityx.Action, error) {
+	var actionType schema.Type
+
+	switch event.Event {
+	case near.EventFTTransfer:
+		actionType = typex.TransactionTransfer
+	case near.EventFTMint:
+		actionType = typex.TransactionMint
+	case near.EventFTBurn:
+		actionType = typex.TransactionBurn
+	default:
+		return nil, nil
+	}
+
+	data, err := event.FungibleTokenData()
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]*activityx.Action, 0, len(data))
+
+	for _, item := range data {
+		from, to := item.OldOwnerID, item.NewOwnerID
+
+		// The contract is the counterparty of mints and burns.
+		switch actionType {
+		case typex.TransactionMint:
+			from, to = contractID, item.OwnerID
+		case typex.TransactionBurn:
+			from, to = item.OwnerID, contractID
+		}
+
+		action, err := w.buildFungibleTokenAction(ctx, actionType, contractID, from, to, item.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions, nil
+}
+
+// handleNonFungibleTokenEvent processes a NEP-171 event and returns an action for each token.
+func (w *worker) handleNonFungibleTokenEvent(ctx context.Context, contractID string, event *near.Event) ([]*activityx.Action, error) {
+	var actionType schema.Type
+
+	switch event.Event {
+	case near.EventNFTTransfer:
+		actionType = typex.CollectibleTransfer
+	case near.EventNFTMint:
+		actionType = typex.CollectibleMint
+	case near.EventNFTBurn:
+		actionType = typex.CollectibleBurn
+	default:
+		return nil, nil
+	}
+
+	data, err := event.NonFungibleTokenData()
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []*activityx.Action
+
+	for _, item := range data {
+		from, to := item.OldOwnerID, item.NewOwnerID
+
+		switch actionType {
+		case typex.CollectibleMint:
+			from, to = contractID, item.OwnerID
+		case typex.CollectibleBurn:
+			from, to = item.OwnerID, contractID
+		}
+
+		for _, tokenID := range item.TokenIDs {
+			action, err := w.buildNonFungibleTokenAction(ctx, actionType, contractID, from, to, tokenID)
+			if err != nil {
+				return nil, err
+			}
+
+			actions = append(actions, action)
+		}
+	}
+
+	return actions, nil
+}
+
+// handleTokenTransferCall processes an ft_transfer or nft_transfer call by its arguments, other calls are ignored.
+func (w *worker) handleTokenTransferCall(ctx context.Context, signerID, contractID string, functionCall *near.FunctionCallAction) (*activityx.Action, error) {
+	if !lo.Contains([]string{methodFTTransfer, methodFTTransferCall, methodNFTTransfer, methodNFTTransferCall}, functionCall.MethodName) {
+		return nil, nil
+	}
+
+	argsBytes, err := near.DecodeBase64(functionCall.Args)
+	if err != nil {
+		return nil, fmt.Errorf("decode args: %w", err)
+	}
+
+	var args TransferArgs
+	if err := json.Unmarshal(argsBytes, &args); err != nil {
+		return nil, fmt.Errorf("unmarshal args: %w", err)
+	}
+
+	switch functionCall.MethodName {
+	case methodFTTransfer, methodFTTransferCall:
+		return w.buildFungibleTokenAction(ctx, typex.TransactionTransfer, contractID, signerID, args.ReceiverID, args.Amount)
+	default:
+		return w.buildNonFungibleTokenAction(ctx, typex.CollectibleTransfer, contractID, signerID, args.ReceiverID, args.TokenID)
+	}
+}
+
 // buildNearTransactionTransferAction returns the native transfer transaction action.
 func (w *worker) buildNearTransactionTransferAction(_ context.Context, from, to string, tokenValue *big.Int) (*activityx.Action, error) {
 	return &activityx.Action{
@@ -139,11 +352,85 @@ func (w *worker) buildNearTransactionTransferAction(_ context.Context, from, to
 	}, nil
 }
 
+// buildFungibleTokenAction returns a NEP-141 token transfer, mint or burn action.
+func (w *worker) buildFungibleTokenAction(ctx context.Context, actionType schema.Type, contractID, from, to, amount string) (*activityx.Action, error) {
+	value, err := decimal.NewFromString(amount)
+	if err != nil {
+		return nil, fmt.Errorf("parse amount %s: %w", amount, err)
+	}
+
+	tokenMetadata, err := w.tokenClient.LookupFungibleToken(ctx, contractID)
+	if err != nil {
+		return nil, fmt.Errorf("lookup token metadata %s: %w", contractID, err)
+	}
+
+	tokenMetadata.Value = lo.ToPtr(value)
+
+	action := activityx.Action{
+		Type: actionType,
+		From: from,
+		To:   to,
+	}
+
+	switch actionType {
+	case typex.TransactionMint:
+		action.Metadata = metadata.TransactionMint(*tokenMetadata)
+	case typex.TransactionBurn:
+		action.Metadata = metadata.TransactionBurn(*tokenMetadata)
+	default:
+		action.Metadata = metadata.TransactionTransfer(*tokenMetadata)
+	}
+
+	return &action, nil
+}
+
+// buildNonFungibleTokenAction returns a NEP-171 token transfer, mint or burn action.
+// NEP-171 token ids are strings, those not in decimal are appended to the collection name.
+func (w *worker) buildNonFungibleTokenAction(ctx context.Context, actionType schema.Type, contractID, from, to, tokenID string) (*activityx.Action, error) {
+	tokenMetadata, err := w.tokenClient.LookupNonFungibleToken(ctx, contractID)
+	if err != nil {
+		return nil, fmt.Errorf("lookup collection metadata %s: %w", contractID, err)
+	}
+
+	if id, err := decimal.NewFromString(tokenID); err == nil && id.IsInteger() {
+		tokenMetadata.ID = lo.ToPtr(id)
+	} else {
+		tokenMetadata.Name = fmt.Sprintf("%s #%s", tokenMetadata.Name, tokenID)
+	}
+
+	tokenMetadata.Value = lo.ToPtr(decimal.NewFromInt(1))
+
+	action := activityx.Action{
+		Type: actionType,
+		From: from,
+		To:   to,
+	}
+
+	switch actionType {
+	case typex.CollectibleMint:
+		action.Metadata = metadata.CollectibleMint(*tokenMetadata)
+	case typex.CollectibleBurn:
+		action.Metadata = metadata.CollectibleBurn(*tokenMetadata)
+	default:
+		action.Metadata = metadata.CollectibleTransfer(*tokenMetadata)
+	}
+
+	return &action, nil
+}
+
 // NewWorker returns a new Near worker.
-func NewWorker(config *config.Module) (engine.Worker, error) {
+func NewWorker(config *config.Module, redisClient rueidis.Client) (engine.Worker, error) {
 	var instance = worker{
 		config: config,
 	}
 
+	var err error
+
+	if instance.nearClient, err = near.Dial(context.Background(), config.Endpoint.URL); err != nil {
+		return nil, fmt.Errorf("initialize near client: %w", err)
+	}
+
+	instance.tokenClient = token.NewClient(instance.nearClient, token.WithRueidisClient(redisClient))
+
 	return &instance, nil
 }
@@ -20,6 +20,8 @@ import (
 func TestWorker_Near(t *testing.T) {
 	t.Parallel()
 
+	endpoint := "https://archival-rpc.mainnet.near.org"
+
 	type arguments struct {
 		task   *source.Task
 		config *config.Module
@@ -63,6 +65,12 @@ func TestWorker_Near(t *testing.T) {
 						},
 					},
 				},
+				config: &config.Module{
+					Network: network.Near,
+					Endpoint: config.Endpoint{
+						URL: endpoint,
+					},
+				},
 			},
 			want: &activityx.Activity{
 				ID:        "CaPbVFXTdzH9qUJ7WZZvB2CV8GpkqoxzVhBZa75FZvq5",
@@ -96,6 +104,92 @@ func TestWorker_Near(t *testing.T) {
 			},
 			wantError: require.NoError,
 		},
+		{
+			name: "Near NEP-141 Transfer Event",
+			arguments: arguments{
+				task: &source.Task{
+					Network: network.Near,
+					Block: near.Block{
+						Header: near.BlockHeader{
+							Height:    127337369,
+							GasPrice:  "100000000",
+							Timestamp: 1725525629383529996,
+						},
+					},
+					Transaction: near.Transaction{
+						Transaction: near.TransactionDetails{
+							SignerID:   "alice.near",
+							ReceiverID: "usdt.tether-token.near",
+							Actions: []near.Action{
+								{
+									FunctionCall: &near.FunctionCallAction{
+										MethodName: "ft_transfer",
+										Args:       "eyJyZWNlaXZlcl9pZCI6ImJvYi5uZWFyIiwiYW1vdW50IjoiMjUwMDAwMDAifQ==",
+										Deposit:    "1",
+									},
+								},
+							},
+							Hash: "6mGNFXSJYL8hJTCEbGkpdN2mo9bmbvMZkwUYkKpvHpC3",
+						},
+						TransactionOutcome: near.TransactionOutcome{
+							Outcome: near.Outcome{
+								GasBurnt: 2428077860192,
+							},
+						},
+						ReceiptsOutcome: []near.ReceiptOutcome{
+							{
+								ID: "3hFZTMUSZvbhxvPiRr2QNLMvzWjiPSwWG6gwNRqRuZQ1",
+								Outcome: near.Outcome{
+									Logs: []string{
+										`EVENT_JSON:{"standard":"nep141","version":"1.0.0","event":"ft_transfer","data":[{"old_owner_id":"alice.near","new_owner_id":"bob.near","amount":"25000000"}]}`,
+									},
+									ExecutorID: "usdt.tether-token.near",
+								},
+							},
+						},
+					},
+				},
+				config: &config.Module{
+					Network: network.Near,
+					Endpoint: config.Endpoint{
+						URL: endpoint,
+					},
+				},
+			},
+			want: &activityx.Activity{
+				ID:        "6mGNFXSJYL8hJTCEbGkpdN2mo9bmbvMZkwUYkKpvHpC3",
+				Network:   network.Near,
+				Index:     0,
+				From:      "alice.near",
+				To:        "usdt.tether-token.near",
+				Type:      typex.TransactionTransfer,
+				Timestamp: 1725525629,
+				Fee: &activityx.Fee{
+					Amount:  lo.Must(decimal.NewFromString("242807786019200000000")),
+					Decimal: 24,
+				},
+				Calldata: &activityx.Calldata{
+					ParsedFunction: "ft_transfer",
+				},
+				Status: true,
+				Actions: []*activityx.Action{
+					{
+						Type: typex.TransactionTransfer,
+						From: "alice.near",
+						To:   "bob.near",
+						Metadata: metadata.TransactionTransfer{
+							Address:  lo.ToPtr("usdt.tether-token.near"),
+							Name:     "Tether USD",
+							Symbol:   "USDt",
+							Value:    lo.ToPtr(lo.Must(decimal.NewFromString("25000000"))),
+							Decimals: 6,
+							Standard: metadata.StandardNEP141,
+						},
+					},
+				},
+			},
+			wantError: require.NoError,
+		},
 	}
 
 	for _, testcase := range testcases {
@@ -106,7 +200,7 @@ func TestWorker_Near(t *testing.T) {
 
 			ctx := context.Background()
 
-			instance, err := worker.NewWorker(testcase.arguments.config)
+			instance, err := worker.NewWorker(testcase.arguments.config, nil)
 			require.NoError(t, err)
 
 			feed, err := instance.Transform(ctx, testcase.arguments.task)
@@ -6,7 +6,9 @@ import (
 	"github.com/redis/rueidis"
 	"github.com/rss3-network/node/config"
 	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/internal/engine/protocol"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/core/arweave"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/core/bitcoin"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/core/ethereum"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/core/farcaster"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/core/near"
@@ -15,7 +17,7 @@ import (
 
 // NewWorker creates a new core worker.
 func NewWorker(config *config.Module, redisClient rueidis.Client) (engine.Worker, error) {
-	switch config.Network.Protocol() {
+	switch protocol.NetworkProtocol(config.Network) {
 	case network.EthereumProtocol:
 		return ethereum.NewWorker(config, redisClient)
 	case network.ArweaveProtocol:
@@ -23,7 +25,9 @@ func NewWorker(config *config.Module, redisClient rueidis.Client) (engine.Worker
 	case network.FarcasterProtocol:
 		return farcaster.NewWorker()
 	case network.NearProtocol:
-		return near.NewWorker(config)
+		return near.NewWorker(config, redisClient)
+	case protocol.BitcoinProtocol:
+		return bitcoin.NewWorker(config)
 	default:
 		return nil, fmt.Errorf("unsupported worker %s", config.Network)
 	}
@@ -13,10 +13,16 @@ import (
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/arbitrum"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/base"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/benddao"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/blur"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/cow"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/crossbell"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/curve"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/eas"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/eigenlayer"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/ens"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/farcaster"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/gmx"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/governor"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/highlight"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/iqwiki"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/kiwistand"
@@ -37,6 +43,7 @@ import (
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/polymarket"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/rainbow"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/rss3"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/safe"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/savm"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/stargate"
 	"github.com/rss3-network/node/internal/engine/worker/decentralized/contract/uniswap"
@@ -66,11 +73,11 @@ func newNonCoreWorker(config *config.Module, databaseClient database.Client, red
 	case decentralized.OpenSea:
 		return opensea.NewWorker(config)
 	case decentralized.Uniswap:
-		return uniswap.NewWorker(config)
+		return uniswap.NewWorker(config, redisClient)
 	case decentralized.Arbitrum:
-		return arbitrum.NewWorker(config)
+		return arbitrum.NewWorker(config, databaseClient)
 	case decentralized.Optimism:
-		return optimism.NewWorker(config)
+		return optimism.NewWorker(config, databaseClient)
 	case decentralized.Aavegotchi:
 		return aavegotchi.NewWorker(config)
 	case decentralized.Lens:
@@ -104,7 +111,7 @@ func newNonCoreWorker(config *config.Module, databaseClient database.Client, red
 	case decentralized.VSL:
 		return vsl.NewWorker(config)
 	case decentralized.Stargate:
-		return stargate.NewWorker(config)
+		return stargate.NewWorker(config, databaseClient)
 	case decentralized.Curve:
 		return curve.NewWorker(config, redisClient)
 	case decentralized.Paraswap:
@@ -113,10 +120,12 @@ func newNonCoreWorker(config *config.Module, databaseClient database.Client, red
 		return cow.NewWorker(config)
 	case decentralized.BendDAO:
 		return benddao.NewWorker(config)
+	case decentralized.Blur:
+		return blur.NewWorker(config)
 	case decentralized.Base:
-		return base.NewWorker(config)
+		return base.NewWorker(config, databaseClient)
 	case decentralized.Linea:
-		return linea.NewWorker(config)
+		return linea.NewWorker(config, databaseClient)
 	case decentralized.Polymarket:
 		return polymarket.NewWorker(config)
 	case decentralized.LiNEAR:
@@ -127,6 +136,18 @@ func newNonCoreWorker(config *config.Module, databaseClient database.Client, red
 		return rainbow.NewWorker(config)
 	case decentralized.NearSocial:
 		return nearsocial.NewWorker(config)
+	case decentralized.Governor:
+		return governor.NewWorker(config)
+	case decentralized.Safe:
+		return safe.NewWorker(config)
+	case decentralized.GMX:
+		return gmx.NewWorker(config)
+	case decentralized.EigenLayer:
+		return eigenlayer.NewWorker(config)
+	case decentralized.EAS:
+		return eas.NewWorker(config, databaseClient)
+	case decentralized.Farcaster:
+		return farcaster.NewWorker(config, databaseClient)
 	default:
 		return nil, fmt.Errorf("unsupported worker %s", config.Worker)
 	}
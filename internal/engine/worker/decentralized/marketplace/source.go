@@ -0,0 +1,100 @@
+package marketplace
+
+import (
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract/blur"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+)
+
+var (
+	// GemSwap https://etherscan.io/address/0x83C8F28c26bF6aaca652Df1DbBE0e1b56F8baba2
+	AddressGemSwap = common.HexToAddress("0x83C8F28c26bF6aaca652Df1DbBE0e1b56F8baba2")
+	// ReservoirV6Dot0Dot0 https://etherscan.io/address/0x178A86D36D89c7FDeBeA90b739605da7B131ff6A
+	AddressReservoirV6Dot0Dot0 = common.HexToAddress("0x178A86D36D89c7FDeBeA90b739605da7B131ff6A")
+	// ReservoirV6Dot0Dot1 is deployed to the same address on all networks.
+	AddressReservoirV6Dot0Dot1 = common.HexToAddress("0xC2c862322E9c97D6244a3506655DA95F05246Fd8")
+)
+
+// Domains of the aggregators and front-ends sourcing the marketplace trades.
+const (
+	DomainBlur      = "blur.io"
+	DomainGem       = "gem.xyz"
+	DomainMagicEden = "magiceden.io"
+	DomainOpenSea   = "opensea.io"
+	DomainReservoir = "reservoir.tools"
+)
+
+const (
+	selectorLength  = 4
+	domainTagLength = 4
+)
+
+// routers are the aggregator contracts routing the trades to the marketplaces.
+var routers = map[common.Address]string{
+	blur.AddressAggregator:     DomainBlur,
+	AddressGemSwap:             DomainGem,
+	AddressReservoirV6Dot0Dot0: DomainReservoir,
+	AddressReservoirV6Dot0Dot1: DomainReservoir,
+}
+
+// domainTags are the tags of the domains appended to the calldata of the trades, which follows the Seaport
+// domain registry, and the routers of Reservoir append the tag of the front-end calling them in the same way.
+var domainTags = lo.SliceToMap([]string{DomainBlur, DomainGem, DomainMagicEden, DomainOpenSea, DomainReservoir}, func(domain string) ([domainTagLength]byte, string) {
+	return DomainTag(domain), domain
+})
+
+// DomainTag returns the tag of the domain, which is the first 4 bytes of the Keccak-256 hash of the domain.
+func DomainTag(domain string) [domainTagLength]byte {
+	return [domainTagLength]byte(crypto.Keccak256([]byte(domain)))
+}
+
+// SourceOf returns the domain of the aggregator or front-end sourcing the transaction.
+// The tag in the calldata takes precedence over the router, as a router is called by many front-ends.
+func SourceOf(transaction *ethereum.Transaction) (string, bool) {
+	if domain, ok := sourceOfCalldata(transaction.Input); ok {
+		return domain, true
+	}
+
+	if transaction.To == nil {
+		return "", false
+	}
+
+	domain, ok := routers[*transaction.To]
+
+	return domain, ok
+}
+
+// sourceOfCalldata returns the domain of the tag in the tail of the calldata. The arguments are encoded to
+// words of 32 bytes after the function selector, so the bytes beyond the last word are the appended tail.
+func sourceOfCalldata(input []byte) (string, bool) {
+	if len(input) < selectorLength || (len(input)-selectorLength)%common.HashLength < domainTagLength {
+		return "", false
+	}
+
+	domain, ok := domainTags[[domainTagLength]byte(input[len(input)-domainTagLength:])]
+
+	return domain, ok
+}
+
+// Attribute appends the URL of the aggregator or front-end sourcing the transaction to the related URLs of the trade actions,
+// as the metadata of the trades has no field for it.
+func Attribute(transaction *ethereum.Transaction, actions []*activityx.Action) {
+	domain, ok := SourceOf(transaction)
+	if !ok {
+		return
+	}
+
+	sourceURL := (&url.URL{Scheme: "https", Host: domain}).String()
+
+	for _, action := range actions {
+		if action.Type == typex.CollectibleTrade {
+			action.RelatedURLs = append(action.RelatedURLs, sourceURL)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package marketplace_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rss3-network/node/internal/engine/worker/decentralized/marketplace"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract/blur"
+	"github.com/rss3-network/node/provider/ethereum/contract/opensea"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomainTag(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "0x360c6ebe", hexutil.Encode(lo.ToPtr(marketplace.DomainTag(marketplace.DomainOpenSea))[:]))
+	require.Equal(t, "0x1d4da48b", hexutil.Encode(lo.ToPtr(marketplace.DomainTag(marketplace.DomainReservoir))[:]))
+}
+
+func TestSourceOf(t *testing.T) {
+	t.Parallel()
+
+	// The calldata of fulfillBasicOrder_efficient_6GL6yc with a word of arguments.
+	calldata := hexutil.MustDecode("0x00000000" + "0000000000000000000000000000000000000000000000000000000000000020")
+
+	testcases := []struct {
+		name        string
+		transaction *ethereum.Transaction
+		want        string
+		wantOK      bool
+	}{
+		{
+			name: "Tagged by the front-end",
+			transaction: &ethereum.Transaction{
+				To:    lo.ToPtr(opensea.AddressSeaportV1Dot6),
+				Input: append(append([]byte{}, calldata...), hexutil.MustDecode("0x0e1c0c38")...),
+			},
+			want:   marketplace.DomainMagicEden,
+			wantOK: true,
+		},
+		{
+			name: "Tagged by the front-end calling a router",
+			transaction: &ethereum.Transaction{
+				To:    lo.ToPtr(marketplace.AddressReservoirV6Dot0Dot1),
+				Input: append(append([]byte{}, calldata...), hexutil.MustDecode("0x360c6ebe")...),
+			},
+			want:   marketplace.DomainOpenSea,
+			wantOK: true,
+		},
+		{
+			name: "Routed by an aggregator",
+			transaction: &ethereum.Transaction{
+				To:    lo.ToPtr(blur.AddressAggregator),
+				Input: calldata,
+			},
+			want:   marketplace.DomainBlur,
+			wantOK: true,
+		},
+		{
+			name: "Unknown tag",
+			transaction: &ethereum.Transaction{
+				To:    lo.ToPtr(opensea.AddressSeaportV1Dot6),
+				Input: append(append([]byte{}, calldata...), hexutil.MustDecode("0xdeadbeef")...),
+			},
+		},
+		{
+			name: "Words without a tail",
+			transaction: &ethereum.Transaction{
+				To:    lo.ToPtr(common.HexToAddress("0x0000000000000000000000000000000000000001")),
+				Input: append(append([]byte{}, calldata...), common.LeftPadBytes(hexutil.MustDecode("0x360c6ebe"), common.HashLength)...),
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			domain, ok := marketplace.SourceOf(testcase.transaction)
+			require.Equal(t, testcase.wantOK, ok)
+			require.Equal(t, testcase.want, domain)
+		})
+	}
+}
@@ -3,14 +3,17 @@ package bluesky
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/bluesky-social/indigo/api/bsky"
 	"github.com/reiver/go-bsky/app/bsky/feed"
+	"github.com/reiver/go-bsky/app/bsky/graph"
 	"github.com/rss3-network/node/internal/database"
 	"github.com/rss3-network/node/internal/database/model"
 	"github.com/rss3-network/node/internal/engine"
 	source "github.com/rss3-network/node/internal/engine/protocol/atproto"
 	at "github.com/rss3-network/node/provider/atproto"
+	workerx "github.com/rss3-network/node/schema/worker"
 	"github.com/rss3-network/node/schema/worker/federated"
 	"github.com/rss3-network/protocol-go/schema"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
@@ -25,7 +28,9 @@ import (
 var _ engine.Worker = (*worker)(nil)
 
 const (
-	ActorProfile = "app.bsky.actor.profile"
+	ActorProfile  = "app.bsky.actor.profile"
+	GraphBlock    = "app.bsky.graph.block"
+	GraphListItem = "app.bsky.graph.listitem"
 )
 
 type worker struct {
@@ -55,6 +60,7 @@ func (w *worker) Tags() []tag.Tag {
 func (w *worker) Types() []schema.Type {
 	return []schema.Type{
 		typex.SocialComment,
+		typex.SocialDelete,
 		typex.SocialPost,
 		typex.SocialShare,
 		typex.SocialLike,
@@ -70,6 +76,9 @@ func (w *worker) Filter() engine.DataSourceFilter {
 			feed.RepostTypeValue,
 			feed.LikeTypeValue,
 			ActorProfile,
+			graph.FollowTypeValue,
+			GraphBlock,
+			GraphListItem,
 		},
 	}
 }
@@ -86,13 +95,21 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 		return nil, fmt.Errorf("build activity: %w", err)
 	}
 
+	// Deleted records are no longer available, so they are transformed by the indexed activities
+	if atprotoTask.Message.Action == at.ActionDelete {
+		return w.transformDelete(ctx, atprotoTask.Message, activity)
+	}
+
 	// Handle atproto message.
 	switch atprotoTask.Message.Collection {
 	case feed.PostTypeValue:
-		if atprotoTask.Message.RefMessage == nil {
-			w.transformPost(ctx, atprotoTask.Message, activity)
-		} else {
+		switch {
+		case atprotoTask.Message.RefMessage != nil:
 			w.transformComment(ctx, atprotoTask.Message, activity)
+		case atprotoTask.Message.QuoteMessage != nil:
+			w.transformQuote(ctx, atprotoTask.Message, activity)
+		default:
+			w.transformPost(ctx, atprotoTask.Message, activity)
 		}
 	case feed.RepostTypeValue:
 		if atprotoTask.Message.RefMessage != nil {
@@ -104,6 +121,12 @@ func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Ac
 		}
 	case ActorProfile:
 		w.transformProfile(ctx, atprotoTask.Message, activity)
+	case graph.FollowTypeValue:
+		w.transformRelation(ctx, atprotoTask.Message, activity, workerx.SocialRelationFollow)
+	case GraphBlock:
+		w.transformRelation(ctx, atprotoTask.Message, activity, workerx.SocialRelationBlock)
+	case GraphListItem:
+		w.transformRelation(ctx, atprotoTask.Message, activity, workerx.SocialRelationList)
 	default:
 		zap.L().Warn("unsupported type", zap.String("type", atprotoTask.Message.Collection))
 
@@ -145,6 +168,21 @@ func (w *worker) transformComment(_ context.Context, message at.Message, activit
 	}
 }
 
+// transformQuote transforms a quote message into an activity, which shares the quoted post.
+func (w *worker) transformQuote(_ context.Context, message at.Message, activity *activityx.Activity) {
+	activity.Type = typex.SocialShare
+	activity.From = message.Did.String()
+	activity.To = message.QuoteMessage.Did.String()
+
+	post := w.buildPostMetadata(message)
+
+	post.Target = w.buildPostMetadata(lo.FromPtr(message.QuoteMessage))
+
+	activity.Actions = []*activityx.Action{
+		w.buildPostAction(activity.From, activity.To, activity.Type, post),
+	}
+}
+
 // transformRepost transforms a repost message into an activity.
 func (w *worker) transformRepost(_ context.Context, message at.Message, activity *activityx.Activity) {
 	activity.Type = typex.SocialShare
@@ -185,6 +223,101 @@ func (w *worker) transformProfile(_ context.Context, message at.Message, activit
 	}
 }
 
+// transformRelation transforms a follow, block or list item message into an activity.
+// The protocol has no types of relations, so the relation is a SocialProfile update of the account.
+func (w *worker) transformRelation(_ context.Context, message at.Message, activity *activityx.Activity, relation string) {
+	if message.RefMessage == nil {
+		return
+	}
+
+	activity.Type = typex.SocialProfile
+	activity.From = message.Did.String()
+	activity.To = message.RefMessage.Did.String()
+
+	action := &activityx.Action{
+		Tag:      activity.Type.Tag(),
+		Type:     activity.Type,
+		Platform: w.Platform(),
+		From:     activity.From,
+		To:       activity.To,
+		Metadata: &metadata.SocialProfile{
+			Action:    metadata.ActionSocialProfileUpdate,
+			ProfileID: message.Did.String(),
+			Handle:    message.Handle,
+			Key:       relation,
+			Value:     lo.CoalesceOrEmpty(message.RefMessage.Handle, activity.To),
+		},
+	}
+
+	// The list of a list item is linked to the action
+	if message.ListItem != nil {
+		if listURL := buildListURL(message.ListItem.List); listURL != "" {
+			action.RelatedURLs = []string{listURL}
+		}
+	}
+
+	activity.Actions = []*activityx.Action{action}
+}
+
+// transformDelete transforms a deleted record. A deleted post is indexed as a SocialDelete activity and a deleted follow,
+// block or list item is indexed as the relation undoing it, other deleted records such as likes and reposts are removed.
+// The activity replaces the activity of the deleted record, as both have the ID of the record.
+func (w *worker) transformDelete(ctx context.Context, message at.Message, activity *activityx.Activity) (*activityx.Activity, error) {
+	stored, _, err := w.databaseClient.FindActivity(ctx, model.ActivityQuery{
+		ID:          lo.ToPtr(activity.ID),
+		Network:     lo.ToPtr(activity.Network),
+		ActionLimit: 100,
+		ActionPage:  1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("find activity %s: %w", activity.ID, err)
+	}
+
+	if err := w.databaseClient.DeleteActivity(ctx, activity.Network, activity.ID); err != nil {
+		return nil, fmt.Errorf("delete activity %s: %w", activity.ID, err)
+	}
+
+	switch message.Collection {
+	case feed.PostTypeValue:
+		activity.Type = typex.SocialDelete
+		activity.From = message.Did.String()
+		activity.To = message.Did.String()
+
+		// The deleted post keeps no content
+		activity.Actions = []*activityx.Action{
+			w.buildPostAction(activity.From, activity.To, activity.Type, w.buildPostMetadata(message)),
+		}
+	case graph.FollowTypeValue, GraphBlock, GraphListItem:
+		if stored == nil {
+			return nil, nil
+		}
+
+		for _, action := range stored.Actions {
+			profile, ok := action.Metadata.(*metadata.SocialProfile)
+			if !ok {
+				continue
+			}
+
+			profile.Key = workerx.SocialRelationUndo[profile.Key]
+			action.Metadata = profile
+
+			activity.Actions = append(activity.Actions, action)
+		}
+
+		activity.Type = typex.SocialProfile
+		activity.From = stored.From
+		activity.To = stored.To
+	default:
+		return nil, nil
+	}
+
+	if len(activity.Actions) == 0 {
+		return nil, nil
+	}
+
+	return activity, nil
+}
+
 // buildPostMetadata constructs metadata for a post message.
 func (w *worker) buildPostMetadata(message at.Message) *metadata.SocialPost {
 	post := &metadata.SocialPost{
@@ -258,11 +391,13 @@ func (w *worker) buildPostMedia(embed *bsky.FeedPost_Embed) []metadata.Media {
 		}
 	}
 
-	if embed.EmbedRecord != nil && embed.EmbedRecord.Record != nil {
-		media = append(media, metadata.Media{
-			Address:  embed.EmbedRecord.Record.Uri,
-			MimeType: "media/mp4",
-		})
+	// The media of a quote post, the quoted record is the target of the post
+	if embed.EmbedRecordWithMedia != nil && embed.EmbedRecordWithMedia.Media != nil {
+		media = append(media, w.buildPostMedia(&bsky.FeedPost_Embed{
+			EmbedImages:   embed.EmbedRecordWithMedia.Media.EmbedImages,
+			EmbedVideo:    embed.EmbedRecordWithMedia.Media.EmbedVideo,
+			EmbedExternal: embed.EmbedRecordWithMedia.Media.EmbedExternal,
+		})...)
 	}
 
 	if embed.EmbedVideo != nil && embed.EmbedVideo.Video != nil {
@@ -282,6 +417,16 @@ func (w *worker) buildPostMedia(embed *bsky.FeedPost_Embed) []metadata.Media {
 	return media
 }
 
+// buildListURL builds the URL of a list from its AT URI (format: at://did/app.bsky.graph.list/rkey).
+func buildListURL(uri string) string {
+	parts := strings.Split(strings.TrimPrefix(uri, "at://"), "/")
+	if len(parts) != 3 {
+		return ""
+	}
+
+	return fmt.Sprintf("https://bsky.app/profile/%s/lists/%s", parts[0], parts[2])
+}
+
 // saveProfiles saves the profiles to the database.
 func (w *worker) saveProfiles(ctx context.Context, task *source.Task) {
 	profiles := []*model.BlueskyProfile{{
@@ -30,7 +30,7 @@ import (
 	"go.uber.org/zap"
 )
 
-var _ engine.Worker = (*worker)(nil)
+var _ engine.MutatingWorker = (*worker)(nil)
 
 type worker struct {
 	httpClient     httpx.Client
@@ -61,7 +61,10 @@ func (w *worker) Tags() []tag.Tag {
 func (w *worker) Types() []schema.Type {
 	return []schema.Type{
 		typex.SocialComment,
+		typex.SocialDelete,
+		typex.SocialLike,
 		typex.SocialPost,
+		typex.SocialRevise,
 		typex.SocialShare,
 	}
 }
@@ -71,37 +74,69 @@ func (w *worker) Filter() engine.DataSourceFilter {
 	return nil
 }
 
-// Transform processes the task and converts it into an activity.
+// Transform processes the task and converts it into an activity, the mutations of the indexed activities are returned by TransformMutations.
 func (w *worker) Transform(ctx context.Context, task engine.Task) (*activityx.Activity, error) {
+	activity, _, err := w.TransformMutations(ctx, task)
+
+	return activity, err
+}
+
+// TransformMutations processes the task and converts it into an activity,
+// deleted and edited posts and undone activities are returned as the mutations of the indexed activities.
+func (w *worker) TransformMutations(ctx context.Context, task engine.Task) (*activityx.Activity, []*engine.Mutation, error) {
 	activityPubTask, ok := task.(*source.Task)
 
 	if !ok {
-		return nil, fmt.Errorf("invalid task type: %T", task)
+		return nil, nil, fmt.Errorf("invalid task type: %T", task)
 	}
 
 	activity, err := task.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
 
 	if err != nil {
-		return nil, fmt.Errorf("build activity: %w", err)
+		return nil, nil, fmt.Errorf("build activity: %w", err)
 	}
 
+	var mutations []*engine.Mutation
+
 	// Handle ActivityPub message.
 	switch activityPubTask.Message.Type {
 	case mastodon.MessageTypeCreate.String():
 		err = w.handleActivityPubCreate(ctx, activityPubTask.Message, activity)
 	case mastodon.MessageTypeAnnounce.String():
 		err = w.handleActivityPubAnnounce(ctx, activityPubTask.Message, activity)
+	case mastodon.MessageTypeLike.String():
+		err = w.handleActivityPubLike(ctx, activityPubTask.Message, activity)
+	case mastodon.MessageTypeFollow.String(), mastodon.MessageTypeAccept.String():
+		// The protocol has no type of follows, follows are not indexed until it has one
+		zap.L().Debug("skipping Follow", zap.String("ID", activityPubTask.Message.ID))
+
+		return nil, nil, nil
+	case mastodon.MessageTypeUpdate.String():
+		activity, mutations, err = w.handleActivityPubUpdate(ctx, activityPubTask.Message, activity)
+	case mastodon.MessageTypeDelete.String():
+		activity, mutations, err = w.handleActivityPubDelete(activityPubTask.Message, activity)
+	case mastodon.MessageTypeUndo.String():
+		activity, mutations, err = w.handleActivityPubUndo(activityPubTask.Message, activity)
 	default:
 		zap.L().Debug("unsupported type", zap.String("type", activityPubTask.Message.Type))
 
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("handle %s message: %w", activityPubTask.Message.Type, err)
+		return nil, nil, fmt.Errorf("handle %s message: %w", activityPubTask.Message.Type, err)
 	}
 
-	return activity, nil
+	// Messages of other ActivityPub software are indexed with their platforms
+	if activity != nil && activityPubTask.Platform != federated.PlatformUnknown {
+		activity.Platform = activityPubTask.Platform.String()
+
+		for _, action := range activity.Actions {
+			action.Platform = activity.Platform
+		}
+	}
+
+	return activity, mutations, nil
 }
 
 func (w *worker) handleActivityPubCreate(ctx context.Context, message activitypub.Object, activity *activityx.Activity) error {
@@ -110,7 +145,13 @@ func (w *worker) handleActivityPubCreate(ctx context.Context, message activitypu
 		return fmt.Errorf("failed to extract Create objects: %w", err)
 	}
 
-	currentUserHandle := convertURLToHandle(message.ID)
+	currentUserHandle := convertMessageToHandle(message)
+
+	// The post is indexed by the id of its object, as deletions and edits refer to the object,
+	// and the ids of the activities of Pleroma and Lemmy differ from the ids of their objects.
+	if len(noteObjects) > 0 && noteObjects[0].ID != "" {
+		activity.ID = strings.TrimSuffix(noteObjects[0].ID, mastodon.ActivitySuffix)
+	}
 
 	for _, currentNoteObject := range noteObjects {
 		if err := w.handleSingleActivityPubCreate(ctx, message, currentNoteObject, activity, currentUserHandle); err != nil {
@@ -145,41 +186,19 @@ func (w *worker) handleSingleActivityPubCreate(ctx context.Context, message acti
 	if replyToURLID, ok := noteObject[mastodon.InReplyTo].(string); ok {
 		activity.Type = typex.SocialComment
 
-		result, err := w.getParentStatusByParentID(ctx, replyToURLID)
-		if err != nil {
-			zap.L().Error("failed to get parent status and content by parent ID", zap.String("parentID", replyToURLID), zap.String("ID", message.ID))
-		}
-
-		var (
-			targetContent string
-			targetTime    uint64
-		)
-
-		if result != nil {
-			targetContent, err = html2text.FromString(result.Content, html2text.Options{
-				PrettyTables: true,
-			})
-
-			if err != nil {
-				zap.L().Error("failed to convert HTML to text", zap.Error(err), zap.String("ID", replyToURLID))
-
-				targetContent = result.Content
-			}
-
-			targetTime = w.parseTimestamp(result.Timestamp)
-		}
-
-		post.Target = w.buildTarget(replyToURLID, targetContent, targetTime)
+		post.Target = w.buildTargetByID(ctx, message, replyToURLID)
 		post.TargetURL = replyToURLID
 		toUserHandle = convertURLToHandle(replyToURLID)
+	} else if note.QuoteURL != "" {
+		// A quote of Misskey and the forks of Mastodon shares the quoted post with the content of the quote
+		activity.Type = typex.SocialShare
 
-		if result != nil && result.Attachments != nil {
-			w.buildPostMedia(post.Target, result.Attachments)
-		}
-
-		if result != nil && result.Tags != nil {
-			w.buildPostTags(post.Target, result.Tags)
-		}
+		post.Target = w.buildTargetByID(ctx, message, note.QuoteURL)
+		post.TargetURL = note.QuoteURL
+		toUserHandle = convertURLToHandle(note.QuoteURL)
+	} else if note.Audience != "" {
+		// A post of Lemmy is posted to its community
+		toUserHandle = convertActorToHandle(note.Audience)
 	}
 
 	activity.To = toUserHandle
@@ -205,6 +224,45 @@ func (w *worker) handleSingleActivityPubCreate(ctx context.Context, message acti
 	return nil
 }
 
+// buildTargetByID builds the target post of the ID, the content of the target is fetched from its instance.
+func (w *worker) buildTargetByID(ctx context.Context, message activitypub.Object, targetID string) *metadata.SocialPost {
+	result, err := w.getParentStatusByParentID(ctx, targetID)
+	if err != nil {
+		zap.L().Error("failed to get parent status and content by parent ID", zap.String("parentID", targetID), zap.String("ID", message.ID))
+	}
+
+	var (
+		targetContent string
+		targetTime    uint64
+	)
+
+	if result != nil {
+		targetContent, err = html2text.FromString(result.Content, html2text.Options{
+			PrettyTables: true,
+		})
+
+		if err != nil {
+			zap.L().Error("failed to convert HTML to text", zap.Error(err), zap.String("ID", targetID))
+
+			targetContent = result.Content
+		}
+
+		targetTime = w.parseTimestamp(result.Timestamp)
+	}
+
+	target := w.buildTarget(targetID, targetContent, targetTime)
+
+	if result != nil && result.Attachments != nil {
+		w.buildPostMedia(target, result.Attachments)
+	}
+
+	if result != nil && result.Tags != nil {
+		w.buildPostTags(target, result.Tags)
+	}
+
+	return target
+}
+
 // handleActivityPubAnnounce handles Announce activities (shares/boosts) in ActivityPub.
 func (w *worker) handleActivityPubAnnounce(ctx context.Context, message activitypub.Object, activity *activityx.Activity) error {
 	objects, err := extractAnnounceObjects(message.Object)
@@ -212,7 +270,7 @@ func (w *worker) handleActivityPubAnnounce(ctx context.Context, message activity
 		return fmt.Errorf("failed to extract objects: %w", err)
 	}
 
-	currentUserHandle := convertURLToHandle(message.ID)
+	currentUserHandle := convertMessageToHandle(message)
 
 	// If the actor is from a relay server then we directly set it as the handle
 	if currentUserHandle == "" {
@@ -308,6 +366,158 @@ func (w *worker) handleSingleActivityPubAnnounce(ctx context.Context, message ac
 	return nil
 }
 
+// handleActivityPubLike handles Like activities (favourites) in ActivityPub.
+func (w *worker) handleActivityPubLike(ctx context.Context, message activitypub.Object, activity *activityx.Activity) error {
+	objects, err := extractAnnounceObjects(message.Object)
+	if err != nil {
+		return fmt.Errorf("failed to extract Like objects: %w", err)
+	}
+
+	currentUserHandle := convertActorToHandle(message.Actor)
+
+	activity.Type = typex.SocialLike
+	activity.Tag = tag.Social
+	activity.From = currentUserHandle
+
+	for _, obj := range objects {
+		activity.To = convertURLToHandle(obj.ID)
+
+		// The liked post is not fetched, as likes are far more frequent than posts
+		post := &metadata.SocialPost{
+			Handle:    currentUserHandle,
+			Timestamp: activity.Timestamp,
+			Target:    w.buildTarget(obj.ID, "", 0),
+			TargetURL: obj.ID,
+		}
+
+		activity.Actions = append(activity.Actions, w.createAction(activity.Type, activity.Tag, obj.ID, currentUserHandle, activity.To, post))
+	}
+
+	activity.TotalActions = uint(len(activity.Actions))
+
+	return w.saveMastodonHandles(ctx, []string{activity.To, activity.From})
+}
+
+// handleActivityPubUpdate handles edited posts, the edit is indexed as a SocialRevise activity and revises the indexed post.
+func (w *worker) handleActivityPubUpdate(ctx context.Context, message activitypub.Object, activity *activityx.Activity) (*activityx.Activity, []*engine.Mutation, error) {
+	// Updates of actors and other objects are not indexed
+	if noteObject, ok := message.Object.(map[string]interface{}); !ok || !lo.Contains(mastodon.PostObjectTypes, fmt.Sprint(noteObject[mastodon.TagType])) {
+		zap.L().Debug("skipping Update of an object which is not a post", zap.String("ID", message.ID))
+
+		return nil, nil, nil
+	}
+
+	noteObjects, err := extractNoteObjects(message.Object)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to extract Update objects: %w", err)
+	}
+
+	note := noteObjects[0]
+	currentUserHandle := convertActorToHandle(message.Actor)
+	post := w.buildPost(ctx, message, note, activity.Timestamp)
+
+	activity.Type = typex.SocialRevise
+	activity.Tag = tag.Social
+	activity.From = currentUserHandle
+	activity.To = currentUserHandle
+	activity.Actions = append(activity.Actions, w.createAction(activity.Type, activity.Tag, note.ID, currentUserHandle, currentUserHandle, post))
+	activity.TotalActions = uint(len(activity.Actions))
+
+	return activity, []*engine.Mutation{buildRevisePostMutation(activity.Network, note.ID, post)}, nil
+}
+
+// handleActivityPubDelete handles deleted posts, the deletion is indexed as a SocialDelete activity and removes the indexed post.
+func (w *worker) handleActivityPubDelete(message activitypub.Object, activity *activityx.Activity) (*activityx.Activity, []*engine.Mutation, error) {
+	objects, err := extractAnnounceObjects(message.Object)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to extract Delete objects: %w", err)
+	}
+
+	mutations := make([]*engine.Mutation, 0, len(objects))
+
+	currentUserHandle := convertActorToHandle(message.Actor)
+
+	activity.Type = typex.SocialDelete
+	activity.Tag = tag.Social
+	activity.From = currentUserHandle
+	activity.To = currentUserHandle
+
+	for _, obj := range objects {
+		// Deletions of accounts are not indexed
+		if obj.ID == message.Actor {
+			continue
+		}
+
+		mutations = append(mutations, buildDeleteMutation(activity.Network, obj.ID))
+
+		// The deleted post keeps no content
+		post := &metadata.SocialPost{
+			Handle:        currentUserHandle,
+			PublicationID: ExtractPublicationID(obj.ID),
+			Timestamp:     activity.Timestamp,
+		}
+
+		activity.Actions = append(activity.Actions, w.createAction(activity.Type, activity.Tag, obj.ID, currentUserHandle, currentUserHandle, post))
+	}
+
+	if len(activity.Actions) == 0 {
+		return nil, nil, nil
+	}
+
+	activity.TotalActions = uint(len(activity.Actions))
+
+	return activity, mutations, nil
+}
+
+// handleActivityPubUndo handles undone activities, the undone activities such as likes and shares are removed.
+func (w *worker) handleActivityPubUndo(message activitypub.Object, activity *activityx.Activity) (*activityx.Activity, []*engine.Mutation, error) {
+	objects, err := extractAnnounceObjects(message.Object)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to extract Undo objects: %w", err)
+	}
+
+	mutations := make([]*engine.Mutation, 0, len(objects))
+
+	for _, obj := range objects {
+		// Follows are not indexed
+		if obj.Type == mastodon.MessageTypeFollow.String() {
+			continue
+		}
+
+		mutations = append(mutations, buildDeleteMutation(activity.Network, obj.ID))
+	}
+
+	return nil, mutations, nil
+}
+
+// buildDeleteMutation removes the indexed activity of the object.
+func buildDeleteMutation(network network.Network, objectID string) *engine.Mutation {
+	return &engine.Mutation{
+		Network: network,
+		ID:      strings.TrimSuffix(objectID, mastodon.ActivitySuffix),
+	}
+}
+
+// buildRevisePostMutation replaces the content of the indexed post with the revised post.
+func buildRevisePostMutation(network network.Network, noteID string, revised *metadata.SocialPost) *engine.Mutation {
+	return &engine.Mutation{
+		Network: network,
+		ID:      strings.TrimSuffix(noteID, mastodon.ActivitySuffix),
+		Revise: func(activity *activityx.Activity) {
+			for _, action := range activity.Actions {
+				post, ok := action.Metadata.(*metadata.SocialPost)
+				if !ok || !lo.Contains(action.RelatedURLs, noteID) {
+					continue
+				}
+
+				post.Body = revised.Body
+				post.Media = revised.Media
+				post.Tags = revised.Tags
+			}
+		},
+	}
+}
+
 // saveMastodonHandles store the unique handles into the relevant DB table
 func (w *worker) saveMastodonHandles(ctx context.Context, handles []string) error {
 	// Find all unique handles
@@ -377,13 +587,14 @@ func (w *worker) buildTarget(parentID string, content string, timeStamp uint64)
 // buildPost constructs a SocialPost object from ActivityPub object and note
 func (w *worker) buildPost(_ context.Context, obj activitypub.Object, note activitypub.Note, timestamp uint64) *metadata.SocialPost {
 	// Create a new SocialPost with the content, profile ID, publication ID, and timestamp
-	handle := convertURLToHandle(obj.ID)
+	handle := convertMessageToHandle(obj)
 
 	// Extract the numeric part at the end of the string
 	publicationID := ExtractPublicationID(note.ID)
 
 	post := &metadata.SocialPost{
 		PublicationID: publicationID,
+		Title:         note.Name,
 		Timestamp:     timestamp,
 		Handle:        handle,
 	}
@@ -614,6 +825,21 @@ func convertURLToHandle(statusID string) string {
 	return ""
 }
 
+// convertMessageToHandle converts the actor of the message to a handle string.
+// Software such as Misskey and Pleroma does not scope the ids of messages to their actors, so the id is the fallback only.
+func convertMessageToHandle(message activitypub.Object) string {
+	return convertURLToHandle(lo.Ternary(message.Actor != "", message.Actor, message.ID))
+}
+
+// convertActorToHandle converts the actor URL to a handle string, the URL itself is the handle of an unknown actor format
+func convertActorToHandle(actor string) string {
+	if handle := convertURLToHandle(actor); handle != "" {
+		return handle
+	}
+
+	return actor
+}
+
 // getParentStatusByParentID retrieves the parent status and content by its parent ID
 func (w *worker) getParentStatusByParentID(ctx context.Context, parentID string) (*activitypub.StatusResult, error) {
 	apiURL := fmt.Sprintf("%s/activity", parentID)
@@ -673,6 +899,13 @@ func ExtractPublicationID(url string) string {
 		}
 	}
 
+	// Software such as Misskey, Pleroma and PeerTube has alphanumeric ids, which are the last parts of the paths
+	for i := len(parts) - 1; i > 3; i-- {
+		if parts[i] != "" && "/"+parts[i] != mastodon.ActivitySuffix {
+			return parts[i]
+		}
+	}
+
 	return ""
 }
 
@@ -17,6 +17,7 @@ import (
 	"github.com/rss3-network/node/config"
 	"github.com/rss3-network/node/internal/database"
 	"github.com/rss3-network/node/internal/database/dialer"
+	"github.com/rss3-network/node/internal/engine"
 	"github.com/rss3-network/node/internal/engine/protocol/activitypub"
 	message "github.com/rss3-network/node/provider/activitypub"
 	redisx "github.com/rss3-network/node/provider/redis"
@@ -174,7 +175,7 @@ func TestWorker(t *testing.T) {
 				},
 			},
 			want: &activity.Activity{
-				ID:           "https://digitalcourage.social/users/Volksverpetzer/statuses/113287832117292671/activity",
+				ID:           "https://digitalcourage.social/users/Volksverpetzer/statuses/113287832117292671",
 				Network:      network.Mastodon,
 				Platform:     federated.PlatformMastodon.String(),
 				From:         "@Volksverpetzer@digitalcourage.social",
@@ -244,7 +245,7 @@ func TestWorker(t *testing.T) {
 				},
 			},
 			want: &activity.Activity{
-				ID:           "https://mastodon.world/users/ctxt/statuses/113287758853959995/activity",
+				ID:           "https://mastodon.world/users/ctxt/statuses/113287758853959995",
 				Network:      network.Mastodon,
 				Platform:     federated.PlatformMastodon.String(),
 				From:         "@ctxt@mastodon.world",
@@ -340,7 +341,7 @@ func TestWorker(t *testing.T) {
 				},
 			},
 			want: &activity.Activity{
-				ID:           "https://mastodon.social/users/DJGummikuh/statuses/113287749405285684/activity",
+				ID:           "https://mastodon.social/users/DJGummikuh/statuses/113287749405285684",
 				Network:      network.Mastodon,
 				Platform:     federated.PlatformMastodon.String(),
 				From:         "@DJGummikuh@mastodon.social",
@@ -397,7 +398,7 @@ func TestWorker(t *testing.T) {
 				},
 			},
 			want: &activity.Activity{
-				ID:           "https://social.timespiral.co.jp/users/find575/statuses/113287741759175713/activity",
+				ID:           "https://social.timespiral.co.jp/users/find575/statuses/113287741759175713",
 				Network:      network.Mastodon,
 				Platform:     federated.PlatformMastodon.String(),
 				From:         "@find575@social.timespiral.co.jp",
@@ -460,7 +461,7 @@ func TestWorker(t *testing.T) {
 				},
 			},
 			want: &activity.Activity{
-				ID:           "https://social.timespiral.co.jp/users/find575/statuses/113287741759175713/activity",
+				ID:           "https://social.timespiral.co.jp/users/find575/statuses/113287741759175713",
 				Network:      network.Mastodon,
 				Platform:     federated.PlatformMastodon.String(),
 				From:         "@find575@social.timespiral.co.jp",
@@ -555,6 +556,107 @@ func TestWorker(t *testing.T) {
 			},
 			wantError: require.NoError,
 		},
+		{
+			name: "Create A Lemmy Post",
+			arguments: arguments{
+				task: &activitypub.Task{
+					Network:  network.Mastodon,
+					Platform: federated.PlatformLemmy,
+					Message: message.Object{
+						ID:        "https://lemmy.ml/activities/create/0c9a3b5e",
+						Type:      "Create",
+						Actor:     "https://lemmy.ml/u/bob",
+						Published: "2024-10-11T08:25:33Z",
+						Object: map[string]interface{}{
+							"id":        "https://lemmy.ml/post/21483",
+							"type":      "Page",
+							"name":      "RSS is not dead",
+							"content":   "<p>A good read</p>",
+							"published": "2024-10-11T08:25:33Z",
+							"audience":  "https://lemmy.world/c/technology",
+						},
+					},
+				},
+			},
+			want: &activity.Activity{
+				ID:           "https://lemmy.ml/post/21483",
+				Network:      network.Mastodon,
+				Platform:     federated.PlatformLemmy.String(),
+				From:         "@bob@lemmy.ml",
+				To:           "@technology@lemmy.world",
+				Type:         typex.SocialPost,
+				Tag:          tag.Social,
+				Status:       true,
+				TotalActions: 1,
+				Actions: []*activity.Action{
+					{
+						Type:     typex.SocialPost,
+						Tag:      tag.Social,
+						Platform: federated.PlatformLemmy.String(),
+						From:     "@bob@lemmy.ml",
+						To:       "@technology@lemmy.world",
+						Metadata: &metadata.SocialPost{
+							PublicationID: "21483",
+							Title:         "RSS is not dead",
+							Body:          "A good read",
+							Handle:        "@bob@lemmy.ml",
+							Timestamp:     1728635133,
+						},
+						RelatedURLs: []string{"https://lemmy.ml/post/21483"},
+					},
+				},
+				Timestamp: 1728635133,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Like A Post",
+			arguments: arguments{
+				task: &activitypub.Task{
+					Network: network.Mastodon,
+					Message: message.Object{
+						Context:   "https://www.w3.org/ns/activitystreams",
+						ID:        "https://mastodon.social/users/alice#likes/4815162342",
+						Type:      "Like",
+						Actor:     "https://mastodon.social/users/alice",
+						Published: "2024-10-11T08:28:26Z",
+						Object:    "https://infosec.exchange/users/ravirockks/statuses/113286145052908177",
+					},
+				},
+			},
+			want: &activity.Activity{
+				ID:           "https://mastodon.social/users/alice#likes/4815162342",
+				Network:      network.Mastodon,
+				Platform:     federated.PlatformMastodon.String(),
+				From:         "@alice@mastodon.social",
+				To:           "@ravirockks@infosec.exchange",
+				Type:         typex.SocialLike,
+				Tag:          tag.Social,
+				TotalActions: 1,
+				Status:       true,
+				Actions: []*activity.Action{
+					{
+						Type:     typex.SocialLike,
+						Tag:      tag.Social,
+						Platform: federated.PlatformMastodon.String(),
+						From:     "@alice@mastodon.social",
+						To:       "@ravirockks@infosec.exchange",
+						Metadata: &metadata.SocialPost{
+							Handle: "@alice@mastodon.social",
+							Target: &metadata.SocialPost{
+								PublicationID: "113286145052908177",
+								Handle:        "@ravirockks@infosec.exchange",
+							},
+							TargetURL: "https://infosec.exchange/users/ravirockks/statuses/113286145052908177",
+							Timestamp: 1728635306,
+						},
+						RelatedURLs: []string{"https://infosec.exchange/users/ravirockks/statuses/113286145052908177"},
+					},
+				},
+				Timestamp: 1728635306,
+			},
+			wantError: require.NoError,
+		},
 	}
 	for _, testcase := range testcases {
 		testcase := testcase
@@ -579,3 +681,140 @@ func TestWorker(t *testing.T) {
 		})
 	}
 }
+
+func TestWorker_TransformMutations(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		task          *activitypub.Task
+		wantActivity  require.ValueAssertionFunc
+		wantMutations []*engine.Mutation
+	}{
+		{
+			name: "Delete A Post",
+			task: &activitypub.Task{
+				Network: network.Mastodon,
+				Message: message.Object{
+					Context:   "https://www.w3.org/ns/activitystreams",
+					ID:        "https://mastodon.social/users/alice/statuses/113288440521347216#delete",
+					Type:      "Delete",
+					Actor:     "https://mastodon.social/users/alice",
+					Published: "2024-10-11T08:28:26Z",
+					Object: map[string]interface{}{
+						"id":   "https://mastodon.social/users/alice/statuses/113288440521347216",
+						"type": "Tombstone",
+					},
+				},
+			},
+			wantActivity: require.NotNil,
+			wantMutations: []*engine.Mutation{
+				{
+					Network: network.Mastodon,
+					ID:      "https://mastodon.social/users/alice/statuses/113288440521347216",
+				},
+			},
+		},
+		{
+			name: "Delete A Pleroma Post",
+			task: &activitypub.Task{
+				Network:  network.Mastodon,
+				Platform: federated.PlatformPleroma,
+				Message: message.Object{
+					Context:   "https://www.w3.org/ns/activitystreams",
+					ID:        "https://pleroma.site/activities/7c2b9e4f",
+					Type:      "Delete",
+					Actor:     "https://pleroma.site/users/dave",
+					Published: "2024-10-11T08:28:26Z",
+					Object:    "https://pleroma.site/objects/a1b2c3d4",
+				},
+			},
+			wantActivity: require.NotNil,
+			wantMutations: []*engine.Mutation{
+				{
+					Network: network.Mastodon,
+					ID:      "https://pleroma.site/objects/a1b2c3d4",
+				},
+			},
+		},
+		{
+			name: "Follow An Account",
+			task: &activitypub.Task{
+				Network: network.Mastodon,
+				Message: message.Object{
+					Context:   "https://www.w3.org/ns/activitystreams",
+					ID:        "https://mastodon.social/8f2d6c1e-5a0b-4c3d-9e7f-1a2b3c4d5e6f",
+					Type:      "Follow",
+					Actor:     "https://mastodon.social/users/alice",
+					Published: "2024-10-11T08:28:26Z",
+					Object:    "https://fosstodon.org/users/bert_hubert",
+				},
+			},
+			wantActivity: require.Nil,
+		},
+		{
+			name: "Unfollow An Account",
+			task: &activitypub.Task{
+				Network: network.Mastodon,
+				Message: message.Object{
+					Context:   "https://www.w3.org/ns/activitystreams",
+					ID:        "https://mastodon.social/users/alice#follows/1234/undo",
+					Type:      "Undo",
+					Actor:     "https://mastodon.social/users/alice",
+					Published: "2024-10-11T08:28:26Z",
+					Object: map[string]interface{}{
+						"id":     "https://mastodon.social/8f2d6c1e-5a0b-4c3d-9e7f-1a2b3c4d5e6f",
+						"type":   "Follow",
+						"actor":  "https://mastodon.social/users/alice",
+						"object": "https://fosstodon.org/users/bert_hubert",
+					},
+				},
+			},
+			wantActivity:  require.Nil,
+			wantMutations: []*engine.Mutation{},
+		},
+		{
+			name: "Undo A Like",
+			task: &activitypub.Task{
+				Network: network.Mastodon,
+				Message: message.Object{
+					Context:   "https://www.w3.org/ns/activitystreams",
+					ID:        "https://mastodon.social/users/alice#likes/5678/undo",
+					Type:      "Undo",
+					Actor:     "https://mastodon.social/users/alice",
+					Published: "2024-10-11T08:28:26Z",
+					Object: map[string]interface{}{
+						"id":     "https://mastodon.social/users/alice#likes/5678",
+						"type":   "Like",
+						"actor":  "https://mastodon.social/users/alice",
+						"object": "https://fosstodon.org/users/bert_hubert/statuses/113288440521347216",
+					},
+				},
+			},
+			wantActivity: require.Nil,
+			wantMutations: []*engine.Mutation{
+				{
+					Network: network.Mastodon,
+					ID:      "https://mastodon.social/users/alice#likes/5678",
+				},
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			instance, err := NewWorker(nil, nil)
+			require.NoError(t, err)
+
+			transformedActivity, mutations, err := instance.(engine.MutatingWorker).TransformMutations(context.Background(), testcase.task)
+			require.NoError(t, err)
+
+			testcase.wantActivity(t, transformedActivity)
+			require.Equal(t, testcase.wantMutations, mutations)
+		})
+	}
+}
@@ -1,12 +1,20 @@
 package worker
 
 import (
+	"fmt"
+
 	"github.com/rss3-network/node/config"
 	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/internal/engine/worker/rss/feed"
+	"github.com/rss3-network/node/schema/worker/rss"
 )
 
-func New(_ *config.Module) (engine.Worker, error) {
-	// Not implemented
-	// If the rsshub worker exists, it will be started by default, and there will not be a separate worker instance
-	return nil, nil
+func New(config *config.Module) (engine.Worker, error) {
+	switch config.Worker.(rss.Worker) {
+	case rss.Feed:
+		return feed.NewWorker(config)
+	default:
+		// The rsshub worker is served by the rss component as a proxy, there is not a separate worker instance.
+		return nil, fmt.Errorf("[rss/factory.go] unsupported worker %s", config.Worker)
+	}
 }
@@ -0,0 +1,154 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/engine"
+	source "github.com/rss3-network/node/internal/engine/protocol/rss"
+	"github.com/rss3-network/node/provider/rss"
+	workerx "github.com/rss3-network/node/schema/worker/rss"
+	"github.com/rss3-network/protocol-go/schema"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+)
+
+var _ engine.Worker = (*worker)(nil)
+
+type worker struct {
+	config *config.Module
+}
+
+func (w *worker) Name() string {
+	return workerx.Feed.String()
+}
+
+func (w *worker) Platform() string {
+	return workerx.PlatformRSS.String()
+}
+
+func (w *worker) Network() []network.Network {
+	return []network.Network{
+		w.config.Network,
+	}
+}
+
+func (w *worker) Tags() []tag.Tag {
+	return []tag.Tag{
+		tag.Social,
+	}
+}
+
+func (w *worker) Types() []schema.Type {
+	return []schema.Type{
+		typex.SocialPost,
+	}
+}
+
+func (w *worker) Filter() engine.DataSourceFilter {
+	return nil
+}
+
+// Transform transforms an item of a feed into a post from its author to the feed.
+func (w *worker) Transform(_ context.Context, task engine.Task) (*activityx.Activity, error) {
+	rssTask, ok := task.(*source.Task)
+	if !ok {
+		return nil, fmt.Errorf("invalid task type: %T", task)
+	}
+
+	activity, err := task.BuildActivity(activityx.WithActivityPlatform(w.Platform()))
+	if err != nil {
+		return nil, fmt.Errorf("build activity: %w", err)
+	}
+
+	author := w.buildAuthor(rssTask)
+
+	activity.Type = typex.SocialPost
+	activity.From = lo.Ternary(author.Handle != "", author.Handle, rssTask.FeedURL)
+	activity.To = rssTask.FeedURL
+	activity.Actions = []*activityx.Action{
+		{
+			Tag:         activity.Type.Tag(),
+			Type:        activity.Type,
+			Platform:    w.Platform(),
+			From:        activity.From,
+			To:          activity.To,
+			Metadata:    w.buildPostMetadata(rssTask, author),
+			RelatedURLs: lo.Compact([]string{rssTask.Item.Link}),
+		},
+	}
+
+	return activity, nil
+}
+
+type author struct {
+	Handle string
+	URL    string
+}
+
+// buildAuthor returns the first author of the item, or the author of the feed.
+// The handle is the name of the author, or the email if the author has no name.
+func (w *worker) buildAuthor(task *source.Task) author {
+	authors := task.Item.Authors
+
+	if task.Feed.Author != nil {
+		authors = append(authors, *task.Feed.Author)
+	}
+
+	for _, value := range authors {
+		if handle := lo.Ternary(value.Name != "", value.Name, value.Email); handle != "" {
+			return author{
+				Handle: handle,
+				URL:    value.URL,
+			}
+		}
+	}
+
+	return author{}
+}
+
+// buildPostMetadata builds the post of the item, the body is the content of the item or its summary.
+func (w *worker) buildPostMetadata(task *source.Task, author author) *metadata.SocialPost {
+	item := task.Item
+
+	post := metadata.SocialPost{
+		Handle:        author.Handle,
+		Title:         item.Title,
+		Body:          lo.Ternary(item.Content != "", item.Content, item.Summary),
+		ProfileID:     task.FeedURL,
+		PublicationID: item.ID,
+		ContentURI:    item.Link,
+		Tags:          item.Categories,
+		AuthorURL:     author.URL,
+		Timestamp:     task.GetTimestamp(),
+	}
+
+	if item.Content != "" {
+		post.Summary = item.Summary
+	}
+
+	for _, enclosure := range item.Enclosures {
+		post.Media = append(post.Media, w.buildMedia(enclosure))
+	}
+
+	return &post
+}
+
+func (w *worker) buildMedia(enclosure rss.Enclosure) metadata.Media {
+	return metadata.Media{
+		Address:  enclosure.URL,
+		MimeType: enclosure.MimeType,
+	}
+}
+
+// NewWorker returns a worker to index the feeds polled by the RSS data source.
+func NewWorker(config *config.Module) (engine.Worker, error) {
+	return &worker{
+		config: config,
+	}, nil
+}
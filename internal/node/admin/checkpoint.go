@@ -0,0 +1,268 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/internal/database"
+	"github.com/rss3-network/node/internal/database/model"
+	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/internal/engine/protocol"
+	"github.com/rss3-network/node/schema/worker/decentralized"
+	"github.com/rss3-network/node/schema/worker/federated"
+	"github.com/rss3-network/node/schema/worker/rss"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrorCheckpointNotFound = errors.New("checkpoint not found")
+	ErrorUnknownWorker      = errors.New("unknown worker")
+)
+
+// Checkpoint is a checkpoint with the state decoded by its protocol.
+type Checkpoint struct {
+	*engine.Checkpoint
+	State any `json:"state"`
+	// Pending is true if the running worker has not picked up the moved state yet.
+	Pending bool `json:"pending"`
+}
+
+// MoveRequest moves the checkpoint of a worker to a position.
+type MoveRequest struct {
+	ID string `json:"-"`
+	protocol.Position
+	// DeleteActivitiesSince deletes the activities of the worker since the timestamp in seconds, requires redis to pause the worker meanwhile.
+	DeleteActivitiesSince *uint64 `json:"delete_activities_since,omitempty"`
+}
+
+// Service inspects and moves the checkpoints of workers, and pauses, resumes or drains them.
+type Service struct {
+	databaseClient database.Client
+	redisClient    rueidis.Client
+}
+
+// ListCheckpoints returns the checkpoints matching the id, network and worker, empty values match all.
+func (s *Service) ListCheckpoints(ctx context.Context, id string, network network.Network, worker string) ([]*Checkpoint, error) {
+	checkpoints, err := s.databaseClient.LoadCheckpoints(ctx, id, network, worker)
+	if err != nil {
+		return nil, fmt.Errorf("load checkpoints: %w", err)
+	}
+
+	result := make([]*Checkpoint, 0, len(checkpoints))
+
+	for _, checkpoint := range checkpoints {
+		value, err := s.decodeCheckpoint(ctx, checkpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+	}
+
+	return result, nil
+}
+
+// GetCheckpoint returns the checkpoint of the worker.
+func (s *Service) GetCheckpoint(ctx context.Context, id string) (*Checkpoint, error) {
+	checkpoint, err := s.loadCheckpoint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decodeCheckpoint(ctx, checkpoint)
+}
+
+// MoveCheckpoint rewinds or fast-forwards the checkpoint of the worker to the position.
+// The state is saved to the database and published for the running worker, which pauses its data source,
+// saves the state again to discard any in-flight progress and resumes from the new position.
+// To delete the activities of the worker, the worker is paused first and resumed after the checkpoint is moved.
+func (s *Service) MoveCheckpoint(ctx context.Context, request MoveRequest) (*Checkpoint, error) {
+	checkpoint, err := s.loadCheckpoint(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var query *model.DeleteActivitiesQuery
+
+	if request.DeleteActivitiesSince != nil {
+		if query, err = buildDeleteActivitiesQuery(checkpoint, time.Unix(int64(*request.DeleteActivitiesSince), 0)); err != nil {
+			return nil, err
+		}
+
+		control, err := s.pauseWorker(ctx, checkpoint.ID)
+		if err != nil {
+			return nil, fmt.Errorf("pause worker: %w", err)
+		}
+
+		defer s.restoreControl(context.WithoutCancel(ctx), checkpoint.ID, control)
+
+		// The worker may have saved the checkpoint before it paused.
+		if checkpoint, err = s.loadCheckpoint(ctx, request.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	state, err := protocol.MoveState(checkpoint.Network, checkpoint.State, request.Position)
+	if err != nil {
+		return nil, err
+	}
+
+	zap.L().Info("moving checkpoint",
+		zap.String("id", checkpoint.ID),
+		zap.ByteString("state.previous", checkpoint.State),
+		zap.ByteString("state.current", state))
+
+	if query != nil {
+		if err := s.databaseClient.DeleteActivitiesSince(ctx, *query); err != nil {
+			return nil, fmt.Errorf("delete activities since %s: %w", query.Timestamp, err)
+		}
+	}
+
+	checkpoint.State = state
+	checkpoint.IndexCount = 0
+
+	if err := s.databaseClient.SaveCheckpoint(ctx, checkpoint); err != nil {
+		return nil, fmt.Errorf("save checkpoint: %w", err)
+	}
+
+	if s.redisClient != nil {
+		if err := SetPendingState(ctx, s.redisClient, checkpoint.ID, state); err != nil {
+			return nil, fmt.Errorf("set pending state: %w", err)
+		}
+	} else {
+		zap.L().Warn("redis is not available, restart the worker to pick up the checkpoint",
+			zap.String("id", checkpoint.ID))
+	}
+
+	return s.GetCheckpoint(ctx, checkpoint.ID)
+}
+
+func (s *Service) loadCheckpoint(ctx context.Context, id string) (*engine.Checkpoint, error) {
+	checkpoints, err := s.databaseClient.LoadCheckpoints(ctx, id, network.Unknown, "")
+	if err != nil {
+		return nil, fmt.Errorf("load checkpoint %s: %w", id, err)
+	}
+
+	if len(checkpoints) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrorCheckpointNotFound, id)
+	}
+
+	return checkpoints[0], nil
+}
+
+func (s *Service) decodeCheckpoint(ctx context.Context, checkpoint *engine.Checkpoint) (*Checkpoint, error) {
+	state, err := protocol.DecodeState(checkpoint.Network, checkpoint.State)
+	if err != nil {
+		return nil, fmt.Errorf("decode state of checkpoint %s: %w", checkpoint.ID, err)
+	}
+
+	value := Checkpoint{
+		Checkpoint: checkpoint,
+		State:      state,
+	}
+
+	if s.redisClient != nil {
+		if _, value.Pending, err = LoadPendingState(ctx, s.redisClient, checkpoint.ID); err != nil {
+			return nil, fmt.Errorf("load pending state: %w", err)
+		}
+	}
+
+	return &value, nil
+}
+
+// buildDeleteActivitiesQuery matches the activities of the network owned by the worker of the checkpoint with the platforms and tags it indexes.
+func buildDeleteActivitiesQuery(checkpoint *engine.Checkpoint, timestamp time.Time) (*model.DeleteActivitiesQuery, error) {
+	query := model.DeleteActivitiesQuery{
+		Network:   checkpoint.Network,
+		Timestamp: timestamp,
+	}
+
+	switch checkpoint.Network.Protocol() {
+	case network.ActivityPubProtocol, network.ATProtocol:
+		// The federated networks are indexed by a single worker, and the platforms follow the software of the instances.
+		worker, err := federated.WorkerString(checkpoint.Worker)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrorUnknownWorker, checkpoint.Worker)
+		}
+
+		query.Tags = federated.ToTagsMap[worker]
+	case network.RSSProtocol:
+		worker, err := rss.WorkerString(checkpoint.Worker)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrorUnknownWorker, checkpoint.Worker)
+		}
+
+		query.Platforms = []string{rss.ToPlatformMap[worker].String()}
+		query.Tags = rss.ToTagsMap[worker]
+	case network.FarcasterProtocol:
+		// The Farcaster network is indexed by the core worker only.
+	default:
+		worker := decentralized.GetValueByWorkerStr(checkpoint.Worker)
+
+		if worker == decentralized.Core {
+			// The core workers index the activities without a platform.
+			query.Platforms = []string{""}
+		} else {
+			platform, exists := decentralized.ToPlatformMap[worker]
+			if !exists {
+				return nil, fmt.Errorf("%w: %s", ErrorUnknownWorker, checkpoint.Worker)
+			}
+
+			query.Platforms = append([]string{platform.String()}, lo.Map(decentralized.ToForkPlatformsMap[worker], func(platform decentralized.Platform, _ int) string {
+				return platform.String()
+			})...)
+		}
+
+		query.Tags = decentralized.ToTagsMap[worker]
+	}
+
+	return &query, nil
+}
+
+// NewService creates an admin service, the running workers are not notified nor controlled if the redis client is nil.
+func NewService(databaseClient database.Client, redisClient rueidis.Client) *Service {
+	return &Service{
+		databaseClient: databaseClient,
+		redisClient:    redisClient,
+	}
+}
+
+// SetPendingState publishes the moved state for the running worker.
+func SetPendingState(ctx context.Context, redisClient rueidis.Client, id string, state json.RawMessage) error {
+	command := redisClient.B().Set().Key(buildPendingStateKey(id)).Value(string(state)).Build()
+
+	return redisClient.Do(ctx, command).Error()
+}
+
+// LoadPendingState returns the moved state of the worker if it has not been picked up.
+func LoadPendingState(ctx context.Context, redisClient rueidis.Client, id string) (json.RawMessage, bool, error) {
+	command := redisClient.B().Get().Key(buildPendingStateKey(id)).Build()
+
+	value, err := redisClient.Do(ctx, command).ToString()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return json.RawMessage(value), true, nil
+}
+
+// DeletePendingState marks the moved state as picked up by the worker.
+func DeletePendingState(ctx context.Context, redisClient rueidis.Client, id string) error {
+	command := redisClient.B().Del().Key(buildPendingStateKey(id)).Build()
+
+	return redisClient.Do(ctx, command).Error()
+}
+
+func buildPendingStateKey(id string) string {
+	return fmt.Sprintf("checkpoint:pending:%s", id)
+}
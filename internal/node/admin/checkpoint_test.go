@@ -0,0 +1,88 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rss3-network/node/internal/database/model"
+	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDeleteActivitiesQuery(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Unix(1731405600, 0)
+
+	testcases := []struct {
+		name       string
+		checkpoint engine.Checkpoint
+		want       *model.DeleteActivitiesQuery
+		wantError  require.ErrorAssertionFunc
+	}{
+		{
+			name:       "Ethereum core",
+			checkpoint: engine.Checkpoint{Network: network.Ethereum, Worker: "core"},
+			want: &model.DeleteActivitiesQuery{
+				Network:   network.Ethereum,
+				Platforms: []string{""},
+				Tags:      []tag.Tag{tag.Collectible, tag.Transaction},
+				Timestamp: timestamp,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name:       "Uniswap with forks",
+			checkpoint: engine.Checkpoint{Network: network.Base, Worker: "uniswap"},
+			want: &model.DeleteActivitiesQuery{
+				Network:   network.Base,
+				Platforms: []string{"Uniswap", "Aerodrome", "PancakeSwap", "Velodrome"},
+				Tags:      []tag.Tag{tag.Exchange, tag.Transaction},
+				Timestamp: timestamp,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name:       "Mastodon",
+			checkpoint: engine.Checkpoint{Network: network.Mastodon, Worker: "mastodon"},
+			want: &model.DeleteActivitiesQuery{
+				Network:   network.Mastodon,
+				Tags:      []tag.Tag{tag.Social},
+				Timestamp: timestamp,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name:       "RSSHub core",
+			checkpoint: engine.Checkpoint{Network: network.RSSHub, Worker: "core"},
+			want: &model.DeleteActivitiesQuery{
+				Network:   network.RSSHub,
+				Platforms: []string{"RSSHub"},
+				Tags:      []tag.Tag{tag.RSS},
+				Timestamp: timestamp,
+			},
+			wantError: require.NoError,
+		},
+		{
+			name:       "Unknown worker",
+			checkpoint: engine.Checkpoint{Network: network.Ethereum, Worker: "unknown"},
+			wantError: func(t require.TestingT, err error, _ ...interface{}) {
+				require.ErrorIs(t, err, ErrorUnknownWorker)
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := buildDeleteActivitiesQuery(&testcase.checkpoint, timestamp)
+			testcase.wantError(t, err)
+			require.Equal(t, testcase.want, query)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/rueidis"
+	"go.uber.org/zap"
+)
+
+//go:generate go run --mod=mod github.com/dmarkham/enumer@v1.5.9 --values --type=Control --linecomment --output control_string.go --json --yaml
+type Control uint64
+
+const (
+	// ControlResume runs the worker, it is the default without a control.
+	ControlResume Control = iota // resume
+	// ControlPause stops the data source of the worker after the in-flight batch until it is resumed.
+	ControlPause // pause
+	// ControlDrain finishes the in-flight batch, commits the checkpoint and exits the worker,
+	// the worker stays paused until it is resumed.
+	ControlDrain // drain
+)
+
+const (
+	// pauseInterval is the interval to check if the worker has paused.
+	pauseInterval = time.Second
+	// pauseTimeout is the maximum time to wait for the worker to commit its in-flight batch and pause.
+	pauseTimeout = 5 * time.Minute
+)
+
+var (
+	ErrorControlUnavailable = errors.New("workers can only be controlled with redis")
+	ErrorWorkerNotPaused    = errors.New("worker has not paused")
+)
+
+// Control returns the control of the worker.
+func (s *Service) Control(ctx context.Context, id string) (Control, error) {
+	if s.redisClient == nil {
+		return ControlResume, nil
+	}
+
+	if _, err := s.loadCheckpoint(ctx, id); err != nil {
+		return ControlResume, err
+	}
+
+	return LoadControl(ctx, s.redisClient, id)
+}
+
+// SetControl pauses, resumes or drains the worker, the running worker picks it up in a few seconds.
+func (s *Service) SetControl(ctx context.Context, id string, control Control) error {
+	if s.redisClient == nil {
+		return ErrorControlUnavailable
+	}
+
+	if _, err := s.loadCheckpoint(ctx, id); err != nil {
+		return err
+	}
+
+	zap.L().Info("controlling worker",
+		zap.String("id", id),
+		zap.Stringer("control", control))
+
+	if control == ControlResume {
+		return DeleteControl(ctx, s.redisClient, id)
+	}
+
+	return SetControl(ctx, s.redisClient, id, control)
+}
+
+// pauseWorker pauses the worker and waits until it stops writing activities and checkpoints,
+// it returns the control of the worker before, to be restored by restoreControl.
+func (s *Service) pauseWorker(ctx context.Context, id string) (Control, error) {
+	if s.redisClient == nil {
+		return ControlResume, ErrorControlUnavailable
+	}
+
+	control, err := LoadControl(ctx, s.redisClient, id)
+	if err != nil {
+		return ControlResume, fmt.Errorf("load control: %w", err)
+	}
+
+	if control == ControlResume {
+		if err := SetControl(ctx, s.redisClient, id, ControlPause); err != nil {
+			return ControlResume, fmt.Errorf("set control: %w", err)
+		}
+	}
+
+	ticker := time.NewTicker(pauseInterval)
+	defer ticker.Stop()
+
+	timer := time.NewTimer(pauseTimeout)
+	defer timer.Stop()
+
+	for {
+		paused, err := LoadPaused(ctx, s.redisClient, id)
+		if err != nil {
+			s.restoreControl(ctx, id, control)
+
+			return ControlResume, fmt.Errorf("load paused: %w", err)
+		}
+
+		if paused {
+			return control, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			s.restoreControl(context.WithoutCancel(ctx), id, control)
+
+			return ControlResume, ctx.Err()
+		case <-timer.C:
+			s.restoreControl(ctx, id, control)
+
+			return ControlResume, fmt.Errorf("%w: %s did not pause in %s, drain the worker if it is not running", ErrorWorkerNotPaused, id, pauseTimeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// restoreControl resumes the worker paused by pauseWorker if it was running before.
+func (s *Service) restoreControl(ctx context.Context, id string, control Control) {
+	if control != ControlResume {
+		return
+	}
+
+	if err := DeleteControl(ctx, s.redisClient, id); err != nil {
+		zap.L().Error("failed to resume worker, resume it manually",
+			zap.String("id", id),
+			zap.Error(err))
+	}
+}
+
+// SetControl publishes the control for the running worker.
+func SetControl(ctx context.Context, redisClient rueidis.Client, id string, control Control) error {
+	command := redisClient.B().Set().Key(buildControlKey(id)).Value(control.String()).Build()
+
+	return redisClient.Do(ctx, command).Error()
+}
+
+// LoadControl returns the control of the worker, ControlResume if there is none.
+func LoadControl(ctx context.Context, redisClient rueidis.Client, id string) (Control, error) {
+	command := redisClient.B().Get().Key(buildControlKey(id)).Build()
+
+	value, err := redisClient.Do(ctx, command).ToString()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return ControlResume, nil
+		}
+
+		return ControlResume, err
+	}
+
+	control, err := ControlString(value)
+	if err != nil {
+		return ControlResume, fmt.Errorf("invalid control %s: %w", value, err)
+	}
+
+	return control, nil
+}
+
+// DeleteControl resumes the worker.
+func DeleteControl(ctx context.Context, redisClient rueidis.Client, id string) error {
+	command := redisClient.B().Del().Key(buildControlKey(id)).Build()
+
+	return redisClient.Do(ctx, command).Error()
+}
+
+// SetPaused records that the worker has committed its in-flight batch and stopped writing until it is resumed.
+func SetPaused(ctx context.Context, redisClient rueidis.Client, id string) error {
+	command := redisClient.B().Set().Key(buildPausedKey(id)).Value(time.Now().UTC().Format(time.RFC3339)).Build()
+
+	return redisClient.Do(ctx, command).Error()
+}
+
+// LoadPaused returns true if the worker has stopped writing.
+func LoadPaused(ctx context.Context, redisClient rueidis.Client, id string) (bool, error) {
+	command := redisClient.B().Exists().Key(buildPausedKey(id)).Build()
+
+	count, err := redisClient.Do(ctx, command).AsInt64()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// DeletePaused records that the worker is writing again.
+func DeletePaused(ctx context.Context, redisClient rueidis.Client, id string) error {
+	command := redisClient.B().Del().Key(buildPausedKey(id)).Build()
+
+	return redisClient.Do(ctx, command).Error()
+}
+
+func buildPausedKey(id string) string {
+	return fmt.Sprintf("worker:paused:%s", id)
+}
+
+func buildControlKey(id string) string {
+	return fmt.Sprintf("worker:control:%s", id)
+}
@@ -0,0 +1,121 @@
+// Code generated by "enumer --values --type=Control --linecomment --output control_string.go --json --yaml"; DO NOT EDIT.
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const _ControlName = "resumepausedrain"
+
+var _ControlIndex = [...]uint8{0, 6, 11, 16}
+
+const _ControlLowerName = "resumepausedrain"
+
+func (i Control) String() string {
+	if i >= Control(len(_ControlIndex)-1) {
+		return fmt.Sprintf("Control(%d)", i)
+	}
+	return _ControlName[_ControlIndex[i]:_ControlIndex[i+1]]
+}
+
+func (Control) Values() []string {
+	return ControlStrings()
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _ControlNoOp() {
+	var x [1]struct{}
+	_ = x[ControlResume-(0)]
+	_ = x[ControlPause-(1)]
+	_ = x[ControlDrain-(2)]
+}
+
+var _ControlValues = []Control{ControlResume, ControlPause, ControlDrain}
+
+var _ControlNameToValueMap = map[string]Control{
+	_ControlName[0:6]:        ControlResume,
+	_ControlLowerName[0:6]:   ControlResume,
+	_ControlName[6:11]:       ControlPause,
+	_ControlLowerName[6:11]:  ControlPause,
+	_ControlName[11:16]:      ControlDrain,
+	_ControlLowerName[11:16]: ControlDrain,
+}
+
+var _ControlNames = []string{
+	_ControlName[0:6],
+	_ControlName[6:11],
+	_ControlName[11:16],
+}
+
+// ControlString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ControlString(s string) (Control, error) {
+	if val, ok := _ControlNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _ControlNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Control values", s)
+}
+
+// ControlValues returns all values of the enum
+func ControlValues() []Control {
+	return _ControlValues
+}
+
+// ControlStrings returns a slice of all String values of the enum
+func ControlStrings() []string {
+	strs := make([]string, len(_ControlNames))
+	copy(strs, _ControlNames)
+	return strs
+}
+
+// IsAControl returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Control) IsAControl() bool {
+	for _, v := range _ControlValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for Control
+func (i Control) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Control
+func (i *Control) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("Control should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ControlString(s)
+	return err
+}
+
+// MarshalYAML implements a YAML Marshaler for Control
+func (i Control) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for Control
+func (i *Control) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = ControlString(s)
+	return err
+}
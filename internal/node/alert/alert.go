@@ -0,0 +1,38 @@
+package alert
+
+import (
+	"fmt"
+	"time"
+)
+
+//go:generate go run --mod=mod github.com/dmarkham/enumer@v1.5.9 --values --type=Kind --linecomment --output kind_string.go --json
+type Kind int
+
+const (
+	KindStatus          Kind = iota + 1 // status
+	KindCheckpointStall                 // checkpoint_stall
+	KindIndexRate                       // index_rate
+)
+
+// Alert is a notification of a worker health condition.
+type Alert struct {
+	// Key identifies the alert for deduplication and resolution.
+	Key       string    `json:"key"`
+	Kind      Kind      `json:"kind"`
+	WorkerID  string    `json:"worker_id"`
+	Network   string    `json:"network"`
+	Worker    string    `json:"worker"`
+	Summary   string    `json:"summary"`
+	Resolved  bool      `json:"resolved"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Title returns a one-line title of the alert used by the notifiers.
+func (a *Alert) Title() string {
+	state := "FIRING"
+	if a.Resolved {
+		state = "RESOLVED"
+	}
+
+	return fmt.Sprintf("[%s] %s %s", state, a.WorkerID, a.Kind)
+}
@@ -0,0 +1,284 @@
+package alert_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/node/alert"
+	workerx "github.com/rss3-network/node/schema/worker"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder is a local HTTP stand-in recording the request bodies.
+type recorder struct {
+	mutex  sync.Mutex
+	bodies []map[string]any
+}
+
+func (r *recorder) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	var body map[string]any
+	if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+		writer.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	r.mutex.Lock()
+	r.bodies = append(r.bodies, body)
+	r.mutex.Unlock()
+
+	writer.WriteHeader(http.StatusAccepted)
+}
+
+func (r *recorder) Bodies() []map[string]any {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return append([]map[string]any(nil), r.bodies...)
+}
+
+func TestManager(t *testing.T) {
+	t.Parallel()
+
+	var (
+		webhook   recorder
+		slack     recorder
+		pagerDuty recorder
+	)
+
+	webhookServer := httptest.NewServer(&webhook)
+	slackServer := httptest.NewServer(&slack)
+	pagerDutyServer := httptest.NewServer(&pagerDuty)
+
+	t.Cleanup(func() {
+		webhookServer.Close()
+		slackServer.Close()
+		pagerDutyServer.Close()
+	})
+
+	manager, err := alert.NewManager(&config.Alert{
+		RepeatInterval: time.Hour,
+		Notifiers: []*config.AlertNotifier{
+			{ID: "webhook", Type: alert.NotifierTypeWebhook, URL: webhookServer.URL},
+			{ID: "slack", Type: alert.NotifierTypeSlack, URL: slackServer.URL},
+			{ID: "pagerduty", Type: alert.NotifierTypePagerDuty, URL: pagerDutyServer.URL, RoutingKey: "routing-key"},
+		},
+		Rules: []*config.AlertRule{
+			{Workers: []string{"ethereum-core"}, Notifiers: []string{"webhook", "pagerduty"}},
+			{Workers: []string{"arweave-mirror"}, Notifiers: []string{"slack"}, CheckpointStall: time.Hour, MinIndexCount: 10},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	observation := alert.Observation{
+		WorkerID:   "ethereum-core",
+		Network:    "ethereum",
+		Worker:     "core",
+		Status:     workerx.StatusUnhealthy,
+		IndexCount: -1,
+	}
+
+	// The alert fires once and is deduplicated until resolved.
+	require.NoError(t, manager.Evaluate(ctx, observation))
+	require.NoError(t, manager.Evaluate(ctx, observation))
+	require.Len(t, webhook.Bodies(), 1)
+	require.Len(t, pagerDuty.Bodies(), 1)
+	require.Equal(t, "trigger", pagerDuty.Bodies()[0]["event_action"])
+	require.Equal(t, "ethereum-core:status:0", pagerDuty.Bodies()[0]["dedup_key"])
+
+	observation.Status = workerx.StatusReady
+
+	require.NoError(t, manager.Evaluate(ctx, observation))
+	require.NoError(t, manager.Evaluate(ctx, observation))
+	require.Len(t, webhook.Bodies(), 2)
+	require.Equal(t, true, webhook.Bodies()[1]["resolved"])
+	require.Equal(t, "resolve", pagerDuty.Bodies()[1]["event_action"])
+
+	// Rules of other workers are not applied.
+	require.Empty(t, slack.Bodies())
+
+	// A stalled checkpoint and a low index count fire two alerts.
+	require.NoError(t, manager.Evaluate(ctx, alert.Observation{
+		WorkerID:            "arweave-mirror",
+		Network:             "arweave",
+		Worker:              "mirror",
+		Status:              workerx.StatusIndexing,
+		CheckpointUpdatedAt: time.Now().Add(-2 * time.Hour),
+		IndexCount:          3,
+	}))
+	require.Len(t, slack.Bodies(), 2)
+	require.Len(t, webhook.Bodies(), 2)
+}
+
+func TestNewManager(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		config    *config.Alert
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name: "Undefined notifier",
+			config: &config.Alert{
+				Rules: []*config.AlertRule{{Notifiers: []string{"slack"}}},
+			},
+			wantError: require.Error,
+		},
+		{
+			name: "Invalid status",
+			config: &config.Alert{
+				Rules: []*config.AlertRule{{Statuses: []string{"Broken"}}},
+			},
+			wantError: require.Error,
+		},
+		{
+			name: "PagerDuty without routing key",
+			config: &config.Alert{
+				Notifiers: []*config.AlertNotifier{{ID: "pagerduty", Type: alert.NotifierTypePagerDuty}},
+			},
+			wantError: require.Error,
+		},
+		{
+			name: "Valid config",
+			config: &config.Alert{
+				Notifiers: []*config.AlertNotifier{{ID: "webhook", Type: alert.NotifierTypeWebhook, URL: "http://localhost"}},
+				Rules:     []*config.AlertRule{{Statuses: []string{"Unhealthy", "Indexing"}}},
+			},
+			wantError: require.NoError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := alert.NewManager(testcase.config, nil)
+			testcase.wantError(t, err)
+		})
+	}
+}
+
+func TestEmailNotifier(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	messages := make(chan string, 1)
+
+	go serveSMTP(listener, messages)
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+
+	notifier, err := alert.NewNotifier(&config.AlertNotifier{
+		ID:   "email",
+		Type: alert.NotifierTypeEmail,
+		SMTP: &config.AlertSMTP{
+			Host: host,
+			Port: parsePort(t, port),
+			From: "node@localhost",
+			To:   []string{"operator@localhost"},
+		},
+	})
+	require.NoError(t, err)
+
+	err = notifier.Notify(context.Background(), &alert.Alert{
+		Key:       "ethereum-core:status:0",
+		Kind:      alert.KindStatus,
+		WorkerID:  "ethereum-core",
+		Summary:   "worker status is Unhealthy",
+		Timestamp: time.Now(),
+	})
+	require.NoError(t, err)
+
+	message := <-messages
+	require.Contains(t, message, "Subject: [FIRING] ethereum-core status")
+	require.Contains(t, message, "worker status is Unhealthy")
+}
+
+func parsePort(t *testing.T, port string) int {
+	value, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	return value
+}
+
+// serveSMTP is a minimal SMTP stand-in accepting a single message.
+func serveSMTP(listener net.Listener, messages chan<- string) {
+	connection, err := listener.Accept()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		_ = connection.Close()
+	}()
+
+	var (
+		reader = bufio.NewReader(connection)
+		reply  = func(line string) { _, _ = connection.Write([]byte(line + "\r\n")) }
+	)
+
+	reply("220 localhost ESMTP")
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(strings.TrimSpace(line))
+
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "DATA"):
+			reply("354 End data with <CR><LF>.<CR><LF>")
+
+			var data strings.Builder
+
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+
+				if line == ".\r\n" {
+					break
+				}
+
+				data.WriteString(line)
+			}
+
+			messages <- data.String()
+
+			reply("250 OK")
+		case strings.HasPrefix(command, "QUIT"):
+			reply("221 Bye")
+
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rss3-network/node/config"
+)
+
+// emailNotifier sends the alert by email through an SMTP server.
+type emailNotifier struct {
+	smtp *config.AlertSMTP
+}
+
+func (n *emailNotifier) Notify(_ context.Context, alert *Alert) error {
+	var auth smtp.Auth
+	if n.smtp.Username != "" {
+		auth = smtp.PlainAuth("", n.smtp.Username, n.smtp.Password, n.smtp.Host)
+	}
+
+	address := net.JoinHostPort(n.smtp.Host, strconv.Itoa(n.smtp.Port))
+
+	if err := smtp.SendMail(address, auth, n.smtp.From, n.smtp.To, n.buildMessage(alert)); err != nil {
+		return fmt.Errorf("send mail: %w", err)
+	}
+
+	return nil
+}
+
+func (n *emailNotifier) buildMessage(alert *Alert) []byte {
+	var buffer bytes.Buffer
+
+	fmt.Fprintf(&buffer, "From: %s\r\n", n.smtp.From)
+	fmt.Fprintf(&buffer, "To: %s\r\n", strings.Join(n.smtp.To, ", "))
+	fmt.Fprintf(&buffer, "Subject: %s\r\n", alert.Title())
+	fmt.Fprintf(&buffer, "Date: %s\r\n", alert.Timestamp.Format(time.RFC1123Z))
+	fmt.Fprintf(&buffer, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&buffer, "%s\r\n\r\n", alert.Summary)
+	fmt.Fprintf(&buffer, "Worker ID: %s\r\nNetwork: %s\r\nWorker: %s\r\n", alert.WorkerID, alert.Network, alert.Worker)
+
+	return buffer.Bytes()
+}
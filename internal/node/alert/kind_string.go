@@ -0,0 +1,105 @@
+// Code generated by "enumer --values --type=Kind --linecomment --output kind_string.go --json"; DO NOT EDIT.
+
+package alert
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const _KindName = "statuscheckpoint_stallindex_rate"
+
+var _KindIndex = [...]uint8{0, 6, 22, 32}
+
+const _KindLowerName = "statuscheckpoint_stallindex_rate"
+
+func (i Kind) String() string {
+	i -= 1
+	if i < 0 || i >= Kind(len(_KindIndex)-1) {
+		return fmt.Sprintf("Kind(%d)", i+1)
+	}
+	return _KindName[_KindIndex[i]:_KindIndex[i+1]]
+}
+
+func (Kind) Values() []string {
+	return KindStrings()
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _KindNoOp() {
+	var x [1]struct{}
+	_ = x[KindStatus-(1)]
+	_ = x[KindCheckpointStall-(2)]
+	_ = x[KindIndexRate-(3)]
+}
+
+var _KindValues = []Kind{KindStatus, KindCheckpointStall, KindIndexRate}
+
+var _KindNameToValueMap = map[string]Kind{
+	_KindName[0:6]:        KindStatus,
+	_KindLowerName[0:6]:   KindStatus,
+	_KindName[6:22]:       KindCheckpointStall,
+	_KindLowerName[6:22]:  KindCheckpointStall,
+	_KindName[22:32]:      KindIndexRate,
+	_KindLowerName[22:32]: KindIndexRate,
+}
+
+var _KindNames = []string{
+	_KindName[0:6],
+	_KindName[6:22],
+	_KindName[22:32],
+}
+
+// KindString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func KindString(s string) (Kind, error) {
+	if val, ok := _KindNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _KindNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Kind values", s)
+}
+
+// KindValues returns all values of the enum
+func KindValues() []Kind {
+	return _KindValues
+}
+
+// KindStrings returns a slice of all String values of the enum
+func KindStrings() []string {
+	strs := make([]string, len(_KindNames))
+	copy(strs, _KindNames)
+	return strs
+}
+
+// IsAKind returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Kind) IsAKind() bool {
+	for _, v := range _KindValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface for Kind
+func (i Kind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Kind
+func (i *Kind) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("Kind should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = KindString(s)
+	return err
+}
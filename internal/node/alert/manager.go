@@ -0,0 +1,308 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/config"
+	workerx "github.com/rss3-network/node/schema/worker"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// Observation is the health of a worker observed in a monitoring cycle.
+type Observation struct {
+	WorkerID string
+	Network  string
+	Worker   string
+	Status   workerx.Status
+	// CheckpointUpdatedAt is the last time the worker saved its checkpoint, zero if unknown.
+	CheckpointUpdatedAt time.Time
+	// IndexCount is the number of activities indexed since the last monitoring cycle, negative if unknown.
+	IndexCount int64
+}
+
+// Manager evaluates the alert rules on observations and sends notifications on transitions.
+type Manager struct {
+	notifiers      map[string]Notifier
+	rules          []*rule
+	repeatInterval time.Duration
+	store          store
+	now            func() time.Time
+}
+
+type rule struct {
+	*config.AlertRule
+	index    int
+	statuses []workerx.Status
+}
+
+// Evaluate checks the observation against all rules, sends new alerts, repeats the firing ones
+// after the repeat interval and sends resolve notifications for the recovered ones.
+func (m *Manager) Evaluate(ctx context.Context, observation Observation) error {
+	var errs []error
+
+	for _, rule := range m.rules {
+		if len(rule.Workers) > 0 && !lo.Contains(rule.Workers, observation.WorkerID) {
+			continue
+		}
+
+		for kind, firing := range m.conditions(rule, observation) {
+			alert := m.buildAlert(rule, kind, observation)
+
+			if err := m.transit(ctx, rule, alert, firing); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("evaluate alerts of worker %s: %v", observation.WorkerID, errs)
+	}
+
+	return nil
+}
+
+// conditions returns whether each kind of alert enabled by the rule is firing.
+func (m *Manager) conditions(rule *rule, observation Observation) map[Kind]bool {
+	conditions := map[Kind]bool{
+		KindStatus: lo.Contains(rule.statuses, observation.Status),
+	}
+
+	if rule.CheckpointStall > 0 {
+		conditions[KindCheckpointStall] = !observation.CheckpointUpdatedAt.IsZero() && m.now().Sub(observation.CheckpointUpdatedAt) > rule.CheckpointStall
+	}
+
+	if rule.MinIndexCount > 0 {
+		conditions[KindIndexRate] = observation.IndexCount >= 0 && observation.IndexCount < rule.MinIndexCount
+	}
+
+	return conditions
+}
+
+func (m *Manager) buildAlert(rule *rule, kind Kind, observation Observation) *Alert {
+	alert := Alert{
+		Key:       fmt.Sprintf("%s:%s:%d", observation.WorkerID, kind, rule.index),
+		Kind:      kind,
+		WorkerID:  observation.WorkerID,
+		Network:   observation.Network,
+		Worker:    observation.Worker,
+		Timestamp: m.now(),
+	}
+
+	switch kind {
+	case KindStatus:
+		alert.Summary = fmt.Sprintf("worker status is %s", observation.Status)
+	case KindCheckpointStall:
+		alert.Summary = fmt.Sprintf("checkpoint has not been updated since %s", observation.CheckpointUpdatedAt.UTC().Format(time.RFC3339))
+	case KindIndexRate:
+		alert.Summary = fmt.Sprintf("indexed %d activities in the last monitoring cycle, expected at least %d", observation.IndexCount, rule.MinIndexCount)
+	}
+
+	return &alert
+}
+
+// transit sends the notification if the alert changes state or the repeat interval has passed.
+func (m *Manager) transit(ctx context.Context, rule *rule, alert *Alert, firing bool) error {
+	notifiedAt, active, err := m.store.Get(ctx, alert.Key)
+	if err != nil {
+		return fmt.Errorf("get alert %s: %w", alert.Key, err)
+	}
+
+	switch {
+	case firing && (!active || m.now().Sub(notifiedAt) >= m.repeatInterval):
+		if err := m.notify(ctx, rule, alert); err != nil {
+			return err
+		}
+
+		return m.store.Set(ctx, alert.Key, alert.Timestamp)
+	case !firing && active:
+		alert.Resolved = true
+		alert.Summary = "resolved: " + alert.Summary
+
+		if err := m.notify(ctx, rule, alert); err != nil {
+			return err
+		}
+
+		return m.store.Delete(ctx, alert.Key)
+	default:
+		return nil
+	}
+}
+
+func (m *Manager) notify(ctx context.Context, rule *rule, alert *Alert) error {
+	var errs []error
+
+	for id, notifier := range m.notifiers {
+		if len(rule.Notifiers) > 0 && !lo.Contains(rule.Notifiers, id) {
+			continue
+		}
+
+		if err := notifier.Notify(ctx, alert); err != nil {
+			errs = append(errs, fmt.Errorf("notifier %s: %w", id, err))
+
+			continue
+		}
+
+		zap.L().Info("sent alert notification",
+			zap.String("notifier", id),
+			zap.String("alert", alert.Key),
+			zap.Bool("resolved", alert.Resolved))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("notify alert %s: %v", alert.Key, errs)
+	}
+
+	return nil
+}
+
+// NewManager creates an alert manager, the state of active alerts is kept in Redis if the client is not nil.
+func NewManager(config *config.Alert, redisClient rueidis.Client) (*Manager, error) {
+	instance := Manager{
+		notifiers:      make(map[string]Notifier, len(config.Notifiers)),
+		rules:          make([]*rule, 0, len(config.Rules)),
+		repeatInterval: config.RepeatInterval,
+		store:          newMemoryStore(),
+		now:            time.Now,
+	}
+
+	if redisClient != nil {
+		instance.store = &redisStore{redisClient: redisClient}
+	}
+
+	for _, notifierConfig := range config.Notifiers {
+		if _, exists := instance.notifiers[notifierConfig.ID]; exists {
+			return nil, fmt.Errorf("duplicate notifier id %s", notifierConfig.ID)
+		}
+
+		notifier, err := NewNotifier(notifierConfig)
+		if err != nil {
+			return nil, fmt.Errorf("new notifier: %w", err)
+		}
+
+		instance.notifiers[notifierConfig.ID] = notifier
+	}
+
+	for index, ruleConfig := range config.Rules {
+		for _, id := range ruleConfig.Notifiers {
+			if _, exists := instance.notifiers[id]; !exists {
+				return nil, fmt.Errorf("undefined notifier %s in rule %d", id, index)
+			}
+		}
+
+		statuses := []workerx.Status{workerx.StatusUnhealthy}
+
+		if len(ruleConfig.Statuses) > 0 {
+			statuses = make([]workerx.Status, 0, len(ruleConfig.Statuses))
+
+			for _, value := range ruleConfig.Statuses {
+				status, err := workerx.StatusString(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid status in rule %d: %w", index, err)
+				}
+
+				statuses = append(statuses, status)
+			}
+		}
+
+		instance.rules = append(instance.rules, &rule{
+			AlertRule: ruleConfig,
+			index:     index,
+			statuses:  statuses,
+		})
+	}
+
+	return &instance, nil
+}
+
+// store keeps the time of the last notification of each active alert.
+type store interface {
+	Get(ctx context.Context, key string) (time.Time, bool, error)
+	Set(ctx context.Context, key string, notifiedAt time.Time) error
+	Delete(ctx context.Context, key string) error
+}
+
+type memoryStore struct {
+	mutex  sync.Mutex
+	alerts map[string]time.Time
+}
+
+func (s *memoryStore) Get(_ context.Context, key string) (time.Time, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	notifiedAt, exists := s.alerts[key]
+
+	return notifiedAt, exists, nil
+}
+
+func (s *memoryStore) Set(_ context.Context, key string, notifiedAt time.Time) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.alerts[key] = notifiedAt
+
+	return nil
+}
+
+func (s *memoryStore) Delete(_ context.Context, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.alerts, key)
+
+	return nil
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		alerts: make(map[string]time.Time),
+	}
+}
+
+// redisStore shares the state of active alerts between monitor instances.
+type redisStore struct {
+	redisClient rueidis.Client
+}
+
+func (s *redisStore) Get(ctx context.Context, key string) (time.Time, bool, error) {
+	command := s.redisClient.B().Get().Key(s.buildCacheKey(key)).Build()
+
+	value, err := s.redisClient.Do(ctx, command).ToString()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return time.Time{}, false, nil
+		}
+
+		return time.Time{}, false, err
+	}
+
+	timestamp, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("parse timestamp: %w", err)
+	}
+
+	return time.Unix(timestamp, 0), true, nil
+}
+
+func (s *redisStore) Set(ctx context.Context, key string, notifiedAt time.Time) error {
+	command := s.redisClient.B().Set().Key(s.buildCacheKey(key)).Value(strconv.FormatInt(notifiedAt.Unix(), 10)).Build()
+
+	return s.redisClient.Do(ctx, command).Error()
+}
+
+func (s *redisStore) Delete(ctx context.Context, key string) error {
+	command := s.redisClient.B().Del().Key(s.buildCacheKey(key)).Build()
+
+	return s.redisClient.Do(ctx, command).Error()
+}
+
+// buildCacheKey builds the cache key for an active alert.
+func (s *redisStore) buildCacheKey(key string) string {
+	return fmt.Sprintf("alert:active:%s", key)
+}
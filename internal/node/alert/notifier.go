@@ -0,0 +1,133 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rss3-network/node/config"
+)
+
+const (
+	NotifierTypeWebhook   = "webhook"
+	NotifierTypeSlack     = "slack"
+	NotifierTypePagerDuty = "pagerduty"
+	NotifierTypeEmail     = "email"
+
+	defaultTimeout = 10 * time.Second
+)
+
+// Notifier sends alerts to an external service.
+type Notifier interface {
+	Notify(ctx context.Context, alert *Alert) error
+}
+
+// NewNotifier creates a notifier by the type of the config.
+func NewNotifier(config *config.AlertNotifier) (Notifier, error) {
+	httpClient := &http.Client{
+		Timeout: defaultTimeout,
+	}
+
+	switch config.Type {
+	case NotifierTypeWebhook:
+		if config.URL == "" {
+			return nil, fmt.Errorf("url is required for webhook notifier %s", config.ID)
+		}
+
+		return &webhookNotifier{httpClient: httpClient, url: config.URL, headers: config.HTTPHeaders}, nil
+	case NotifierTypeSlack:
+		if config.URL == "" {
+			return nil, fmt.Errorf("url is required for slack notifier %s", config.ID)
+		}
+
+		return &slackNotifier{httpClient: httpClient, url: config.URL}, nil
+	case NotifierTypePagerDuty:
+		if config.RoutingKey == "" {
+			return nil, fmt.Errorf("routing key is required for pagerduty notifier %s", config.ID)
+		}
+
+		url := config.URL
+		if url == "" {
+			url = DefaultPagerDutyEndpoint
+		}
+
+		return &pagerDutyNotifier{httpClient: httpClient, url: url, routingKey: config.RoutingKey}, nil
+	case NotifierTypeEmail:
+		if config.SMTP == nil {
+			return nil, fmt.Errorf("smtp is required for email notifier %s", config.ID)
+		}
+
+		return &emailNotifier{smtp: config.SMTP}, nil
+	default:
+		return nil, fmt.Errorf("unsupported notifier type %s", config.Type)
+	}
+}
+
+// postJSON sends the body as JSON to the url and checks for a successful status code.
+func postJSON(ctx context.Context, httpClient *http.Client, url string, headers map[string]string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshal body: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("new request: %w", err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		content, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+
+		return fmt.Errorf("unexpected status: %s, response: %s", response.Status, content)
+	}
+
+	return nil
+}
+
+// webhookNotifier posts the alert as JSON to a generic webhook.
+type webhookNotifier struct {
+	httpClient *http.Client
+	url        string
+	headers    map[string]string
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, alert *Alert) error {
+	return postJSON(ctx, n.httpClient, n.url, n.headers, alert)
+}
+
+// slackNotifier posts the alert to a Slack-compatible incoming webhook.
+type slackNotifier struct {
+	httpClient *http.Client
+	url        string
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+func (n *slackNotifier) Notify(ctx context.Context, alert *Alert) error {
+	message := slackMessage{
+		Text: fmt.Sprintf("*%s*\n%s\nnetwork: `%s`, worker: `%s`", alert.Title(), alert.Summary, alert.Network, alert.Worker),
+	}
+
+	return postJSON(ctx, n.httpClient, n.url, nil, message)
+}
@@ -0,0 +1,63 @@
+package alert
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rss3-network/node/internal/constant"
+)
+
+// DefaultPagerDutyEndpoint is the endpoint of the PagerDuty Events API v2.
+const DefaultPagerDutyEndpoint = "https://events.pagerduty.com/v2/enqueue"
+
+// pagerDutyNotifier triggers and resolves incidents with the PagerDuty Events API v2.
+// Reference https://developer.pagerduty.com/docs/events-api-v2/trigger-events/.
+type pagerDutyNotifier struct {
+	httpClient *http.Client
+	url        string
+	routingKey string
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string `json:"summary"`
+	Source        string `json:"source"`
+	Severity      string `json:"severity"`
+	Timestamp     string `json:"timestamp"`
+	Component     string `json:"component"`
+	Group         string `json:"group"`
+	Class         string `json:"class"`
+	CustomDetails *Alert `json:"custom_details"`
+}
+
+func (n *pagerDutyNotifier) Notify(ctx context.Context, alert *Alert) error {
+	event := pagerDutyEvent{
+		RoutingKey:  n.routingKey,
+		EventAction: "trigger",
+		DedupKey:    alert.Key,
+	}
+
+	if alert.Resolved {
+		event.EventAction = "resolve"
+	} else {
+		event.Payload = &pagerDutyPayload{
+			Summary:       alert.Title() + ": " + alert.Summary,
+			Source:        constant.Name,
+			Severity:      "critical",
+			Timestamp:     alert.Timestamp.UTC().Format(time.RFC3339),
+			Component:     alert.WorkerID,
+			Group:         alert.Network,
+			Class:         alert.Kind.String(),
+			CustomDetails: alert,
+		}
+	}
+
+	return postJSON(ctx, n.httpClient, n.url, nil, event)
+}
@@ -0,0 +1,92 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/constant"
+	"github.com/rss3-network/node/internal/database"
+	"github.com/rss3-network/node/internal/node/admin"
+	"github.com/rss3-network/node/internal/node/component"
+	"github.com/rss3-network/node/internal/node/component/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Component struct {
+	counter      metric.Int64Counter
+	adminService *admin.Service
+}
+
+const Name = "admin"
+
+func (c *Component) Name() string {
+	return Name
+}
+
+var _ component.Component = (*Component)(nil)
+
+// NewComponent registers the admin endpoints, which are only available with an access token.
+func NewComponent(_ context.Context, apiServer *echo.Echo, config *config.File, databaseClient database.Client, redisClient rueidis.Client) *Component {
+	c := &Component{
+		adminService: admin.NewService(databaseClient, redisClient),
+	}
+
+	group := apiServer.Group(fmt.Sprintf("/%s", Name))
+
+	// Add middleware for bearer token authentication
+	group.Use(middleware.BearerAuth(config.Discovery.Server.AccessToken))
+
+	group.GET("/checkpoints", c.GetCheckpoints)
+	group.GET("/checkpoints/:id", c.GetCheckpoint)
+	group.POST("/checkpoints/:id/move", c.MoveCheckpoint)
+
+	group.GET("/workers/:id/control", c.GetWorkerControl)
+	group.POST("/workers/:id/pause", c.PauseWorker)
+	group.POST("/workers/:id/resume", c.ResumeWorker)
+	group.POST("/workers/:id/drain", c.DrainWorker)
+
+	if err := c.InitMeter(); err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+func (c *Component) InitMeter() (err error) {
+	meter := otel.GetMeterProvider().Meter(constant.Name)
+
+	if c.counter, err = meter.Int64Counter(c.Name()); err != nil {
+		return fmt.Errorf("failed to init meter for component %s: %w", c.Name(), err)
+	}
+
+	return nil
+}
+
+func (c *Component) CollectMetric(ctx context.Context, path, value string) {
+	measurementOption := metric.WithAttributes(
+		attribute.String("component", c.Name()),
+		attribute.String("path", path),
+		attribute.String("value", value),
+	)
+
+	c.counter.Add(ctx, int64(1), measurementOption)
+}
+
+func (c *Component) CollectTrace(ctx context.Context, path, value string) {
+	spanStartOptions := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("path", path),
+			attribute.String("value", value),
+		),
+	}
+
+	_, span := otel.Tracer("").Start(ctx, "admin API Query", spanStartOptions...)
+	defer span.End()
+}
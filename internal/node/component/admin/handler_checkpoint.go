@@ -0,0 +1,111 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rss3-network/node/common/http/response"
+	"github.com/rss3-network/node/internal/engine/protocol"
+	"github.com/rss3-network/node/internal/node/admin"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"go.uber.org/zap"
+)
+
+type CheckpointsRequest struct {
+	ID      string          `query:"id"`
+	Network network.Network `query:"network"`
+	Worker  string          `query:"worker"`
+}
+
+type CheckpointsResponse struct {
+	Data []*admin.Checkpoint `json:"data"`
+}
+
+type CheckpointResponse struct {
+	Data *admin.Checkpoint `json:"data"`
+}
+
+// GetCheckpoints returns the checkpoints of the workers with the decoded states.
+func (c *Component) GetCheckpoints(ctx echo.Context) error {
+	var request CheckpointsRequest
+	if err := ctx.Bind(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, "checkpoints")
+
+	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, "checkpoints")
+
+	checkpoints, err := c.adminService.ListCheckpoints(ctx.Request().Context(), request.ID, request.Network, request.Worker)
+	if err != nil {
+		zap.L().Error("failed to list checkpoints", zap.Any("request", request), zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, CheckpointsResponse{
+		Data: checkpoints,
+	})
+}
+
+// GetCheckpoint returns the checkpoint of a worker with the decoded state.
+func (c *Component) GetCheckpoint(ctx echo.Context) error {
+	id := ctx.Param("id")
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, id)
+
+	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, id)
+
+	checkpoint, err := c.adminService.GetCheckpoint(ctx.Request().Context(), id)
+	if err != nil {
+		return c.handleError(ctx, id, err)
+	}
+
+	return ctx.JSON(http.StatusOK, CheckpointResponse{
+		Data: checkpoint,
+	})
+}
+
+// MoveCheckpoint rewinds or fast-forwards the checkpoint of a worker, the running worker picks it up in a few seconds.
+func (c *Component) MoveCheckpoint(ctx echo.Context) error {
+	var request admin.MoveRequest
+	if err := ctx.Bind(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	request.ID = ctx.Param("id")
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, request.ID)
+
+	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, request.ID)
+
+	checkpoint, err := c.adminService.MoveCheckpoint(ctx.Request().Context(), request)
+	if err != nil {
+		return c.handleError(ctx, request.ID, err)
+	}
+
+	zap.L().Info("moved checkpoint via admin API",
+		zap.String("id", request.ID),
+		zap.Any("request", request))
+
+	return ctx.JSON(http.StatusOK, CheckpointResponse{
+		Data: checkpoint,
+	})
+}
+
+func (c *Component) handleError(ctx echo.Context, id string, err error) error {
+	switch {
+	case errors.Is(err, admin.ErrorCheckpointNotFound):
+		return response.NotFoundError(ctx, err)
+	case errors.Is(err, protocol.ErrorUnsupportedPosition),
+		errors.Is(err, admin.ErrorUnknownWorker),
+		errors.Is(err, admin.ErrorControlUnavailable),
+		errors.Is(err, admin.ErrorWorkerNotPaused):
+		return response.BadRequestError(ctx, err)
+	default:
+		zap.L().Error("failed to handle checkpoint request", zap.String("id", id), zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+}
@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rss3-network/node/common/http/response"
+	"github.com/rss3-network/node/internal/node/admin"
+	"go.uber.org/zap"
+)
+
+type WorkerControlResponse struct {
+	Data WorkerControl `json:"data"`
+}
+
+type WorkerControl struct {
+	ID      string        `json:"id"`
+	Control admin.Control `json:"control"`
+}
+
+// GetWorkerControl returns whether the worker is paused, drained or running.
+func (c *Component) GetWorkerControl(ctx echo.Context) error {
+	id := ctx.Param("id")
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, id)
+
+	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, id)
+
+	control, err := c.adminService.Control(ctx.Request().Context(), id)
+	if err != nil {
+		return c.handleError(ctx, id, err)
+	}
+
+	return ctx.JSON(http.StatusOK, WorkerControlResponse{
+		Data: WorkerControl{
+			ID:      id,
+			Control: control,
+		},
+	})
+}
+
+// PauseWorker stops the data source of the worker after its in-flight batch.
+func (c *Component) PauseWorker(ctx echo.Context) error {
+	return c.controlWorker(ctx, admin.ControlPause)
+}
+
+// ResumeWorker restarts the data source of a paused or drained worker from its checkpoint.
+func (c *Component) ResumeWorker(ctx echo.Context) error {
+	return c.controlWorker(ctx, admin.ControlResume)
+}
+
+// DrainWorker commits the in-flight batch of the worker and exits its process.
+func (c *Component) DrainWorker(ctx echo.Context) error {
+	return c.controlWorker(ctx, admin.ControlDrain)
+}
+
+func (c *Component) controlWorker(ctx echo.Context, control admin.Control) error {
+	id := ctx.Param("id")
+
+	go c.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, id)
+
+	go c.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, id)
+
+	if err := c.adminService.SetControl(ctx.Request().Context(), id, control); err != nil {
+		if errors.Is(err, admin.ErrorControlUnavailable) {
+			return response.BadRequestError(ctx, err)
+		}
+
+		return c.handleError(ctx, id, err)
+	}
+
+	zap.L().Info("controlled worker via admin API",
+		zap.String("id", id),
+		zap.Stringer("control", control))
+
+	return ctx.JSON(http.StatusOK, WorkerControlResponse{
+		Data: WorkerControl{
+			ID:      id,
+			Control: control,
+		},
+	})
+}
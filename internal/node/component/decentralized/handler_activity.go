@@ -11,6 +11,7 @@ import (
 	"github.com/rss3-network/node/common/http/response"
 	"github.com/rss3-network/node/docs"
 	"github.com/rss3-network/node/internal/database/model"
+	"github.com/rss3-network/node/internal/node/component/feed"
 	"github.com/rss3-network/node/internal/utils"
 	"github.com/rss3-network/node/schema/worker/decentralized"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
@@ -75,7 +76,7 @@ func (c *Component) GetActivity(ctx echo.Context, id string, request docs.GetDec
 		zap.String("id", id))
 
 	return ctx.JSON(http.StatusOK, ActivityResponse{
-		Data: result,
+		Data: c.TransformBridgeTransfers(ctx.Request().Context(), []*activityx.Activity{result})[0],
 		Meta: lo.Ternary(page == nil, nil, &MetaTotalPages{
 			TotalPages: lo.FromPtr(page),
 		}),
@@ -83,6 +84,9 @@ func (c *Component) GetActivity(ctx echo.Context, id string, request docs.GetDec
 }
 
 func (c *Component) GetAccountActivities(ctx echo.Context, account string, request docs.GetDecentralizedAccountParams) (err error) {
+	// The account with an extension of a feed, such as 0x...rss, requests the activities as a feed.
+	account, format := feed.ParseAccount(account)
+
 	if request.Type, err = utils.ParseTypes(ctx.QueryParams()["type"], request.Tag); err != nil {
 		return response.BadRequestError(ctx, err)
 	}
@@ -143,8 +147,12 @@ func (c *Component) GetAccountActivities(ctx echo.Context, account string, reque
 		zap.String("account", account),
 		zap.Int("count", len(activities)))
 
+	if format != "" {
+		return feed.Response(ctx, feed.New(ctx, format, account, c.TransformActivities(ctx.Request().Context(), activities)))
+	}
+
 	return ctx.JSON(http.StatusOK, ActivitiesResponse{
-		Data: c.TransformActivities(ctx.Request().Context(), activities),
+		Data: c.TransformBridgeTransfers(ctx.Request().Context(), c.TransformActivities(ctx.Request().Context(), activities)),
 		Meta: lo.Ternary(len(activities) < databaseRequest.Limit, nil, &MetaCursor{
 			Cursor: last,
 		}),
@@ -227,7 +235,7 @@ func (c *Component) BatchGetAccountsActivities(ctx echo.Context) (err error) {
 		zap.Int("count", len(activities)))
 
 	return ctx.JSON(http.StatusOK, ActivitiesResponse{
-		Data: c.TransformActivities(ctx.Request().Context(), activities),
+		Data: c.TransformBridgeTransfers(ctx.Request().Context(), c.TransformActivities(ctx.Request().Context(), activities)),
 		Meta: lo.Ternary(len(activities) < databaseRequest.Limit, nil, &MetaCursor{
 			Cursor: last,
 		}),
@@ -265,13 +273,13 @@ func (c *Component) TransformActivities(ctx context.Context, activities []*activ
 }
 
 type ActivityResponse struct {
-	Data *activityx.Activity `json:"data"`
-	Meta *MetaTotalPages     `json:"meta"`
+	Data *Activity       `json:"data"`
+	Meta *MetaTotalPages `json:"meta"`
 }
 
 type ActivitiesResponse struct {
-	Data []*activityx.Activity `json:"data"`
-	Meta *MetaCursor           `json:"meta,omitempty"`
+	Data []*Activity `json:"data"`
+	Meta *MetaCursor `json:"meta,omitempty"`
 }
 
 type MetaTotalPages struct {
@@ -80,7 +80,7 @@ func (c *Component) GetPlatformActivities(ctx echo.Context, plat decentralized.P
 		zap.Int("count", len(activities)))
 
 	return ctx.JSON(http.StatusOK, ActivitiesResponse{
-		Data: c.TransformActivities(ctx.Request().Context(), activities),
+		Data: c.TransformBridgeTransfers(ctx.Request().Context(), c.TransformActivities(ctx.Request().Context(), activities)),
 		Meta: lo.Ternary(len(activities) < databaseRequest.Limit, nil, &MetaCursor{
 			Cursor: last,
 		}),
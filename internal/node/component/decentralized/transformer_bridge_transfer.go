@@ -0,0 +1,106 @@
+package decentralized
+
+import (
+	"context"
+	"time"
+
+	"github.com/rss3-network/node/internal/database/model"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// Activity is an activity with the activities related to it, such as the other side of a bridge transfer.
+type Activity struct {
+	*activityx.Activity
+
+	Related         []string          `json:"related,omitempty"`
+	BridgeTransfers []*BridgeTransfer `json:"bridge_transfers,omitempty"`
+}
+
+// BridgeTransfer links the activities on the source and target networks of a bridge transfer,
+// the transfer is pending until the activity on the target network is indexed.
+type BridgeTransfer struct {
+	Status           model.BridgeTransferStatus `json:"status"`
+	SourceNetwork    network.Network            `json:"source_network"`
+	TargetNetwork    network.Network            `json:"target_network"`
+	SourceActivityID *string                    `json:"source_id,omitempty"`
+	SourceTimestamp  *int64                     `json:"source_timestamp,omitempty"`
+	TargetActivityID *string                    `json:"target_id,omitempty"`
+	TargetTimestamp  *int64                     `json:"target_timestamp,omitempty"`
+}
+
+// TransformBridgeTransfers attaches the bridge transfers to the bridge activities,
+// and relates the activities to the ones on the other side of the transfers.
+func (c *Component) TransformBridgeTransfers(ctx context.Context, activities []*activityx.Activity) []*Activity {
+	results := make([]*Activity, len(activities))
+	activityIDs := make([]string, 0)
+
+	for index, activity := range activities {
+		if activity == nil {
+			continue
+		}
+
+		results[index] = &Activity{Activity: activity}
+
+		if activity.Type == typex.TransactionBridge {
+			activityIDs = append(activityIDs, activity.ID)
+		}
+	}
+
+	if len(activityIDs) == 0 {
+		return results
+	}
+
+	transfers, err := c.databaseClient.LoadDatasetBridgeTransfers(ctx, model.QueryBridgeTransfers{
+		ActivityIDs: lo.Uniq(activityIDs),
+	})
+	if err != nil {
+		// The activities are still available without the bridge transfers.
+		zap.L().Error("failed to load bridge transfers", zap.Error(err))
+
+		return results
+	}
+
+	activityTransfers := make(map[string][]*model.BridgeTransfer)
+
+	for _, transfer := range transfers {
+		for _, activityID := range lo.Compact([]string{lo.FromPtr(transfer.SourceActivityID), lo.FromPtr(transfer.TargetActivityID)}) {
+			activityTransfers[activityID] = append(activityTransfers[activityID], transfer)
+		}
+	}
+
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+
+		for _, transfer := range activityTransfers[result.ID] {
+			if relatedID := transfer.RelatedActivityID(result.ID); relatedID != nil && !lo.Contains(result.Related, *relatedID) {
+				result.Related = append(result.Related, *relatedID)
+			}
+
+			result.BridgeTransfers = append(result.BridgeTransfers, &BridgeTransfer{
+				Status:           transfer.Status(),
+				SourceNetwork:    transfer.SourceNetwork,
+				TargetNetwork:    transfer.TargetNetwork,
+				SourceActivityID: transfer.SourceActivityID,
+				SourceTimestamp:  transformUnixTimestamp(transfer.SourceTimestamp),
+				TargetActivityID: transfer.TargetActivityID,
+				TargetTimestamp:  transformUnixTimestamp(transfer.TargetTimestamp),
+			})
+		}
+	}
+
+	return results
+}
+
+func transformUnixTimestamp(timestamp *time.Time) *int64 {
+	if timestamp == nil {
+		return nil
+	}
+
+	return lo.ToPtr(timestamp.Unix())
+}
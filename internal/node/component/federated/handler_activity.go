@@ -10,6 +10,7 @@ import (
 	"github.com/rss3-network/node/common/http/response"
 	"github.com/rss3-network/node/docs"
 	"github.com/rss3-network/node/internal/database/model"
+	"github.com/rss3-network/node/internal/node/component/feed"
 	"github.com/rss3-network/node/internal/utils"
 	"github.com/rss3-network/node/schema/worker/federated"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
@@ -73,6 +74,9 @@ func (c *Component) GetActivity(ctx echo.Context, id string, request docs.GetFed
 }
 
 func (c *Component) GetAccountActivities(ctx echo.Context, account string, request docs.GetFederatedAccountParams) (err error) {
+	// The account with an extension of a feed, such as alice@mastodon.social.rss, requests the activities as a feed.
+	account, format := feed.ParseAccount(account)
+
 	if request.Type, err = utils.ParseTypes(ctx.QueryParams()["type"], request.Tag); err != nil {
 		return response.BadRequestError(ctx, err)
 	}
@@ -134,6 +138,10 @@ func (c *Component) GetAccountActivities(ctx echo.Context, account string, reque
 		zap.String("account", account),
 		zap.Int("count", len(activities)))
 
+	if format != "" {
+		return feed.Response(ctx, feed.New(ctx, format, account, c.TransformActivities(ctx.Request().Context(), activities)))
+	}
+
 	return ctx.JSON(http.StatusOK, ActivitiesResponse{
 		Data: c.TransformActivities(ctx.Request().Context(), activities),
 		Meta: lo.Ternary(len(activities) < databaseRequest.Limit, nil, &MetaCursor{
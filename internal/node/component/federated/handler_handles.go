@@ -39,7 +39,8 @@ func (c *Component) GetHandles(ctx echo.Context) error {
 	)
 
 	switch request.Platform {
-	case federated.PlatformMastodon:
+	// Handles of all ActivityPub software are indexed by the Mastodon worker
+	case federated.PlatformMastodon, federated.PlatformMisskey, federated.PlatformPleroma, federated.PlatformPixelfed, federated.PlatformLemmy, federated.PlatformPeerTube:
 		handles, err = c.getMastodonHandles(ctx.Request().Context(), request)
 	case federated.PlatformBluesky:
 		handles, err = c.getBlueskyHandles(ctx.Request().Context(), request)
@@ -56,18 +56,26 @@ func (c *Component) buildSocialAuthorURL(_ context.Context, platform, handle str
 		return ""
 	}
 
-	switch platform {
-	case federated.PlatformMastodon.String():
-		parts := strings.SplitN(handle, "@", 3)
-		if len(parts) != 3 {
-			return ""
-		}
+	if platform == federated.PlatformBluesky.String() {
+		return fmt.Sprintf("https://bsky.app/profile/%s", handle)
+	}
 
-		username, domain := parts[1], parts[2]
+	username, domain, ok := splitActivityPubHandle(handle)
+	if !ok {
+		return ""
+	}
 
+	switch platform {
+	case federated.PlatformMastodon.String(), federated.PlatformPleroma.String():
 		return fmt.Sprintf("https://%s/users/%s", domain, username)
-	case federated.PlatformBluesky.String():
-		return fmt.Sprintf("https://bsky.app/profile/%s", handle)
+	case federated.PlatformMisskey.String():
+		return fmt.Sprintf("https://%s/@%s", domain, username)
+	case federated.PlatformPixelfed.String():
+		return fmt.Sprintf("https://%s/%s", domain, username)
+	case federated.PlatformLemmy.String():
+		return fmt.Sprintf("https://%s/u/%s", domain, username)
+	case federated.PlatformPeerTube.String():
+		return fmt.Sprintf("https://%s/accounts/%s", domain, username)
 	default:
 		return ""
 	}
@@ -79,19 +87,38 @@ func (c *Component) buildSocialNoteURL(_ context.Context, _ network.Network, pla
 		return ""
 	}
 
-	switch platform {
-	case federated.PlatformMastodon.String():
-		parts := strings.SplitN(handle, "@", 3)
-		if len(parts) != 3 {
-			return ""
-		}
+	if platform == federated.PlatformBluesky.String() {
+		return fmt.Sprintf("https://bsky.app/profile/%s/post/%s", handle, pubID)
+	}
 
-		username, domain := parts[1], parts[2]
+	username, domain, ok := splitActivityPubHandle(handle)
+	if !ok {
+		return ""
+	}
 
+	// Lemmy shares the ids of posts and comments, so the URL of a note is unknown
+	switch platform {
+	case federated.PlatformMastodon.String():
 		return fmt.Sprintf("https://%s/users/%s/statuses/%s", domain, username, pubID)
-	case federated.PlatformBluesky.String():
-		return fmt.Sprintf("https://bsky.app/profile/%s/post/%s", handle, pubID)
+	case federated.PlatformMisskey.String():
+		return fmt.Sprintf("https://%s/notes/%s", domain, pubID)
+	case federated.PlatformPleroma.String():
+		return fmt.Sprintf("https://%s/objects/%s", domain, pubID)
+	case federated.PlatformPixelfed.String():
+		return fmt.Sprintf("https://%s/p/%s/%s", domain, username, pubID)
+	case federated.PlatformPeerTube.String():
+		return fmt.Sprintf("https://%s/videos/watch/%s", domain, pubID)
 	default:
 		return ""
 	}
 }
+
+// splitActivityPubHandle splits the handle of ActivityPub such as @username@domain
+func splitActivityPubHandle(handle string) (username, domain string, ok bool) {
+	parts := strings.SplitN(handle, "@", 3)
+	if len(parts) != 3 {
+		return "", "", false
+	}
+
+	return parts[1], parts[2], true
+}
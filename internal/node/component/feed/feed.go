@@ -0,0 +1,124 @@
+package feed
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rss3-network/node/common/http/response"
+	"github.com/rss3-network/node/provider/rss"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// extensions are the extensions of accounts requesting their activities as feeds, such as /decentralized/0x...rss.
+var extensions = map[string]string{
+	".rss":  rss.FormatRSS,
+	".atom": rss.FormatAtom,
+	".json": rss.FormatJSON,
+}
+
+// ParseAccount splits the account and the format of the feed, the format is empty if the account has no extension of a feed.
+func ParseAccount(account string) (string, string) {
+	for extension, format := range extensions {
+		if trimmed, found := strings.CutSuffix(account, extension); found && trimmed != "" {
+			return trimmed, format
+		}
+	}
+
+	return account, ""
+}
+
+// New builds a feed of the activities of the account, the latest first.
+// The activities are transformed, so the related URLs include the links of their transactions on explorers.
+func New(ctx echo.Context, format, account string, activities []*activityx.Activity) *rss.Feed {
+	feed := rss.Feed{
+		Format:      format,
+		Title:       fmt.Sprintf("Activities of %s", account),
+		Link:        requestURL(ctx),
+		Description: fmt.Sprintf("The activities of %s indexed by RSS3 Node", account),
+		Items:       make([]*rss.Item, 0, len(activities)),
+	}
+
+	for _, activity := range activities {
+		if activity == nil {
+			continue
+		}
+
+		feed.Items = append(feed.Items, newItem(activity))
+	}
+
+	return &feed
+}
+
+// Response writes the feed to the response.
+func Response(ctx echo.Context, feed *rss.Feed) error {
+	data, err := rss.Encode(feed)
+	if err != nil {
+		zap.L().Error("failed to encode feed",
+			zap.String("format", feed.Format),
+			zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	return ctx.Blob(http.StatusOK, rss.ContentType(feed.Format), data)
+}
+
+// newItem builds the item of the activity, the content describes all actions of the activity.
+func newItem(activity *activityx.Activity) *rss.Item {
+	item := rss.Item{
+		ID:          fmt.Sprintf("urn:rss3:%s:%s", activity.Network, activity.ID),
+		Title:       Title(activity),
+		Link:        relatedURL(activity),
+		Authors:     []rss.Author{{Name: activity.From}},
+		Categories:  lo.Compact([]string{activity.Tag.String(), activity.Type.Name(), activity.Platform, activity.Network.String()}),
+		PublishedAt: time.Unix(int64(activity.Timestamp), 0).UTC(),
+	}
+
+	var content strings.Builder
+
+	for _, action := range activity.Actions {
+		fmt.Fprintf(&content, "<p>%s</p>", html.EscapeString(Describe(action, activity.Network)))
+
+		post, ok := action.Metadata.(*metadata.SocialPost)
+		if !ok {
+			continue
+		}
+
+		if post.Body != "" {
+			fmt.Fprintf(&content, "<blockquote>%s</blockquote>", html.EscapeString(post.Body))
+		}
+
+		for _, media := range post.Media {
+			item.Enclosures = append(item.Enclosures, rss.Enclosure{URL: media.Address, MimeType: media.MimeType})
+		}
+	}
+
+	item.Content = content.String()
+
+	return &item
+}
+
+// relatedURL returns the first related URL of the actions, such as the URL of a post or a transaction.
+func relatedURL(activity *activityx.Activity) string {
+	for _, action := range activity.Actions {
+		if len(action.RelatedURLs) > 0 {
+			return action.RelatedURLs[0]
+		}
+	}
+
+	return ""
+}
+
+// requestURL returns the URL of the activities requested as the feed, which is the link of the feed.
+func requestURL(ctx echo.Context) string {
+	request := ctx.Request()
+
+	return fmt.Sprintf("%s://%s%s", ctx.Scheme(), request.Host, request.URL.RequestURI())
+}
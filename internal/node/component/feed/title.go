@@ -0,0 +1,390 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+
+	workerx "github.com/rss3-network/node/schema/worker"
+	"github.com/rss3-network/protocol-go/schema"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// Title returns a human-readable title of the activity, which describes its primary action,
+// for example "Swapped 1.2 ETH for 3,000 USDC on Uniswap".
+func Title(activity *activityx.Activity) string {
+	action, found := lo.Find(activity.Actions, func(action *activityx.Action) bool {
+		return action.Type == activity.Type
+	})
+
+	if !found {
+		if len(activity.Actions) == 0 {
+			return describe(activity.Type.Name(), activity.Platform, activity.Network)
+		}
+
+		action = activity.Actions[0]
+	}
+
+	return Describe(action, activity.Network)
+}
+
+// Describe returns a human-readable description of the action.
+func Describe(action *activityx.Action, n network.Network) string {
+	var description string
+
+	switch value := action.Metadata.(type) {
+	case *metadata.TransactionTransfer:
+		description = fmt.Sprintf("Transferred %s to %s", formatToken(metadata.Token(*value)), formatAddress(action.To))
+	case *metadata.TransactionMint:
+		description = fmt.Sprintf("Minted %s", formatToken(metadata.Token(*value)))
+	case *metadata.TransactionBurn:
+		description = fmt.Sprintf("Burned %s", formatToken(metadata.Token(*value)))
+	case *metadata.TransactionApproval:
+		description = describeApproval(value.Action == metadata.ActionTransactionRevoke, value.Token, action.To)
+	case *metadata.TransactionBridge:
+		description = fmt.Sprintf("Bridged %s from %s to %s", formatToken(value.Token), formatNetwork(value.SourceNetwork), formatNetwork(value.TargetNetwork))
+	case *metadata.ExchangeSwap:
+		description = fmt.Sprintf("Swapped %s for %s", formatToken(value.From), formatToken(value.To))
+	case *metadata.ExchangeLiquidity:
+		description = describeLiquidity(value)
+	case *metadata.ExchangeStaking:
+		description = describeStaking(value)
+	case *metadata.ExchangeLoan:
+		description = describeLoan(value)
+	case *metadata.CollectibleTransfer:
+		description = fmt.Sprintf("Transferred %s to %s", formatToken(metadata.Token(*value)), formatAddress(action.To))
+	case *metadata.CollectibleMint:
+		description = fmt.Sprintf("Minted %s", formatToken(metadata.Token(*value)))
+	case *metadata.CollectibleBurn:
+		description = fmt.Sprintf("Burned %s", formatToken(metadata.Token(*value)))
+	case *metadata.CollectibleApproval:
+		description = describeApproval(value.Action == metadata.ActionCollectibleApprovalRevoke, value.Token, action.To)
+	case *metadata.CollectibleTrade:
+		description = describeCollectibleTrade(value)
+	case *metadata.CollectibleAuction:
+		description = describeCollectibleAuction(value)
+	case *metadata.MetaverseTransfer:
+		description = fmt.Sprintf("Transferred %s to %s", formatToken(metadata.Token(*value)), formatAddress(action.To))
+	case *metadata.MetaverseMint:
+		description = fmt.Sprintf("Minted %s", formatToken(metadata.Token(*value)))
+	case *metadata.MetaverseBurn:
+		description = fmt.Sprintf("Burned %s", formatToken(metadata.Token(*value)))
+	case *metadata.MetaverseTrade:
+		description = describeMetaverseTrade(value)
+	case *metadata.SocialPost:
+		description = describePost(action.Type, value)
+	case *metadata.SocialProfile:
+		description = describeProfile(value)
+	case *metadata.SocialProxy:
+		description = describeProxy(value)
+	case *metadata.GovernanceProposal:
+		description = fmt.Sprintf("Created the proposal %s", value.ID)
+	case *metadata.GovernanceVote:
+		description = describeVote(value)
+	case *metadata.RSS:
+		return value.Title
+	default:
+		return describe(action.Type.Name(), action.Platform, n)
+	}
+
+	if action.Platform != "" {
+		description = fmt.Sprintf("%s on %s", description, action.Platform)
+	}
+
+	return description
+}
+
+// describe returns a description of an action without known metadata by its type.
+func describe(name, platform string, n network.Network) string {
+	if name == typex.Unknown.Name() {
+		return fmt.Sprintf("Unknown activity on %s", lo.Ternary(platform != "", platform, formatNetwork(n)))
+	}
+
+	return fmt.Sprintf("%s on %s", capitalize(name), lo.Ternary(platform != "", platform, formatNetwork(n)))
+}
+
+func describeApproval(revoke bool, token metadata.Token, spender string) string {
+	if revoke {
+		return fmt.Sprintf("Revoked the approval of %s for %s", formatToken(token), formatAddress(spender))
+	}
+
+	return fmt.Sprintf("Approved %s for %s", formatToken(token), formatAddress(spender))
+}
+
+func describeLiquidity(liquidity *metadata.ExchangeLiquidity) string {
+	tokens := strings.Join(lo.Map(liquidity.Tokens, func(token metadata.Token, _ int) string {
+		return formatToken(token)
+	}), " and ")
+
+	switch liquidity.Action {
+	case metadata.ActionExchangeLiquidityAdd:
+		return fmt.Sprintf("Added liquidity of %s", tokens)
+	case metadata.ActionExchangeLiquidityRemove:
+		return fmt.Sprintf("Removed liquidity of %s", tokens)
+	case metadata.ActionExchangeLiquidityCollect:
+		return fmt.Sprintf("Collected %s", tokens)
+	case metadata.ActionExchangeLiquiditySupply:
+		return fmt.Sprintf("Supplied %s", tokens)
+	case metadata.ActionExchangeLiquidityBorrow:
+		return fmt.Sprintf("Borrowed %s", tokens)
+	case metadata.ActionExchangeLiquidityRepay:
+		return fmt.Sprintf("Repaid %s", tokens)
+	case metadata.ActionExchangeLiquidityWithdraw:
+		return fmt.Sprintf("Withdrew %s", tokens)
+	default:
+		return fmt.Sprintf("Provided liquidity of %s", tokens)
+	}
+}
+
+func describeStaking(staking *metadata.ExchangeStaking) string {
+	switch staking.Action {
+	case metadata.ActionExchangeStakingUnstake:
+		return fmt.Sprintf("Unstaked %s", formatToken(staking.Token))
+	case metadata.ActionExchangeStakingClaim:
+		return fmt.Sprintf("Claimed %s", formatToken(staking.Token))
+	default:
+		return fmt.Sprintf("Staked %s", formatToken(staking.Token))
+	}
+}
+
+func describeLoan(loan *metadata.ExchangeLoan) string {
+	var verb string
+
+	switch loan.Action {
+	case metadata.ActionExchangeLoanRepay:
+		verb = "Repaid"
+	case metadata.ActionExchangeLoanRefinance:
+		verb = "Refinanced"
+	case metadata.ActionExchangeLoanLiquidate:
+		verb = "Liquidated"
+	case metadata.ActionExchangeLoanSeize:
+		verb = "Seized the collateral of"
+	default:
+		verb = "Borrowed"
+	}
+
+	if loan.Amount == nil {
+		return fmt.Sprintf("%s a loan against %s", verb, formatToken(loan.Collateral))
+	}
+
+	return fmt.Sprintf("%s %s against %s", verb, formatToken(*loan.Amount), formatToken(loan.Collateral))
+}
+
+func describeCollectibleTrade(trade *metadata.CollectibleTrade) string {
+	var verb string
+
+	switch trade.Action {
+	case metadata.ActionCollectibleTradeSell:
+		verb = "Sold"
+	case metadata.ActionCollectibleTradeOffer:
+		verb = "Offered for"
+	case metadata.ActionCollectibleTradeSet, metadata.ActionCollectibleTradeCreate:
+		verb = "Listed"
+	case metadata.ActionCollectibleTradeFinalize:
+		verb = "Finalized the trade of"
+	default:
+		verb = "Bought"
+	}
+
+	return withCost(fmt.Sprintf("%s %s", verb, formatToken(trade.Token)), trade.Cost)
+}
+
+func describeCollectibleAuction(auction *metadata.CollectibleAuction) string {
+	switch auction.Action {
+	case metadata.ActionCollectibleAuctionBid:
+		return withCost(fmt.Sprintf("Bid on %s", formatToken(auction.Token)), auction.Cost)
+	case metadata.ActionCollectibleAuctionFinalize:
+		return withCost(fmt.Sprintf("Finalized the auction of %s", formatToken(auction.Token)), auction.Cost)
+	case metadata.ActionCollectibleAuctionCancel:
+		return fmt.Sprintf("Cancelled the auction of %s", formatToken(auction.Token))
+	case metadata.ActionCollectibleAuctionUpdate:
+		return withCost(fmt.Sprintf("Updated the auction of %s", formatToken(auction.Token)), auction.Cost)
+	case metadata.ActionCollectibleAuctionInvalidate:
+		return fmt.Sprintf("Invalidated the auction of %s", formatToken(auction.Token))
+	default:
+		return withCost(fmt.Sprintf("Created an auction of %s", formatToken(auction.Token)), auction.Cost)
+	}
+}
+
+func describeMetaverseTrade(trade *metadata.MetaverseTrade) string {
+	var verb string
+
+	switch trade.Action {
+	case metadata.ActionMetaverseTradeList:
+		verb = "Listed"
+	case metadata.ActionMetaverseTradeSell:
+		verb = "Sold"
+	default:
+		verb = "Bought"
+	}
+
+	return withCost(fmt.Sprintf("%s %s", verb, formatToken(trade.Token)), &trade.Cost)
+}
+
+func describePost(postType schema.Type, post *metadata.SocialPost) string {
+	switch postType {
+	case typex.SocialComment:
+		return "Commented on a post"
+	case typex.SocialShare:
+		return "Shared a post"
+	case typex.SocialRevise:
+		return "Revised a post"
+	case typex.SocialMint:
+		return "Minted a post"
+	case typex.SocialDelete:
+		return "Deleted a post"
+	case typex.SocialLike:
+		return "Liked a post"
+	case typex.SocialReward:
+		if post.Reward != nil {
+			return fmt.Sprintf("Rewarded a post with %s", formatToken(*post.Reward))
+		}
+
+		return "Rewarded a post"
+	}
+
+	if post.Title != "" {
+		return fmt.Sprintf("Posted %q", post.Title)
+	}
+
+	return "Posted"
+}
+
+// relations are the verbs of the relations of the social graph, which are indexed as profile updates.
+var relations = map[string]string{
+	workerx.SocialRelationFollow:   "Followed",
+	workerx.SocialRelationUnfollow: "Unfollowed",
+	workerx.SocialRelationBlock:    "Blocked",
+	workerx.SocialRelationUnblock:  "Unblocked",
+	workerx.SocialRelationList:     "Listed",
+	workerx.SocialRelationUnlist:   "Unlisted",
+}
+
+func describeProfile(profile *metadata.SocialProfile) string {
+	if verb, found := relations[profile.Key]; found && profile.Action == metadata.ActionSocialProfileUpdate {
+		return fmt.Sprintf("%s %s", verb, profile.Value)
+	}
+
+	var verb string
+
+	switch profile.Action {
+	case metadata.ActionSocialProfileCreate:
+		verb = "Created"
+	case metadata.ActionSocialProfileRenew:
+		verb = "Renewed"
+	case metadata.ActionSocialProfileWrap:
+		verb = "Wrapped"
+	case metadata.ActionSocialProfileUnwrap:
+		verb = "Unwrapped"
+	default:
+		verb = "Updated"
+	}
+
+	if handle := lo.Ternary(profile.Handle != "", profile.Handle, profile.ProfileID); handle != "" {
+		return fmt.Sprintf("%s the profile %s", verb, handle)
+	}
+
+	return fmt.Sprintf("%s the profile", verb)
+}
+
+func describeProxy(proxy *metadata.SocialProxy) string {
+	if proxy.Action == metadata.ActionSocialProxyRemove {
+		return fmt.Sprintf("Removed the proxy %s", formatAddress(proxy.ProxyAddress.String()))
+	}
+
+	return fmt.Sprintf("Appointed the proxy %s", formatAddress(proxy.ProxyAddress.String()))
+}
+
+func describeVote(vote *metadata.GovernanceVote) string {
+	switch vote.Action {
+	case metadata.ActionGovernanceVoteAgainst:
+		return fmt.Sprintf("Voted against the proposal %s", vote.Proposal.ID)
+	case metadata.ActionGovernanceVoteAbstain:
+		return fmt.Sprintf("Abstained from the proposal %s", vote.Proposal.ID)
+	default:
+		return fmt.Sprintf("Voted for the proposal %s", vote.Proposal.ID)
+	}
+}
+
+func withCost(description string, cost *metadata.Token) string {
+	if cost == nil || cost.Value == nil {
+		return description
+	}
+
+	return fmt.Sprintf("%s for %s", description, formatToken(*cost))
+}
+
+// formatToken formats the token as the value and the symbol, or as the name and the id of a non-fungible token.
+func formatToken(token metadata.Token) string {
+	if token.ID != nil {
+		name := lo.CoalesceOrEmpty(token.Name, token.Symbol, formatAddress(lo.FromPtr(token.Address)))
+
+		return fmt.Sprintf("%s #%s", name, token.ID.String())
+	}
+
+	symbol := lo.CoalesceOrEmpty(token.Symbol, token.Name, formatAddress(lo.FromPtr(token.Address)))
+
+	if token.Value == nil {
+		return symbol
+	}
+
+	return strings.TrimSpace(fmt.Sprintf("%s %s", formatValue(token.Value.Shift(-int32(token.Decimals))), symbol))
+}
+
+// formatValue formats the value with thousands separators, and 4 decimal places or 4 significant digits of a fraction.
+func formatValue(value decimal.Decimal) string {
+	places := int32(4)
+
+	if value.Abs().LessThan(decimal.NewFromInt(1)) && !value.IsZero() {
+		// The exponent of the first significant digit, such as -5 of 0.000012.
+		places = max(4, 3-(int32(value.NumDigits())+value.Exponent()-1))
+	}
+
+	integer, fraction, _ := strings.Cut(value.Round(places).String(), ".")
+
+	sign := ""
+	if strings.HasPrefix(integer, "-") {
+		sign, integer = "-", integer[1:]
+	}
+
+	var builder strings.Builder
+
+	for index, digit := range integer {
+		if index > 0 && (len(integer)-index)%3 == 0 {
+			builder.WriteRune(',')
+		}
+
+		builder.WriteRune(digit)
+	}
+
+	if fraction != "" {
+		return fmt.Sprintf("%s%s.%s", sign, builder.String(), fraction)
+	}
+
+	return sign + builder.String()
+}
+
+// formatAddress shortens an address of EVM, such as 0xd8dA...6045.
+func formatAddress(address string) string {
+	if len(address) == 42 && strings.HasPrefix(address, "0x") {
+		return fmt.Sprintf("%s...%s", address[:6], address[len(address)-4:])
+	}
+
+	return address
+}
+
+func formatNetwork(n network.Network) string {
+	return capitalize(strings.ReplaceAll(n.String(), "_", " "))
+}
+
+func capitalize(value string) string {
+	if value == "" {
+		return value
+	}
+
+	return strings.ToUpper(value[:1]) + value[1:]
+}
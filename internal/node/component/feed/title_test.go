@@ -0,0 +1,197 @@
+package feed_test
+
+import (
+	"testing"
+
+	"github.com/rss3-network/node/internal/node/component/feed"
+	workerx "github.com/rss3-network/node/schema/worker"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTitle(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		activity *activityx.Activity
+		want     string
+	}{
+		{
+			name: "Swap",
+			activity: &activityx.Activity{
+				Network: network.Ethereum,
+				Tag:     tag.Exchange,
+				Type:    typex.ExchangeSwap,
+				Actions: []*activityx.Action{
+					{
+						Tag:      tag.Transaction,
+						Type:     typex.TransactionTransfer,
+						From:     "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+						To:       "0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD",
+						Metadata: lo.ToPtr(metadata.TransactionTransfer{Value: lo.ToPtr(decimal.RequireFromString("1200000000000000000")), Symbol: "ETH", Decimals: 18}),
+					},
+					{
+						Tag:      tag.Exchange,
+						Type:     typex.ExchangeSwap,
+						Platform: "Uniswap",
+						Metadata: &metadata.ExchangeSwap{
+							From: metadata.Token{Value: lo.ToPtr(decimal.RequireFromString("1200000000000000000")), Symbol: "ETH", Decimals: 18},
+							To:   metadata.Token{Value: lo.ToPtr(decimal.RequireFromString("3000000000")), Symbol: "USDC", Decimals: 6},
+						},
+					},
+				},
+			},
+			want: "Swapped 1.2 ETH for 3,000 USDC on Uniswap",
+		},
+		{
+			name: "Transfer of a small value",
+			activity: &activityx.Activity{
+				Network: network.Ethereum,
+				Tag:     tag.Transaction,
+				Type:    typex.TransactionTransfer,
+				Actions: []*activityx.Action{
+					{
+						Tag:      tag.Transaction,
+						Type:     typex.TransactionTransfer,
+						To:       "0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD",
+						Metadata: lo.ToPtr(metadata.TransactionTransfer{Value: lo.ToPtr(decimal.RequireFromString("12345678901234")), Symbol: "ETH", Decimals: 18}),
+					},
+				},
+			},
+			want: "Transferred 0.00001235 ETH to 0x3fC9...7FAD",
+		},
+		{
+			name: "Collectible trade",
+			activity: &activityx.Activity{
+				Network: network.Ethereum,
+				Tag:     tag.Collectible,
+				Type:    typex.CollectibleTrade,
+				Actions: []*activityx.Action{
+					{
+						Tag:      tag.Collectible,
+						Type:     typex.CollectibleTrade,
+						Platform: "OpenSea",
+						Metadata: &metadata.CollectibleTrade{
+							Action: metadata.ActionCollectibleTradeBuy,
+							Token:  metadata.Token{ID: lo.ToPtr(decimal.NewFromInt(8348)), Name: "Azuki"},
+							Cost:   &metadata.Token{Value: lo.ToPtr(decimal.RequireFromString("15250000000000000000")), Symbol: "WETH", Decimals: 18},
+						},
+					},
+				},
+			},
+			want: "Bought Azuki #8348 for 15.25 WETH on OpenSea",
+		},
+		{
+			name: "Post with a title",
+			activity: &activityx.Activity{
+				Network: network.Arweave,
+				Tag:     tag.Social,
+				Type:    typex.SocialPost,
+				Actions: []*activityx.Action{
+					{
+						Tag:      tag.Social,
+						Type:     typex.SocialPost,
+						Platform: "Mirror",
+						Metadata: &metadata.SocialPost{Title: "Hello RSS3", Body: "The open information layer"},
+					},
+				},
+			},
+			want: `Posted "Hello RSS3" on Mirror`,
+		},
+		{
+			name: "Follow",
+			activity: &activityx.Activity{
+				Network: network.Mastodon,
+				Tag:     tag.Social,
+				Type:    typex.SocialProfile,
+				Actions: []*activityx.Action{
+					{
+						Tag:      tag.Social,
+						Type:     typex.SocialProfile,
+						Platform: "Mastodon",
+						Metadata: &metadata.SocialProfile{
+							Action: metadata.ActionSocialProfileUpdate,
+							Handle: "@alice@mastodon.social",
+							Key:    workerx.SocialRelationFollow,
+							Value:  "@bob@mastodon.social",
+						},
+					},
+				},
+			},
+			want: "Followed @bob@mastodon.social on Mastodon",
+		},
+		{
+			name: "Unknown",
+			activity: &activityx.Activity{
+				Network: network.Ethereum,
+				Tag:     tag.Unknown,
+				Type:    typex.Unknown,
+			},
+			want: "Unknown activity on Ethereum",
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testcase.want, feed.Title(testcase.activity))
+		})
+	}
+}
+
+func TestParseAccount(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		account     string
+		wantAccount string
+		wantFormat  string
+	}{
+		{
+			name:        "RSS",
+			account:     "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045.rss",
+			wantAccount: "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+			wantFormat:  "rss",
+		},
+		{
+			name:        "Atom of a federated account",
+			account:     "alice@mastodon.social.atom",
+			wantAccount: "alice@mastodon.social",
+			wantFormat:  "atom",
+		},
+		{
+			name:        "JSON Feed",
+			account:     "vitalik.eth.json",
+			wantAccount: "vitalik.eth",
+			wantFormat:  "json",
+		},
+		{
+			name:        "Without extension",
+			account:     "vitalik.eth",
+			wantAccount: "vitalik.eth",
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			account, format := feed.ParseAccount(testcase.account)
+			require.Equal(t, testcase.wantAccount, account)
+			require.Equal(t, testcase.wantFormat, format)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/node/admin"
 	rssx "github.com/rss3-network/node/internal/node/component/rss"
 	"github.com/rss3-network/node/internal/node/monitor"
 	"github.com/rss3-network/node/schema/worker"
@@ -179,7 +180,7 @@ func (c *Component) fetchWorkerInfo(ctx context.Context, module *config.Module)
 		workerProgress monitor.WorkerProgress
 	)
 
-	if module.Network.Protocol() == network.RSSProtocol {
+	if module.Network.Protocol() == network.RSSProtocol && module.Worker != rss.Feed {
 		// check RSS worker health status
 		status, _ = c.checkRSSWorkerHealth(ctx, module)
 	} else {
@@ -294,8 +295,9 @@ func (c *Component) getWorkerStatusAndProgressByID(ctx context.Context, workerID
 
 	statusKey := c.buildWorkerIDStatusCacheKey(workerID)
 	progressKey := c.buildWorkerProgressCacheKey(workerID)
+	controlKey := c.buildWorkerControlCacheKey(workerID)
 
-	command := c.redisClient.B().Mget().Key(statusKey, progressKey).Build()
+	command := c.redisClient.B().Mget().Key(statusKey, progressKey, controlKey).Build()
 
 	result := c.redisClient.Do(ctx, command)
 	if err := result.Error(); err != nil {
@@ -305,7 +307,7 @@ func (c *Component) getWorkerStatusAndProgressByID(ctx context.Context, workerID
 	}
 
 	values, err := result.ToArray()
-	if err != nil || len(values) < 2 {
+	if err != nil || len(values) < 3 {
 		return worker.StatusUnknown, monitor.WorkerProgress{}
 	}
 
@@ -326,6 +328,13 @@ func (c *Component) getWorkerStatusAndProgressByID(ctx context.Context, workerID
 		status = worker.StatusUnknown
 	}
 
+	// Report the paused worker before the monitor flags it in the next cycle.
+	if controlValue, err := values[2].ToString(); err == nil {
+		if control, err := admin.ControlString(controlValue); err == nil && control != admin.ControlResume {
+			status = worker.StatusPaused
+		}
+	}
+
 	// Parse the progress
 	progressValue, err := c.parseRedisJSONValue(values[1].String())
 	if err != nil {
@@ -373,6 +382,11 @@ func (c *Component) buildWorkerIDStatusCacheKey(workerID string) string {
 	return fmt.Sprintf("worker:status:id:%s", workerID)
 }
 
+// buildWorkerControlCacheKey builds the cache key for the worker control by id.
+func (c *Component) buildWorkerControlCacheKey(workerID string) string {
+	return fmt.Sprintf("worker:control:%s", workerID)
+}
+
 // buildWorkerProgressCacheKey builds the cache key for the worker progress by id.
 func (c *Component) buildWorkerProgressCacheKey(workerID string) string {
 	return fmt.Sprintf("worker:progress:%s", workerID)
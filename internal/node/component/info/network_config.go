@@ -324,10 +324,15 @@ var NetworkToWorkersMap = map[network.Network][]worker.Worker{
 		decentralized.Core,
 		decentralized.Cow,
 		decentralized.Curve,
+		decentralized.EAS,
+		decentralized.GMX,
+		decentralized.Governor,
 		decentralized.Highlight,
 		decentralized.Oneinch,
+		decentralized.OpenSea,
 		decentralized.Paraswap,
 		decentralized.Rainbow,
+		decentralized.Safe,
 		decentralized.Stargate,
 		decentralized.Uniswap,
 		decentralized.Zerion,
@@ -341,9 +346,13 @@ var NetworkToWorkersMap = map[network.Network][]worker.Worker{
 		decentralized.Aave,
 		decentralized.Core,
 		decentralized.Curve,
+		decentralized.GMX,
+		decentralized.Governor,
 		decentralized.Oneinch,
+		decentralized.OpenSea,
 		decentralized.Paraswap,
 		decentralized.Rainbow,
+		decentralized.Safe,
 		decentralized.Stargate,
 		decentralized.Uniswap,
 		decentralized.Zerion,
@@ -354,9 +363,13 @@ var NetworkToWorkersMap = map[network.Network][]worker.Worker{
 		decentralized.Core,
 		decentralized.Cow,
 		decentralized.Curve,
+		decentralized.EAS,
+		decentralized.Governor,
 		decentralized.Oneinch,
+		decentralized.OpenSea,
 		decentralized.Paraswap,
 		decentralized.Rainbow,
+		decentralized.Safe,
 		decentralized.Stargate,
 		decentralized.Uniswap,
 		decentralized.Zerion,
@@ -365,9 +378,11 @@ var NetworkToWorkersMap = map[network.Network][]worker.Worker{
 		decentralized.Aave,
 		decentralized.Core,
 		decentralized.Curve,
+		decentralized.Governor,
 		decentralized.Oneinch,
 		decentralized.Paraswap,
 		decentralized.Rainbow,
+		decentralized.Safe,
 		decentralized.Stargate,
 		decentralized.Uniswap,
 		decentralized.Zerion,
@@ -375,15 +390,20 @@ var NetworkToWorkersMap = map[network.Network][]worker.Worker{
 	network.Crossbell: {
 		decentralized.Core,
 		decentralized.Crossbell,
+		decentralized.Safe,
 	},
 	network.Ethereum: {
 		decentralized.Aave,
 		decentralized.Arbitrum,
 		decentralized.Base,
+		decentralized.Blur,
 		decentralized.Core,
 		decentralized.Cow,
 		decentralized.Curve,
+		decentralized.EAS,
+		decentralized.EigenLayer,
 		decentralized.ENS,
+		decentralized.Governor,
 		decentralized.Highlight,
 		decentralized.Lido,
 		decentralized.Linea,
@@ -395,6 +415,7 @@ var NetworkToWorkersMap = map[network.Network][]worker.Worker{
 		decentralized.Paraswap,
 		decentralized.Rainbow,
 		decentralized.RSS3,
+		decentralized.Safe,
 		decentralized.Stargate,
 		decentralized.Uniswap,
 		decentralized.VSL,
@@ -407,13 +428,17 @@ var NetworkToWorkersMap = map[network.Network][]worker.Worker{
 		decentralized.Core,
 		decentralized.Cow,
 		decentralized.Curve,
+		decentralized.Governor,
 		decentralized.Oneinch,
+		decentralized.Safe,
 		decentralized.Zerion,
 	},
 	network.Linea: {
 		decentralized.Core,
+		decentralized.EAS,
 		decentralized.Linea,
 		decentralized.Rainbow,
+		decentralized.Safe,
 		decentralized.Stargate,
 		decentralized.Uniswap,
 		decentralized.Zerion,
@@ -430,13 +455,18 @@ var NetworkToWorkersMap = map[network.Network][]worker.Worker{
 		decentralized.Aave,
 		decentralized.Core,
 		decentralized.Curve,
+		decentralized.EAS,
+		decentralized.Farcaster,
+		decentralized.Governor,
 		decentralized.Highlight,
 		decentralized.KiwiStand,
 		decentralized.Matters,
 		decentralized.Oneinch,
+		decentralized.OpenSea,
 		decentralized.Optimism,
 		decentralized.Paraswap,
 		decentralized.Rainbow,
+		decentralized.Safe,
 		decentralized.Stargate,
 		decentralized.Uniswap,
 		decentralized.Zerion,
@@ -446,13 +476,16 @@ var NetworkToWorkersMap = map[network.Network][]worker.Worker{
 		decentralized.Aavegotchi,
 		decentralized.Core,
 		decentralized.Curve,
+		decentralized.Governor,
 		decentralized.Highlight,
 		decentralized.IQWiki,
 		decentralized.Lens,
 		decentralized.Oneinch,
+		decentralized.OpenSea,
 		decentralized.Paraswap,
 		decentralized.Polymarket,
 		decentralized.Rainbow,
+		decentralized.Safe,
 		decentralized.Stargate,
 		decentralized.Uniswap,
 		decentralized.Zerion,
@@ -462,15 +495,18 @@ var NetworkToWorkersMap = map[network.Network][]worker.Worker{
 	},
 	network.SatoshiVM: {
 		decentralized.Core,
+		decentralized.Safe,
 		decentralized.SAVM,
 		decentralized.Uniswap,
 	},
 	network.VSL: {
 		decentralized.Core,
+		decentralized.Safe,
 	},
 	network.XLayer: {
 		decentralized.Core,
 		decentralized.Curve,
+		decentralized.Safe,
 		decentralized.Zerion,
 	},
 }
@@ -507,11 +543,17 @@ var WorkerToConfigMap = map[network.Protocol]map[worker.Worker]workerConfig{
 		decentralized.Arbitrum:   defaultWorkerConfig(decentralized.Arbitrum, network.EthereumProtocol, nil),
 		decentralized.BendDAO:    defaultWorkerConfig(decentralized.BendDAO, network.EthereumProtocol, nil),
 		decentralized.Base:       defaultWorkerConfig(decentralized.Base, network.EthereumProtocol, nil),
+		decentralized.Blur:       defaultWorkerConfig(decentralized.Blur, network.EthereumProtocol, nil),
 		decentralized.Core:       defaultWorkerConfig(decentralized.Core, network.EthereumProtocol, nil),
 		decentralized.Cow:        defaultWorkerConfig(decentralized.Cow, network.EthereumProtocol, nil),
 		decentralized.Crossbell:  customWorkerConfigWithIPFS(decentralized.Crossbell, network.EthereumProtocol, ""),
 		decentralized.Curve:      defaultWorkerConfig(decentralized.Curve, network.EthereumProtocol, nil),
+		decentralized.EAS:        defaultWorkerConfig(decentralized.EAS, network.EthereumProtocol, nil),
+		decentralized.EigenLayer: defaultWorkerConfig(decentralized.EigenLayer, network.EthereumProtocol, nil),
 		decentralized.ENS:        defaultWorkerConfig(decentralized.ENS, network.EthereumProtocol, nil),
+		decentralized.Farcaster:  defaultWorkerConfig(decentralized.Farcaster, network.EthereumProtocol, nil),
+		decentralized.GMX:        defaultWorkerConfig(decentralized.GMX, network.EthereumProtocol, nil),
+		decentralized.Governor:   defaultWorkerConfig(decentralized.Governor, network.EthereumProtocol, nil),
 		decentralized.Highlight:  defaultWorkerConfig(decentralized.Highlight, network.EthereumProtocol, nil),
 		decentralized.IQWiki:     customWorkerConfigWithIPFS(decentralized.IQWiki, network.EthereumProtocol, ""),
 		decentralized.KiwiStand:  defaultWorkerConfig(decentralized.KiwiStand, network.EthereumProtocol, nil),
@@ -528,6 +570,7 @@ var WorkerToConfigMap = map[network.Protocol]map[worker.Worker]workerConfig{
 		decentralized.Polymarket: defaultWorkerConfig(decentralized.Polymarket, network.EthereumProtocol, nil),
 		decentralized.Rainbow:    defaultWorkerConfig(decentralized.Rainbow, network.EthereumProtocol, nil),
 		decentralized.RSS3:       defaultWorkerConfig(decentralized.RSS3, network.EthereumProtocol, nil),
+		decentralized.Safe:       defaultWorkerConfig(decentralized.Safe, network.EthereumProtocol, nil),
 		decentralized.SAVM:       defaultWorkerConfig(decentralized.SAVM, network.EthereumProtocol, nil),
 		decentralized.Stargate:   defaultWorkerConfig(decentralized.Stargate, network.EthereumProtocol, nil),
 		decentralized.Uniswap:    defaultWorkerConfig(decentralized.Uniswap, network.EthereumProtocol, nil),
@@ -10,9 +10,11 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/rss3-network/node/config"
 	"github.com/rss3-network/node/internal/constant"
+	"github.com/rss3-network/node/internal/database"
 	"github.com/rss3-network/node/internal/node/component"
 	"github.com/rss3-network/node/internal/node/component/middleware"
 	"github.com/rss3-network/node/schema/worker"
+	"github.com/rss3-network/node/schema/worker/rss"
 	"github.com/rss3-network/protocol-go/schema/network"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
@@ -23,10 +25,11 @@ import (
 )
 
 type Component struct {
-	config     *config.File
-	httpClient *http.Client
-	rsshub     *configx
-	counter    metric.Int64Counter
+	config         *config.File
+	httpClient     *http.Client
+	databaseClient database.Client
+	rsshub         *configx
+	counter        metric.Int64Counter
 }
 
 type configx struct {
@@ -52,12 +55,13 @@ func (h *Component) Name() string {
 
 var _ component.Component = (*Component)(nil)
 
-func NewComponent(_ context.Context, apiServer *echo.Echo, config *config.File) *Component {
+func NewComponent(_ context.Context, apiServer *echo.Echo, config *config.File, databaseClient database.Client) *Component {
 	RecentRequests = cb.New(MaxRecentRequests)
 
 	c := &Component{
-		config:     config,
-		httpClient: http.DefaultClient,
+		config:         config,
+		httpClient:     http.DefaultClient,
+		databaseClient: databaseClient,
 	}
 
 	group := apiServer.Group(fmt.Sprintf("/%s", Name))
@@ -65,12 +69,24 @@ func NewComponent(_ context.Context, apiServer *echo.Echo, config *config.File)
 	// Add middleware for bearer token authentication
 	group.Use(middleware.BearerAuth(config.Discovery.Server.AccessToken))
 
-	group.GET("/*", c.Handler)
-
 	if err := c.InitMeter(); err != nil {
 		panic(err)
 	}
 
+	// The feed worker indexes feeds into the database instead of proxying RSSHub.
+	if config.Component.RSS != nil && config.Component.RSS.Worker == rss.Feed {
+		if databaseClient == nil {
+			panic("Missing database for the feed worker of Component RSS")
+		}
+
+		group.GET("/feed", c.GetFeedActivities)
+		group.GET("/authors/:author", c.GetAuthorActivities)
+
+		return c
+	}
+
+	group.GET("/*", c.Handler)
+
 	if config.Component.RSS != nil && config.Component.RSS.Network == network.RSSHub {
 		c.rsshub = &configx{
 			id:       config.Component.RSS.ID,
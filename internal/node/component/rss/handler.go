@@ -1,6 +1,7 @@
 package rss
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -25,6 +26,10 @@ func (h *Component) Handler(ctx echo.Context) error {
 
 	addRecentRequest(ctx.Request().RequestURI)
 
+	if h.rsshub == nil {
+		return response.NotFoundError(ctx, fmt.Errorf("RSSHub is not configured"))
+	}
+
 	data, err := h.getActivities(ctx.Request().Context(), path, ctx.Request().URL)
 	if err != nil {
 		zap.L().Error("failed to get activities from RSS feed",
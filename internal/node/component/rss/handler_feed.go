@@ -0,0 +1,154 @@
+package rss
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/creasty/defaults"
+	"github.com/labstack/echo/v4"
+	"github.com/rss3-network/node/common/http/response"
+	"github.com/rss3-network/node/internal/database/model"
+	"github.com/rss3-network/node/schema/worker/rss"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	networkx "github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+type FeedActivitiesRequest struct {
+	// URL is the URL of the feed configured for the feed worker.
+	URL    string  `query:"url" validate:"required"`
+	Cursor *string `query:"cursor"`
+	Limit  int     `query:"limit" default:"100" validate:"min=1,max=100"`
+}
+
+type AuthorActivitiesRequest struct {
+	// Author is the name of the author, or the email of an author without a name.
+	Author string  `param:"author" validate:"required"`
+	Cursor *string `query:"cursor"`
+	Limit  int     `query:"limit" default:"100" validate:"min=1,max=100"`
+}
+
+type ActivitiesResponse struct {
+	Data []*activityx.Activity `json:"data"`
+	Meta *MetaCursor           `json:"meta,omitempty"`
+}
+
+type MetaCursor struct {
+	Cursor string `json:"cursor"`
+}
+
+// GetFeedActivities returns the posts indexed from the feed, the latest first.
+func (h *Component) GetFeedActivities(ctx echo.Context) error {
+	var request FeedActivitiesRequest
+
+	if err := ctx.Bind(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := defaults.Set(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := ctx.Validate(&request); err != nil {
+		return response.ValidationFailedError(ctx, err)
+	}
+
+	return h.findActivities(ctx, request.URL, activityx.DirectionIn, request.Cursor, request.Limit)
+}
+
+// GetAuthorActivities returns the posts of the author indexed from all feeds, the latest first.
+func (h *Component) GetAuthorActivities(ctx echo.Context) error {
+	var request AuthorActivitiesRequest
+
+	if err := ctx.Bind(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := defaults.Set(&request); err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	if err := ctx.Validate(&request); err != nil {
+		return response.ValidationFailedError(ctx, err)
+	}
+
+	return h.findActivities(ctx, request.Author, activityx.DirectionOut, request.Cursor, request.Limit)
+}
+
+// findActivities finds the activities of the owner, which is the feed receiving posts or the author sending them.
+func (h *Component) findActivities(ctx echo.Context, owner string, direction activityx.Direction, cursor *string, limit int) error {
+	go h.CollectTrace(ctx.Request().Context(), ctx.Request().RequestURI, owner)
+
+	go h.CollectMetric(ctx.Request().Context(), ctx.Request().RequestURI, owner)
+
+	addRecentRequest(ctx.Request().RequestURI)
+
+	cursorActivity, err := h.getCursor(ctx.Request().Context(), cursor)
+	if err != nil {
+		return response.BadRequestError(ctx, err)
+	}
+
+	query := model.ActivitiesQuery{
+		Owner:     lo.ToPtr(owner),
+		Direction: lo.ToPtr(direction),
+		Platform:  rss.PlatformRSS.String(),
+		Cursor:    cursorActivity,
+		Limit:     limit,
+	}
+
+	activities, err := h.databaseClient.FindActivities(ctx.Request().Context(), query)
+	if err != nil {
+		zap.L().Error("failed to find feed activities",
+			zap.String("owner", owner),
+			zap.Error(err))
+
+		return response.InternalError(ctx)
+	}
+
+	zap.L().Info("successfully retrieved feed activities",
+		zap.String("owner", owner),
+		zap.Int("activity_count", len(activities)))
+
+	last, _ := lo.Last(activities)
+
+	return ctx.JSON(http.StatusOK, ActivitiesResponse{
+		Data: activities,
+		Meta: lo.Ternary(len(activities) < limit, nil, &MetaCursor{
+			Cursor: h.transformCursor(last),
+		}),
+	})
+}
+
+func (h *Component) getCursor(ctx context.Context, cursor *string) (*activityx.Activity, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	str := strings.Split(*cursor, ":")
+	if len(str) != 2 {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	network, err := networkx.NetworkString(str[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	data, _, err := h.databaseClient.FindActivity(ctx, model.ActivityQuery{ID: lo.ToPtr(str[0]), Network: lo.ToPtr(network)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cursor: %w", err)
+	}
+
+	return data, nil
+}
+
+func (h *Component) transformCursor(activity *activityx.Activity) string {
+	if activity == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%s", activity.ID, activity.Network)
+}
@@ -0,0 +1,47 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/internal/node/admin"
+	"go.uber.org/zap"
+)
+
+// applyPendingState saves the checkpoint state moved by the admin API, it returns nil if there is none.
+func (s *Server) applyPendingState(ctx context.Context) (*engine.Checkpoint, error) {
+	if s.redisClient == nil {
+		return nil, nil
+	}
+
+	state, pending, err := admin.LoadPendingState(ctx, s.redisClient, s.id)
+	if err != nil {
+		return nil, fmt.Errorf("load pending state: %w", err)
+	}
+
+	if !pending {
+		return nil, nil
+	}
+
+	checkpoint := engine.Checkpoint{
+		ID:      s.id,
+		Network: s.config.Network,
+		Worker:  s.worker.Name(),
+		State:   state,
+	}
+
+	if err := s.saveCheckpoint(ctx, &checkpoint); err != nil {
+		return nil, fmt.Errorf("save checkpoint: %w", err)
+	}
+
+	if err := admin.DeletePendingState(ctx, s.redisClient, s.id); err != nil {
+		return nil, fmt.Errorf("delete pending state: %w", err)
+	}
+
+	zap.L().Info("applied moved checkpoint",
+		zap.String("id", s.id),
+		zap.ByteString("state", state))
+
+	return &checkpoint, nil
+}
@@ -0,0 +1,185 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/internal/engine/protocol"
+	"github.com/rss3-network/node/internal/node/admin"
+	"go.uber.org/zap"
+)
+
+const (
+	// controlInterval is the interval to check for controls and checkpoints moved by the admin API.
+	controlInterval = 10 * time.Second
+	// drainTimeout is the maximum time to discard the tasks of a stopped data source.
+	drainTimeout = time.Minute
+	// shutdownTimeout is the maximum time to commit the in-flight batch after the worker is shut down.
+	shutdownTimeout = time.Minute
+)
+
+// loadControl returns the control of the worker, workers without redis are never paused.
+func (s *Server) loadControl(ctx context.Context) (admin.Control, error) {
+	if s.redisClient == nil {
+		return admin.ControlResume, nil
+	}
+
+	return admin.LoadControl(ctx, s.redisClient, s.id)
+}
+
+// pause waits until the worker is resumed and recreates the data source from its checkpoint.
+// It returns false if the worker is drained or shut down while paused.
+func (s *Server) pause(ctx context.Context, control admin.Control) (bool, error) {
+	// The in-flight batch has been committed, so the admin service can safely delete the activities of the worker.
+	if err := s.setPaused(ctx, true); err != nil {
+		return false, fmt.Errorf("set paused: %w", err)
+	}
+
+	zap.L().Info("paused worker",
+		zap.String("id", s.id),
+		zap.Stringer("control", control))
+
+	ticker := newControlTicker(s.redisClient)
+	defer ticker.Stop()
+
+	for {
+		if control == admin.ControlDrain {
+			return false, s.drain(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return false, nil
+		case <-ticker.C():
+			var err error
+
+			if control, err = s.loadControl(ctx); err != nil {
+				zap.L().Error("failed to load control", zap.String("id", s.id), zap.Error(err))
+
+				continue
+			}
+
+			if control != admin.ControlResume {
+				continue
+			}
+
+			if err := s.reloadSource(ctx); err != nil {
+				return false, fmt.Errorf("reload source: %w", err)
+			}
+
+			if err := s.setPaused(ctx, false); err != nil {
+				return false, fmt.Errorf("set paused: %w", err)
+			}
+
+			zap.L().Info("resumed worker", zap.String("id", s.id))
+
+			return true, nil
+		}
+	}
+}
+
+// drain keeps the worker paused after it exits, the in-flight batch has been committed by the caller.
+func (s *Server) drain(ctx context.Context) error {
+	if err := admin.SetControl(ctx, s.redisClient, s.id, admin.ControlPause); err != nil {
+		return fmt.Errorf("set control: %w", err)
+	}
+
+	zap.L().Info("drained worker", zap.String("id", s.id))
+
+	return nil
+}
+
+// setPaused records whether the worker has stopped writing activities and checkpoints.
+func (s *Server) setPaused(ctx context.Context, paused bool) error {
+	if s.redisClient == nil {
+		return nil
+	}
+
+	if paused {
+		return admin.SetPaused(ctx, s.redisClient, s.id)
+	}
+
+	return admin.DeletePaused(ctx, s.redisClient, s.id)
+}
+
+// reloadSource recreates the data source from the checkpoint of the worker, including the state moved while it was paused.
+func (s *Server) reloadSource(ctx context.Context) error {
+	if _, err := s.applyPendingState(ctx); err != nil {
+		return fmt.Errorf("apply pending state: %w", err)
+	}
+
+	checkpoint, err := s.databaseClient.LoadCheckpoint(ctx, s.id, s.config.Network, s.worker.Name())
+	if err != nil {
+		return fmt.Errorf("load checkpoint: %w", err)
+	}
+
+	if s.source, err = protocol.New(s.config, s.worker.Filter(), checkpoint, s.databaseClient, s.redisClient); err != nil {
+		return fmt.Errorf("new protocol: %w", err)
+	}
+
+	return nil
+}
+
+// controlTicker ticks to check for controls and moved checkpoints, it never ticks without redis.
+type controlTicker struct {
+	ticker *time.Ticker
+}
+
+func (t *controlTicker) C() <-chan time.Time {
+	if t.ticker == nil {
+		return nil
+	}
+
+	return t.ticker.C
+}
+
+func (t *controlTicker) Stop() {
+	if t.ticker != nil {
+		t.ticker.Stop()
+	}
+}
+
+func newControlTicker(redisClient rueidis.Client) *controlTicker {
+	if redisClient == nil {
+		return &controlTicker{}
+	}
+
+	return &controlTicker{
+		ticker: time.NewTicker(controlInterval),
+	}
+}
+
+// withShutdownTimeout returns a context which outlives the parent context by the timeout,
+// so the in-flight batch is committed on shutdown, but not retried forever.
+func withShutdownTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	shutdownCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+
+	stop := context.AfterFunc(ctx, func() {
+		time.AfterFunc(timeout, cancel)
+	})
+
+	return shutdownCtx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// drainSource discards the tasks of a stopped data source until it reports its exit or the timeout,
+// so it is not blocked on pushing tasks nobody reads.
+func drainSource(tasksChan <-chan *engine.Tasks, errorChan <-chan error) {
+	timer := time.NewTimer(drainTimeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-tasksChan:
+		case <-errorChan:
+			return
+		case <-timer.C:
+			return
+		}
+	}
+}
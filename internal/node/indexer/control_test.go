@@ -0,0 +1,41 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithShutdownTimeout(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Outlive the parent by the timeout", func(t *testing.T) {
+		t.Parallel()
+
+		parentCtx, cancelParent := context.WithCancel(context.Background())
+
+		ctx, cancel := withShutdownTimeout(parentCtx, 100*time.Millisecond)
+		defer cancel()
+
+		cancelParent()
+
+		require.NoError(t, ctx.Err())
+		require.Eventually(t, func() bool {
+			return ctx.Err() != nil
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("Not expire before the parent is canceled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := withShutdownTimeout(context.Background(), 10*time.Millisecond)
+
+		time.Sleep(50 * time.Millisecond)
+		require.NoError(t, ctx.Err())
+
+		cancel()
+		require.ErrorIs(t, ctx.Err(), context.Canceled)
+	})
+}
@@ -12,12 +12,16 @@ import (
 	"github.com/rss3-network/node/config"
 	"github.com/rss3-network/node/internal/constant"
 	"github.com/rss3-network/node/internal/database"
+	"github.com/rss3-network/node/internal/database/model"
 	"github.com/rss3-network/node/internal/engine"
 	"github.com/rss3-network/node/internal/engine/protocol"
 	decentralizedWorker "github.com/rss3-network/node/internal/engine/worker/decentralized"
 	federatedWorker "github.com/rss3-network/node/internal/engine/worker/federated"
+	rssWorker "github.com/rss3-network/node/internal/engine/worker/rss"
+	"github.com/rss3-network/node/internal/node/admin"
 	"github.com/rss3-network/node/internal/node/monitor"
 	"github.com/rss3-network/node/internal/stream"
+	meterx "github.com/rss3-network/node/internal/telemetry/meter"
 	decentralizedx "github.com/rss3-network/node/schema/worker/decentralized"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
 	"github.com/rss3-network/protocol-go/schema/network"
@@ -30,6 +34,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// mutationActionLimit is the maximum number of actions of an activity loaded to be revised.
+const mutationActionLimit = 100
+
 type Server struct {
 	id             string
 	config         *config.Module
@@ -48,17 +55,57 @@ type Server struct {
 }
 
 func (s *Server) Run(ctx context.Context) error {
+	zap.L().Info("starting node server",
+		zap.String("version", constant.BuildVersion()),
+		zap.String("worker", s.worker.Name()))
+
+	// The worker stays paused across restarts until it is resumed.
+	control, err := s.loadControl(ctx)
+	if err != nil {
+		return fmt.Errorf("load control: %w", err)
+	}
+
+	if control != admin.ControlResume {
+		if resumed, err := s.pause(ctx, control); err != nil || !resumed {
+			return err
+		}
+	} else if err := s.setPaused(ctx, false); err != nil {
+		return fmt.Errorf("set paused: %w", err)
+	}
+
+	for {
+		restart, err := s.runSource(ctx)
+		if err != nil || !restart {
+			return err
+		}
+
+		zap.L().Info("restarting data source",
+			zap.String("id", s.id))
+	}
+}
+
+// runSource runs the data source until it stops, or returns true to restart it after the worker is resumed or its checkpoint is moved.
+// The in-flight batch is always committed before the data source is stopped, including on shutdown.
+func (s *Server) runSource(ctx context.Context) (bool, error) {
 	var (
 		// TODO Develop a more effective solution to implement back pressure.
 		tasksChan = make(chan *engine.Tasks)
 		errorChan = make(chan error)
 	)
 
-	zap.L().Info("starting node server",
-		zap.String("version", constant.BuildVersion()),
-		zap.String("worker", s.worker.Name()))
+	sourceCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stopSource := func() {
+		cancel()
+
+		go drainSource(tasksChan, errorChan)
+	}
+
+	s.source.Start(sourceCtx, tasksChan, errorChan)
 
-	s.source.Start(ctx, tasksChan, errorChan)
+	controlTicker := newControlTicker(s.redisClient)
+	defer controlTicker.Stop()
 
 	for {
 		select {
@@ -66,8 +113,12 @@ func (s *Server) Run(ctx context.Context) error {
 			zap.L().Debug("received tasks from source",
 				zap.Int("task_count", tasks.Len()))
 
+			// Do not interrupt the batch between saving the activities and the checkpoint on shutdown,
+			// but give up retrying it after the shutdown timeout.
+			handleCtx, cancelHandle := withShutdownTimeout(ctx, shutdownTimeout)
+
 			retryableFunc := func() error {
-				if err := s.handleTasks(ctx, tasks); err != nil {
+				if err := s.handleTasks(handleCtx, tasks); err != nil {
 					return fmt.Errorf("handle tasks: %w", err)
 				}
 
@@ -75,6 +126,7 @@ func (s *Server) Run(ctx context.Context) error {
 			}
 
 			err := retry.Do(retryableFunc,
+				retry.Context(handleCtx),
 				retry.Attempts(0),
 				retry.Delay(time.Second),            // Set initial delay to 1 second.
 				retry.DelayType(retry.BackOffDelay), // Use backoff delay type, increasing delay on each retry.
@@ -85,15 +137,62 @@ func (s *Server) Run(ctx context.Context) error {
 						zap.Error(err))
 				}),
 			)
+
+			cancelHandle()
+
 			if err != nil {
-				return fmt.Errorf("retry handle tasks: %w", err)
+				return false, fmt.Errorf("retry handle tasks: %w", err)
 			}
 		case err := <-errorChan:
+			if err != nil && ctx.Err() == nil {
+				return false, fmt.Errorf("an error occurred in the protocol: %w", err)
+			}
+
+			return false, nil
+		case <-ctx.Done():
+			stopSource()
+
+			// The stopped worker writes nothing until it is started again.
+			if err := s.setPaused(context.WithoutCancel(ctx), true); err != nil {
+				zap.L().Error("failed to set paused", zap.String("id", s.id), zap.Error(err))
+			}
+
+			zap.L().Info("shut down worker gracefully", zap.String("id", s.id))
+
+			return false, nil
+		case <-controlTicker.C():
+			control, err := s.loadControl(ctx)
+			if err != nil {
+				zap.L().Error("failed to load control", zap.String("id", s.id), zap.Error(err))
+
+				continue
+			}
+
+			if control != admin.ControlResume {
+				stopSource()
+
+				return s.pause(ctx, control)
+			}
+
+			checkpoint, err := s.applyPendingState(ctx)
 			if err != nil {
-				return fmt.Errorf("an error occurred in the protocol: %w", err)
+				zap.L().Error("failed to apply pending state", zap.String("id", s.id), zap.Error(err))
+
+				continue
+			}
+
+			if checkpoint == nil {
+				continue
+			}
+
+			// Stop the data source and discard the in-flight tasks of the previous state.
+			stopSource()
+
+			if s.source, err = protocol.New(s.config, s.worker.Filter(), checkpoint, s.databaseClient, s.redisClient); err != nil {
+				return false, fmt.Errorf("new protocol: %w", err)
 			}
 
-			return nil
+			return true, nil
 		}
 	}
 }
@@ -130,35 +229,51 @@ func (s *Server) handleTasks(ctx context.Context, tasks *engine.Tasks) error {
 	)
 
 	// If no tasks are returned, only save the checkpoint to the database.
+	// The data source has caught up to its state without new tasks, so the worker is not lagging.
 	if tasks.Len() == 0 {
+		checkpoint.IndexedAt = time.Now()
+
 		zap.L().Info("no tasks to process, saving checkpoint",
 			zap.Any("checkpoint", checkpoint))
 
-		if err := s.databaseClient.SaveCheckpoint(ctx, &checkpoint); err != nil {
+		if err := s.saveCheckpoint(ctx, &checkpoint); err != nil {
 			return fmt.Errorf("save checkpoint: %w", err)
 		}
 
 		return nil
 	}
 
+	instruments := meterx.Default()
+
 	resultPool := pool.NewWithResults[*activityx.Activity]().WithMaxGoroutines(lo.Ternary(tasks.Len() < 20*runtime.NumCPU(), tasks.Len(), 20*runtime.NumCPU()))
 
-	for _, task := range tasks.Tasks {
-		task := task
+	// The mutations of each task are kept in the order of the tasks.
+	mutations := make([][]*engine.Mutation, tasks.Len())
+
+	for index, task := range tasks.Tasks {
+		index, task := index, task
 
 		resultPool.Go(func() *activityx.Activity {
-			activity, err := s.worker.Transform(ctx, task)
+			activity, taskMutations, err := s.transform(ctx, task)
 			if err != nil {
 				zap.L().Error("failed to transform task",
 					zap.String("task_id", task.ID()),
 					zap.Error(err))
 
+				instruments.RecordTaskOutcome(ctx, s.id, checkpoint.Network, checkpoint.Worker, meterx.OutcomeFailed)
+
 				return nil
 			}
 
+			mutations[index] = taskMutations
+
 			if activity != nil && len(activity.Actions) > 0 {
 				zap.L().Info("successfully transformed task",
 					zap.String("task_id", task.ID()))
+
+				instruments.RecordTaskOutcome(ctx, s.id, checkpoint.Network, checkpoint.Worker, meterx.OutcomeTransformed)
+			} else {
+				instruments.RecordTaskOutcome(ctx, s.id, checkpoint.Network, checkpoint.Worker, meterx.OutcomeSkipped)
 			}
 
 			return activity
@@ -178,20 +293,34 @@ func (s *Server) handleTasks(ctx context.Context, tasks *engine.Tasks) error {
 	s.meterTasksCounter.Add(ctx, int64(tasks.Len()), meterTasksCounterAttributes)
 	checkpoint.IndexCount = int64(len(activities))
 
+	// The index lag is measured by the latest task of the batch.
+	latestTask := lo.MaxBy(tasks.Tasks, func(task engine.Task, latest engine.Task) bool {
+		return task.GetTimestamp() > latest.GetTimestamp()
+	})
+
+	checkpoint.IndexedAt = time.Unix(int64(latestTask.GetTimestamp()), 0)
+
 	// Low priority for Ethereum protocol and Core worker.
 	// Prevent low priority worker from overwriting activities from high priority worker in database.
 	lowPriority := checkpoint.Network.Protocol() == network.EthereumProtocol && s.worker.Name() == decentralizedx.Core.String()
 
 	// Save activities and checkpoint to the database.
-	if err := s.databaseClient.SaveActivities(ctx, activities, lowPriority); err != nil {
+	if err := meterx.ObserveDatabaseWrite(ctx, "save_activities", func() error {
+		return s.databaseClient.SaveActivities(ctx, activities, lowPriority)
+	}); err != nil {
 		return fmt.Errorf("save %d activities: %w", len(activities), err)
 	}
 
+	// Apply the mutations after the activities are saved, so the activities deleted or revised in the same batch stay changed.
+	if err := s.applyMutations(ctx, lo.Flatten(mutations)); err != nil {
+		return fmt.Errorf("apply mutations: %w", err)
+	}
+
 	zap.L().Info("successfully saved activities and checkpoint",
 		zap.Int("activity_count", len(activities)),
 		zap.Any("checkpoint", checkpoint))
 
-	if err := s.databaseClient.SaveCheckpoint(ctx, &checkpoint); err != nil {
+	if err := s.saveCheckpoint(ctx, &checkpoint); err != nil {
 		return fmt.Errorf("save checkpoint: %w", err)
 	}
 
@@ -199,6 +328,8 @@ func (s *Server) handleTasks(ctx context.Context, tasks *engine.Tasks) error {
 	duration := time.Since(taskTimer).Seconds()
 	s.meterTasksHistogram.Record(ctx, duration, meterTasksCounterAttributes)
 
+	instruments.RecordActivities(ctx, s.id, checkpoint.Network, checkpoint.Worker, activities)
+
 	// Push activities to the stream.
 	if s.streamClient != nil && len(activities) > 0 {
 		if err := s.streamClient.PushActivities(ctx, activities); err != nil {
@@ -212,6 +343,74 @@ func (s *Server) handleTasks(ctx context.Context, tasks *engine.Tasks) error {
 	return nil
 }
 
+// transform transforms the task into an activity and the mutations of the indexed activities if the worker has any.
+func (s *Server) transform(ctx context.Context, task engine.Task) (*activityx.Activity, []*engine.Mutation, error) {
+	if worker, ok := s.worker.(engine.MutatingWorker); ok {
+		return worker.TransformMutations(ctx, task)
+	}
+
+	activity, err := s.worker.Transform(ctx, task)
+
+	return activity, nil, err
+}
+
+// applyMutations deletes or revises the indexed activities and saves the datasets of the activities in order.
+func (s *Server) applyMutations(ctx context.Context, mutations []*engine.Mutation) error {
+	for _, mutation := range mutations {
+		if mutation.Save != nil {
+			if err := mutation.Save(ctx); err != nil {
+				return fmt.Errorf("save datasets of activity %s: %w", mutation.ID, err)
+			}
+
+			continue
+		}
+
+		if mutation.Revise == nil {
+			if err := s.databaseClient.DeleteActivity(ctx, mutation.Network, mutation.ID); err != nil {
+				return fmt.Errorf("delete activity %s: %w", mutation.ID, err)
+			}
+
+			continue
+		}
+
+		activity, _, err := s.databaseClient.FindActivity(ctx, model.ActivityQuery{
+			ID:          lo.ToPtr(mutation.ID),
+			Network:     lo.ToPtr(mutation.Network),
+			ActionLimit: mutationActionLimit,
+			ActionPage:  1,
+		})
+		if err != nil {
+			return fmt.Errorf("find activity %s: %w", mutation.ID, err)
+		}
+
+		if activity == nil {
+			zap.L().Debug("skipping revision of an activity which is not indexed", zap.String("id", mutation.ID))
+
+			continue
+		}
+
+		mutation.Revise(activity)
+
+		if err := s.databaseClient.SaveActivities(ctx, []*activityx.Activity{activity}, false); err != nil {
+			return fmt.Errorf("save revised activity %s: %w", mutation.ID, err)
+		}
+	}
+
+	if len(mutations) > 0 {
+		zap.L().Debug("successfully applied mutations",
+			zap.Int("mutation_count", len(mutations)))
+	}
+
+	return nil
+}
+
+// saveCheckpoint saves the checkpoint and records the latency of the write.
+func (s *Server) saveCheckpoint(ctx context.Context, checkpoint *engine.Checkpoint) error {
+	return meterx.ObserveDatabaseWrite(ctx, "save_checkpoint", func() error {
+		return s.databaseClient.SaveCheckpoint(ctx, checkpoint)
+	})
+}
+
 func (s *Server) initializeMeter() (err error) {
 	// init meter
 	meter := otel.GetMeterProvider().Meter(constant.Name)
@@ -232,6 +431,10 @@ func (s *Server) initializeMeter() (err error) {
 		return fmt.Errorf("failed to observe meter LatestBlock: %w", err)
 	}
 
+	if _, err = meterx.Default().RegisterIndexLag(s.id, s.config.Network, s.worker.Name(), s.indexedAtMetricHandler); err != nil {
+		return fmt.Errorf("failed to observe meter IndexLag: %w", err)
+	}
+
 	zap.L().Info("successfully initialized meters")
 
 	return nil
@@ -241,7 +444,7 @@ func (s *Server) initializeMeter() (err error) {
 func (s *Server) currentBlockMetricHandler(ctx context.Context, observer metric.Int64Observer) error {
 	go func() {
 		// get current block height state
-		latestCheckpoint, err := s.databaseClient.LoadCheckpoint(ctx, s.id, s.source.Network(), s.worker.Name())
+		latestCheckpoint, err := s.databaseClient.LoadCheckpoint(ctx, s.id, s.config.Network, s.worker.Name())
 		if err != nil {
 			zap.L().Error("failed to find latest checkpoint",
 				zap.Error(err))
@@ -280,6 +483,16 @@ func (s *Server) currentBlockMetricHandler(ctx context.Context, observer metric.
 	return nil
 }
 
+// indexedAtMetricHandler gets the indexed time from the checkpoint, which is not updated while the worker stalls.
+func (s *Server) indexedAtMetricHandler(ctx context.Context) (time.Time, error) {
+	checkpoint, err := s.databaseClient.LoadCheckpoint(ctx, s.id, s.config.Network, s.worker.Name())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("load checkpoint: %w", err)
+	}
+
+	return checkpoint.IndexedAt, nil
+}
+
 // latestBlockMetricHandler gets the latest block height/number from the network rpc.
 func (s *Server) latestBlockMetricHandler(ctx context.Context, observer metric.Int64Observer) error {
 	go func() {
@@ -313,7 +526,24 @@ func (s *Server) latestBlockMetricHandler(ctx context.Context, observer metric.I
 	return nil
 }
 
-func NewServer(ctx context.Context, config *config.Module, databaseClient database.Client, streamClient stream.Client, redisClient rueidis.Client) (server *Server, err error) {
+func NewServer(ctx context.Context, config *config.Module, databaseClient database.Client, streamClient stream.Client, redisClient rueidis.Client) (*Server, error) {
+	instance, checkpoint, err := newServer(ctx, config, databaseClient, streamClient, redisClient)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize protocol.
+	if instance.source, err = protocol.New(instance.config, instance.worker.Filter(), checkpoint, databaseClient, redisClient); err != nil {
+		return nil, fmt.Errorf("new protocol: %w", err)
+	}
+
+	zap.L().Info("successfully created new indexer server")
+
+	return instance, nil
+}
+
+// newServer creates a server without the data source and returns the checkpoint of the worker.
+func newServer(ctx context.Context, config *config.Module, databaseClient database.Client, streamClient stream.Client, redisClient rueidis.Client) (_ *Server, _ *engine.Checkpoint, err error) {
 	zap.L().Debug("creating new server instance",
 		zap.String("id", config.ID),
 		zap.String("network", config.Network.String()))
@@ -334,22 +564,28 @@ func NewServer(ctx context.Context, config *config.Module, databaseClient databa
 		zap.Any("params", config.Parameters))
 
 	// Initialize worker.
-	switch config.Network.Protocol() {
-	case network.ArweaveProtocol, network.EthereumProtocol, network.FarcasterProtocol, network.RSSProtocol, network.NearProtocol:
+	switch protocol.NetworkProtocol(config.Network) {
+	case network.ArweaveProtocol, network.EthereumProtocol, network.FarcasterProtocol, network.NearProtocol, protocol.BitcoinProtocol:
 		if instance.worker, err = decentralizedWorker.New(instance.config, databaseClient, instance.redisClient); err != nil {
-			return nil, fmt.Errorf("new decentralized worker: %w", err)
+			return nil, nil, fmt.Errorf("new decentralized worker: %w", err)
 		}
 
 		zap.L().Debug("created decentralized worker",
 			zap.String("protocol", string(config.Network.Protocol())))
 	case network.ActivityPubProtocol, network.ATProtocol:
 		if instance.worker, err = federatedWorker.New(instance.config, databaseClient, instance.redisClient); err != nil {
-			return nil, fmt.Errorf("new federated worker: %w", err)
+			return nil, nil, fmt.Errorf("new federated worker: %w", err)
 		}
 
 		zap.L().Debug("created federated worker")
+	case network.RSSProtocol:
+		if instance.worker, err = rssWorker.New(instance.config); err != nil {
+			return nil, nil, fmt.Errorf("new rss worker: %w", err)
+		}
+
+		zap.L().Debug("created rss worker")
 	default:
-		return nil, fmt.Errorf("unknown worker protocol: %s", config.Network.Protocol())
+		return nil, nil, fmt.Errorf("unknown worker protocol: %s", config.Network.Protocol())
 	}
 
 	zap.L().Info("worker initialized successfully",
@@ -359,56 +595,72 @@ func NewServer(ctx context.Context, config *config.Module, databaseClient databa
 
 	zap.L().Debug("initializing monitor")
 
-	switch config.Network.Protocol() {
+	switch protocol.NetworkProtocol(config.Network) {
 	case network.ActivityPubProtocol:
 		instance.monitorClient, err = monitor.NewActivityPubClient(config.Network, config.Parameters)
 		if err != nil {
-			return nil, fmt.Errorf("error occurred in creating new activitypub monitorClient: %w", err)
+			return nil, nil, fmt.Errorf("error occurred in creating new activitypub monitorClient: %w", err)
 		}
 	case network.ATProtocol:
 		instance.monitorClient, err = monitor.NewAtprotoClient()
 		if err != nil {
-			return nil, fmt.Errorf("new atproto monitorClient: %w", err)
+			return nil, nil, fmt.Errorf("new atproto monitorClient: %w", err)
 		}
 	case network.ArweaveProtocol:
 		instance.monitorClient, err = monitor.NewArweaveClient()
 		if err != nil {
-			return nil, fmt.Errorf("new arweave monitorClient: %w", err)
+			return nil, nil, fmt.Errorf("new arweave monitorClient: %w", err)
 		}
 	case network.FarcasterProtocol:
 		instance.monitorClient, err = monitor.NewFarcasterClient()
 		if err != nil {
-			return nil, fmt.Errorf("new arweave monitorClient: %w", err)
+			return nil, nil, fmt.Errorf("new arweave monitorClient: %w", err)
 		}
 	case network.EthereumProtocol:
 		instance.monitorClient, err = monitor.NewEthereumClient(config.Endpoint)
 		if err != nil {
-			return nil, fmt.Errorf("new ethereum monitorClient: %w", err)
+			return nil, nil, fmt.Errorf("new ethereum monitorClient: %w", err)
 		}
 	case network.NearProtocol:
 		instance.monitorClient, err = monitor.NewNearClient(config.Endpoint)
 		if err != nil {
-			return nil, fmt.Errorf("new near monitorClient: %w", err)
+			return nil, nil, fmt.Errorf("new near monitorClient: %w", err)
+		}
+	case network.RSSProtocol:
+		instance.monitorClient, err = monitor.NewRSSClient()
+		if err != nil {
+			return nil, nil, fmt.Errorf("new rss monitorClient: %w", err)
+		}
+	case protocol.BitcoinProtocol:
+		instance.monitorClient, err = monitor.NewBitcoinClient(config.Endpoint, config.Parameters)
+		if err != nil {
+			return nil, nil, fmt.Errorf("new bitcoin monitorClient: %w", err)
 		}
 	}
 
 	zap.L().Debug("successfully created monitor client",
-		zap.String("protocol", string(config.Network.Protocol())))
+		zap.String("protocol", string(protocol.NetworkProtocol(config.Network))))
 
 	if err := instance.initializeMeter(); err != nil {
-		return nil, fmt.Errorf("initialize meter: %w", err)
+		return nil, nil, fmt.Errorf("initialize meter: %w", err)
+	}
+
+	// A checkpoint moved while the worker was stopped has been saved already, apply it again in case
+	// the worker overwrote it before stopping.
+	if _, err := instance.applyPendingState(ctx); err != nil {
+		return nil, nil, fmt.Errorf("apply pending state: %w", err)
 	}
 
 	// Load checkpoint for initialize the protocol.
 	checkpoint, err := instance.databaseClient.LoadCheckpoint(ctx, instance.id, config.Network, instance.worker.Name())
 	if err != nil {
-		return nil, fmt.Errorf("loca checkpoint: %w", err)
+		return nil, nil, fmt.Errorf("loca checkpoint: %w", err)
 	}
 
 	// Unmarshal checkpoint state to map for print it in log.
 	var state map[string]any
 	if err := json.Unmarshal(checkpoint.State, &state); err != nil {
-		return nil, fmt.Errorf("unmarshal checkpoint state: %w", err)
+		return nil, nil, fmt.Errorf("unmarshal checkpoint state: %w", err)
 	}
 
 	zap.L().Debug("successfully loaded checkpoint",
@@ -417,12 +669,5 @@ func NewServer(ctx context.Context, config *config.Module, databaseClient databa
 		zap.String("checkpoint.worker", checkpoint.Worker),
 		zap.Any("checkpoint.state", state))
 
-	// Initialize protocol.
-	if instance.source, err = protocol.New(instance.config, instance.worker.Filter(), checkpoint, databaseClient, redisClient); err != nil {
-		return nil, fmt.Errorf("new protocol: %w", err)
-	}
-
-	zap.L().Info("successfully created new indexer server")
-
-	return &instance, nil
+	return &instance, checkpoint, nil
 }
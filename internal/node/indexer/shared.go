@@ -0,0 +1,406 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/constant"
+	"github.com/rss3-network/node/internal/database"
+	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/internal/engine/protocol"
+	"github.com/rss3-network/node/internal/engine/protocol/ethereum"
+	"github.com/rss3-network/node/internal/node/admin"
+	"github.com/rss3-network/node/internal/stream"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/samber/lo"
+	"github.com/sourcegraph/conc/pool"
+	"go.uber.org/zap"
+)
+
+// SharedServer runs a single ethereum dataSource and fans the tasks out to multiple workers on the same network,
+// so the RPC traffic scales with the networks rather than with the workers.
+type SharedServer struct {
+	network        network.Network
+	source         engine.DataSource
+	routes         []*route
+	module         *config.Module
+	databaseClient database.Client
+	redisClient    rueidis.Client
+}
+
+// route is a worker fed by the SharedServer.
+type route struct {
+	server *Server
+	filter *ethereum.Filter
+	// blockNumber is the block number of the worker checkpoint,
+	// tasks at or below it have already been indexed by the worker.
+	blockNumber uint64
+	// paused is true if the worker is paused or drained, the shared data source skips its tasks.
+	paused bool
+}
+
+func (s *SharedServer) Run(ctx context.Context) error {
+	zap.L().Info("starting shared node server",
+		zap.String("version", constant.BuildVersion()),
+		zap.String("network", s.network.String()),
+		zap.Strings("workers", lo.Map(s.routes, func(route *route, _ int) string {
+			return route.server.worker.Name()
+		})))
+
+	for {
+		restart, err := s.runSource(ctx)
+		if err != nil || !restart {
+			return err
+		}
+
+		zap.L().Info("restarting shared data source",
+			zap.String("network", s.network.String()))
+	}
+}
+
+// runSource runs the shared data source until it stops, or returns true to restart it after workers are paused,
+// resumed or their checkpoints are moved. The data source is not started while all workers are paused.
+func (s *SharedServer) runSource(ctx context.Context) (bool, error) {
+	var (
+		tasksChan = make(chan *engine.Tasks)
+		errorChan = make(chan error)
+	)
+
+	sourceCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stopSource := func() {
+		cancel()
+
+		go drainSource(tasksChan, errorChan)
+	}
+
+	if s.running() {
+		s.source.Start(sourceCtx, tasksChan, errorChan)
+	}
+
+	controlTicker := newControlTicker(s.redisClient)
+	defer controlTicker.Stop()
+
+	for {
+		select {
+		case tasks := <-tasksChan:
+			zap.L().Debug("received tasks from shared source",
+				zap.Int("task_count", tasks.Len()))
+
+			// Do not interrupt the batch between saving the activities and the checkpoints on shutdown,
+			// but give up retrying it after the shutdown timeout.
+			dispatchCtx, cancelDispatch := withShutdownTimeout(ctx, shutdownTimeout)
+
+			err := s.dispatchTasks(dispatchCtx, tasks)
+
+			cancelDispatch()
+
+			if err != nil {
+				return false, fmt.Errorf("dispatch tasks: %w", err)
+			}
+		case err := <-errorChan:
+			if err != nil && ctx.Err() == nil {
+				return false, fmt.Errorf("an error occurred in the protocol: %w", err)
+			}
+
+			return false, nil
+		case <-ctx.Done():
+			stopSource()
+
+			// The stopped workers write nothing until they are started again.
+			for _, route := range s.routes {
+				route.paused = true
+			}
+
+			if err := s.setPaused(context.WithoutCancel(ctx)); err != nil {
+				zap.L().Error("failed to set paused", zap.String("network", s.network.String()), zap.Error(err))
+			}
+
+			zap.L().Info("shut down shared workers gracefully", zap.String("network", s.network.String()))
+
+			return false, nil
+		case <-controlTicker.C():
+			changed, drained, err := s.loadControls(ctx)
+			if err != nil {
+				zap.L().Error("failed to load controls", zap.String("network", s.network.String()), zap.Error(err))
+
+				continue
+			}
+
+			for _, route := range s.routes {
+				checkpoint, err := route.server.applyPendingState(ctx)
+				if err != nil {
+					zap.L().Error("failed to apply pending state", zap.String("id", route.server.id), zap.Error(err))
+
+					continue
+				}
+
+				changed = changed || checkpoint != nil
+			}
+
+			if !changed {
+				continue
+			}
+
+			// Stop the shared data source and restart it from the most lagging running worker.
+			stopSource()
+
+			// The process exits once the last running worker is drained.
+			if drained && !s.running() {
+				zap.L().Info("drained shared workers", zap.String("network", s.network.String()))
+
+				return false, nil
+			}
+
+			if err := s.initializeSource(ctx); err != nil {
+				return false, fmt.Errorf("initialize source: %w", err)
+			}
+
+			return true, nil
+		}
+	}
+}
+
+// loadControls pauses and resumes the workers by their controls, it returns whether any worker is paused or resumed
+// and whether any worker is drained. A drained worker stays paused while the others keep running.
+func (s *SharedServer) loadControls(ctx context.Context) (changed bool, drained bool, err error) {
+	for _, route := range s.routes {
+		control, err := route.server.loadControl(ctx)
+		if err != nil {
+			return false, false, fmt.Errorf("load control of %s: %w", route.server.id, err)
+		}
+
+		if control == admin.ControlDrain {
+			if err := route.server.drain(ctx); err != nil {
+				return false, false, err
+			}
+
+			drained = true
+		}
+
+		if paused := control != admin.ControlResume; paused != route.paused {
+			// The tasks are dispatched in the same loop, so the in-flight batch of the worker has been committed.
+			if err := route.server.setPaused(ctx, paused); err != nil {
+				return false, false, fmt.Errorf("set paused of %s: %w", route.server.id, err)
+			}
+
+			route.paused = paused
+			changed = true
+
+			zap.L().Info("controlled shared worker",
+				zap.String("id", route.server.id),
+				zap.Stringer("control", control))
+		}
+	}
+
+	return changed, drained, nil
+}
+
+// setPaused records whether each worker has stopped writing activities and checkpoints.
+func (s *SharedServer) setPaused(ctx context.Context) error {
+	for _, route := range s.routes {
+		if err := route.server.setPaused(ctx, route.paused); err != nil {
+			return fmt.Errorf("set paused of %s: %w", route.server.id, err)
+		}
+	}
+
+	return nil
+}
+
+// running returns true if any worker is not paused.
+func (s *SharedServer) running() bool {
+	return lo.ContainsBy(s.routes, func(route *route) bool {
+		return !route.paused
+	})
+}
+
+// dispatchTasks routes the tasks to every worker whose filter matches them and waits for all workers to finish,
+// each worker saves its own checkpoint.
+func (s *SharedServer) dispatchTasks(ctx context.Context, tasks *engine.Tasks) error {
+	var state ethereum.State
+	if err := json.Unmarshal(s.source.State(), &state); err != nil {
+		return fmt.Errorf("unmarshal source state: %w", err)
+	}
+
+	dispatchPool := pool.New().WithContext(ctx).WithFirstError()
+
+	for _, route := range s.routes {
+		route := route
+
+		if route.paused {
+			continue
+		}
+
+		routedTasks := lo.Filter(tasks.Tasks, func(task engine.Task, _ int) bool {
+			ethereumTask, ok := task.(*ethereum.Task)
+			if !ok {
+				return false
+			}
+
+			return ethereumTask.Header.Number.Uint64() > route.blockNumber && route.filter.Match(ethereumTask)
+		})
+
+		// The shared source is still catching up on blocks already indexed by this worker,
+		// skip it to avoid rolling its checkpoint back.
+		if len(routedTasks) == 0 && state.BlockNumber < route.blockNumber {
+			continue
+		}
+
+		dispatchPool.Go(func(ctx context.Context) error {
+			retryableFunc := func() error {
+				if err := route.server.handleTasks(ctx, tasks.Fork(routedTasks)); err != nil {
+					return fmt.Errorf("handle tasks: %w", err)
+				}
+
+				// The worker has saved its checkpoint at the state of the shared data source.
+				route.blockNumber = max(route.blockNumber, state.BlockNumber)
+
+				return nil
+			}
+
+			return retry.Do(retryableFunc,
+				retry.Attempts(0),
+				retry.Delay(time.Second),            // Set initial delay to 1 second.
+				retry.DelayType(retry.BackOffDelay), // Use backoff delay type, increasing delay on each retry.
+				retry.MaxDelay(5*time.Minute),
+				retry.Context(ctx),
+				retry.OnRetry(func(n uint, err error) {
+					zap.L().Error("failed to handle tasks, retrying",
+						zap.String("worker", route.server.worker.Name()),
+						zap.Uint("retry_count", n),
+						zap.Error(err))
+				}),
+			)
+		})
+	}
+
+	return dispatchPool.Wait()
+}
+
+// NewSharedServer creates a server that indexes the modules with one shared dataSource.
+// All modules must be on the same ethereum network and endpoint, the parameters of the first module are used by the dataSource.
+func NewSharedServer(ctx context.Context, modules []*config.Module, databaseClient database.Client, streamClient stream.Client, redisClient rueidis.Client) (*SharedServer, error) {
+	if len(modules) == 0 {
+		return nil, fmt.Errorf("no module to index")
+	}
+
+	primary := modules[0]
+
+	if primary.Network.Protocol() != network.EthereumProtocol {
+		return nil, fmt.Errorf("shared dataSource is unsupported on protocol %s", primary.Network.Protocol())
+	}
+
+	instance := SharedServer{
+		network:        primary.Network,
+		routes:         make([]*route, 0, len(modules)),
+		module:         primary,
+		databaseClient: databaseClient,
+		redisClient:    redisClient,
+	}
+
+	for _, module := range modules {
+		if module.Network != primary.Network {
+			return nil, fmt.Errorf("module %s is on network %s, expected %s", module.ID, module.Network, primary.Network)
+		}
+
+		if module.Endpoint.URL != primary.Endpoint.URL {
+			return nil, fmt.Errorf("module %s uses a different endpoint from module %s", module.ID, primary.ID)
+		}
+
+		server, _, err := newServer(ctx, module, databaseClient, streamClient, redisClient)
+		if err != nil {
+			return nil, fmt.Errorf("new server %s: %w", module.ID, err)
+		}
+
+		// The filter is nil for workers consuming all blocks, such as the core worker.
+		filter, _ := server.worker.Filter().(*ethereum.Filter)
+
+		instance.routes = append(instance.routes, &route{
+			server: server,
+			filter: filter,
+		})
+	}
+
+	// The workers stay paused across restarts until they are resumed,
+	// the paused keys left by a previous run are cleared for the running workers.
+	if _, _, err := instance.loadControls(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := instance.setPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := instance.initializeSource(ctx); err != nil {
+		return nil, err
+	}
+
+	zap.L().Info("successfully created new shared indexer server",
+		zap.String("network", primary.Network.String()),
+		zap.Int("workers", len(instance.routes)))
+
+	return &instance, nil
+}
+
+// initializeSource loads the checkpoints of the running workers and creates the shared data source from the most lagging one,
+// the data source is kept if all workers are paused.
+func (s *SharedServer) initializeSource(ctx context.Context) error {
+	var (
+		sourceCheckpoint  *engine.Checkpoint
+		sourceBlockNumber uint64
+	)
+
+	routes := lo.Filter(s.routes, func(route *route, _ int) bool {
+		return !route.paused
+	})
+
+	if len(routes) == 0 {
+		return nil
+	}
+
+	for _, route := range routes {
+		checkpoint, err := s.databaseClient.LoadCheckpoint(ctx, route.server.id, s.network, route.server.worker.Name())
+		if err != nil {
+			return fmt.Errorf("load checkpoint of %s: %w", route.server.id, err)
+		}
+
+		var state ethereum.State
+		if err := json.Unmarshal(checkpoint.State, &state); err != nil {
+			return fmt.Errorf("unmarshal checkpoint state of %s: %w", route.server.id, err)
+		}
+
+		route.blockNumber = state.BlockNumber
+
+		// The shared dataSource starts from the most lagging worker.
+		if sourceCheckpoint == nil || state.BlockNumber < sourceBlockNumber {
+			sourceCheckpoint, sourceBlockNumber = checkpoint, state.BlockNumber
+		}
+	}
+
+	filter := ethereum.MergeFilters(lo.Map(routes, func(route *route, _ int) *ethereum.Filter {
+		return route.filter
+	})...)
+
+	source, err := protocol.New(s.module, filter, sourceCheckpoint, s.databaseClient, s.redisClient)
+	if err != nil {
+		return fmt.Errorf("new protocol: %w", err)
+	}
+
+	s.source = source
+
+	for _, route := range s.routes {
+		route.server.source = source
+	}
+
+	zap.L().Debug("initialized shared data source",
+		zap.Uint64("block_number", sourceBlockNumber),
+		zap.Any("filter", filter))
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package monitor
+
+import (
+	"context"
+
+	"github.com/rss3-network/node/config"
+	"github.com/rss3-network/node/internal/engine"
+	"github.com/rss3-network/node/internal/node/alert"
+	"go.uber.org/zap"
+)
+
+// evaluateAlerts sends alerts on the health of the worker observed in the current monitoring cycle.
+func (m *Monitor) evaluateAlerts(ctx context.Context, w *config.Module, checkpoint *engine.Checkpoint, previousProgress WorkerProgress) {
+	if m.alertManager == nil {
+		return
+	}
+
+	observation := alert.Observation{
+		WorkerID:            w.ID,
+		Network:             w.Network.String(),
+		Worker:              w.Worker.Name(),
+		Status:              m.GetWorkerStatusByID(ctx, w.ID),
+		CheckpointUpdatedAt: checkpoint.UpdatedAt,
+		IndexCount:          -1,
+	}
+
+	// The index count is unknown in the first monitoring cycle.
+	if previousProgress != (WorkerProgress{}) {
+		observation.IndexCount = checkpoint.IndexCount - previousProgress.IndexCount
+	}
+
+	if err := m.alertManager.Evaluate(ctx, observation); err != nil {
+		zap.L().Error("failed to evaluate alerts", zap.String("worker_id", w.ID), zap.Error(err))
+	}
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/rss3-network/node/config"
 	"github.com/rss3-network/node/internal/engine/protocol/activitypub"
+	bitcoinSource "github.com/rss3-network/node/internal/engine/protocol/bitcoin"
 	"github.com/rss3-network/node/provider/arweave"
+	"github.com/rss3-network/node/provider/bitcoin"
 	"github.com/rss3-network/node/provider/ethereum"
 	"github.com/rss3-network/node/provider/farcaster"
 	"github.com/rss3-network/node/provider/httpx"
@@ -98,6 +100,48 @@ func NewNearClient(endpoint config.Endpoint) (Client, error) {
 	}, nil
 }
 
+// bitcoinClient is a client implementation for bitcoin.
+type bitcoinClient struct {
+	client bitcoin.Client
+}
+
+// make sure client implements Client
+var _ Client = (*bitcoinClient)(nil)
+
+func (c *bitcoinClient) CurrentState(state CheckpointState) (uint64, uint64) {
+	return state.BlockHeight, state.BlockTimestamp
+}
+
+func (c *bitcoinClient) TargetState(param *config.Parameters) (uint64, uint64) {
+	return getTargetBlockFromParam(param), 0
+}
+
+func (c *bitcoinClient) LatestState(ctx context.Context) (uint64, uint64, error) {
+	latestWorkerState, err := c.client.GetBlockHeight(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return latestWorkerState, 0, nil
+}
+
+// NewBitcoinClient returns a new bitcoin client of the API set in the parameters.
+func NewBitcoinClient(endpoint config.Endpoint, param *config.Parameters) (Client, error) {
+	option, err := bitcoinSource.NewOption(param)
+	if err != nil {
+		return nil, fmt.Errorf("parse config: %w", err)
+	}
+
+	client, err := bitcoin.Dial(context.Background(), endpoint.URL, option.API)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bitcoinClient{
+		client: client,
+	}, nil
+}
+
 // arweaveClient is a client implementation for arweave.
 type arweaveClient struct {
 	arweaveClient arweave.Client
@@ -256,6 +300,28 @@ func (c *atprotoClient) LatestState(_ context.Context) (uint64, uint64, error) {
 // NewAtprotoClient returns a new atproto client.
 func NewAtprotoClient() (Client, error) { return &atprotoClient{}, nil }
 
+// rssClient is a client implementation for rss feeds.
+type rssClient struct{}
+
+// make sure client implements Client
+var _ Client = (*rssClient)(nil)
+
+// CurrentState returns the current timestamp, as feeds are polled at intervals and have no remote state to catch up with.
+func (c *rssClient) CurrentState(_ CheckpointState) (uint64, uint64) {
+	return uint64(time.Now().Unix()), 0
+}
+
+func (c *rssClient) TargetState(_ *config.Parameters) (uint64, uint64) {
+	return 0, 0
+}
+
+func (c *rssClient) LatestState(_ context.Context) (uint64, uint64, error) {
+	return uint64(time.Now().Unix()), 0, nil
+}
+
+// NewRSSClient returns a new rss client.
+func NewRSSClient() (Client, error) { return &rssClient{}, nil }
+
 // getTargetBlockFromParam returns the target block number/height from the parameters.
 func getTargetBlockFromParam(param *config.Parameters) uint64 {
 	if param == nil {
@@ -9,10 +9,13 @@ import (
 
 	"github.com/rss3-network/node/config"
 	"github.com/rss3-network/node/config/parameter"
+	"github.com/rss3-network/node/internal/engine"
 	"github.com/rss3-network/node/internal/engine/protocol/atproto"
 	"github.com/rss3-network/node/internal/engine/protocol/farcaster"
+	"github.com/rss3-network/node/internal/node/admin"
 	workerx "github.com/rss3-network/node/schema/worker"
 	"github.com/rss3-network/node/schema/worker/decentralized"
+	"github.com/rss3-network/node/schema/worker/rss"
 	"github.com/rss3-network/protocol-go/schema/network"
 	"go.uber.org/zap"
 )
@@ -41,7 +44,7 @@ type WorkerProgress struct {
 func (m *Monitor) MonitorWorkerStatus(ctx context.Context) error {
 	var wg sync.WaitGroup
 
-	errChan := make(chan error, len(m.config.Component.Decentralized)+len(m.config.Component.Federated))
+	errChan := make(chan error, len(m.config.Component.Decentralized)+len(m.config.Component.Federated)+1)
 
 	processWorker := func(w *config.Module, processFunc func(context.Context, *config.Module) error) {
 		wg.Add(1)
@@ -65,6 +68,11 @@ func (m *Monitor) MonitorWorkerStatus(ctx context.Context) error {
 		}
 	}
 
+	// The RSSHub proxy has no checkpoint, only the feed worker indexes.
+	if m.config.Component.RSS != nil && m.config.Component.RSS.Worker == rss.Feed {
+		processWorker(m.config.Component.RSS, m.processFeedWorker)
+	}
+
 	go func() {
 		wg.Wait()
 		close(errChan)
@@ -81,8 +89,12 @@ func (m *Monitor) MonitorWorkerStatus(ctx context.Context) error {
 
 // processDecentralizedWorker processes the decentralized worker status.
 func (m *Monitor) processDecentralizedWorker(ctx context.Context, w *config.Module) error {
+	if paused, err := m.flagPausedWorker(ctx, w.ID); err != nil || paused {
+		return err
+	}
+
 	// get checkpoint info from database
-	indexCount, state, err := m.getCheckpointState(ctx, w.ID, w.Network, w.Worker.Name())
+	checkpoint, state, err := m.getCheckpointState(ctx, w.ID, w.Network, w.Worker.Name())
 	if err != nil {
 		zap.L().Error("get checkpoint info", zap.Error(err))
 		return err
@@ -106,17 +118,59 @@ func (m *Monitor) processDecentralizedWorker(ctx context.Context, w *config.Modu
 		return fmt.Errorf("detect unhealthy: %w", err)
 	}
 
-	if err := m.UpdateWorkerProgress(ctx, w.ID, ConstructWorkerProgress(currentWorkerState, targetWorkerState, latestWorkerState, indexCount)); err != nil {
+	previousProgress := m.getWorkerProgress(ctx, w.ID)
+
+	if err := m.UpdateWorkerProgress(ctx, w.ID, ConstructWorkerProgress(currentWorkerState, targetWorkerState, latestWorkerState, checkpoint.IndexCount)); err != nil {
 		return fmt.Errorf("update worker progress: %w", err)
 	}
 
+	m.evaluateAlerts(ctx, w, checkpoint, previousProgress)
+
+	return nil
+}
+
+// processFeedWorker processes the feed worker status, feeds are polled at intervals so the worker is never behind.
+func (m *Monitor) processFeedWorker(ctx context.Context, w *config.Module) error {
+	if paused, err := m.flagPausedWorker(ctx, w.ID); err != nil || paused {
+		return err
+	}
+
+	checkpoint, _, err := m.getCheckpointState(ctx, w.ID, w.Network, w.Worker.Name())
+	if err != nil {
+		zap.L().Error("get checkpoint info", zap.Error(err))
+		return err
+	}
+
+	previousProgress := m.getWorkerProgress(ctx, w.ID)
+
+	if err := m.UpdateWorkerProgress(ctx, w.ID, ConstructWorkerProgress(0, 0, 0, checkpoint.IndexCount)); err != nil {
+		return fmt.Errorf("update worker progress: %w", err)
+	}
+
+	targetStatus := workerx.StatusReady
+
+	// The feeds have not been polled yet.
+	if len(checkpoint.State) == 0 || string(checkpoint.State) == "{}" {
+		targetStatus = workerx.StatusIndexing
+	}
+
+	if err := m.UpdateWorkerStatusByID(ctx, w.ID, targetStatus.String()); err != nil {
+		return err
+	}
+
+	m.evaluateAlerts(ctx, w, checkpoint, previousProgress)
+
 	return nil
 }
 
 // processFederatedWorker processes the federated worker status.
 func (m *Monitor) processFederatedWorker(ctx context.Context, w *config.Module) error {
+	if paused, err := m.flagPausedWorker(ctx, w.ID); err != nil || paused {
+		return err
+	}
+
 	// get checkpoint info from database
-	indexCount, workerState, err := m.getCheckpointState(ctx, w.ID, w.Network, w.Worker.Name())
+	checkpoint, workerState, err := m.getCheckpointState(ctx, w.ID, w.Network, w.Worker.Name())
 	if err != nil {
 		zap.L().Error("get checkpoint info", zap.Error(err))
 		return err
@@ -128,6 +182,8 @@ func (m *Monitor) processFederatedWorker(ctx context.Context, w *config.Module)
 	}
 
 	targetStatus := workerx.StatusReady
+	indexCount := checkpoint.IndexCount
+	previousProgress := m.getWorkerProgress(ctx, w.ID)
 
 	switch w.Network {
 	case network.Mastodon:
@@ -154,7 +210,13 @@ func (m *Monitor) processFederatedWorker(ctx context.Context, w *config.Module)
 		return fmt.Errorf("unsupported network")
 	}
 
-	return m.UpdateWorkerStatusByID(ctx, w.ID, targetStatus.String())
+	if err := m.UpdateWorkerStatusByID(ctx, w.ID, targetStatus.String()); err != nil {
+		return err
+	}
+
+	m.evaluateAlerts(ctx, w, checkpoint, previousProgress)
+
+	return nil
 }
 
 // getWorkerIndexingStateByClients gets the latest block height (arweave), block number (ethereum), event id (farcaster).
@@ -227,6 +289,9 @@ func (m *Monitor) flagWorkerStatus(ctx context.Context, workerID string, current
 			// if the worker is unhealthy and made progress in the last cycle, flag it as indexing
 			targetStatus = workerx.StatusIndexing
 		}
+	case workerx.StatusPaused:
+		// if the worker has been resumed, flag it as indexing until it catches up
+		targetStatus = workerx.StatusIndexing
 	default:
 	}
 
@@ -239,20 +304,43 @@ func (m *Monitor) flagWorkerStatus(ctx context.Context, workerID string, current
 	return nil
 }
 
-// getCheckpointState gets the checkpoint state from the database.
-func (m *Monitor) getCheckpointState(ctx context.Context, id string, network network.Network, worker string) (int64, CheckpointState, error) {
+// flagPausedWorker flags the worker as paused if it is paused or drained by the admin API,
+// the progress and alerts of a paused worker are not evaluated.
+func (m *Monitor) flagPausedWorker(ctx context.Context, workerID string) (bool, error) {
+	if m.redisClient == nil {
+		return false, nil
+	}
+
+	control, err := admin.LoadControl(ctx, m.redisClient, workerID)
+	if err != nil {
+		return false, fmt.Errorf("load control: %w", err)
+	}
+
+	if control == admin.ControlResume {
+		return false, nil
+	}
+
+	if err := m.UpdateWorkerStatusByID(ctx, workerID, workerx.StatusPaused.String()); err != nil {
+		return false, fmt.Errorf("update worker status: %w", err)
+	}
+
+	return true, nil
+}
+
+// getCheckpointState gets the checkpoint and its state from the database.
+func (m *Monitor) getCheckpointState(ctx context.Context, id string, network network.Network, worker string) (*engine.Checkpoint, CheckpointState, error) {
 	checkpoint, err := m.databaseClient.LoadCheckpoint(ctx, id, network, worker)
 	if err != nil {
-		return 0, CheckpointState{}, fmt.Errorf("load checkpoint: %w", err)
+		return nil, CheckpointState{}, fmt.Errorf("load checkpoint: %w", err)
 	}
 
 	var state CheckpointState
 	if err := json.Unmarshal(checkpoint.State, &state); err != nil {
 		zap.L().Error("unmarshal checkpoint state", zap.Error(err))
-		return 0, CheckpointState{}, err
+		return nil, CheckpointState{}, err
 	}
 
-	return checkpoint.IndexCount, state, nil
+	return checkpoint, state, nil
 }
 
 // GetWorkerStatusByID gets worker status from Redis cache by network and workerName.
@@ -12,6 +12,8 @@ import (
 	"github.com/rss3-network/node/config"
 	"github.com/rss3-network/node/config/parameter"
 	"github.com/rss3-network/node/internal/database"
+	"github.com/rss3-network/node/internal/engine/protocol"
+	"github.com/rss3-network/node/internal/node/alert"
 	"github.com/rss3-network/node/provider/ethereum/contract/vsl"
 	"github.com/rss3-network/protocol-go/schema/network"
 	"go.uber.org/zap"
@@ -29,6 +31,7 @@ type Monitor struct {
 	networkParamsCaller *vsl.NetworkParamsCaller
 	settlementCaller    *vsl.SettlementCaller
 	clients             map[network.Network]Client
+	alertManager        *alert.Manager
 }
 
 func (m *Monitor) Run(ctx context.Context) error {
@@ -100,7 +103,7 @@ func initNetworkClient(m *config.Module) (Client, error) {
 
 	var err error
 
-	switch m.Network.Protocol() {
+	switch protocol.NetworkProtocol(m.Network) {
 	case network.ActivityPubProtocol:
 		client, err = NewActivityPubClient(m.Network, m.Parameters)
 	case network.ArweaveProtocol:
@@ -113,6 +116,8 @@ func initNetworkClient(m *config.Module) (Client, error) {
 		client, err = NewNearClient(m.Endpoint)
 	case network.ATProtocol:
 		client, err = NewAtprotoClient()
+	case protocol.BitcoinProtocol:
+		client, err = NewBitcoinClient(m.Endpoint, m.Parameters)
 	default:
 		return nil, fmt.Errorf("unsupported network protocol: %s", m.Network)
 	}
@@ -127,7 +132,7 @@ func initNetworkClient(m *config.Module) (Client, error) {
 }
 
 // NewMonitor creates a new monitor instance.
-func NewMonitor(_ context.Context, configFile *config.File, databaseClient database.Client, redisClient rueidis.Client, networkParamsCaller *vsl.NetworkParamsCaller, settlementCaller *vsl.SettlementCaller) (*Monitor, error) {
+func NewMonitor(_ context.Context, configFile *config.File, databaseClient database.Client, redisClient rueidis.Client, networkParamsCaller *vsl.NetworkParamsCaller, settlementCaller *vsl.SettlementCaller) (_ *Monitor, err error) {
 	zap.L().Debug("creating new monitor instance")
 
 	totalModules := len(configFile.Component.Decentralized) + len(configFile.Component.Federated)
@@ -159,6 +164,16 @@ func NewMonitor(_ context.Context, configFile *config.File, databaseClient datab
 		settlementCaller:    settlementCaller,
 	}
 
+	if configFile.Alert != nil {
+		if instance.alertManager, err = alert.NewManager(configFile.Alert, redisClient); err != nil {
+			return nil, fmt.Errorf("new alert manager: %w", err)
+		}
+
+		zap.L().Info("alert manager initialized",
+			zap.Int("notifiers", len(configFile.Alert.Notifiers)),
+			zap.Int("rules", len(configFile.Alert.Rules)))
+	}
+
 	zap.L().Info("monitor instance created successfully")
 
 	return instance, nil
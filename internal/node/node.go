@@ -17,6 +17,7 @@ import (
 	"github.com/rss3-network/node/docs"
 	"github.com/rss3-network/node/internal/database"
 	"github.com/rss3-network/node/internal/node/component"
+	"github.com/rss3-network/node/internal/node/component/admin"
 	"github.com/rss3-network/node/internal/node/component/aggregator"
 	"github.com/rss3-network/node/internal/node/component/decentralized"
 	"github.com/rss3-network/node/internal/node/component/federated"
@@ -84,7 +85,7 @@ func NewCoreService(ctx context.Context, config *config.File, databaseClient dat
 	}
 
 	if config.Component.RSS != nil {
-		rssComponent := rss.NewComponent(ctx, apiServer, config)
+		rssComponent := rss.NewComponent(ctx, apiServer, config, databaseClient)
 		{
 			var comp component.Component = rssComponent
 			node.components = append(node.components, &comp)
@@ -110,6 +111,15 @@ func NewCoreService(ctx context.Context, config *config.File, databaseClient dat
 		}
 	}
 
+	// The admin endpoints modify the checkpoints, so they are never exposed without an access token.
+	if databaseClient != nil && config.Discovery.Server.AccessToken != "" {
+		adminComponent := admin.NewComponent(ctx, apiServer, config, databaseClient, redisClient)
+		{
+			var comp component.Component = adminComponent
+			node.components = append(node.components, &comp)
+		}
+	}
+
 	docs.RegisterHandlers(apiServer, aggComp)
 
 	// Generate openapi.json
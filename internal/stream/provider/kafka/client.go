@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/rss3-network/node/internal/stream"
+	"github.com/rss3-network/node/internal/telemetry/meter"
 	activityx "github.com/rss3-network/protocol-go/schema/activity"
 	"github.com/twmb/franz-go/pkg/kadm"
 	"github.com/twmb/franz-go/pkg/kgo"
@@ -63,9 +64,9 @@ func (c *Client) PushActivities(ctx context.Context, activities []*activityx.Act
 		records = append(records, record)
 	}
 
-	produceResults := c.kafkaClient.ProduceSync(ctx, records...)
-
-	if err := produceResults.FirstErr(); err != nil {
+	if err := meter.ObserveStreamPush(ctx, c.topic, func() error {
+		return c.kafkaClient.ProduceSync(ctx, records...).FirstErr()
+	}); err != nil {
 		return fmt.Errorf("push activities: %w", err)
 	}
 
@@ -0,0 +1,39 @@
+// Command generate writes the Prometheus rules and the Grafana dashboard of the indexing SLO metrics.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/rss3-network/node/internal/telemetry/meter"
+)
+
+func main() {
+	output := flag.String("output", "deploy/monitoring", "the output directory")
+
+	flag.Parse()
+
+	files := map[string]func() ([]byte, error){
+		filepath.Join("prometheus", "rules.yaml"):  meter.PrometheusRules,
+		filepath.Join("grafana", "dashboard.json"): meter.GrafanaDashboard,
+	}
+
+	for name, generate := range files {
+		data, err := generate()
+		if err != nil {
+			log.Fatalf("generate %s: %v", name, err)
+		}
+
+		path := filepath.Join(*output, name)
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			log.Fatalf("create directory of %s: %v", path, err)
+		}
+
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			log.Fatalf("write %s: %v", path, err)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package meter
+
+//go:generate go run ./generate --output ../../../deploy/monitoring
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rss3-network/node/internal/constant"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.uber.org/zap"
+)
+
+// The names of the metrics as exposed by the Prometheus exporter,
+// which appends the `_total` suffix to counters.
+const (
+	MetricIndexLag              = "rss3_node_index_lag_seconds"
+	MetricTaskOutcomes          = "rss3_node_task_outcomes_total"
+	MetricActivities            = "rss3_node_activities_total"
+	MetricRPCDuration           = "rss3_node_rpc_request_duration_seconds"
+	MetricRPCErrors             = "rss3_node_rpc_errors_total"
+	MetricDatabaseWriteDuration = "rss3_node_database_write_duration_seconds"
+	MetricStreamPushDuration    = "rss3_node_stream_push_duration_seconds"
+	MetricStreamErrors          = "rss3_node_stream_errors_total"
+)
+
+// The outcomes of a task handled by the indexer.
+const (
+	OutcomeTransformed = "transformed"
+	OutcomeSkipped     = "skipped"
+	OutcomeFailed      = "failed"
+)
+
+const (
+	statusOK    = "ok"
+	statusError = "error"
+)
+
+// durationBuckets are the histogram boundaries in seconds for remote calls.
+var durationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// Instruments are the instruments of the indexing SLO metrics.
+type Instruments struct {
+	meter metric.Meter
+
+	IndexLag              metric.Float64ObservableGauge
+	TaskOutcomes          metric.Int64Counter
+	Activities            metric.Int64Counter
+	RPCDuration           metric.Float64Histogram
+	RPCErrors             metric.Int64Counter
+	DatabaseWriteDuration metric.Float64Histogram
+	StreamPushDuration    metric.Float64Histogram
+	StreamErrors          metric.Int64Counter
+}
+
+// RecordTaskOutcome counts a task handled by the worker.
+func (i *Instruments) RecordTaskOutcome(ctx context.Context, id string, network network.Network, worker, outcome string) {
+	i.TaskOutcomes.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("id", id),
+		attribute.String("network", network.String()),
+		attribute.String("worker", worker),
+		attribute.String("outcome", outcome),
+	))
+}
+
+// RecordActivities counts the activities indexed by the worker by tag, type and platform.
+func (i *Instruments) RecordActivities(ctx context.Context, id string, network network.Network, worker string, activities []*activityx.Activity) {
+	for _, activity := range activities {
+		var typeName string
+		if activity.Type != nil {
+			typeName = activity.Type.Name()
+		}
+
+		i.Activities.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("id", id),
+			attribute.String("network", network.String()),
+			attribute.String("worker", worker),
+			attribute.String("tag", activity.Tag.String()),
+			attribute.String("type", typeName),
+			attribute.String("platform", activity.Platform),
+		))
+	}
+}
+
+// RegisterIndexLag observes the seconds between now and the indexed time of the worker on every collection,
+// so the lag keeps growing while the worker stalls. Nothing is observed while the indexed time is unknown.
+func (i *Instruments) RegisterIndexLag(id string, network network.Network, worker string, indexedAt func(ctx context.Context) (time.Time, error)) (metric.Registration, error) {
+	attributes := metric.WithAttributes(
+		attribute.String("id", id),
+		attribute.String("network", network.String()),
+		attribute.String("worker", worker),
+	)
+
+	return i.meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		timestamp, err := indexedAt(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !timestamp.IsZero() {
+			observer.ObserveFloat64(i.IndexLag, time.Since(timestamp).Seconds(), attributes)
+		}
+
+		return nil
+	}, i.IndexLag)
+}
+
+// NewInstruments creates the instruments from the meter.
+func NewInstruments(meter metric.Meter) (_ *Instruments, err error) {
+	instance := Instruments{
+		meter: meter,
+	}
+
+	if instance.IndexLag, err = meter.Float64ObservableGauge(MetricIndexLag, metric.WithUnit("s"),
+		metric.WithDescription("Seconds between now and the timestamp of the latest indexed task.")); err != nil {
+		return nil, err
+	}
+
+	if instance.TaskOutcomes, err = meter.Int64Counter(counterName(MetricTaskOutcomes),
+		metric.WithDescription("Number of tasks handled by outcome.")); err != nil {
+		return nil, err
+	}
+
+	if instance.Activities, err = meter.Int64Counter(counterName(MetricActivities),
+		metric.WithDescription("Number of indexed activities by tag, type and platform.")); err != nil {
+		return nil, err
+	}
+
+	if instance.RPCDuration, err = meter.Float64Histogram(MetricRPCDuration, metric.WithUnit("s"), metric.WithExplicitBucketBoundaries(durationBuckets...),
+		metric.WithDescription("Latency of the remote calls made by the data sources by method.")); err != nil {
+		return nil, err
+	}
+
+	if instance.RPCErrors, err = meter.Int64Counter(counterName(MetricRPCErrors),
+		metric.WithDescription("Number of failed remote calls made by the data sources by method.")); err != nil {
+		return nil, err
+	}
+
+	if instance.DatabaseWriteDuration, err = meter.Float64Histogram(MetricDatabaseWriteDuration, metric.WithUnit("s"), metric.WithExplicitBucketBoundaries(durationBuckets...),
+		metric.WithDescription("Latency of the database writes made by the indexer by operation.")); err != nil {
+		return nil, err
+	}
+
+	if instance.StreamPushDuration, err = meter.Float64Histogram(MetricStreamPushDuration, metric.WithUnit("s"), metric.WithExplicitBucketBoundaries(durationBuckets...),
+		metric.WithDescription("Latency of pushing activities to the stream.")); err != nil {
+		return nil, err
+	}
+
+	if instance.StreamErrors, err = meter.Int64Counter(counterName(MetricStreamErrors),
+		metric.WithDescription("Number of failed pushes to the stream.")); err != nil {
+		return nil, err
+	}
+
+	return &instance, nil
+}
+
+// counterName returns the instrument name of a counter, the exporter appends the suffix back.
+func counterName(name string) string {
+	return strings.TrimSuffix(name, "_total")
+}
+
+var (
+	defaultInstruments     *Instruments
+	defaultInstrumentsOnce sync.Once
+)
+
+// Default returns the instruments created from the global meter provider.
+func Default() *Instruments {
+	defaultInstrumentsOnce.Do(func() {
+		var err error
+
+		if defaultInstruments, err = NewInstruments(otel.GetMeterProvider().Meter(constant.Name)); err != nil {
+			zap.L().Error("failed to create instruments, metrics are disabled", zap.Error(err))
+
+			defaultInstruments, _ = NewInstruments(noop.NewMeterProvider().Meter(constant.Name))
+		}
+	})
+
+	return defaultInstruments
+}
+
+// ObserveRPC calls the remote method of the network and records its latency and error.
+func ObserveRPC[T any](ctx context.Context, network network.Network, method string, call func() (T, error)) (T, error) {
+	startedAt := time.Now()
+
+	result, err := call()
+
+	instruments := Default()
+	attributes := []attribute.KeyValue{
+		attribute.String("network", network.String()),
+		attribute.String("method", method),
+	}
+
+	if err != nil {
+		instruments.RPCErrors.Add(ctx, 1, metric.WithAttributes(attributes...))
+	}
+
+	instruments.RPCDuration.Record(ctx, time.Since(startedAt).Seconds(), metric.WithAttributes(append(attributes, statusAttribute(err))...))
+
+	return result, err
+}
+
+// ObserveDatabaseWrite calls the database write operation and records its latency.
+func ObserveDatabaseWrite(ctx context.Context, operation string, call func() error) error {
+	startedAt := time.Now()
+
+	err := call()
+
+	Default().DatabaseWriteDuration.Record(ctx, time.Since(startedAt).Seconds(), metric.WithAttributes(
+		attribute.String("operation", operation),
+		statusAttribute(err),
+	))
+
+	return err
+}
+
+// ObserveStreamPush calls the stream push and records its latency and error.
+func ObserveStreamPush(ctx context.Context, topic string, call func() error) error {
+	startedAt := time.Now()
+
+	err := call()
+
+	instruments := Default()
+	attributes := []attribute.KeyValue{
+		attribute.String("topic", topic),
+	}
+
+	if err != nil {
+		instruments.StreamErrors.Add(ctx, 1, metric.WithAttributes(attributes...))
+	}
+
+	instruments.StreamPushDuration.Record(ctx, time.Since(startedAt).Seconds(), metric.WithAttributes(append(attributes, statusAttribute(err))...))
+
+	return err
+}
+
+func statusAttribute(err error) attribute.KeyValue {
+	if err != nil {
+		return attribute.String("status", statusError)
+	}
+
+	return attribute.String("status", statusOK)
+}
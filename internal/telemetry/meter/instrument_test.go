@@ -0,0 +1,110 @@
+package meter_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rss3-network/node/internal/telemetry/meter"
+	activityx "github.com/rss3-network/protocol-go/schema/activity"
+	"github.com/rss3-network/protocol-go/schema/network"
+	"github.com/rss3-network/protocol-go/schema/tag"
+	"github.com/rss3-network/protocol-go/schema/typex"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	prometheusexporter "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func TestInstruments(t *testing.T) {
+	t.Parallel()
+
+	registry := prometheus.NewRegistry()
+
+	exporter, err := prometheusexporter.New(prometheusexporter.WithRegisterer(registry))
+	require.NoError(t, err)
+
+	instruments, err := meter.NewInstruments(sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporter)).Meter("test"))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	attributes := metric.WithAttributes(attribute.String("status", "ok"))
+
+	_, err = instruments.RegisterIndexLag("ethereum-core", network.Ethereum, "core", func(context.Context) (time.Time, error) {
+		return time.Now().Add(-time.Minute), nil
+	})
+	require.NoError(t, err)
+
+	instruments.RecordTaskOutcome(ctx, "ethereum-core", network.Ethereum, "core", meter.OutcomeFailed)
+	instruments.RecordActivities(ctx, "ethereum-core", network.Ethereum, "core", []*activityx.Activity{
+		{Tag: tag.Transaction, Type: typex.TransactionTransfer, Platform: "Uniswap"},
+	})
+	instruments.RPCDuration.Record(ctx, 0.1, attributes)
+	instruments.RPCErrors.Add(ctx, 1)
+	instruments.DatabaseWriteDuration.Record(ctx, 0.1, attributes)
+	instruments.StreamPushDuration.Record(ctx, 0.1, attributes)
+	instruments.StreamErrors.Add(ctx, 1)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	names := make(map[string]bool, len(families))
+	for _, family := range families {
+		names[family.GetName()] = true
+	}
+
+	// The generated rules and dashboard depend on the names exposed by the exporter.
+	for _, name := range []string{
+		meter.MetricIndexLag,
+		meter.MetricTaskOutcomes,
+		meter.MetricActivities,
+		meter.MetricRPCDuration,
+		meter.MetricRPCErrors,
+		meter.MetricDatabaseWriteDuration,
+		meter.MetricStreamPushDuration,
+		meter.MetricStreamErrors,
+	} {
+		require.True(t, names[name], "metric %s is not exported", name)
+	}
+}
+
+func TestGeneratedFiles(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		path     string
+		generate func() ([]byte, error)
+	}{
+		{
+			name:     "Prometheus rules",
+			path:     filepath.Join("..", "..", "..", "deploy", "monitoring", "prometheus", "rules.yaml"),
+			generate: meter.PrometheusRules,
+		},
+		{
+			name:     "Grafana dashboard",
+			path:     filepath.Join("..", "..", "..", "deploy", "monitoring", "grafana", "dashboard.json"),
+			generate: meter.GrafanaDashboard,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			want, err := testcase.generate()
+			require.NoError(t, err)
+
+			got, err := os.ReadFile(testcase.path)
+			require.NoError(t, err)
+
+			require.Equal(t, string(want), string(got), "run go generate ./internal/telemetry/meter to update the file")
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package meter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The names of the recording rules.
+const (
+	RecordIndexLag              = "rss3_node:index_lag_seconds:max"
+	RecordTaskOutcomesRate      = "rss3_node:task_outcomes:rate5m"
+	RecordTaskFailureRatio      = "rss3_node:task_failure_ratio:rate5m"
+	RecordActivitiesRate        = "rss3_node:activities:rate5m"
+	RecordRPCDurationP99        = "rss3_node:rpc_request_duration_seconds:p99_5m"
+	RecordRPCErrorRatio         = "rss3_node:rpc_error_ratio:rate5m"
+	RecordDatabaseWriteDuration = "rss3_node:database_write_duration_seconds:p99_5m"
+	RecordStreamPushDurationP99 = "rss3_node:stream_push_duration_seconds:p99_5m"
+	RecordStreamErrorsIncrease  = "rss3_node:stream_errors:increase10m"
+)
+
+const (
+	generatedHeader              = "# Code generated by internal/telemetry/meter/generate. DO NOT EDIT.\n"
+	prometheusRuleGroupRecording = "rss3_node.recording"
+	prometheusRuleGroupAlerting  = "rss3_node.alerting"
+)
+
+type prometheusRuleFile struct {
+	Groups []prometheusRuleGroup `yaml:"groups"`
+}
+
+type prometheusRuleGroup struct {
+	Name  string           `yaml:"name"`
+	Rules []prometheusRule `yaml:"rules"`
+}
+
+type prometheusRule struct {
+	Record      string            `yaml:"record,omitempty"`
+	Alert       string            `yaml:"alert,omitempty"`
+	Expr        string            `yaml:"expr"`
+	For         string            `yaml:"for,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// PrometheusRules returns the Prometheus recording and alerting rules of the indexing SLO metrics.
+func PrometheusRules() ([]byte, error) {
+	file := prometheusRuleFile{
+		Groups: []prometheusRuleGroup{
+			{
+				Name: prometheusRuleGroupRecording,
+				Rules: []prometheusRule{
+					{
+						Record: RecordIndexLag,
+						Expr:   fmt.Sprintf("max by (id, network, worker) (%s)", MetricIndexLag),
+					},
+					{
+						Record: RecordTaskOutcomesRate,
+						Expr:   fmt.Sprintf("sum by (id, network, worker, outcome) (rate(%s[5m]))", MetricTaskOutcomes),
+					},
+					{
+						Record: RecordTaskFailureRatio,
+						Expr: fmt.Sprintf(`sum by (id, network, worker) (rate(%[1]s{outcome=%[2]q}[5m])) / sum by (id, network, worker) (rate(%[1]s[5m]))`,
+							MetricTaskOutcomes, OutcomeFailed),
+					},
+					{
+						Record: RecordActivitiesRate,
+						Expr:   fmt.Sprintf("sum by (network, worker, tag, type, platform) (rate(%s[5m]))", MetricActivities),
+					},
+					{
+						Record: RecordRPCDurationP99,
+						Expr:   fmt.Sprintf("histogram_quantile(0.99, sum by (network, method, le) (rate(%s_bucket[5m])))", MetricRPCDuration),
+					},
+					{
+						Record: RecordRPCErrorRatio,
+						Expr: fmt.Sprintf("sum by (network, method) (rate(%s[5m])) / sum by (network, method) (rate(%s_count[5m]))",
+							MetricRPCErrors, MetricRPCDuration),
+					},
+					{
+						Record: RecordDatabaseWriteDuration,
+						Expr:   fmt.Sprintf("histogram_quantile(0.99, sum by (operation, le) (rate(%s_bucket[5m])))", MetricDatabaseWriteDuration),
+					},
+					{
+						Record: RecordStreamPushDurationP99,
+						Expr:   fmt.Sprintf("histogram_quantile(0.99, sum by (topic, le) (rate(%s_bucket[5m])))", MetricStreamPushDuration),
+					},
+					{
+						Record: RecordStreamErrorsIncrease,
+						Expr:   fmt.Sprintf("sum by (topic) (increase(%s[10m]))", MetricStreamErrors),
+					},
+				},
+			},
+			{
+				Name: prometheusRuleGroupAlerting,
+				Rules: []prometheusRule{
+					{
+						Alert:  "RSS3NodeIndexLagHigh",
+						Expr:   RecordIndexLag + " > 900",
+						For:    "15m",
+						Labels: map[string]string{"severity": "warning"},
+						Annotations: map[string]string{
+							"summary":     "Worker {{ $labels.id }} is lagging behind",
+							"description": "Worker {{ $labels.id }} on {{ $labels.network }} is {{ $value | humanizeDuration }} behind the head.",
+						},
+					},
+					{
+						Alert:  "RSS3NodeIndexStalled",
+						Expr:   fmt.Sprintf("sum by (id, network, worker) (increase(%s[30m])) == 0", MetricTaskOutcomes),
+						For:    "15m",
+						Labels: map[string]string{"severity": "critical"},
+						Annotations: map[string]string{
+							"summary":     "Worker {{ $labels.id }} stopped handling tasks",
+							"description": "Worker {{ $labels.id }} on {{ $labels.network }} has not handled any task in the last 30 minutes.",
+						},
+					},
+					{
+						Alert:  "RSS3NodeTransformFailureRatioHigh",
+						Expr:   RecordTaskFailureRatio + " > 0.05",
+						For:    "15m",
+						Labels: map[string]string{"severity": "warning"},
+						Annotations: map[string]string{
+							"summary":     "Worker {{ $labels.id }} fails to transform tasks",
+							"description": "{{ $value | humanizePercentage }} of the tasks of worker {{ $labels.id }} failed to transform.",
+						},
+					},
+					{
+						Alert:  "RSS3NodeRPCErrorRatioHigh",
+						Expr:   RecordRPCErrorRatio + " > 0.1",
+						For:    "10m",
+						Labels: map[string]string{"severity": "warning"},
+						Annotations: map[string]string{
+							"summary":     "Remote calls to {{ $labels.network }} are failing",
+							"description": "{{ $value | humanizePercentage }} of the {{ $labels.method }} calls to {{ $labels.network }} failed.",
+						},
+					},
+					{
+						Alert:  "RSS3NodeRPCLatencyHigh",
+						Expr:   RecordRPCDurationP99 + " > 5",
+						For:    "15m",
+						Labels: map[string]string{"severity": "warning"},
+						Annotations: map[string]string{
+							"summary":     "Remote calls to {{ $labels.network }} are slow",
+							"description": "The p99 latency of the {{ $labels.method }} calls to {{ $labels.network }} is {{ $value | humanizeDuration }}.",
+						},
+					},
+					{
+						Alert:  "RSS3NodeDatabaseWriteLatencyHigh",
+						Expr:   RecordDatabaseWriteDuration + " > 2",
+						For:    "15m",
+						Labels: map[string]string{"severity": "warning"},
+						Annotations: map[string]string{
+							"summary":     "Database writes are slow",
+							"description": "The p99 latency of the {{ $labels.operation }} writes is {{ $value | humanizeDuration }}.",
+						},
+					},
+					{
+						Alert:  "RSS3NodeStreamPushFailing",
+						Expr:   RecordStreamErrorsIncrease + " > 0",
+						For:    "5m",
+						Labels: map[string]string{"severity": "warning"},
+						Annotations: map[string]string{
+							"summary":     "Pushing activities to the stream is failing",
+							"description": "{{ $value }} pushes to the {{ $labels.topic }} topic failed in the last 10 minutes.",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var buffer bytes.Buffer
+
+	buffer.WriteString(generatedHeader)
+
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(file); err != nil {
+		return nil, fmt.Errorf("encode rules: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("close encoder: %w", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+type grafanaPanel struct {
+	ID          int               `json:"id"`
+	Type        string            `json:"type"`
+	Title       string            `json:"title"`
+	Datasource  map[string]string `json:"datasource"`
+	GridPos     map[string]int    `json:"gridPos"`
+	FieldConfig map[string]any    `json:"fieldConfig"`
+	Targets     []grafanaTarget   `json:"targets"`
+}
+
+type grafanaTarget struct {
+	RefID        string `json:"refId"`
+	Expr         string `json:"expr"`
+	LegendFormat string `json:"legendFormat"`
+}
+
+// GrafanaDashboard returns the Grafana dashboard of the indexing SLO metrics.
+func GrafanaDashboard() ([]byte, error) {
+	panels := []struct {
+		title  string
+		unit   string
+		expr   string
+		legend string
+	}{
+		{"Index lag", "s", fmt.Sprintf(`%s{network=~"$network", worker=~"$worker"}`, RecordIndexLag), "{{id}}"},
+		{"Tasks by outcome", "ops", fmt.Sprintf(`%s{network=~"$network", worker=~"$worker"}`, RecordTaskOutcomesRate), "{{id}} {{outcome}}"},
+		{"Transform failure ratio", "percentunit", fmt.Sprintf(`%s{network=~"$network", worker=~"$worker"}`, RecordTaskFailureRatio), "{{id}}"},
+		{"Activities by tag and type", "ops", fmt.Sprintf(`sum by (tag, type) (%s{network=~"$network", worker=~"$worker"})`, RecordActivitiesRate), "{{tag}}/{{type}}"},
+		{"Activities by platform", "ops", fmt.Sprintf(`sum by (platform) (%s{network=~"$network", worker=~"$worker"})`, RecordActivitiesRate), "{{platform}}"},
+		{"RPC latency p99", "s", fmt.Sprintf(`%s{network=~"$network"}`, RecordRPCDurationP99), "{{network}} {{method}}"},
+		{"RPC error ratio", "percentunit", fmt.Sprintf(`%s{network=~"$network"}`, RecordRPCErrorRatio), "{{network}} {{method}}"},
+		{"Database write latency p99", "s", RecordDatabaseWriteDuration, "{{operation}}"},
+		{"Stream push latency p99", "s", RecordStreamPushDurationP99, "{{topic}}"},
+		{"Stream push errors", "short", RecordStreamErrorsIncrease, "{{topic}}"},
+	}
+
+	const (
+		panelWidth  = 12
+		panelHeight = 8
+	)
+
+	datasource := map[string]string{"type": "prometheus", "uid": "${datasource}"}
+
+	dashboardPanels := make([]grafanaPanel, 0, len(panels))
+
+	for index, panel := range panels {
+		dashboardPanels = append(dashboardPanels, grafanaPanel{
+			ID:         index + 1,
+			Type:       "timeseries",
+			Title:      panel.title,
+			Datasource: datasource,
+			GridPos: map[string]int{
+				"h": panelHeight,
+				"w": panelWidth,
+				"x": (index % 2) * panelWidth,
+				"y": (index / 2) * panelHeight,
+			},
+			FieldConfig: map[string]any{
+				"defaults": map[string]any{"unit": panel.unit},
+			},
+			Targets: []grafanaTarget{
+				{RefID: "A", Expr: panel.expr, LegendFormat: panel.legend},
+			},
+		})
+	}
+
+	dashboard := map[string]any{
+		"uid":           "rss3-node-indexing",
+		"title":         "RSS3 Node Indexing",
+		"tags":          []string{"rss3", "node"},
+		"schemaVersion": 39,
+		"refresh":       "1m",
+		"time":          map[string]string{"from": "now-6h", "to": "now"},
+		"panels":        dashboardPanels,
+		"templating": map[string]any{
+			"list": []map[string]any{
+				{
+					"name":  "datasource",
+					"type":  "datasource",
+					"query": "prometheus",
+				},
+				{
+					"name":       "network",
+					"type":       "query",
+					"datasource": datasource,
+					"query":      fmt.Sprintf("label_values(%s, network)", MetricTaskOutcomes),
+					"includeAll": true,
+					"multi":      true,
+					"current":    map[string]string{"text": "All", "value": "$__all"},
+				},
+				{
+					"name":       "worker",
+					"type":       "query",
+					"datasource": datasource,
+					"query":      fmt.Sprintf(`label_values(%s{network=~"$network"}, worker)`, MetricTaskOutcomes),
+					"includeAll": true,
+					"multi":      true,
+					"current":    map[string]string{"text": "All", "value": "$__all"},
+				},
+			},
+		},
+	}
+
+	data, err := json.MarshalIndent(dashboard, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal dashboard: %w", err)
+	}
+
+	return append(data, '\n'), nil
+}
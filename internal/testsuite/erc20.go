@@ -0,0 +1,45 @@
+package testsuite
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rss3-network/node/provider/ethereum/contract/erc20"
+	"github.com/samber/lo"
+)
+
+// ERC20Token is the metadata of an ERC-20 token served by ERC20Call.
+type ERC20Token struct {
+	Name     string
+	Symbol   string
+	Decimals uint8
+}
+
+// ERC20Call returns a contract call serving the name, symbol and decimals methods of the ERC-20 tokens.
+func ERC20Call(tokens map[common.Address]ERC20Token) ContractCall {
+	erc20ABI := lo.Must(erc20.ERC20MetaData.GetAbi())
+
+	return func(to common.Address, input []byte) ([]byte, error) {
+		token, exists := tokens[to]
+		if !exists || len(input) < 4 {
+			return nil, fmt.Errorf("unsupported call %s of %s", hexutil.Encode(input), to)
+		}
+
+		method, err := erc20ABI.MethodById(input[:4])
+		if err != nil {
+			return nil, fmt.Errorf("load method by ID: %w", err)
+		}
+
+		switch method.Name {
+		case "name":
+			return method.Outputs.Pack(token.Name)
+		case "symbol":
+			return method.Outputs.Pack(token.Symbol)
+		case "decimals":
+			return method.Outputs.Pack(token.Decimals)
+		default:
+			return nil, fmt.Errorf("unsupported method %s of %s", method.Name, to)
+		}
+	}
+}
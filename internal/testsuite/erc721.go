@@ -0,0 +1,50 @@
+package testsuite
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+	"github.com/rss3-network/node/provider/ethereum/contract/erc721"
+	"github.com/samber/lo"
+)
+
+// ERC721Collection is the metadata of an ERC-721 collection served by ERC721Call.
+type ERC721Collection struct {
+	Name   string
+	Symbol string
+}
+
+// ERC721Call returns a contract call serving the supportsInterface, name and symbol methods of the ERC-721 collections.
+func ERC721Call(collections map[common.Address]ERC721Collection) ContractCall {
+	erc721ABI := lo.Must(erc721.ERC721MetaData.GetAbi())
+
+	return func(to common.Address, input []byte) ([]byte, error) {
+		collection, exists := collections[to]
+		if !exists || len(input) < 4 {
+			return nil, fmt.Errorf("unsupported call %s of %s", hexutil.Encode(input), to)
+		}
+
+		method, err := erc721ABI.MethodById(input[:4])
+		if err != nil {
+			return nil, fmt.Errorf("load method by ID: %w", err)
+		}
+
+		switch method.Name {
+		case "supportsInterface":
+			values, err := method.Inputs.Unpack(input[4:])
+			if err != nil {
+				return nil, fmt.Errorf("unpack supportsInterface: %w", err)
+			}
+
+			return method.Outputs.Pack(values[0].([4]byte) == contract.InterfaceIDERC721)
+		case "name":
+			return method.Outputs.Pack(collection.Name)
+		case "symbol":
+			return method.Outputs.Pack(collection.Symbol)
+		default:
+			return nil, fmt.Errorf("unsupported method %s of %s", method.Name, to)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package testsuite
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rss3-network/node/provider/ethereum/contract/multicall3"
+	"github.com/samber/lo"
+)
+
+// RPCHandler returns the result of a JSON-RPC method call.
+type RPCHandler func(method string, params []json.RawMessage) (any, error)
+
+// ContractCall returns the output of a call of the contract at the address.
+type ContractCall func(to common.Address, input []byte) ([]byte, error)
+
+// NewRPCServer starts a local JSON-RPC server serving the method calls by the handler,
+// the error of the handler is returned as the error of the call, and the server is closed when the test finishes.
+func NewRPCServer(t *testing.T, handler RPCHandler) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		var body struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			http.Error(writer, "invalid request", http.StatusBadRequest)
+
+			return
+		}
+
+		response := map[string]any{"jsonrpc": "2.0", "id": body.ID}
+
+		if result, err := handler(body.Method, body.Params); err != nil {
+			response["error"] = map[string]any{"code": -32000, "message": err.Error()}
+		} else {
+			response["result"] = result
+		}
+
+		_ = json.NewEncoder(writer).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+// NewEthereumRPCServer starts a local JSON-RPC server serving the eth_call method by the contract call.
+func NewEthereumRPCServer(t *testing.T, call ContractCall) string {
+	t.Helper()
+
+	return NewRPCServer(t, EthereumCallHandler(call))
+}
+
+// EthereumCallHandler returns a handler serving the eth_call method by the contract call,
+// the calls aggregated by the aggregate3 method of Multicall3 are served one by one.
+func EthereumCallHandler(call ContractCall) RPCHandler {
+	multicall3ABI := lo.Must(multicall3.Multicall3MetaData.GetAbi())
+
+	var handle ContractCall
+
+	handle = func(to common.Address, input []byte) ([]byte, error) {
+		if to != multicall3.AddressMulticall3 {
+			return call(to, input)
+		}
+
+		if len(input) < 4 {
+			return nil, fmt.Errorf("invalid input %s", hexutil.Encode(input))
+		}
+
+		method, err := multicall3ABI.MethodById(input[:4])
+		if err != nil || method.Name != "aggregate3" {
+			return nil, fmt.Errorf("unsupported method %s of multicall3", hexutil.Encode(input[:4]))
+		}
+
+		values, err := method.Inputs.Unpack(input[4:])
+		if err != nil {
+			return nil, fmt.Errorf("unpack aggregate3: %w", err)
+		}
+
+		var calls []multicall3.Multicall3Call3
+
+		if err := method.Inputs.Copy(&calls, values); err != nil {
+			return nil, fmt.Errorf("copy aggregate3: %w", err)
+		}
+
+		results := lo.Map(calls, func(item multicall3.Multicall3Call3, _ int) multicall3.Multicall3Result {
+			data, err := handle(item.Target, item.CallData)
+
+			return multicall3.Multicall3Result{Success: err == nil, ReturnData: data}
+		})
+
+		return method.Outputs.Pack(results)
+	}
+
+	return func(method string, params []json.RawMessage) (any, error) {
+		if method != "eth_call" || len(params) == 0 {
+			return nil, fmt.Errorf("unsupported method %s", method)
+		}
+
+		var message struct {
+			To   common.Address `json:"to"`
+			Data hexutil.Bytes  `json:"data"`
+		}
+
+		if err := json.Unmarshal(params[0], &message); err != nil {
+			return nil, fmt.Errorf("invalid call message: %w", err)
+		}
+
+		output, err := handle(message.To, message.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		return hexutil.Bytes(output), nil
+	}
+}
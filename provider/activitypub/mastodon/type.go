@@ -8,6 +8,11 @@ const (
 	MessageTypeCreate                      // Create ActivityPub message
 	MessageTypeAnnounce                    // Announce ActivityPub message
 	MessageTypeLike                        // Like ActivityPub message
+	MessageTypeUpdate                      // Update ActivityPub message
+	MessageTypeDelete                      // Delete ActivityPub message
+	MessageTypeFollow                      // Follow ActivityPub message
+	MessageTypeAccept                      // Accept ActivityPub message
+	MessageTypeUndo                        // Undo ActivityPub message
 )
 
 // ActivityPub standard contexts and public addressing
@@ -42,8 +47,45 @@ const (
 	Tag                 = "tag"
 	TagTypeHashtag      = "Hashtag"
 	TagTypeMention      = "Mention"
+	ObjectTypeNote      = "Note"
+	ObjectTypeTombstone = "Tombstone"
 )
 
+// Object types and extensions of other ActivityPub software
+const (
+	ObjectTypeArticle  = "Article"
+	ObjectTypePage     = "Page"
+	ObjectTypeImage    = "Image"
+	ObjectTypeVideo    = "Video"
+	ObjectTypeAudio    = "Audio"
+	ObjectTypeQuestion = "Question"
+
+	Content        = "content"
+	Published      = "published"
+	Audience       = "audience"
+	MediaURL       = "url"
+	LinkHref       = "href"
+	QuestionOneOf  = "oneOf"
+	QuestionAnyOf  = "anyOf"
+	Replies        = "replies"
+	TotalItems     = "totalItems"
+	QuoteURL       = "quoteUrl"
+	QuoteURI       = "quoteUri"
+	MisskeyQuote   = "_misskey_quote"
+	MisskeyContent = "_misskey_content"
+)
+
+// PostObjectTypes are the types of objects indexed as posts
+var PostObjectTypes = []string{
+	ObjectTypeNote,
+	ObjectTypeArticle,
+	ObjectTypePage,
+	ObjectTypeImage,
+	ObjectTypeVideo,
+	ObjectTypeAudio,
+	ObjectTypeQuestion,
+}
+
 // HTTP paths and headers
 const (
 	ActivitySuffix    = "/activity"
@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _MessageTypeName = "NoneCreateAnnounceLike"
+const _MessageTypeName = "NoneCreateAnnounceLikeUpdateDeleteFollowAcceptUndo"
 
-var _MessageTypeIndex = [...]uint8{0, 4, 10, 18, 22}
+var _MessageTypeIndex = [...]uint8{0, 4, 10, 18, 22, 28, 34, 40, 46, 50}
 
-const _MessageTypeLowerName = "nonecreateannouncelike"
+const _MessageTypeLowerName = "nonecreateannouncelikeupdatedeletefollowacceptundo"
 
 func (i MessageType) String() string {
 	if i < 0 || i >= MessageType(len(_MessageTypeIndex)-1) {
@@ -32,9 +32,14 @@ func _MessageTypeNoOp() {
 	_ = x[MessageTypeCreate-(1)]
 	_ = x[MessageTypeAnnounce-(2)]
 	_ = x[MessageTypeLike-(3)]
+	_ = x[MessageTypeUpdate-(4)]
+	_ = x[MessageTypeDelete-(5)]
+	_ = x[MessageTypeFollow-(6)]
+	_ = x[MessageTypeAccept-(7)]
+	_ = x[MessageTypeUndo-(8)]
 }
 
-var _MessageTypeValues = []MessageType{MessageTypeNone, MessageTypeCreate, MessageTypeAnnounce, MessageTypeLike}
+var _MessageTypeValues = []MessageType{MessageTypeNone, MessageTypeCreate, MessageTypeAnnounce, MessageTypeLike, MessageTypeUpdate, MessageTypeDelete, MessageTypeFollow, MessageTypeAccept, MessageTypeUndo}
 
 var _MessageTypeNameToValueMap = map[string]MessageType{
 	_MessageTypeName[0:4]:        MessageTypeNone,
@@ -45,6 +50,16 @@ var _MessageTypeNameToValueMap = map[string]MessageType{
 	_MessageTypeLowerName[10:18]: MessageTypeAnnounce,
 	_MessageTypeName[18:22]:      MessageTypeLike,
 	_MessageTypeLowerName[18:22]: MessageTypeLike,
+	_MessageTypeName[22:28]:      MessageTypeUpdate,
+	_MessageTypeLowerName[22:28]: MessageTypeUpdate,
+	_MessageTypeName[28:34]:      MessageTypeDelete,
+	_MessageTypeLowerName[28:34]: MessageTypeDelete,
+	_MessageTypeName[34:40]:      MessageTypeFollow,
+	_MessageTypeLowerName[34:40]: MessageTypeFollow,
+	_MessageTypeName[40:46]:      MessageTypeAccept,
+	_MessageTypeLowerName[40:46]: MessageTypeAccept,
+	_MessageTypeName[46:50]:      MessageTypeUndo,
+	_MessageTypeLowerName[46:50]: MessageTypeUndo,
 }
 
 var _MessageTypeNames = []string{
@@ -52,6 +67,11 @@ var _MessageTypeNames = []string{
 	_MessageTypeName[4:10],
 	_MessageTypeName[10:18],
 	_MessageTypeName[18:22],
+	_MessageTypeName[22:28],
+	_MessageTypeName[28:34],
+	_MessageTypeName[34:40],
+	_MessageTypeName[40:46],
+	_MessageTypeName[46:50],
 }
 
 // MessageTypeString retrieves an enum value from the enum constants string name.
@@ -38,14 +38,18 @@ type Tag struct {
 }
 
 // Note represents a note object in ActivityPub.
+// Posts of other types such as Article, Page and Video are normalised to notes by the data source.
 type Note struct {
 	ID        string   `json:"id"`
 	Type      string   `json:"type"`
+	Name      string   `json:"name,omitempty"`
 	Content   string   `json:"content"`
 	Published string   `json:"published,omitempty"`
 	To        []string `json:"to,omitempty"`
 	CC        []string `json:"cc,omitempty"`
 	Tag       []Tag    `json:"tag,omitempty"`
+	Audience  string   `json:"audience,omitempty"`
+	QuoteURL  string   `json:"quoteUrl,omitempty"`
 }
 
 // StatusResult represents the result of a status request.
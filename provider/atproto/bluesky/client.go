@@ -275,28 +275,9 @@ func (c *Client) GetRecord(ctx context.Context, repo string, path string) (*at.M
 		return nil, nil, nil
 	}
 
-	// Parse and validate the DID
-	did, err := syntax.ParseDID(repo)
-	if err != nil {
-		zap.L().Error("parse DID failed", zap.Error(err), zap.String("repo", repo))
-
-		return nil, nil, fmt.Errorf("parse DID: %w", err)
-	}
-
-	// Get authenticated client
-	client, err := c.GetXrpcClient(ctx, c.LookupDIDEndpoint(ctx, did))
-	if err != nil {
-		zap.L().Error("create xrpc client failed", zap.Error(err))
-
-		return nil, nil, fmt.Errorf("create xrpc client: %w", err)
-	}
-
-	// Fetch the handle associated with the DID
-	handle, err := c.GetHandle(ctx, client, did)
+	did, client, handle, err := c.resolveRepo(ctx, repo)
 	if err != nil {
-		zap.L().Error("get profile failed", zap.Error(err))
-
-		return nil, nil, fmt.Errorf("get profile: %w", err)
+		return nil, nil, err
 	}
 
 	message := &at.Message{
@@ -346,6 +327,69 @@ func (c *Client) GetRecord(ctx context.Context, repo string, path string) (*at.M
 	return message, nil, nil
 }
 
+// GetDeletedRecord builds the message of a record deleted from a repository, the record itself is no longer available.
+// Parameters:
+// - repo: The DID of the repository
+// - path: The path in format "collection/rkey"
+// - deletedAt: The time of the commit deleting the record
+// Returns the message of the deleted record or nil if the record is filtered out.
+func (c *Client) GetDeletedRecord(ctx context.Context, repo string, path string, deletedAt string) (*at.Message, error) {
+	collection, rkey := c.ParsePath(path)
+
+	if !lo.Contains(c.filter, collection) {
+		return nil, nil
+	}
+
+	createdAt, isValid := c.ParseCreatedAt(ctx, deletedAt)
+	if !isValid {
+		return nil, nil
+	}
+
+	did, _, handle, err := c.resolveRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &at.Message{
+		URI:        c.BuildURI(did, collection, rkey),
+		Did:        did,
+		Handle:     handle,
+		Collection: collection,
+		Rkey:       rkey,
+		CreatedAt:  createdAt,
+		Action:     at.ActionDelete,
+	}, nil
+}
+
+// resolveRepo resolves the DID of a repository, the client of its endpoint and the handle of its owner.
+func (c *Client) resolveRepo(ctx context.Context, repo string) (syntax.DID, *XrpcClient, string, error) {
+	// Parse and validate the DID
+	did, err := syntax.ParseDID(repo)
+	if err != nil {
+		zap.L().Error("parse DID failed", zap.Error(err), zap.String("repo", repo))
+
+		return "", nil, "", fmt.Errorf("parse DID: %w", err)
+	}
+
+	// Get authenticated client
+	client, err := c.GetXrpcClient(ctx, c.LookupDIDEndpoint(ctx, did))
+	if err != nil {
+		zap.L().Error("create xrpc client failed", zap.Error(err))
+
+		return "", nil, "", fmt.Errorf("create xrpc client: %w", err)
+	}
+
+	// Fetch the handle associated with the DID
+	handle, err := c.GetHandle(ctx, client, did)
+	if err != nil {
+		zap.L().Error("get profile failed", zap.Error(err))
+
+		return "", nil, "", fmt.Errorf("get profile: %w", err)
+	}
+
+	return did, client, handle, nil
+}
+
 // GetHandle retrieves the handle (username) for a given DID.
 // Parameters:
 // - did: User's decentralized identifier
@@ -421,6 +465,13 @@ func (c *Client) ParseRecord(ctx context.Context, rec cbg.CBORMarshaler, message
 			}
 		}
 
+		// Quotes of records other than posts, such as lists and feeds, are indexed as posts
+		if quote := c.ParseQuote(rec.Embed); quote != nil {
+			if message.QuoteMessage, err = c.ParseRepoStrongRef(ctx, quote); err != nil {
+				zap.L().Warn("parse quoted record failed", zap.Error(err), zap.String("uri", quote.Uri))
+			}
+		}
+
 		message.Feed = rec
 		message.CreatedAt = createdAt
 	case *bsky.ActorProfile:
@@ -463,6 +514,42 @@ func (c *Client) ParseRecord(ctx context.Context, rec cbg.CBORMarshaler, message
 		}
 
 		message.CreatedAt = createdAt
+	case *bsky.GraphFollow:
+		createdAt, isValid := c.ParseCreatedAt(ctx, rec.CreatedAt)
+		if !isValid {
+			return false, nil
+		}
+
+		if message.RefMessage, err = c.ParseSubject(ctx, rec.Subject); err != nil {
+			return false, fmt.Errorf("parse subject: %w", err)
+		}
+
+		message.CreatedAt = createdAt
+		message.Follow = rec
+	case *bsky.GraphBlock:
+		createdAt, isValid := c.ParseCreatedAt(ctx, rec.CreatedAt)
+		if !isValid {
+			return false, nil
+		}
+
+		if message.RefMessage, err = c.ParseSubject(ctx, rec.Subject); err != nil {
+			return false, fmt.Errorf("parse subject: %w", err)
+		}
+
+		message.CreatedAt = createdAt
+		message.Block = rec
+	case *bsky.GraphListitem:
+		createdAt, isValid := c.ParseCreatedAt(ctx, rec.CreatedAt)
+		if !isValid {
+			return false, nil
+		}
+
+		if message.RefMessage, err = c.ParseSubject(ctx, rec.Subject); err != nil {
+			return false, fmt.Errorf("parse subject: %w", err)
+		}
+
+		message.CreatedAt = createdAt
+		message.ListItem = rec
 	default:
 		return false, nil
 	}
@@ -510,6 +597,37 @@ func (c *Client) ParseRepoStrongRef(ctx context.Context, ref *atproto.RepoStrong
 	return target, nil
 }
 
+// ParseQuote returns the reference to the record quoted by the embed of a post.
+func (c *Client) ParseQuote(embed *bsky.FeedPost_Embed) *atproto.RepoStrongRef {
+	switch {
+	case embed == nil:
+		return nil
+	case embed.EmbedRecord != nil:
+		return embed.EmbedRecord.Record
+	case embed.EmbedRecordWithMedia != nil && embed.EmbedRecordWithMedia.Record != nil:
+		return embed.EmbedRecordWithMedia.Record.Record
+	default:
+		return nil
+	}
+}
+
+// ParseSubject builds the message of the account which is the subject of a follow, a block or a list item.
+// Parameters:
+// - subject: The DID of the account
+// Returns the message containing the DID and the handle of the account.
+func (c *Client) ParseSubject(ctx context.Context, subject string) (*at.Message, error) {
+	did, _, handle, err := c.resolveRepo(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	return &at.Message{
+		URI:    fmt.Sprintf("at://%s", did),
+		Did:    did,
+		Handle: handle,
+	}, nil
+}
+
 // ParseURI splits an AT Protocol URI into components.
 // Parameters:
 // - uri: Complete AT Protocol URI (format: at://did/collection/rkey)
@@ -7,6 +7,13 @@ import (
 	"github.com/bluesky-social/indigo/atproto/syntax"
 )
 
+// Actions of the operations of repositories
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
 type Message struct {
 	URI        string
 	Did        syntax.DID
@@ -14,8 +21,16 @@ type Message struct {
 	Collection string
 	Rkey       string
 	CreatedAt  time.Time
+	// Action is the action of the operation, which is empty for the records of repository snapshots
+	Action string
 
-	Feed       *bsky.FeedPost
-	Profile    *bsky.ActorProfile
+	Feed     *bsky.FeedPost
+	Profile  *bsky.ActorProfile
+	Follow   *bsky.GraphFollow
+	Block    *bsky.GraphBlock
+	ListItem *bsky.GraphListitem
+	// RefMessage is the parent of a reply, the subject of a repost or a like, or the account of a follow, a block or a list item
 	RefMessage *Message
+	// QuoteMessage is the post quoted by a post
+	QuoteMessage *Message
 }
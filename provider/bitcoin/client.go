@@ -0,0 +1,37 @@
+package bitcoin
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// APIs supported by the client.
+const (
+	// APIRPC is the JSON-RPC of Bitcoin Core and compatible nodes, which requires the transaction index to resolve prevouts.
+	APIRPC = "rpc"
+	// APIEsplora is the REST API of Esplora, which is served by mempool.space and Blockstream.
+	APIEsplora = "esplora"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// Client provides basic methods to query Bitcoin.
+type Client interface {
+	GetBlockHeight(ctx context.Context) (uint64, error)
+	GetBlockHash(ctx context.Context, height uint64) (string, error)
+	GetBlock(ctx context.Context, hash string) (*Block, error)
+	GetTransaction(ctx context.Context, txID string) (*Transaction, error)
+}
+
+// Dial creates a new client of the API for a given URL.
+func Dial(ctx context.Context, endpoint, api string) (Client, error) {
+	switch api {
+	case APIRPC, "":
+		return DialRPC(ctx, endpoint)
+	case APIEsplora:
+		return DialEsplora(ctx, endpoint)
+	default:
+		return nil, fmt.Errorf("unsupported api %s", api)
+	}
+}
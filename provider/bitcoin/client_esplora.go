@@ -0,0 +1,191 @@
+package bitcoin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/rss3-network/node/provider/httpx"
+	"github.com/samber/lo"
+)
+
+// esploraPageSize is the number of transactions of a block returned by a page.
+const esploraPageSize = 25
+
+var _ Client = (*esploraClient)(nil)
+
+// esploraClient is a client of the REST API of Esplora, whose transactions have the prevouts of the inputs.
+type esploraClient struct {
+	httpClient httpx.Client
+	endpoint   *url.URL
+}
+
+// DialEsplora creates a new client of the Esplora API for a given URL.
+func DialEsplora(_ context.Context, endpoint string) (Client, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parse endpoint %s: %w", endpoint, err)
+	}
+
+	httpClient, err := httpx.NewHTTPClient(httpx.WithTimeout(defaultTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	return &esploraClient{
+		httpClient: httpClient,
+		endpoint:   endpointURL,
+	}, nil
+}
+
+func (c *esploraClient) fetch(ctx context.Context, path ...string) ([]byte, error) {
+	body, err := c.httpClient.Fetch(ctx, c.endpoint.JoinPath(path...).String())
+	if err != nil {
+		return nil, err
+	}
+	defer lo.Try(body.Close)
+
+	return io.ReadAll(body)
+}
+
+func (c *esploraClient) fetchJSON(ctx context.Context, result any, path ...string) error {
+	data, err := c.fetch(ctx, path...)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, result)
+}
+
+// GetBlockHeight returns the height of the tip of the chain.
+func (c *esploraClient) GetBlockHeight(ctx context.Context) (uint64, error) {
+	data, err := c.fetch(ctx, "blocks", "tip", "height")
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// GetBlockHash returns the hash of the block at the height of the best chain.
+func (c *esploraClient) GetBlockHash(ctx context.Context, height uint64) (string, error) {
+	data, err := c.fetch(ctx, "block-height", strconv.FormatUint(height, 10))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
+
+// GetBlock returns the block with the transactions fetched by pages.
+func (c *esploraClient) GetBlock(ctx context.Context, hash string) (*Block, error) {
+	var result struct {
+		ID                string `json:"id"`
+		Height            uint64 `json:"height"`
+		Timestamp         int64  `json:"timestamp"`
+		PreviousBlockHash string `json:"previousblockhash"`
+		TxCount           int    `json:"tx_count"`
+	}
+
+	if err := c.fetchJSON(ctx, &result, "block", hash); err != nil {
+		return nil, fmt.Errorf("fetch block: %w", err)
+	}
+
+	block := Block{
+		Hash:              result.ID,
+		Height:            result.Height,
+		Time:              result.Timestamp,
+		PreviousBlockHash: result.PreviousBlockHash,
+		Transactions:      make([]Transaction, 0, result.TxCount),
+	}
+
+	for startIndex := 0; startIndex < result.TxCount; startIndex += esploraPageSize {
+		var transactions []esploraTransaction
+
+		if err := c.fetchJSON(ctx, &transactions, "block", hash, "txs", strconv.Itoa(startIndex)); err != nil {
+			return nil, fmt.Errorf("fetch transactions from %d: %w", startIndex, err)
+		}
+
+		for _, transaction := range transactions {
+			block.Transactions = append(block.Transactions, transaction.normalize())
+		}
+	}
+
+	return &block, nil
+}
+
+// GetTransaction returns the transaction.
+func (c *esploraClient) GetTransaction(ctx context.Context, txID string) (*Transaction, error) {
+	var result esploraTransaction
+
+	if err := c.fetchJSON(ctx, &result, "tx", txID); err != nil {
+		return nil, err
+	}
+
+	return lo.ToPtr(result.normalize()), nil
+}
+
+type esploraTransaction struct {
+	TxID    string          `json:"txid"`
+	Inputs  []esploraInput  `json:"vin"`
+	Outputs []esploraOutput `json:"vout"`
+}
+
+type esploraInput struct {
+	TxID       string         `json:"txid"`
+	Vout       uint32         `json:"vout"`
+	Prevout    *esploraOutput `json:"prevout"`
+	ScriptSig  string         `json:"scriptsig"`
+	Witness    []string       `json:"witness"`
+	IsCoinbase bool           `json:"is_coinbase"`
+}
+
+type esploraOutput struct {
+	ScriptPubKey        string `json:"scriptpubkey"`
+	ScriptPubKeyAddress string `json:"scriptpubkey_address"`
+	Value               uint64 `json:"value"`
+}
+
+func (t esploraTransaction) normalize() Transaction {
+	transaction := Transaction{
+		TxID:    t.TxID,
+		Inputs:  make([]Input, 0, len(t.Inputs)),
+		Outputs: make([]Output, 0, len(t.Outputs)),
+	}
+
+	for _, input := range t.Inputs {
+		normalized := Input{
+			TxID:    input.TxID,
+			Vout:    input.Vout,
+			Witness: input.Witness,
+		}
+
+		if input.IsCoinbase {
+			normalized.TxID, normalized.Coinbase = "", input.ScriptSig
+		}
+
+		if input.Prevout != nil {
+			normalized.Prevout = lo.ToPtr(input.Prevout.normalize())
+		}
+
+		transaction.Inputs = append(transaction.Inputs, normalized)
+	}
+
+	for _, output := range t.Outputs {
+		transaction.Outputs = append(transaction.Outputs, output.normalize())
+	}
+
+	return transaction
+}
+
+func (o esploraOutput) normalize() Output {
+	return Output{
+		Value:        o.Value,
+		Address:      o.ScriptPubKeyAddress,
+		ScriptPubKey: o.ScriptPubKey,
+	}
+}
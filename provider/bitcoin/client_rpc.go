@@ -0,0 +1,226 @@
+package bitcoin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/rss3-network/node/provider/httpx"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+var _ Client = (*rpcClient)(nil)
+
+// rpcClient is a client of the JSON-RPC of Bitcoin Core, the credentials are set as the user info of the endpoint.
+type rpcClient struct {
+	httpClient httpx.Client
+	endpoint   string
+}
+
+// RPCError is an error returned by the JSON-RPC endpoint.
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message)
+}
+
+// DialRPC creates a new client of the JSON-RPC for a given URL.
+func DialRPC(_ context.Context, endpoint string) (Client, error) {
+	httpClient, err := httpx.NewHTTPClient(httpx.WithTimeout(defaultTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpcClient{
+		httpClient: httpClient,
+		endpoint:   endpoint,
+	}, nil
+}
+
+func (c *rpcClient) call(ctx context.Context, method string, params []any, result any) error {
+	payload := map[string]any{
+		"jsonrpc": "1.0",
+		"id":      "rss3",
+		"method":  method,
+		"params":  params,
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.httpClient.Post(ctx, c.endpoint, bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		return err
+	}
+	defer lo.Try(body.Close)
+
+	var jsonResponse struct {
+		Result json.RawMessage `json:"result"`
+		Error  *RPCError       `json:"error"`
+	}
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&jsonResponse); err != nil {
+		return err
+	}
+
+	if jsonResponse.Error != nil {
+		return jsonResponse.Error
+	}
+
+	return json.Unmarshal(jsonResponse.Result, result)
+}
+
+// GetBlockHeight returns the height of the best block.
+func (c *rpcClient) GetBlockHeight(ctx context.Context) (uint64, error) {
+	var result uint64
+
+	if err := c.call(ctx, "getblockcount", []any{}, &result); err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// GetBlockHash returns the hash of the block at the height of the best chain.
+func (c *rpcClient) GetBlockHash(ctx context.Context, height uint64) (string, error) {
+	var result string
+
+	if err := c.call(ctx, "getblockhash", []any{height}, &result); err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
+
+// GetBlock returns the block with the decoded transactions of verbosity 2.
+func (c *rpcClient) GetBlock(ctx context.Context, hash string) (*Block, error) {
+	var result rpcBlock
+
+	if err := c.call(ctx, "getblock", []any{hash, 2}, &result); err != nil {
+		return nil, err
+	}
+
+	block := Block{
+		Hash:              result.Hash,
+		Height:            result.Height,
+		Time:              result.Time,
+		PreviousBlockHash: result.PreviousBlockHash,
+		Transactions:      make([]Transaction, 0, len(result.Transactions)),
+	}
+
+	for _, transaction := range result.Transactions {
+		normalized, err := transaction.normalize()
+		if err != nil {
+			return nil, fmt.Errorf("normalize transaction %s: %w", transaction.TxID, err)
+		}
+
+		block.Transactions = append(block.Transactions, *normalized)
+	}
+
+	return &block, nil
+}
+
+// GetTransaction returns the decoded transaction, the node must maintain the transaction index for transactions not in the mempool.
+func (c *rpcClient) GetTransaction(ctx context.Context, txID string) (*Transaction, error) {
+	var result rpcTransaction
+
+	if err := c.call(ctx, "getrawtransaction", []any{txID, true}, &result); err != nil {
+		return nil, err
+	}
+
+	return result.normalize()
+}
+
+type rpcBlock struct {
+	Hash              string           `json:"hash"`
+	Height            uint64           `json:"height"`
+	Time              int64            `json:"time"`
+	PreviousBlockHash string           `json:"previousblockhash"`
+	Transactions      []rpcTransaction `json:"tx"`
+}
+
+type rpcTransaction struct {
+	TxID    string      `json:"txid"`
+	Inputs  []rpcInput  `json:"vin"`
+	Outputs []rpcOutput `json:"vout"`
+}
+
+type rpcInput struct {
+	TxID     string     `json:"txid"`
+	Vout     uint32     `json:"vout"`
+	Coinbase string     `json:"coinbase"`
+	Witness  []string   `json:"txinwitness"`
+	Prevout  *rpcOutput `json:"prevout"`
+}
+
+// rpcOutput is an output of which the value is in BTC.
+type rpcOutput struct {
+	Value        json.Number `json:"value"`
+	ScriptPubKey struct {
+		Hex     string `json:"hex"`
+		Address string `json:"address"`
+	} `json:"scriptPubKey"`
+}
+
+func (t rpcTransaction) normalize() (*Transaction, error) {
+	transaction := Transaction{
+		TxID:    t.TxID,
+		Inputs:  make([]Input, 0, len(t.Inputs)),
+		Outputs: make([]Output, 0, len(t.Outputs)),
+	}
+
+	for _, input := range t.Inputs {
+		normalized := Input{
+			TxID:     input.TxID,
+			Vout:     input.Vout,
+			Coinbase: input.Coinbase,
+			Witness:  input.Witness,
+		}
+
+		// Prevouts are returned by verbosity 3 of Bitcoin Core v25 and later
+		if input.Prevout != nil {
+			prevout, err := input.Prevout.normalize()
+			if err != nil {
+				return nil, fmt.Errorf("normalize prevout: %w", err)
+			}
+
+			normalized.Prevout = prevout
+		}
+
+		transaction.Inputs = append(transaction.Inputs, normalized)
+	}
+
+	for _, output := range t.Outputs {
+		normalized, err := output.normalize()
+		if err != nil {
+			return nil, fmt.Errorf("normalize output: %w", err)
+		}
+
+		transaction.Outputs = append(transaction.Outputs, *normalized)
+	}
+
+	return &transaction, nil
+}
+
+func (o rpcOutput) normalize() (*Output, error) {
+	value, err := decimal.NewFromString(o.Value.String())
+	if err != nil {
+		return nil, fmt.Errorf("parse value %s: %w", o.Value, err)
+	}
+
+	return &Output{
+		Value:        uint64(value.Shift(Decimals).IntPart()),
+		Address:      o.ScriptPubKey.Address,
+		ScriptPubKey: o.ScriptPubKey.Hex,
+	}, nil
+}
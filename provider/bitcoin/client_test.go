@@ -0,0 +1,151 @@
+package bitcoin_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rss3-network/node/provider/bitcoin"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+const testBlockHash = "0f9188f13cb7b2c71f2a335e3a4fc328bf5beb436012afca590b1a11466e2206"
+
+// newRPCServer starts a local JSON-RPC server of Bitcoin Core serving a block of verbosity 2.
+func newRPCServer(t *testing.T) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		var body struct {
+			Method string `json:"method"`
+		}
+
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		switch body.Method {
+		case "getblockcount":
+			_, _ = fmt.Fprint(writer, `{"result":101,"error":null,"id":"rss3"}`)
+		case "getblock":
+			_, _ = fmt.Fprintf(writer, `{"result":{"hash":"%s","height":101,"time":1731405600,"previousblockhash":"3a7c5b2e8f9d1e0c4b6a7d8e9f0a1b2c3d4e5f60718293a4b5c6d7e8f9a0b1c2","tx":[
+				{"txid":"b9f8a7e6d5c4b3a2918070605040302010f0e0d0c0b0a0908070605040302010","vin":[{"coinbase":"0165","sequence":4294967295}],"vout":[{"value":50.00000000,"n":0,"scriptPubKey":{"hex":"0014751e76e8199196d454941c45d1b3a323f1433bd6","address":"bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080","type":"witness_v0_keyhash"}}]},
+				{"txid":"4d3c2b1a0f9e8d7c6b5a49382716051f4e3d2c1b0a99887766554433221100ff","vin":[{"txid":"b9f8a7e6d5c4b3a2918070605040302010f0e0d0c0b0a0908070605040302010","vout":0,"scriptSig":{"asm":"","hex":""},"txinwitness":["3044","02"],"sequence":4294967293}],"vout":[{"value":0.1,"n":0,"scriptPubKey":{"hex":"0014","address":"bcrt1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qzf6rtl","type":"witness_v0_keyhash"}},{"value":49.89999859,"n":1,"scriptPubKey":{"hex":"0014751e76e8199196d454941c45d1b3a323f1433bd6","address":"bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080","type":"witness_v0_keyhash"}},{"value":0,"n":2,"scriptPubKey":{"hex":"6a0568656c6c6f","type":"nulldata"}}]}
+			]},"error":null,"id":"rss3"}`, testBlockHash)
+		default:
+			_, _ = fmt.Fprint(writer, `{"result":null,"error":{"code":-32601,"message":"Method not found"},"id":"rss3"}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+// newEsploraServer starts a local Esplora API serving a block of 27 transactions, which are returned by pages of 25.
+func newEsploraServer(t *testing.T) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch {
+		case request.URL.Path == "/api/blocks/tip/height":
+			_, _ = fmt.Fprint(writer, "101")
+		case request.URL.Path == "/api/block-height/101":
+			_, _ = fmt.Fprint(writer, testBlockHash)
+		case request.URL.Path == "/api/block/"+testBlockHash:
+			_, _ = fmt.Fprintf(writer, `{"id":"%s","height":101,"timestamp":1731405600,"previousblockhash":"3a7c5b2e8f9d1e0c4b6a7d8e9f0a1b2c3d4e5f60718293a4b5c6d7e8f9a0b1c2","tx_count":27}`, testBlockHash)
+		case strings.HasPrefix(request.URL.Path, "/api/block/"+testBlockHash+"/txs/"):
+			var startIndex int
+
+			_, _ = fmt.Sscanf(strings.TrimPrefix(request.URL.Path, "/api/block/"+testBlockHash+"/txs/"), "%d", &startIndex)
+
+			transactions := lo.Map(lo.Range(min(25, 27-startIndex)), func(index int, _ int) map[string]any {
+				return map[string]any{
+					"txid": fmt.Sprintf("%064x", startIndex+index),
+					"vin": []map[string]any{
+						{
+							"txid":        fmt.Sprintf("%064x", 1000+startIndex+index),
+							"vout":        1,
+							"prevout":     map[string]any{"scriptpubkey": "0014", "scriptpubkey_address": "bcrt1qsender", "value": 20000},
+							"scriptsig":   "",
+							"witness":     []string{"3044", "02"},
+							"is_coinbase": false,
+						},
+					},
+					"vout": []map[string]any{
+						{"scriptpubkey": "0014", "scriptpubkey_address": "bcrt1qrecipient", "value": 19000},
+					},
+				}
+			})
+
+			_ = json.NewEncoder(writer).Encode(transactions)
+		default:
+			http.NotFound(writer, request)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL + "/api"
+}
+
+func TestClient_GetBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("JSON-RPC", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := bitcoin.Dial(context.Background(), newRPCServer(t), bitcoin.APIRPC)
+		require.NoError(t, err)
+
+		height, err := client.GetBlockHeight(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, uint64(101), height)
+
+		block, err := client.GetBlock(context.Background(), testBlockHash)
+		require.NoError(t, err)
+
+		require.Equal(t, uint64(101), block.Height)
+		require.Len(t, block.Transactions, 2)
+		require.True(t, block.Transactions[0].IsCoinbase())
+		require.False(t, block.Transactions[1].IsCoinbase())
+
+		// Values in BTC are converted to satoshis, and outputs without addresses are kept
+		require.Equal(t, []bitcoin.Output{
+			{Value: 10000000, Address: "bcrt1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qzf6rtl", ScriptPubKey: "0014"},
+			{Value: 4989999859, Address: "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080", ScriptPubKey: "0014751e76e8199196d454941c45d1b3a323f1433bd6"},
+			{Value: 0, ScriptPubKey: "6a0568656c6c6f"},
+		}, block.Transactions[1].Outputs)
+
+		_, err = client.GetTransaction(context.Background(), "4d3c2b1a0f9e8d7c6b5a49382716051f4e3d2c1b0a99887766554433221100ff")
+		require.ErrorContains(t, err, "Method not found")
+	})
+
+	t.Run("Esplora", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := bitcoin.Dial(context.Background(), newEsploraServer(t), bitcoin.APIEsplora)
+		require.NoError(t, err)
+
+		height, err := client.GetBlockHeight(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, uint64(101), height)
+
+		hash, err := client.GetBlockHash(context.Background(), height)
+		require.NoError(t, err)
+		require.Equal(t, testBlockHash, hash)
+
+		block, err := client.GetBlock(context.Background(), hash)
+		require.NoError(t, err)
+
+		require.Equal(t, "3a7c5b2e8f9d1e0c4b6a7d8e9f0a1b2c3d4e5f60718293a4b5c6d7e8f9a0b1c2", block.PreviousBlockHash)
+		require.Len(t, block.Transactions, 27)
+		require.Equal(t, fmt.Sprintf("%064x", 26), block.Transactions[26].TxID)
+		require.Equal(t, &bitcoin.Output{Value: 20000, Address: "bcrt1qsender", ScriptPubKey: "0014"}, block.Transactions[26].Inputs[0].Prevout)
+	})
+}
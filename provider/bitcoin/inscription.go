@@ -0,0 +1,188 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+// Opcodes of the scripts used by the envelopes of inscriptions.
+const (
+	opFalse     = 0x00
+	opPushData1 = 0x4c
+	opPushData2 = 0x4d
+	opPushData4 = 0x4e
+	op1Negate   = 0x4f
+	op1         = 0x51
+	op16        = 0x60
+	opIf        = 0x63
+	opEndIf     = 0x68
+)
+
+// annexTag is the first byte of the annex, which is the last element of a witness if present.
+const annexTag = 0x50
+
+// inscriptionTagContentType is the tag of the content type of inscriptions.
+const inscriptionTagContentType = 1
+
+var inscriptionProtocolID = []byte("ord")
+
+// Inscription is an Ordinals inscription, which is revealed by the envelope in the tapscript of an input.
+type Inscription struct {
+	ContentType string
+	Body        []byte
+}
+
+// InscriptionID returns the ID of the inscription of the index in the reveal transaction.
+func InscriptionID(txID string, index int) string {
+	return fmt.Sprintf("%si%d", txID, index)
+}
+
+// ParseInscriptions returns the inscriptions of the input by its witness, inputs not spent by a script path have no inscriptions.
+func ParseInscriptions(witness []string) ([]Inscription, error) {
+	elements := make([][]byte, 0, len(witness))
+
+	for _, element := range witness {
+		data, err := hex.DecodeString(element)
+		if err != nil {
+			return nil, fmt.Errorf("decode witness: %w", err)
+		}
+
+		elements = append(elements, data)
+	}
+
+	if len(elements) >= 2 && len(elements[len(elements)-1]) > 0 && elements[len(elements)-1][0] == annexTag {
+		elements = elements[:len(elements)-1]
+	}
+
+	// A script path spend has the tapscript and the control block as the last elements
+	if len(elements) < 2 {
+		return nil, nil
+	}
+
+	instructions, err := parseScript(elements[len(elements)-2])
+	if err != nil {
+		// Scripts failing to parse are not valid tapscripts, which have no inscriptions either
+		return nil, nil //nolint:nilerr
+	}
+
+	var inscriptions []Inscription
+
+	for index := 0; index+2 < len(instructions); index++ {
+		if !instructions[index].isPush || len(instructions[index].data) != 0 ||
+			instructions[index+1].opcode != opIf ||
+			!instructions[index+2].isPush || !bytes.Equal(instructions[index+2].data, inscriptionProtocolID) {
+			continue
+		}
+
+		inscription, end, ok := parseEnvelope(instructions, index+3)
+		if !ok {
+			continue
+		}
+
+		inscriptions = append(inscriptions, inscription)
+		index = end
+	}
+
+	return inscriptions, nil
+}
+
+// parseEnvelope parses the fields of the envelope from the instruction, and returns the index of OP_ENDIF.
+// The fields are pairs of tags and values before the body, whose tag is an empty push followed by the chunks of the body.
+func parseEnvelope(instructions []instruction, start int) (Inscription, int, bool) {
+	var (
+		inscription Inscription
+		body        bool
+	)
+
+	for index := start; index < len(instructions); index++ {
+		current := instructions[index]
+
+		switch {
+		case current.opcode == opEndIf:
+			return inscription, index, true
+		case !current.isPush:
+			return Inscription{}, index, false
+		case body:
+			inscription.Body = append(inscription.Body, current.data...)
+		case len(current.data) == 0:
+			// The tag of the body is an empty push
+			body = true
+		case index+1 < len(instructions) && instructions[index+1].isPush:
+			if len(current.data) == 1 && current.data[0] == inscriptionTagContentType {
+				inscription.ContentType = string(instructions[index+1].data)
+			}
+
+			index++
+		default:
+			return Inscription{}, index, false
+		}
+	}
+
+	return Inscription{}, len(instructions), false
+}
+
+type instruction struct {
+	opcode byte
+	data   []byte
+	isPush bool
+}
+
+// parseScript parses the script to instructions, the small integers pushed by opcodes are treated as data.
+func parseScript(script []byte) ([]instruction, error) {
+	var instructions []instruction
+
+	for offset := 0; offset < len(script); {
+		opcode := script[offset]
+		offset++
+
+		var size int
+
+		switch {
+		case opcode == opFalse:
+			instructions = append(instructions, instruction{opcode: opcode, data: []byte{}, isPush: true})
+
+			continue
+		case opcode < opPushData1:
+			size = int(opcode)
+		case opcode == opPushData1, opcode == opPushData2, opcode == opPushData4:
+			width := map[byte]int{opPushData1: 1, opPushData2: 2, opPushData4: 4}[opcode]
+			if offset+width > len(script) {
+				return nil, fmt.Errorf("invalid push at %d", offset)
+			}
+
+			switch width {
+			case 1:
+				size = int(script[offset])
+			case 2:
+				size = int(binary.LittleEndian.Uint16(script[offset:]))
+			default:
+				size = int(binary.LittleEndian.Uint32(script[offset:]))
+			}
+
+			offset += width
+		case opcode == op1Negate:
+			instructions = append(instructions, instruction{opcode: opcode, data: []byte{0x81}, isPush: true})
+
+			continue
+		case opcode >= op1 && opcode <= op16:
+			instructions = append(instructions, instruction{opcode: opcode, data: []byte{opcode - op1 + 1}, isPush: true})
+
+			continue
+		default:
+			instructions = append(instructions, instruction{opcode: opcode})
+
+			continue
+		}
+
+		if size < 0 || offset+size > len(script) {
+			return nil, fmt.Errorf("invalid push of %d bytes at %d", size, offset)
+		}
+
+		instructions = append(instructions, instruction{opcode: opcode, data: script[offset : offset+size], isPush: true})
+		offset += size
+	}
+
+	return instructions, nil
+}
@@ -0,0 +1,83 @@
+package bitcoin_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/rss3-network/node/provider/bitcoin"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSignature    = "11111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111"
+	testControlBlock = "c1f30544d6009c8d8d94f5d030b2e844b1a3ca036255161c479db1cca5b374dd1c"
+	// testScript is <pubkey> OP_CHECKSIG OP_FALSE OP_IF "ord" OP_1 "text/plain;charset=utf-8" OP_0 "Hello, world!" OP_ENDIF.
+	testScript = "20f30544d6009c8d8d94f5d030b2e844b1a3ca036255161c479db1cca5b374dd1cac0063036f72645118746578742f706c61696e3b636861727365743d7574662d38000d48656c6c6f2c20776f726c642168"
+)
+
+func TestParseInscriptions(t *testing.T) {
+	t.Parallel()
+
+	// The tag of the content type is pushed as data, and the body of 300 bytes is pushed in chunks of OP_PUSHDATA2 and OP_PUSHDATA1
+	chunkedScript := "0063036f7264" + "0101" + "09" + hex.EncodeToString([]byte("image/png")) +
+		"00" + "4d0401" + strings.Repeat("ab", 260) + "4c28" + strings.Repeat("ab", 40) + "68"
+
+	testcases := []struct {
+		name    string
+		witness []string
+		want    []bitcoin.Inscription
+	}{
+		{
+			name:    "Text inscription",
+			witness: []string{testSignature, testScript, testControlBlock},
+			want: []bitcoin.Inscription{
+				{
+					ContentType: "text/plain;charset=utf-8",
+					Body:        []byte("Hello, world!"),
+				},
+			},
+		},
+		{
+			name:    "Text inscription with annex",
+			witness: []string{testSignature, testScript, testControlBlock, "50"},
+			want: []bitcoin.Inscription{
+				{
+					ContentType: "text/plain;charset=utf-8",
+					Body:        []byte("Hello, world!"),
+				},
+			},
+		},
+		{
+			name:    "Inscription of pushes with lengths",
+			witness: []string{chunkedScript, testControlBlock},
+			want: []bitcoin.Inscription{
+				{
+					ContentType: "image/png",
+					Body:        []byte(strings.Repeat("\xab", 300)),
+				},
+			},
+		},
+		{
+			name:    "Key path spend",
+			witness: []string{testSignature},
+		},
+		{
+			name:    "Script without envelope",
+			witness: []string{testSignature, "20f30544d6009c8d8d94f5d030b2e844b1a3ca036255161c479db1cca5b374dd1cac", testControlBlock},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			inscriptions, err := bitcoin.ParseInscriptions(testcase.witness)
+			require.NoError(t, err)
+
+			require.Equal(t, testcase.want, inscriptions)
+		})
+	}
+}
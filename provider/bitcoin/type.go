@@ -0,0 +1,42 @@
+package bitcoin
+
+// Decimals is the decimals of BTC, whose smallest unit is satoshi.
+const Decimals = 8
+
+// Block is a block with its transactions, the APIs are normalized to this model.
+type Block struct {
+	Hash              string        `json:"hash"`
+	Height            uint64        `json:"height"`
+	Time              int64         `json:"time"`
+	PreviousBlockHash string        `json:"previousblockhash"`
+	Transactions      []Transaction `json:"tx"`
+}
+
+type Transaction struct {
+	TxID    string   `json:"txid"`
+	Inputs  []Input  `json:"vin"`
+	Outputs []Output `json:"vout"`
+}
+
+// IsCoinbase returns whether the transaction is the coinbase transaction of a block, which spends no outputs.
+func (t Transaction) IsCoinbase() bool {
+	return len(t.Inputs) == 1 && t.Inputs[0].Coinbase != ""
+}
+
+// Input spends the output of the index in a previous transaction.
+type Input struct {
+	TxID     string   `json:"txid"`
+	Vout     uint32   `json:"vout"`
+	Coinbase string   `json:"coinbase,omitempty"`
+	Witness  []string `json:"txinwitness,omitempty"`
+	// Prevout is the output spent, which is only returned by some APIs.
+	Prevout *Output `json:"prevout,omitempty"`
+}
+
+type Output struct {
+	// Value is the amount of the output in satoshis.
+	Value uint64 `json:"value"`
+	// Address is the address of the script, which is empty for scripts without addresses such as OP_RETURN.
+	Address      string `json:"address,omitempty"`
+	ScriptPubKey string `json:"scriptpubkey"`
+}
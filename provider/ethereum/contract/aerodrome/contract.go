@@ -0,0 +1,34 @@
+package aerodrome
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+)
+
+// Velodrome on Optimism shares the contracts of Aerodrome on Base.
+
+// Pool
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/Pool.abi --pkg aerodrome --type Pool --out contract_pool.go
+// PoolFactory https://basescan.org/address/0x420DD381b31aEf6683db6B902084cB0FFECe40Da
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/PoolFactory.abi --pkg aerodrome --type PoolFactory --out contract_pool_factory.go
+// CLPool
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/CLPool.abi --pkg aerodrome --type CLPool --out contract_cl_pool.go
+// CLFactory https://basescan.org/address/0x5e7BB104d84c7CB9B682AaC2F3d509f5F406809A
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/CLFactory.abi --pkg aerodrome --type CLFactory --out contract_cl_factory.go
+
+// https://aerodrome.finance/security
+// https://velodrome.finance/security
+var (
+	AddressRouter                                = common.HexToAddress("0xcF77a3Ba9A5CA399B7c97c74d54e5b1Beb874E43")
+	AddressPoolFactory                           = common.HexToAddress("0x420DD381b31aEf6683db6B902084cB0FFECe40Da")
+	AddressCLSwapRouter                          = common.HexToAddress("0xBE6D8f0d05cC4be24d5167a3eF062215bE6D18a5")
+	AddressCLFactory                             = common.HexToAddress("0x5e7BB104d84c7CB9B682AaC2F3d509f5F406809A")
+	AddressCLNonfungiblePositionManager          = common.HexToAddress("0x827922686190790b37229fd06084350E74485b72")
+	AddressVelodromeRouter                       = common.HexToAddress("0xa062aE8A9c5e11aaA026fc2670B0D65cCc8B2858")
+	AddressVelodromePoolFactory                  = common.HexToAddress("0xF1046053aa5682b4F9a81b5481394DA16BE5FF5a")
+	AddressVelodromeCLSwapRouter                 = common.HexToAddress("0x0792a633F0c19c351081CF4B211F68F79bCc9676")
+	AddressVelodromeCLFactory                    = common.HexToAddress("0xCc0bDDB707055e04e497aB22a59c2aF4391cd12F")
+	AddressVelodromeCLNonfungiblePositionManager = common.HexToAddress("0x416b433906b1B72FA758e166e239c43d68dC6F29")
+
+	EventHashPoolSwap = contract.EventHash("Swap(address,address,uint256,uint256,uint256,uint256)")
+)
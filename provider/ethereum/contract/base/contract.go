@@ -16,6 +16,7 @@ import (
 var (
 	AddressOptimismPortal         = common.HexToAddress("0x49048044D57e1C92A77f79988d21Fa8fAF74E97e")
 	AddressL1StandardBridge       = common.HexToAddress("0x3154Cf16ccdb4C6d922629664174b904d80F2C35")
+	AddressL1CrossDomainMessenger = common.HexToAddress("0x866E82a600A1414e583f7F13623F1aC5d58b0Afa")
 	AddressL2CrossDomainMessenger = common.HexToAddress("0x4200000000000000000000000000000000000007")
 
 	EventHashOptimismPortalTransactionDeposited           = contract.EventHash("TransactionDeposited(address,address,uint256,bytes)")
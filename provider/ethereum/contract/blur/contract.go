@@ -0,0 +1,43 @@
+package blur
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+)
+
+// BlurExchange https://etherscan.io/address/0x000000000000Ad05Ccc4F10045630fb830B95127
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/BlurExchange.abi --pkg blur --type BlurExchange --out blur_exchange.go
+// BlurExchangeV2 https://etherscan.io/address/0xb2ecfE4E4D61f8790bbb9DE2D1259B9e2410CEA5
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/BlurExchangeV2.abi --pkg blur --type BlurExchangeV2 --out blur_exchange_v2.go
+// Blend https://etherscan.io/address/0x29469395eAf6f95920E59F858042f0e28D98a20B
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/Blend.abi --pkg blur --type Blend --out blend.go
+
+var (
+	AddressBlurExchange   = common.HexToAddress("0x000000000000Ad05Ccc4F10045630fb830B95127")
+	AddressBlurExchangeV2 = common.HexToAddress("0xb2ecfE4E4D61f8790bbb9DE2D1259B9e2410CEA5")
+	AddressBlend          = common.HexToAddress("0x29469395eAf6f95920E59F858042f0e28D98a20B")
+	// BlurPool is the ETH pool paying the bids of the exchange and the loans of Blend.
+	AddressBlurPool = common.HexToAddress("0x0000000000A39bb272e79075ade125fd351887Ac")
+	// Aggregator routes the trades of the Blur front-end to the other marketplaces.
+	AddressAggregator = common.HexToAddress("0x39da41747a83aeE658334415666f3EF92DD0D541")
+
+	EventHashBlurExchangeOrdersMatched                = contract.EventHash("OrdersMatched(address,address,(address,uint8,address,address,uint256,uint256,address,uint256,uint256,uint256,(uint16,address)[],uint256,bytes),bytes32,(address,uint8,address,address,uint256,uint256,address,uint256,uint256,uint256,(uint16,address)[],uint256,bytes),bytes32)")
+	EventHashBlurExchangeV2Execution                  = contract.EventHash("Execution((address,uint256,uint256,address,uint8),bytes32,uint256,uint256,(address,uint16),((address,uint16),(address,uint16)),uint8)")
+	EventHashBlurExchangeV2Execution721Packed         = contract.EventHash("Execution721Packed(bytes32,uint256,uint256)")
+	EventHashBlurExchangeV2Execution721TakerFeePacked = contract.EventHash("Execution721TakerFeePacked(bytes32,uint256,uint256,uint256)")
+	EventHashBlurExchangeV2Execution721MakerFeePacked = contract.EventHash("Execution721MakerFeePacked(bytes32,uint256,uint256,uint256)")
+	EventHashBlendLoanOfferTaken                      = contract.EventHash("LoanOfferTaken(bytes32,uint256,address,address,address,uint256,uint256,uint256,uint256)")
+	EventHashBlendRefinance                           = contract.EventHash("Refinance(uint256,address,address,uint256,uint256,uint256)")
+	EventHashBlendRepay                               = contract.EventHash("Repay(uint256,address)")
+	EventHashBlendStartAuction                        = contract.EventHash("StartAuction(uint256,address)")
+	EventHashBlendSeize                               = contract.EventHash("Seize(uint256,address)")
+	EventHashBlendBuyLocked                           = contract.EventHash("BuyLocked(uint256,address,address,address,uint256)")
+)
+
+const (
+	OrderTypeAsk uint8 = 0
+	OrderTypeBid uint8 = 1
+
+	AssetTypeERC721  uint8 = 0
+	AssetTypeERC1155 uint8 = 1
+)
@@ -0,0 +1,33 @@
+package blur
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PackedExecution is an execution of an ERC-721 order, which is packed into two words by the BlurExchangeV2.
+type PackedExecution struct {
+	Trader       common.Address
+	TokenID      *big.Int
+	ListingIndex uint8
+	Collection   common.Address
+	Price        *big.Int
+	OrderType    uint8
+}
+
+// UnpackExecution unpacks the tokenIdListingIndexTrader and collectionPriceSide words of the packed execution events.
+// The token ID takes the upper 11 bytes, the listing index takes 1 byte and the trader takes the lower 20 bytes of the first word,
+// and the order type takes the upper 1 byte, the price takes 11 bytes and the collection takes the lower 20 bytes of the second word.
+func UnpackExecution(tokenIDListingIndexTrader, collectionPriceSide *big.Int) PackedExecution {
+	first, second := common.BigToHash(tokenIDListingIndexTrader), common.BigToHash(collectionPriceSide)
+
+	return PackedExecution{
+		Trader:       common.BytesToAddress(first[12:]),
+		TokenID:      new(big.Int).SetBytes(first[:11]),
+		ListingIndex: first[11],
+		Collection:   common.BytesToAddress(second[12:]),
+		Price:        new(big.Int).SetBytes(second[1:12]),
+		OrderType:    second[0],
+	}
+}
@@ -0,0 +1,72 @@
+package blur_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/provider/ethereum/contract/blur"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnpackExecution(t *testing.T) {
+	t.Parallel()
+
+	type arguments struct {
+		tokenIDListingIndexTrader string
+		collectionPriceSide       string
+	}
+
+	testcases := []struct {
+		name      string
+		arguments arguments
+		want      blur.PackedExecution
+	}{
+		{
+			name: "Ask",
+			arguments: arguments{
+				tokenIDListingIndexTrader: "0x0000000000000000001007004e2b1d8c3a5f6e7d8c9b0a1f2e3d4c5b6a798877",
+				collectionPriceSide:       "0x00000000482a1c7300080000ed5af388653567af2f388e6224dc7c4b3241c544",
+			},
+			want: blur.PackedExecution{
+				Trader:       common.HexToAddress("0x4e2b1d8c3a5f6e7d8c9b0a1f2e3d4c5b6a798877"),
+				TokenID:      big.NewInt(4103),
+				ListingIndex: 0,
+				Collection:   common.HexToAddress("0xED5AF388653567Af2F388E6224dC7C4b3241C544"),
+				Price:        lo.Must(new(big.Int).SetString("5200000000000000000", 10)),
+				OrderType:    blur.OrderTypeAsk,
+			},
+		},
+		{
+			name: "Bid of a listing",
+			arguments: arguments{
+				tokenIDListingIndexTrader: "0x0000000000000000001007034e2b1d8c3a5f6e7d8c9b0a1f2e3d4c5b6a798877",
+				collectionPriceSide:       "0x01000000482a1c7300080000ed5af388653567af2f388e6224dc7c4b3241c544",
+			},
+			want: blur.PackedExecution{
+				Trader:       common.HexToAddress("0x4e2b1d8c3a5f6e7d8c9b0a1f2e3d4c5b6a798877"),
+				TokenID:      big.NewInt(4103),
+				ListingIndex: 3,
+				Collection:   common.HexToAddress("0xED5AF388653567Af2F388E6224dC7C4b3241C544"),
+				Price:        lo.Must(new(big.Int).SetString("5200000000000000000", 10)),
+				OrderType:    blur.OrderTypeBid,
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			execution := blur.UnpackExecution(
+				common.HexToHash(testcase.arguments.tokenIDListingIndexTrader).Big(),
+				common.HexToHash(testcase.arguments.collectionPriceSide).Big(),
+			)
+
+			require.Equal(t, testcase.want, execution)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package blur
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// lienLength is the length of an ABI encoded lien followed by its ID, both of which are static.
+const lienLength = 10 * common.HashLength
+
+// Lien is a loan of Blend, which is stored as a hash and only emitted partially by the events,
+// so it is read from the arguments of the calls repaying, refinancing, auctioning and seizing it.
+type Lien struct {
+	Lender            common.Address
+	Borrower          common.Address
+	Collection        common.Address
+	TokenID           *big.Int
+	Amount            *big.Int
+	StartTime         *big.Int
+	Rate              *big.Int
+	AuctionStartBlock *big.Int
+	AuctionDuration   *big.Int
+}
+
+// FindLien finds the lien of the collection and ID in the calldata. All functions of Blend operating a lien
+// take it followed by its ID, so the calldata is scanned for them instead of being decoded by the function,
+// which also finds the liens in the calls of routers and multicalls.
+func FindLien(input []byte, collection common.Address, lienID *big.Int) (*Lien, bool) {
+	var (
+		collectionWord = common.BytesToHash(collection.Bytes())
+		lienIDWord     = common.BigToHash(lienID)
+	)
+
+	for offset := 0; offset+lienLength <= len(input); offset++ {
+		words := input[offset : offset+lienLength]
+
+		if !bytes.Equal(word(words, 2), collectionWord.Bytes()) || !bytes.Equal(word(words, 9), lienIDWord.Bytes()) {
+			continue
+		}
+
+		// The lender and the borrower must be addresses.
+		if !isAddressWord(word(words, 0)) || !isAddressWord(word(words, 1)) {
+			continue
+		}
+
+		return &Lien{
+			Lender:            common.BytesToAddress(word(words, 0)),
+			Borrower:          common.BytesToAddress(word(words, 1)),
+			Collection:        collection,
+			TokenID:           new(big.Int).SetBytes(word(words, 3)),
+			Amount:            new(big.Int).SetBytes(word(words, 4)),
+			StartTime:         new(big.Int).SetBytes(word(words, 5)),
+			Rate:              new(big.Int).SetBytes(word(words, 6)),
+			AuctionStartBlock: new(big.Int).SetBytes(word(words, 7)),
+			AuctionDuration:   new(big.Int).SetBytes(word(words, 8)),
+		}, true
+	}
+
+	return nil, false
+}
+
+func word(data []byte, index int) []byte {
+	return data[index*common.HashLength : (index+1)*common.HashLength]
+}
+
+func isAddressWord(data []byte) bool {
+	return bytes.Equal(data[:common.HashLength-common.AddressLength], make([]byte, common.HashLength-common.AddressLength))
+}
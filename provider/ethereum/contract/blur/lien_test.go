@@ -0,0 +1,94 @@
+package blur_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rss3-network/node/provider/ethereum/contract/blur"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindLien(t *testing.T) {
+	t.Parallel()
+
+	// The arguments of repay((address,address,address,uint256,uint256,uint256,uint256,uint256,uint256),uint256).
+	repayArguments := "000000000000000000000000843829986e895fac0f1a2d8e7e3b0e33f9a9c0d1" +
+		"0000000000000000000000002a0a0b2c8a7f1d3e4c5b6a798877665544332211" +
+		"000000000000000000000000ed5af388653567af2f388e6224dc7c4b3241c544" +
+		"0000000000000000000000000000000000000000000000000000000000001007" +
+		"00000000000000000000000000000000000000000000000029a2241af62c0000" +
+		"00000000000000000000000000000000000000000000000000000000670e7240" +
+		"00000000000000000000000000000000000000000000000000000000000005dc" +
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff" +
+		"0000000000000000000000000000000000000000000000000000000000002328" +
+		"000000000000000000000000000000000000000000000000000000000001e240"
+
+	lien := &blur.Lien{
+		Lender:            common.HexToAddress("0x843829986e895fac0f1a2d8e7e3b0e33f9a9c0d1"),
+		Borrower:          common.HexToAddress("0x2a0a0b2c8a7f1d3e4c5b6a798877665544332211"),
+		Collection:        common.HexToAddress("0xED5AF388653567Af2F388E6224dC7C4b3241C544"),
+		TokenID:           big.NewInt(4103),
+		Amount:            lo.Must(new(big.Int).SetString("3000000000000000000", 10)),
+		StartTime:         big.NewInt(1729000000),
+		Rate:              big.NewInt(1500),
+		AuctionStartBlock: new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)),
+		AuctionDuration:   big.NewInt(9000),
+	}
+
+	type arguments struct {
+		input      []byte
+		collection common.Address
+		lienID     *big.Int
+	}
+
+	testcases := []struct {
+		name      string
+		arguments arguments
+		want      *blur.Lien
+		wantFound bool
+	}{
+		{
+			name: "Repay",
+			arguments: arguments{
+				input:      hexutil.MustDecode("0xd4a9e0a8" + repayArguments),
+				collection: lien.Collection,
+				lienID:     big.NewInt(123456),
+			},
+			want:      lien,
+			wantFound: true,
+		},
+		{
+			name: "Repay in a multicall",
+			arguments: arguments{
+				input:      hexutil.MustDecode("0xac9650d8" + "0000000000000000000000000000000000000000000000000000000000000144" + "d4a9e0a8" + repayArguments + "00000000000000000000000000000000000000000000000000000000"),
+				collection: lien.Collection,
+				lienID:     big.NewInt(123456),
+			},
+			want:      lien,
+			wantFound: true,
+		},
+		{
+			name: "Another lien",
+			arguments: arguments{
+				input:      hexutil.MustDecode("0xd4a9e0a8" + repayArguments),
+				collection: lien.Collection,
+				lienID:     big.NewInt(123457),
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			lien, found := blur.FindLien(testcase.arguments.input, testcase.arguments.collection, testcase.arguments.lienID)
+			require.Equal(t, testcase.wantFound, found)
+			require.Equal(t, testcase.want, lien)
+		})
+	}
+}
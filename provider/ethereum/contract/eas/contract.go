@@ -0,0 +1,56 @@
+package eas
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+	"github.com/rss3-network/protocol-go/schema/network"
+)
+
+// EAS https://github.com/ethereum-attestation-service/eas-contracts/blob/v1.3.0/contracts/EAS.sol
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/EAS.abi --pkg eas --type EAS --out eas.go
+
+// SchemaRegistry https://github.com/ethereum-attestation-service/eas-contracts/blob/v1.3.0/contracts/SchemaRegistry.sol
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/SchemaRegistry.abi --pkg eas --type SchemaRegistry --out schema_registry.go
+
+var (
+	// EAS https://etherscan.io/address/0xA1207F3BBa224E2c9c3c6D5aF63D0eb1582Ce587
+	AddressEASMainnet = common.HexToAddress("0xA1207F3BBa224E2c9c3c6D5aF63D0eb1582Ce587")
+	// SchemaRegistry https://etherscan.io/address/0xA7b39296258348C78294F95B872b282326A97BDF
+	AddressSchemaRegistryMainnet = common.HexToAddress("0xA7b39296258348C78294F95B872b282326A97BDF")
+	// EAS is a predeploy of the OP Stack https://specs.optimism.io/protocol/predeploys.html#eas
+	AddressEASOPStack = common.HexToAddress("0x4200000000000000000000000000000000000021")
+	// SchemaRegistry is a predeploy of the OP Stack https://specs.optimism.io/protocol/predeploys.html#schemaregistry
+	AddressSchemaRegistryOPStack = common.HexToAddress("0x4200000000000000000000000000000000000020")
+	// EAS https://arbiscan.io/address/0xbD75f629A22Dc1ceD33dDA0b68c546A1c035c458
+	AddressEASArbitrum = common.HexToAddress("0xbD75f629A22Dc1ceD33dDA0b68c546A1c035c458")
+	// SchemaRegistry https://arbiscan.io/address/0xA310da9c5B885E7fb3fbA9D66E9Ba6Df512b78eB
+	AddressSchemaRegistryArbitrum = common.HexToAddress("0xA310da9c5B885E7fb3fbA9D66E9Ba6Df512b78eB")
+	// EAS https://lineascan.build/address/0xaEF4103A04090071165F78D45D83A0C0782c2B2a
+	AddressEASLinea = common.HexToAddress("0xaEF4103A04090071165F78D45D83A0C0782c2B2a")
+	// SchemaRegistry https://lineascan.build/address/0x55D26f9ae0203EF95494AE4C170eD35f4Cf77797
+	AddressSchemaRegistryLinea = common.HexToAddress("0x55D26f9ae0203EF95494AE4C170eD35f4Cf77797")
+
+	EventAttested = contract.EventHash("Attested(address,address,bytes32,bytes32)")
+	EventRevoked  = contract.EventHash("Revoked(address,address,bytes32,bytes32)")
+)
+
+// Deployment is a deployment of EAS and its SchemaRegistry.
+type Deployment struct {
+	EAS            common.Address
+	SchemaRegistry common.Address
+}
+
+var deployments = map[network.Network]Deployment{
+	network.Ethereum: {EAS: AddressEASMainnet, SchemaRegistry: AddressSchemaRegistryMainnet},
+	network.Optimism: {EAS: AddressEASOPStack, SchemaRegistry: AddressSchemaRegistryOPStack},
+	network.Base:     {EAS: AddressEASOPStack, SchemaRegistry: AddressSchemaRegistryOPStack},
+	network.Arbitrum: {EAS: AddressEASArbitrum, SchemaRegistry: AddressSchemaRegistryArbitrum},
+	network.Linea:    {EAS: AddressEASLinea, SchemaRegistry: AddressSchemaRegistryLinea},
+}
+
+// DeploymentOf returns the deployment of EAS on the network.
+func DeploymentOf(n network.Network) (Deployment, bool) {
+	deployment, ok := deployments[n]
+
+	return deployment, ok
+}
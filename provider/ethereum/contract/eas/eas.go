@@ -0,0 +1,556 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package eas
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Attestation is an auto generated low-level Go binding around an user-defined struct.
+type Attestation struct {
+	Uid            [32]byte
+	Schema         [32]byte
+	Time           uint64
+	ExpirationTime uint64
+	RevocationTime uint64
+	RefUID         [32]byte
+	Recipient      common.Address
+	Attester       common.Address
+	Revocable      bool
+	Data           []byte
+}
+
+// EASMetaData contains all meta data concerning the EAS contract.
+var EASMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"schemaUID\",\"type\":\"bytes32\"}],\"name\":\"Attested\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"schemaUID\",\"type\":\"bytes32\"}],\"name\":\"Revoked\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"}],\"name\":\"getAttestation\",\"outputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// EASABI is the input ABI used to generate the binding from.
+// Deprecated: Use EASMetaData.ABI instead.
+var EASABI = EASMetaData.ABI
+
+// EAS is an auto generated Go binding around an Ethereum contract.
+type EAS struct {
+	EASCaller     // Read-only binding to the contract
+	EASTransactor // Write-only binding to the contract
+	EASFilterer   // Log filterer for contract events
+}
+
+// EASCaller is an auto generated read-only Go binding around an Ethereum contract.
+type EASCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EASTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type EASTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EASFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type EASFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EASSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type EASSession struct {
+	Contract     *EAS              // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// EASCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type EASCallerSession struct {
+	Contract *EASCaller    // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// EASTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type EASTransactorSession struct {
+	Contract     *EASTransactor    // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// EASRaw is an auto generated low-level Go binding around an Ethereum contract.
+type EASRaw struct {
+	Contract *EAS // Generic contract binding to access the raw methods on
+}
+
+// EASCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type EASCallerRaw struct {
+	Contract *EASCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// EASTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type EASTransactorRaw struct {
+	Contract *EASTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewEAS creates a new instance of EAS, bound to a specific deployed contract.
+func NewEAS(address common.Address, backend bind.ContractBackend) (*EAS, error) {
+	contract, err := bindEAS(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &EAS{EASCaller: EASCaller{contract: contract}, EASTransactor: EASTransactor{contract: contract}, EASFilterer: EASFilterer{contract: contract}}, nil
+}
+
+// NewEASCaller creates a new read-only instance of EAS, bound to a specific deployed contract.
+func NewEASCaller(address common.Address, caller bind.ContractCaller) (*EASCaller, error) {
+	contract, err := bindEAS(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EASCaller{contract: contract}, nil
+}
+
+// NewEASTransactor creates a new write-only instance of EAS, bound to a specific deployed contract.
+func NewEASTransactor(address common.Address, transactor bind.ContractTransactor) (*EASTransactor, error) {
+	contract, err := bindEAS(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EASTransactor{contract: contract}, nil
+}
+
+// NewEASFilterer creates a new log filterer instance of EAS, bound to a specific deployed contract.
+func NewEASFilterer(address common.Address, filterer bind.ContractFilterer) (*EASFilterer, error) {
+	contract, err := bindEAS(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &EASFilterer{contract: contract}, nil
+}
+
+// bindEAS binds a generic wrapper to an already deployed contract.
+func bindEAS(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := EASMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EAS *EASRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EAS.Contract.EASCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EAS *EASRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EAS.Contract.EASTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EAS *EASRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EAS.Contract.EASTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EAS *EASCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EAS.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EAS *EASTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EAS.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EAS *EASTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EAS.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetAttestation is a free data retrieval call binding the contract method 0xa3112a64.
+//
+// Solidity: function getAttestation(bytes32 uid) view returns((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes))
+func (_EAS *EASCaller) GetAttestation(opts *bind.CallOpts, uid [32]byte) (Attestation, error) {
+	var out []interface{}
+	err := _EAS.contract.Call(opts, &out, "getAttestation", uid)
+
+	if err != nil {
+		return *new(Attestation), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(Attestation)).(*Attestation)
+
+	return out0, err
+
+}
+
+// GetAttestation is a free data retrieval call binding the contract method 0xa3112a64.
+//
+// Solidity: function getAttestation(bytes32 uid) view returns((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes))
+func (_EAS *EASSession) GetAttestation(uid [32]byte) (Attestation, error) {
+	return _EAS.Contract.GetAttestation(&_EAS.CallOpts, uid)
+}
+
+// GetAttestation is a free data retrieval call binding the contract method 0xa3112a64.
+//
+// Solidity: function getAttestation(bytes32 uid) view returns((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes))
+func (_EAS *EASCallerSession) GetAttestation(uid [32]byte) (Attestation, error) {
+	return _EAS.Contract.GetAttestation(&_EAS.CallOpts, uid)
+}
+
+// EASAttestedIterator is returned from FilterAttested and is used to iterate over the raw logs and unpacked data for Attested events raised by the EAS contract.
+type EASAttestedIterator struct {
+	Event *EASAttested // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EASAttestedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EASAttested)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EASAttested)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EASAttestedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EASAttestedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EASAttested represents a Attested event raised by the EAS contract.
+type EASAttested struct {
+	Recipient common.Address
+	Attester  common.Address
+	Uid       [32]byte
+	SchemaUID [32]byte
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterAttested is a free log retrieval operation binding the contract event 0x8bf46bf4cfd674fa735a3d63ec1c9ad4153f033c290341f3a588b75685141b35.
+//
+// Solidity: event Attested(address indexed recipient, address indexed attester, bytes32 uid, bytes32 indexed schemaUID)
+func (_EAS *EASFilterer) FilterAttested(opts *bind.FilterOpts, recipient []common.Address, attester []common.Address, schemaUID [][32]byte) (*EASAttestedIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+	var attesterRule []interface{}
+	for _, attesterItem := range attester {
+		attesterRule = append(attesterRule, attesterItem)
+	}
+
+	var schemaUIDRule []interface{}
+	for _, schemaUIDItem := range schemaUID {
+		schemaUIDRule = append(schemaUIDRule, schemaUIDItem)
+	}
+
+	logs, sub, err := _EAS.contract.FilterLogs(opts, "Attested", recipientRule, attesterRule, schemaUIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EASAttestedIterator{contract: _EAS.contract, event: "Attested", logs: logs, sub: sub}, nil
+}
+
+// WatchAttested is a free log subscription operation binding the contract event 0x8bf46bf4cfd674fa735a3d63ec1c9ad4153f033c290341f3a588b75685141b35.
+//
+// Solidity: event Attested(address indexed recipient, address indexed attester, bytes32 uid, bytes32 indexed schemaUID)
+func (_EAS *EASFilterer) WatchAttested(opts *bind.WatchOpts, sink chan<- *EASAttested, recipient []common.Address, attester []common.Address, schemaUID [][32]byte) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+	var attesterRule []interface{}
+	for _, attesterItem := range attester {
+		attesterRule = append(attesterRule, attesterItem)
+	}
+
+	var schemaUIDRule []interface{}
+	for _, schemaUIDItem := range schemaUID {
+		schemaUIDRule = append(schemaUIDRule, schemaUIDItem)
+	}
+
+	logs, sub, err := _EAS.contract.WatchLogs(opts, "Attested", recipientRule, attesterRule, schemaUIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EASAttested)
+				if err := _EAS.contract.UnpackLog(event, "Attested", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAttested is a log parse operation binding the contract event 0x8bf46bf4cfd674fa735a3d63ec1c9ad4153f033c290341f3a588b75685141b35.
+//
+// Solidity: event Attested(address indexed recipient, address indexed attester, bytes32 uid, bytes32 indexed schemaUID)
+func (_EAS *EASFilterer) ParseAttested(log types.Log) (*EASAttested, error) {
+	event := new(EASAttested)
+	if err := _EAS.contract.UnpackLog(event, "Attested", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EASRevokedIterator is returned from FilterRevoked and is used to iterate over the raw logs and unpacked data for Revoked events raised by the EAS contract.
+type EASRevokedIterator struct {
+	Event *EASRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EASRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EASRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EASRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EASRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EASRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EASRevoked represents a Revoked event raised by the EAS contract.
+type EASRevoked struct {
+	Recipient common.Address
+	Attester  common.Address
+	Uid       [32]byte
+	SchemaUID [32]byte
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterRevoked is a free log retrieval operation binding the contract event 0xf930a6e2523c9cc298691873087a740550b8fc85a0680830414c148ed927f615.
+//
+// Solidity: event Revoked(address indexed recipient, address indexed attester, bytes32 uid, bytes32 indexed schemaUID)
+func (_EAS *EASFilterer) FilterRevoked(opts *bind.FilterOpts, recipient []common.Address, attester []common.Address, schemaUID [][32]byte) (*EASRevokedIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+	var attesterRule []interface{}
+	for _, attesterItem := range attester {
+		attesterRule = append(attesterRule, attesterItem)
+	}
+
+	var schemaUIDRule []interface{}
+	for _, schemaUIDItem := range schemaUID {
+		schemaUIDRule = append(schemaUIDRule, schemaUIDItem)
+	}
+
+	logs, sub, err := _EAS.contract.FilterLogs(opts, "Revoked", recipientRule, attesterRule, schemaUIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EASRevokedIterator{contract: _EAS.contract, event: "Revoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRevoked is a free log subscription operation binding the contract event 0xf930a6e2523c9cc298691873087a740550b8fc85a0680830414c148ed927f615.
+//
+// Solidity: event Revoked(address indexed recipient, address indexed attester, bytes32 uid, bytes32 indexed schemaUID)
+func (_EAS *EASFilterer) WatchRevoked(opts *bind.WatchOpts, sink chan<- *EASRevoked, recipient []common.Address, attester []common.Address, schemaUID [][32]byte) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+	var attesterRule []interface{}
+	for _, attesterItem := range attester {
+		attesterRule = append(attesterRule, attesterItem)
+	}
+
+	var schemaUIDRule []interface{}
+	for _, schemaUIDItem := range schemaUID {
+		schemaUIDRule = append(schemaUIDRule, schemaUIDItem)
+	}
+
+	logs, sub, err := _EAS.contract.WatchLogs(opts, "Revoked", recipientRule, attesterRule, schemaUIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EASRevoked)
+				if err := _EAS.contract.UnpackLog(event, "Revoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRevoked is a log parse operation binding the contract event 0xf930a6e2523c9cc298691873087a740550b8fc85a0680830414c148ed927f615.
+//
+// Solidity: event Revoked(address indexed recipient, address indexed attester, bytes32 uid, bytes32 indexed schemaUID)
+func (_EAS *EASFilterer) ParseRevoked(log types.Log) (*EASRevoked, error) {
+	event := new(EASRevoked)
+	if err := _EAS.contract.UnpackLog(event, "Revoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
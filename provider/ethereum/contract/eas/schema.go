@@ -0,0 +1,118 @@
+package eas
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// typeIPFSHash is an alias of bytes32 in the schemas, which is supported by the EAS SDK.
+const typeIPFSHash = "ipfsHash"
+
+// Field is a decoded field of the attestation data.
+type Field struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+// ParseSchema parses a schema definition, such as `uint256 eventId, uint8 voteIndex`,
+// into the ABI arguments of the attestation data.
+func ParseSchema(definition string) (abi.Arguments, error) {
+	arguments := make(abi.Arguments, 0)
+
+	// A schema without fields is allowed by the SchemaRegistry.
+	if strings.TrimSpace(definition) == "" {
+		return arguments, nil
+	}
+
+	for _, field := range strings.Split(definition, ",") {
+		parts := strings.Fields(field)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid schema field %q", field)
+		}
+
+		typeName, name := parts[0], parts[1]
+
+		if typeName == typeIPFSHash {
+			typeName = "bytes32"
+		}
+
+		argumentType, err := abi.NewType(typeName, "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("parse type of schema field %q: %w", field, err)
+		}
+
+		arguments = append(arguments, abi.Argument{
+			Name: name,
+			Type: argumentType,
+		})
+	}
+
+	return arguments, nil
+}
+
+// DecodeData decodes the attestation data by the schema definition.
+func DecodeData(definition string, data []byte) ([]Field, error) {
+	arguments, err := ParseSchema(definition)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := arguments.UnpackValues(data)
+	if err != nil {
+		return nil, fmt.Errorf("unpack data: %w", err)
+	}
+
+	fields := make([]Field, 0, len(arguments))
+
+	for index, argument := range arguments {
+		fields = append(fields, Field{
+			Name:  argument.Name,
+			Type:  argument.Type.String(),
+			Value: formatValue(reflect.ValueOf(values[index])),
+		})
+	}
+
+	return fields, nil
+}
+
+// formatValue formats the unpacked value to a JSON friendly value,
+// integers larger than 64 bits are formatted to decimal strings and bytes are formatted to hex strings.
+func formatValue(value reflect.Value) any {
+	switch value := value.Interface().(type) {
+	case *big.Int:
+		return value.String()
+	case common.Address:
+		return value.String()
+	case []byte:
+		return hexutil.Encode(value)
+	}
+
+	switch value.Kind() {
+	case reflect.Array, reflect.Slice:
+		// Fixed bytes such as bytes32
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			data := make([]byte, value.Len())
+			for index := range data {
+				data[index] = byte(value.Index(index).Uint())
+			}
+
+			return hexutil.Encode(data)
+		}
+
+		values := make([]any, 0, value.Len())
+		for index := 0; index < value.Len(); index++ {
+			values = append(values, formatValue(value.Index(index)))
+		}
+
+		return values
+	default:
+		return value.Interface()
+	}
+}
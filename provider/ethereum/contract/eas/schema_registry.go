@@ -0,0 +1,220 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package eas
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// SchemaRecord is an auto generated low-level Go binding around an user-defined struct.
+type SchemaRecord struct {
+	Uid       [32]byte
+	Resolver  common.Address
+	Revocable bool
+	Schema    string
+}
+
+// SchemaRegistryMetaData contains all meta data concerning the SchemaRegistry contract.
+var SchemaRegistryMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"}],\"name\":\"getSchema\",\"outputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"contractISchemaResolver\",\"name\":\"resolver\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"schema\",\"type\":\"string\"}],\"internalType\":\"structSchemaRecord\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// SchemaRegistryABI is the input ABI used to generate the binding from.
+// Deprecated: Use SchemaRegistryMetaData.ABI instead.
+var SchemaRegistryABI = SchemaRegistryMetaData.ABI
+
+// SchemaRegistry is an auto generated Go binding around an Ethereum contract.
+type SchemaRegistry struct {
+	SchemaRegistryCaller     // Read-only binding to the contract
+	SchemaRegistryTransactor // Write-only binding to the contract
+	SchemaRegistryFilterer   // Log filterer for contract events
+}
+
+// SchemaRegistryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SchemaRegistryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SchemaRegistryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SchemaRegistryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SchemaRegistryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SchemaRegistryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SchemaRegistrySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SchemaRegistrySession struct {
+	Contract     *SchemaRegistry   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// SchemaRegistryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SchemaRegistryCallerSession struct {
+	Contract *SchemaRegistryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// SchemaRegistryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SchemaRegistryTransactorSession struct {
+	Contract     *SchemaRegistryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// SchemaRegistryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SchemaRegistryRaw struct {
+	Contract *SchemaRegistry // Generic contract binding to access the raw methods on
+}
+
+// SchemaRegistryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SchemaRegistryCallerRaw struct {
+	Contract *SchemaRegistryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SchemaRegistryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SchemaRegistryTransactorRaw struct {
+	Contract *SchemaRegistryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSchemaRegistry creates a new instance of SchemaRegistry, bound to a specific deployed contract.
+func NewSchemaRegistry(address common.Address, backend bind.ContractBackend) (*SchemaRegistry, error) {
+	contract, err := bindSchemaRegistry(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaRegistry{SchemaRegistryCaller: SchemaRegistryCaller{contract: contract}, SchemaRegistryTransactor: SchemaRegistryTransactor{contract: contract}, SchemaRegistryFilterer: SchemaRegistryFilterer{contract: contract}}, nil
+}
+
+// NewSchemaRegistryCaller creates a new read-only instance of SchemaRegistry, bound to a specific deployed contract.
+func NewSchemaRegistryCaller(address common.Address, caller bind.ContractCaller) (*SchemaRegistryCaller, error) {
+	contract, err := bindSchemaRegistry(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaRegistryCaller{contract: contract}, nil
+}
+
+// NewSchemaRegistryTransactor creates a new write-only instance of SchemaRegistry, bound to a specific deployed contract.
+func NewSchemaRegistryTransactor(address common.Address, transactor bind.ContractTransactor) (*SchemaRegistryTransactor, error) {
+	contract, err := bindSchemaRegistry(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaRegistryTransactor{contract: contract}, nil
+}
+
+// NewSchemaRegistryFilterer creates a new log filterer instance of SchemaRegistry, bound to a specific deployed contract.
+func NewSchemaRegistryFilterer(address common.Address, filterer bind.ContractFilterer) (*SchemaRegistryFilterer, error) {
+	contract, err := bindSchemaRegistry(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaRegistryFilterer{contract: contract}, nil
+}
+
+// bindSchemaRegistry binds a generic wrapper to an already deployed contract.
+func bindSchemaRegistry(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SchemaRegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SchemaRegistry *SchemaRegistryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SchemaRegistry.Contract.SchemaRegistryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SchemaRegistry *SchemaRegistryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SchemaRegistry.Contract.SchemaRegistryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SchemaRegistry *SchemaRegistryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SchemaRegistry.Contract.SchemaRegistryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SchemaRegistry *SchemaRegistryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SchemaRegistry.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SchemaRegistry *SchemaRegistryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SchemaRegistry.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SchemaRegistry *SchemaRegistryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SchemaRegistry.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetSchema is a free data retrieval call binding the contract method 0xa2ea7c6e.
+//
+// Solidity: function getSchema(bytes32 uid) view returns((bytes32,address,bool,string))
+func (_SchemaRegistry *SchemaRegistryCaller) GetSchema(opts *bind.CallOpts, uid [32]byte) (SchemaRecord, error) {
+	var out []interface{}
+	err := _SchemaRegistry.contract.Call(opts, &out, "getSchema", uid)
+
+	if err != nil {
+		return *new(SchemaRecord), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(SchemaRecord)).(*SchemaRecord)
+
+	return out0, err
+
+}
+
+// GetSchema is a free data retrieval call binding the contract method 0xa2ea7c6e.
+//
+// Solidity: function getSchema(bytes32 uid) view returns((bytes32,address,bool,string))
+func (_SchemaRegistry *SchemaRegistrySession) GetSchema(uid [32]byte) (SchemaRecord, error) {
+	return _SchemaRegistry.Contract.GetSchema(&_SchemaRegistry.CallOpts, uid)
+}
+
+// GetSchema is a free data retrieval call binding the contract method 0xa2ea7c6e.
+//
+// Solidity: function getSchema(bytes32 uid) view returns((bytes32,address,bool,string))
+func (_SchemaRegistry *SchemaRegistryCallerSession) GetSchema(uid [32]byte) (SchemaRecord, error) {
+	return _SchemaRegistry.Contract.GetSchema(&_SchemaRegistry.CallOpts, uid)
+}
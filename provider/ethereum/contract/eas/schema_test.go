@@ -0,0 +1,93 @@
+package eas_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rss3-network/node/provider/ethereum/contract/eas"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeData(t *testing.T) {
+	t.Parallel()
+
+	type arguments struct {
+		definition string
+		data       []byte
+	}
+
+	testcases := []struct {
+		name      string
+		arguments arguments
+		want      []eas.Field
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name: "Coinbase Verified Account",
+			arguments: arguments{
+				definition: "bool verifiedAccount",
+				data:       hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000001"),
+			},
+			want: []eas.Field{
+				{Name: "verifiedAccount", Type: "bool", Value: true},
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Gitcoin Passport Score",
+			arguments: arguments{
+				definition: "uint256 score,uint32 scorer_id,uint8 score_decimals",
+				data: hexutil.MustDecode("0x" +
+					"00000000000000000000000000000000000000000000000168d28e3f00280000" +
+					"000000000000000000000000000000000000000000000000000000000000050c" +
+					"0000000000000000000000000000000000000000000000000000000000000012"),
+			},
+			want: []eas.Field{
+				{Name: "score", Type: "uint256", Value: "26000000000000000000"},
+				{Name: "scorer_id", Type: "uint32", Value: uint32(1292)},
+				{Name: "score_decimals", Type: "uint8", Value: uint8(18)},
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Strings, addresses and fixed bytes",
+			arguments: arguments{
+				definition: "string name, address[] members, ipfsHash content",
+				data: hexutil.MustDecode("0x" +
+					"0000000000000000000000000000000000000000000000000000000000000060" +
+					"00000000000000000000000000000000000000000000000000000000000000a0" +
+					"1111111111111111111111111111111111111111111111111111111111111111" +
+					"0000000000000000000000000000000000000000000000000000000000000004" +
+					"5253533300000000000000000000000000000000000000000000000000000000" +
+					"0000000000000000000000000000000000000000000000000000000000000001" +
+					"000000000000000000000000c8b960d09c0078c18dcbe7eb9ab9d816bcca8944"),
+			},
+			want: []eas.Field{
+				{Name: "name", Type: "string", Value: "RSS3"},
+				{Name: "members", Type: "address[]", Value: []any{"0xC8b960D09C0078c18Dcbe7eB9AB9d816BcCa8944"}},
+				{Name: "content", Type: "bytes32", Value: "0x1111111111111111111111111111111111111111111111111111111111111111"},
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Tuples are not supported",
+			arguments: arguments{
+				definition: "(uint256 x, uint256 y) point",
+			},
+			wantError: require.Error,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			fields, err := eas.DecodeData(testcase.arguments.definition, testcase.arguments.data)
+			testcase.wantError(t, err)
+
+			require.Equal(t, testcase.want, fields)
+		})
+	}
+}
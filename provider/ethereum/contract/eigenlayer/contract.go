@@ -0,0 +1,59 @@
+package eigenlayer
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+)
+
+// StrategyManager https://etherscan.io/address/0x858646372CC42E1A627fcE94aa7A7033e7CF075A
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/StrategyManager.abi --pkg eigenlayer --type StrategyManager --out strategy_manager.go
+
+// DelegationManager https://etherscan.io/address/0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/DelegationManager.abi --pkg eigenlayer --type DelegationManager --out delegation_manager.go
+
+// Strategy https://github.com/Layr-Labs/eigenlayer-contracts/blob/mainnet/src/contracts/strategies/StrategyBase.sol
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/Strategy.abi --pkg eigenlayer --type Strategy --out strategy.go
+
+var (
+	AddressStrategyManager   = common.HexToAddress("0x858646372CC42E1A627fcE94aa7A7033e7CF075A")
+	AddressDelegationManager = common.HexToAddress("0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A")
+	// AddressBeaconChainETHStrategy is the virtual strategy of the natively restaked ETH of EigenPods, whose shares are in wei.
+	AddressBeaconChainETHStrategy = common.HexToAddress("0xbeaC0eeEeeeeEEeEeEEEEeeEEeEeeeEeeEEBEaC0")
+
+	// Liquid restaking tokens
+	AddressEtherFiETH = common.HexToAddress("0x35fA164735182de50811E8e2E824cFb9B6118ac2") // eETH
+	AddressRenzoETH   = common.HexToAddress("0xbf5495Efe5DB9ce00f80364C8B423567e58d2110") // ezETH
+	AddressKelpETH    = common.HexToAddress("0xA1290d69c65A6Fe4DF752f95823fae25cB99e5A7") // rsETH
+
+	EventDeposit             = contract.EventHash("Deposit(address,address,address,uint256)")
+	EventStakerDelegated     = contract.EventHash("StakerDelegated(address,address)")
+	EventStakerUndelegated   = contract.EventHash("StakerUndelegated(address,address)")
+	EventWithdrawalQueued    = contract.EventHash("WithdrawalQueued(bytes32,(address,address,address,uint256,uint32,address[],uint256[]))")
+	EventWithdrawalCompleted = contract.EventHash("WithdrawalCompleted(bytes32)")
+
+	MethodIDCompleteQueuedWithdrawal  = contract.MethodID("completeQueuedWithdrawal((address,address,address,uint256,uint32,address[],uint256[]),address[],uint256,bool)")
+	MethodIDCompleteQueuedWithdrawals = contract.MethodID("completeQueuedWithdrawals((address,address,address,uint256,uint32,address[],uint256[])[],address[][],uint256[],bool[])")
+)
+
+// LiquidRestakingPlatforms are the platforms of the liquid restaking tokens.
+var LiquidRestakingPlatforms = map[common.Address]string{
+	AddressEtherFiETH: "ether.fi",
+	AddressRenzoETH:   "Renzo",
+	AddressKelpETH:    "Kelp DAO",
+}
+
+type CompleteQueuedWithdrawalInput struct {
+	Withdrawal           IDelegationManagerWithdrawal
+	Tokens               []common.Address
+	MiddlewareTimesIndex *big.Int
+	ReceiveAsTokens      bool
+}
+
+type CompleteQueuedWithdrawalsInput struct {
+	Withdrawals            []IDelegationManagerWithdrawal
+	Tokens                 [][]common.Address
+	MiddlewareTimesIndexes []*big.Int
+	ReceiveAsTokens        []bool
+}
@@ -0,0 +1,243 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package eigenlayer
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// StrategyMetaData contains all meta data concerning the Strategy contract.
+var StrategyMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"underlyingToken\",\"outputs\":[{\"internalType\":\"contractIERC20\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amountShares\",\"type\":\"uint256\"}],\"name\":\"sharesToUnderlyingView\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// StrategyABI is the input ABI used to generate the binding from.
+// Deprecated: Use StrategyMetaData.ABI instead.
+var StrategyABI = StrategyMetaData.ABI
+
+// Strategy is an auto generated Go binding around an Ethereum contract.
+type Strategy struct {
+	StrategyCaller     // Read-only binding to the contract
+	StrategyTransactor // Write-only binding to the contract
+	StrategyFilterer   // Log filterer for contract events
+}
+
+// StrategyCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StrategyCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StrategyTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StrategyTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StrategyFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StrategyFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StrategySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StrategySession struct {
+	Contract     *Strategy         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StrategyCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StrategyCallerSession struct {
+	Contract *StrategyCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// StrategyTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StrategyTransactorSession struct {
+	Contract     *StrategyTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// StrategyRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StrategyRaw struct {
+	Contract *Strategy // Generic contract binding to access the raw methods on
+}
+
+// StrategyCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StrategyCallerRaw struct {
+	Contract *StrategyCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StrategyTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StrategyTransactorRaw struct {
+	Contract *StrategyTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStrategy creates a new instance of Strategy, bound to a specific deployed contract.
+func NewStrategy(address common.Address, backend bind.ContractBackend) (*Strategy, error) {
+	contract, err := bindStrategy(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Strategy{StrategyCaller: StrategyCaller{contract: contract}, StrategyTransactor: StrategyTransactor{contract: contract}, StrategyFilterer: StrategyFilterer{contract: contract}}, nil
+}
+
+// NewStrategyCaller creates a new read-only instance of Strategy, bound to a specific deployed contract.
+func NewStrategyCaller(address common.Address, caller bind.ContractCaller) (*StrategyCaller, error) {
+	contract, err := bindStrategy(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StrategyCaller{contract: contract}, nil
+}
+
+// NewStrategyTransactor creates a new write-only instance of Strategy, bound to a specific deployed contract.
+func NewStrategyTransactor(address common.Address, transactor bind.ContractTransactor) (*StrategyTransactor, error) {
+	contract, err := bindStrategy(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StrategyTransactor{contract: contract}, nil
+}
+
+// NewStrategyFilterer creates a new log filterer instance of Strategy, bound to a specific deployed contract.
+func NewStrategyFilterer(address common.Address, filterer bind.ContractFilterer) (*StrategyFilterer, error) {
+	contract, err := bindStrategy(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StrategyFilterer{contract: contract}, nil
+}
+
+// bindStrategy binds a generic wrapper to an already deployed contract.
+func bindStrategy(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := StrategyMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Strategy *StrategyRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Strategy.Contract.StrategyCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Strategy *StrategyRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Strategy.Contract.StrategyTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Strategy *StrategyRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Strategy.Contract.StrategyTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Strategy *StrategyCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Strategy.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Strategy *StrategyTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Strategy.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Strategy *StrategyTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Strategy.Contract.contract.Transact(opts, method, params...)
+}
+
+// SharesToUnderlyingView is a free data retrieval call binding the contract method 0x7a8b2637.
+//
+// Solidity: function sharesToUnderlyingView(uint256 amountShares) view returns(uint256)
+func (_Strategy *StrategyCaller) SharesToUnderlyingView(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Strategy.contract.Call(opts, &out, "sharesToUnderlyingView", amountShares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SharesToUnderlyingView is a free data retrieval call binding the contract method 0x7a8b2637.
+//
+// Solidity: function sharesToUnderlyingView(uint256 amountShares) view returns(uint256)
+func (_Strategy *StrategySession) SharesToUnderlyingView(amountShares *big.Int) (*big.Int, error) {
+	return _Strategy.Contract.SharesToUnderlyingView(&_Strategy.CallOpts, amountShares)
+}
+
+// SharesToUnderlyingView is a free data retrieval call binding the contract method 0x7a8b2637.
+//
+// Solidity: function sharesToUnderlyingView(uint256 amountShares) view returns(uint256)
+func (_Strategy *StrategyCallerSession) SharesToUnderlyingView(amountShares *big.Int) (*big.Int, error) {
+	return _Strategy.Contract.SharesToUnderlyingView(&_Strategy.CallOpts, amountShares)
+}
+
+// UnderlyingToken is a free data retrieval call binding the contract method 0x2495a599.
+//
+// Solidity: function underlyingToken() view returns(address)
+func (_Strategy *StrategyCaller) UnderlyingToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Strategy.contract.Call(opts, &out, "underlyingToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// UnderlyingToken is a free data retrieval call binding the contract method 0x2495a599.
+//
+// Solidity: function underlyingToken() view returns(address)
+func (_Strategy *StrategySession) UnderlyingToken() (common.Address, error) {
+	return _Strategy.Contract.UnderlyingToken(&_Strategy.CallOpts)
+}
+
+// UnderlyingToken is a free data retrieval call binding the contract method 0x2495a599.
+//
+// Solidity: function underlyingToken() view returns(address)
+func (_Strategy *StrategyCallerSession) UnderlyingToken() (common.Address, error) {
+	return _Strategy.Contract.UnderlyingToken(&_Strategy.CallOpts)
+}
@@ -0,0 +1,318 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package eigenlayer
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// StrategyManagerMetaData contains all meta data concerning the StrategyManager contract.
+var StrategyManagerMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"contractIERC20\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"contractIStrategy\",\"name\":\"strategy\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"Deposit\",\"type\":\"event\"}]",
+}
+
+// StrategyManagerABI is the input ABI used to generate the binding from.
+// Deprecated: Use StrategyManagerMetaData.ABI instead.
+var StrategyManagerABI = StrategyManagerMetaData.ABI
+
+// StrategyManager is an auto generated Go binding around an Ethereum contract.
+type StrategyManager struct {
+	StrategyManagerCaller     // Read-only binding to the contract
+	StrategyManagerTransactor // Write-only binding to the contract
+	StrategyManagerFilterer   // Log filterer for contract events
+}
+
+// StrategyManagerCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StrategyManagerCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StrategyManagerTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StrategyManagerTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StrategyManagerFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StrategyManagerFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StrategyManagerSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StrategyManagerSession struct {
+	Contract     *StrategyManager  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StrategyManagerCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StrategyManagerCallerSession struct {
+	Contract *StrategyManagerCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// StrategyManagerTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StrategyManagerTransactorSession struct {
+	Contract     *StrategyManagerTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// StrategyManagerRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StrategyManagerRaw struct {
+	Contract *StrategyManager // Generic contract binding to access the raw methods on
+}
+
+// StrategyManagerCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StrategyManagerCallerRaw struct {
+	Contract *StrategyManagerCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StrategyManagerTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StrategyManagerTransactorRaw struct {
+	Contract *StrategyManagerTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStrategyManager creates a new instance of StrategyManager, bound to a specific deployed contract.
+func NewStrategyManager(address common.Address, backend bind.ContractBackend) (*StrategyManager, error) {
+	contract, err := bindStrategyManager(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &StrategyManager{StrategyManagerCaller: StrategyManagerCaller{contract: contract}, StrategyManagerTransactor: StrategyManagerTransactor{contract: contract}, StrategyManagerFilterer: StrategyManagerFilterer{contract: contract}}, nil
+}
+
+// NewStrategyManagerCaller creates a new read-only instance of StrategyManager, bound to a specific deployed contract.
+func NewStrategyManagerCaller(address common.Address, caller bind.ContractCaller) (*StrategyManagerCaller, error) {
+	contract, err := bindStrategyManager(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StrategyManagerCaller{contract: contract}, nil
+}
+
+// NewStrategyManagerTransactor creates a new write-only instance of StrategyManager, bound to a specific deployed contract.
+func NewStrategyManagerTransactor(address common.Address, transactor bind.ContractTransactor) (*StrategyManagerTransactor, error) {
+	contract, err := bindStrategyManager(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StrategyManagerTransactor{contract: contract}, nil
+}
+
+// NewStrategyManagerFilterer creates a new log filterer instance of StrategyManager, bound to a specific deployed contract.
+func NewStrategyManagerFilterer(address common.Address, filterer bind.ContractFilterer) (*StrategyManagerFilterer, error) {
+	contract, err := bindStrategyManager(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StrategyManagerFilterer{contract: contract}, nil
+}
+
+// bindStrategyManager binds a generic wrapper to an already deployed contract.
+func bindStrategyManager(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := StrategyManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StrategyManager *StrategyManagerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StrategyManager.Contract.StrategyManagerCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StrategyManager *StrategyManagerRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StrategyManager.Contract.StrategyManagerTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StrategyManager *StrategyManagerRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StrategyManager.Contract.StrategyManagerTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StrategyManager *StrategyManagerCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StrategyManager.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StrategyManager *StrategyManagerTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StrategyManager.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StrategyManager *StrategyManagerTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StrategyManager.Contract.contract.Transact(opts, method, params...)
+}
+
+// StrategyManagerDepositIterator is returned from FilterDeposit and is used to iterate over the raw logs and unpacked data for Deposit events raised by the StrategyManager contract.
+type StrategyManagerDepositIterator struct {
+	Event *StrategyManagerDeposit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StrategyManagerDepositIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StrategyManagerDeposit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StrategyManagerDeposit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StrategyManagerDepositIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StrategyManagerDepositIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StrategyManagerDeposit represents a Deposit event raised by the StrategyManager contract.
+type StrategyManagerDeposit struct {
+	Staker   common.Address
+	Token    common.Address
+	Strategy common.Address
+	Shares   *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeposit is a free log retrieval operation binding the contract event 0x7cfff908a4b583f36430b25d75964c458d8ede8a99bd61be750e97ee1b2f3a96.
+//
+// Solidity: event Deposit(address staker, address token, address strategy, uint256 shares)
+func (_StrategyManager *StrategyManagerFilterer) FilterDeposit(opts *bind.FilterOpts) (*StrategyManagerDepositIterator, error) {
+
+	logs, sub, err := _StrategyManager.contract.FilterLogs(opts, "Deposit")
+	if err != nil {
+		return nil, err
+	}
+	return &StrategyManagerDepositIterator{contract: _StrategyManager.contract, event: "Deposit", logs: logs, sub: sub}, nil
+}
+
+// WatchDeposit is a free log subscription operation binding the contract event 0x7cfff908a4b583f36430b25d75964c458d8ede8a99bd61be750e97ee1b2f3a96.
+//
+// Solidity: event Deposit(address staker, address token, address strategy, uint256 shares)
+func (_StrategyManager *StrategyManagerFilterer) WatchDeposit(opts *bind.WatchOpts, sink chan<- *StrategyManagerDeposit) (event.Subscription, error) {
+
+	logs, sub, err := _StrategyManager.contract.WatchLogs(opts, "Deposit")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StrategyManagerDeposit)
+				if err := _StrategyManager.contract.UnpackLog(event, "Deposit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeposit is a log parse operation binding the contract event 0x7cfff908a4b583f36430b25d75964c458d8ede8a99bd61be750e97ee1b2f3a96.
+//
+// Solidity: event Deposit(address staker, address token, address strategy, uint256 shares)
+func (_StrategyManager *StrategyManagerFilterer) ParseDeposit(log types.Log) (*StrategyManagerDeposit, error) {
+	event := new(StrategyManagerDeposit)
+	if err := _StrategyManager.contract.UnpackLog(event, "Deposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,98 @@
+package entrypoint
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+)
+
+// EntryPoint v0.6 https://etherscan.io/address/0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/EntryPointV06.abi --pkg entrypoint --type EntryPointV06 --out entrypoint_v06.go
+
+// EntryPoint v0.7 https://etherscan.io/address/0x0000000071727De22E5E9d8BAf0edAc6f37da032
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/EntryPointV07.abi --pkg entrypoint --type EntryPointV07 --out entrypoint_v07.go
+
+// SmartAccount is the execution functions of the common smart accounts, including SimpleAccount, Coinbase Smart Wallet,
+// Safe 4337 Module and ERC-7579 accounts such as Kernel.
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/SmartAccount.abi --pkg entrypoint --type SmartAccount --out smart_account.go
+
+var (
+	// The EntryPoints are deployed to the same addresses on all networks.
+	AddressEntryPointV06 = common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+	AddressEntryPointV07 = common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+
+	EventUserOperationEvent = contract.EventHash("UserOperationEvent(bytes32,address,address,uint256,bool,uint256,uint256)")
+	EventBeforeExecution    = contract.EventHash("BeforeExecution()")
+
+	MethodIDEntryPointV06HandleOps           = contract.MethodID("handleOps((address,uint256,bytes,bytes,uint256,uint256,uint256,uint256,uint256,bytes,bytes)[],address)")
+	MethodIDEntryPointV06HandleAggregatedOps = contract.MethodID("handleAggregatedOps(((address,uint256,bytes,bytes,uint256,uint256,uint256,uint256,uint256,bytes,bytes)[],address,bytes)[],address)")
+	MethodIDEntryPointV07HandleOps           = contract.MethodID("handleOps((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes)[],address)")
+	MethodIDEntryPointV07HandleAggregatedOps = contract.MethodID("handleAggregatedOps(((address,uint256,bytes,bytes,bytes32,uint256,bytes32,bytes,bytes)[],address,bytes)[],address)")
+
+	MethodIDSmartAccountExecute            = contract.MethodID("execute(address,uint256,bytes)")
+	MethodIDSmartAccountExecuteMode        = contract.MethodID("execute(bytes32,bytes)")
+	MethodIDSmartAccountExecuteBatch       = contract.MethodID("executeBatch(address[],bytes[])")
+	MethodIDSmartAccountExecuteBatchValues = contract.MethodID("executeBatch(address[],uint256[],bytes[])")
+	MethodIDSmartAccountExecuteBatchCalls  = contract.MethodID("executeBatch((address,uint256,bytes)[])")
+	MethodIDSmartAccountExecuteUserOp      = contract.MethodID("executeUserOp(address,uint256,bytes,uint8)")
+)
+
+// The call types of the execution mode of ERC-7579 https://eips.ethereum.org/EIPS/eip-7579#execution-behavior
+const (
+	CallTypeSingle byte = 0x00
+	CallTypeBatch  byte = 0x01
+)
+
+type EntryPointV06HandleOpsInput struct {
+	Ops         []UserOperation
+	Beneficiary common.Address
+}
+
+type EntryPointV06HandleAggregatedOpsInput struct {
+	OpsPerAggregator []IEntryPointUserOpsPerAggregator
+	Beneficiary      common.Address
+}
+
+type EntryPointV07HandleOpsInput struct {
+	Ops         []PackedUserOperation
+	Beneficiary common.Address
+}
+
+type EntryPointV07HandleAggregatedOpsInput struct {
+	OpsPerAggregator []EntryPointV07UserOpsPerAggregator
+	Beneficiary      common.Address
+}
+
+type SmartAccountExecuteInput struct {
+	Dest  common.Address
+	Value *big.Int
+	Func  []byte
+}
+
+type SmartAccountExecuteModeInput struct {
+	Mode              [32]byte
+	ExecutionCalldata []byte
+}
+
+type SmartAccountExecuteBatchInput struct {
+	Dest []common.Address
+	Func [][]byte
+}
+
+type SmartAccountExecuteBatchValuesInput struct {
+	Dest  []common.Address
+	Value []*big.Int
+	Func  [][]byte
+}
+
+type SmartAccountExecuteBatchCallsInput struct {
+	Calls []CoinbaseSmartWalletCall
+}
+
+type SmartAccountExecuteUserOpInput struct {
+	To        common.Address
+	Value     *big.Int
+	Data      []byte
+	Operation uint8
+}
@@ -0,0 +1,314 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package entrypoint
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// CoinbaseSmartWalletCall is an auto generated low-level Go binding around an user-defined struct.
+type CoinbaseSmartWalletCall struct {
+	Target common.Address
+	Value  *big.Int
+	Data   []byte
+}
+
+// SmartAccountMetaData contains all meta data concerning the SmartAccount contract.
+var SmartAccountMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"dest\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"func\",\"type\":\"bytes\"}],\"name\":\"execute\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"mode\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"executionCalldata\",\"type\":\"bytes\"}],\"name\":\"execute\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"dest\",\"type\":\"address[]\"},{\"internalType\":\"bytes[]\",\"name\":\"func\",\"type\":\"bytes[]\"}],\"name\":\"executeBatch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"dest\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"value\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes[]\",\"name\":\"func\",\"type\":\"bytes[]\"}],\"name\":\"executeBatch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structCoinbaseSmartWallet.Call[]\",\"name\":\"calls\",\"type\":\"tuple[]\"}],\"name\":\"executeBatch\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"uint8\",\"name\":\"operation\",\"type\":\"uint8\"}],\"name\":\"executeUserOp\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// SmartAccountABI is the input ABI used to generate the binding from.
+// Deprecated: Use SmartAccountMetaData.ABI instead.
+var SmartAccountABI = SmartAccountMetaData.ABI
+
+// SmartAccount is an auto generated Go binding around an Ethereum contract.
+type SmartAccount struct {
+	SmartAccountCaller     // Read-only binding to the contract
+	SmartAccountTransactor // Write-only binding to the contract
+	SmartAccountFilterer   // Log filterer for contract events
+}
+
+// SmartAccountCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SmartAccountCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SmartAccountTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SmartAccountTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SmartAccountFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SmartAccountFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SmartAccountSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SmartAccountSession struct {
+	Contract     *SmartAccount     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// SmartAccountCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SmartAccountCallerSession struct {
+	Contract *SmartAccountCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// SmartAccountTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SmartAccountTransactorSession struct {
+	Contract     *SmartAccountTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// SmartAccountRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SmartAccountRaw struct {
+	Contract *SmartAccount // Generic contract binding to access the raw methods on
+}
+
+// SmartAccountCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SmartAccountCallerRaw struct {
+	Contract *SmartAccountCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SmartAccountTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SmartAccountTransactorRaw struct {
+	Contract *SmartAccountTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSmartAccount creates a new instance of SmartAccount, bound to a specific deployed contract.
+func NewSmartAccount(address common.Address, backend bind.ContractBackend) (*SmartAccount, error) {
+	contract, err := bindSmartAccount(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartAccount{SmartAccountCaller: SmartAccountCaller{contract: contract}, SmartAccountTransactor: SmartAccountTransactor{contract: contract}, SmartAccountFilterer: SmartAccountFilterer{contract: contract}}, nil
+}
+
+// NewSmartAccountCaller creates a new read-only instance of SmartAccount, bound to a specific deployed contract.
+func NewSmartAccountCaller(address common.Address, caller bind.ContractCaller) (*SmartAccountCaller, error) {
+	contract, err := bindSmartAccount(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartAccountCaller{contract: contract}, nil
+}
+
+// NewSmartAccountTransactor creates a new write-only instance of SmartAccount, bound to a specific deployed contract.
+func NewSmartAccountTransactor(address common.Address, transactor bind.ContractTransactor) (*SmartAccountTransactor, error) {
+	contract, err := bindSmartAccount(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartAccountTransactor{contract: contract}, nil
+}
+
+// NewSmartAccountFilterer creates a new log filterer instance of SmartAccount, bound to a specific deployed contract.
+func NewSmartAccountFilterer(address common.Address, filterer bind.ContractFilterer) (*SmartAccountFilterer, error) {
+	contract, err := bindSmartAccount(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartAccountFilterer{contract: contract}, nil
+}
+
+// bindSmartAccount binds a generic wrapper to an already deployed contract.
+func bindSmartAccount(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SmartAccountMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SmartAccount *SmartAccountRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SmartAccount.Contract.SmartAccountCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SmartAccount *SmartAccountRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SmartAccount.Contract.SmartAccountTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SmartAccount *SmartAccountRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SmartAccount.Contract.SmartAccountTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SmartAccount *SmartAccountCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SmartAccount.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SmartAccount *SmartAccountTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SmartAccount.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SmartAccount *SmartAccountTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SmartAccount.Contract.contract.Transact(opts, method, params...)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xb61d27f6.
+//
+// Solidity: function execute(address dest, uint256 value, bytes func) returns()
+func (_SmartAccount *SmartAccountTransactor) Execute(opts *bind.TransactOpts, dest common.Address, value *big.Int, arg2 []byte) (*types.Transaction, error) {
+	return _SmartAccount.contract.Transact(opts, "execute", dest, value, arg2)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xb61d27f6.
+//
+// Solidity: function execute(address dest, uint256 value, bytes func) returns()
+func (_SmartAccount *SmartAccountSession) Execute(dest common.Address, value *big.Int, arg2 []byte) (*types.Transaction, error) {
+	return _SmartAccount.Contract.Execute(&_SmartAccount.TransactOpts, dest, value, arg2)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xb61d27f6.
+//
+// Solidity: function execute(address dest, uint256 value, bytes func) returns()
+func (_SmartAccount *SmartAccountTransactorSession) Execute(dest common.Address, value *big.Int, arg2 []byte) (*types.Transaction, error) {
+	return _SmartAccount.Contract.Execute(&_SmartAccount.TransactOpts, dest, value, arg2)
+}
+
+// Execute0 is a paid mutator transaction binding the contract method 0xe9ae5c53.
+//
+// Solidity: function execute(bytes32 mode, bytes executionCalldata) payable returns()
+func (_SmartAccount *SmartAccountTransactor) Execute0(opts *bind.TransactOpts, mode [32]byte, executionCalldata []byte) (*types.Transaction, error) {
+	return _SmartAccount.contract.Transact(opts, "execute0", mode, executionCalldata)
+}
+
+// Execute0 is a paid mutator transaction binding the contract method 0xe9ae5c53.
+//
+// Solidity: function execute(bytes32 mode, bytes executionCalldata) payable returns()
+func (_SmartAccount *SmartAccountSession) Execute0(mode [32]byte, executionCalldata []byte) (*types.Transaction, error) {
+	return _SmartAccount.Contract.Execute0(&_SmartAccount.TransactOpts, mode, executionCalldata)
+}
+
+// Execute0 is a paid mutator transaction binding the contract method 0xe9ae5c53.
+//
+// Solidity: function execute(bytes32 mode, bytes executionCalldata) payable returns()
+func (_SmartAccount *SmartAccountTransactorSession) Execute0(mode [32]byte, executionCalldata []byte) (*types.Transaction, error) {
+	return _SmartAccount.Contract.Execute0(&_SmartAccount.TransactOpts, mode, executionCalldata)
+}
+
+// ExecuteBatch is a paid mutator transaction binding the contract method 0x18dfb3c7.
+//
+// Solidity: function executeBatch(address[] dest, bytes[] func) returns()
+func (_SmartAccount *SmartAccountTransactor) ExecuteBatch(opts *bind.TransactOpts, dest []common.Address, arg1 [][]byte) (*types.Transaction, error) {
+	return _SmartAccount.contract.Transact(opts, "executeBatch", dest, arg1)
+}
+
+// ExecuteBatch is a paid mutator transaction binding the contract method 0x18dfb3c7.
+//
+// Solidity: function executeBatch(address[] dest, bytes[] func) returns()
+func (_SmartAccount *SmartAccountSession) ExecuteBatch(dest []common.Address, arg1 [][]byte) (*types.Transaction, error) {
+	return _SmartAccount.Contract.ExecuteBatch(&_SmartAccount.TransactOpts, dest, arg1)
+}
+
+// ExecuteBatch is a paid mutator transaction binding the contract method 0x18dfb3c7.
+//
+// Solidity: function executeBatch(address[] dest, bytes[] func) returns()
+func (_SmartAccount *SmartAccountTransactorSession) ExecuteBatch(dest []common.Address, arg1 [][]byte) (*types.Transaction, error) {
+	return _SmartAccount.Contract.ExecuteBatch(&_SmartAccount.TransactOpts, dest, arg1)
+}
+
+// ExecuteBatch0 is a paid mutator transaction binding the contract method 0x47e1da2a.
+//
+// Solidity: function executeBatch(address[] dest, uint256[] value, bytes[] func) returns()
+func (_SmartAccount *SmartAccountTransactor) ExecuteBatch0(opts *bind.TransactOpts, dest []common.Address, value []*big.Int, arg2 [][]byte) (*types.Transaction, error) {
+	return _SmartAccount.contract.Transact(opts, "executeBatch0", dest, value, arg2)
+}
+
+// ExecuteBatch0 is a paid mutator transaction binding the contract method 0x47e1da2a.
+//
+// Solidity: function executeBatch(address[] dest, uint256[] value, bytes[] func) returns()
+func (_SmartAccount *SmartAccountSession) ExecuteBatch0(dest []common.Address, value []*big.Int, arg2 [][]byte) (*types.Transaction, error) {
+	return _SmartAccount.Contract.ExecuteBatch0(&_SmartAccount.TransactOpts, dest, value, arg2)
+}
+
+// ExecuteBatch0 is a paid mutator transaction binding the contract method 0x47e1da2a.
+//
+// Solidity: function executeBatch(address[] dest, uint256[] value, bytes[] func) returns()
+func (_SmartAccount *SmartAccountTransactorSession) ExecuteBatch0(dest []common.Address, value []*big.Int, arg2 [][]byte) (*types.Transaction, error) {
+	return _SmartAccount.Contract.ExecuteBatch0(&_SmartAccount.TransactOpts, dest, value, arg2)
+}
+
+// ExecuteBatch1 is a paid mutator transaction binding the contract method 0x34fcd5be.
+//
+// Solidity: function executeBatch((address,uint256,bytes)[] calls) payable returns()
+func (_SmartAccount *SmartAccountTransactor) ExecuteBatch1(opts *bind.TransactOpts, calls []CoinbaseSmartWalletCall) (*types.Transaction, error) {
+	return _SmartAccount.contract.Transact(opts, "executeBatch1", calls)
+}
+
+// ExecuteBatch1 is a paid mutator transaction binding the contract method 0x34fcd5be.
+//
+// Solidity: function executeBatch((address,uint256,bytes)[] calls) payable returns()
+func (_SmartAccount *SmartAccountSession) ExecuteBatch1(calls []CoinbaseSmartWalletCall) (*types.Transaction, error) {
+	return _SmartAccount.Contract.ExecuteBatch1(&_SmartAccount.TransactOpts, calls)
+}
+
+// ExecuteBatch1 is a paid mutator transaction binding the contract method 0x34fcd5be.
+//
+// Solidity: function executeBatch((address,uint256,bytes)[] calls) payable returns()
+func (_SmartAccount *SmartAccountTransactorSession) ExecuteBatch1(calls []CoinbaseSmartWalletCall) (*types.Transaction, error) {
+	return _SmartAccount.Contract.ExecuteBatch1(&_SmartAccount.TransactOpts, calls)
+}
+
+// ExecuteUserOp is a paid mutator transaction binding the contract method 0x7bb37428.
+//
+// Solidity: function executeUserOp(address to, uint256 value, bytes data, uint8 operation) returns()
+func (_SmartAccount *SmartAccountTransactor) ExecuteUserOp(opts *bind.TransactOpts, to common.Address, value *big.Int, data []byte, operation uint8) (*types.Transaction, error) {
+	return _SmartAccount.contract.Transact(opts, "executeUserOp", to, value, data, operation)
+}
+
+// ExecuteUserOp is a paid mutator transaction binding the contract method 0x7bb37428.
+//
+// Solidity: function executeUserOp(address to, uint256 value, bytes data, uint8 operation) returns()
+func (_SmartAccount *SmartAccountSession) ExecuteUserOp(to common.Address, value *big.Int, data []byte, operation uint8) (*types.Transaction, error) {
+	return _SmartAccount.Contract.ExecuteUserOp(&_SmartAccount.TransactOpts, to, value, data, operation)
+}
+
+// ExecuteUserOp is a paid mutator transaction binding the contract method 0x7bb37428.
+//
+// Solidity: function executeUserOp(address to, uint256 value, bytes data, uint8 operation) returns()
+func (_SmartAccount *SmartAccountTransactorSession) ExecuteUserOp(to common.Address, value *big.Int, data []byte, operation uint8) (*types.Transaction, error) {
+	return _SmartAccount.Contract.ExecuteUserOp(&_SmartAccount.TransactOpts, to, value, data, operation)
+}
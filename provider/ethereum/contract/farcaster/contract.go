@@ -0,0 +1,32 @@
+package farcaster
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+)
+
+// IdRegistry https://optimistic.etherscan.io/address/0x00000000Fc6c5F01Fc30151999387Bb99A9f489b
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/IdRegistry.abi --pkg farcaster --type IdRegistry --out id_registry.go
+// KeyRegistry https://optimistic.etherscan.io/address/0x00000000Fc1237824fb747aBDE0FF18990E59b7e
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/KeyRegistry.abi --pkg farcaster --type KeyRegistry --out key_registry.go
+// StorageRegistry https://optimistic.etherscan.io/address/0x00000000fcCe7f938e7aE6D3c335bD6a1a7c593D
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/StorageRegistry.abi --pkg farcaster --type StorageRegistry --out storage_registry.go
+
+var (
+	AddressIdRegistry      = common.HexToAddress("0x00000000Fc6c5F01Fc30151999387Bb99A9f489b")
+	AddressKeyRegistry     = common.HexToAddress("0x00000000Fc1237824fb747aBDE0FF18990E59b7e")
+	AddressStorageRegistry = common.HexToAddress("0x00000000fcCe7f938e7aE6D3c335bD6a1a7c593D")
+	// IdGateway registers FIDs to the IdRegistry and rents storage from the StorageRegistry.
+	AddressIdGateway = common.HexToAddress("0x00000000Fc25870C6eD6b6c7E41Fb078b7656f69")
+	// KeyGateway adds keys to the KeyRegistry.
+	AddressKeyGateway = common.HexToAddress("0x00000000fC56947c7E7183f8Ca4B62398CaAdf0B")
+
+	EventHashIdRegistryRegister              = contract.EventHash("Register(address,uint256,address)")
+	EventHashIdRegistryTransfer              = contract.EventHash("Transfer(address,address,uint256)")
+	EventHashIdRegistryRecover               = contract.EventHash("Recover(address,address,uint256)")
+	EventHashIdRegistryChangeRecoveryAddress = contract.EventHash("ChangeRecoveryAddress(uint256,address)")
+	EventHashKeyRegistryAdd                  = contract.EventHash("Add(uint256,uint32,bytes,bytes,uint8,bytes)")
+	EventHashKeyRegistryRemove               = contract.EventHash("Remove(uint256,bytes,bytes)")
+	EventHashKeyRegistryAdminReset           = contract.EventHash("AdminReset(uint256,bytes,bytes)")
+	EventHashStorageRegistryRent             = contract.EventHash("Rent(address,uint256,uint256)")
+)
@@ -0,0 +1,57 @@
+package gmx
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+)
+
+// EventEmitter https://github.com/gmx-io/gmx-synthetics/blob/main/contracts/event/EventEmitter.sol
+// All the events of GMX v2 are emitted by the EventEmitter as EventLog, EventLog1 or EventLog2 with the name of the event.
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/EventEmitter.abi --pkg gmx --type EventEmitter --out event_emitter.go
+
+var (
+	AddressEventEmitterArbitrum  = common.HexToAddress("0xC8ee91A54287DB53897056e12D9819156D3822Fb")
+	AddressEventEmitterAvalanche = common.HexToAddress("0xDb17B211c34240B014ab6d61d4A31FA0C0e20c26")
+
+	EventEventLog1 = contract.EventHash("EventLog1(address,string,string,bytes32,(((string,address)[],(string,address[])[]),((string,uint256)[],(string,uint256[])[]),((string,int256)[],(string,int256[])[]),((string,bool)[],(string,bool[])[]),((string,bytes32)[],(string,bytes32[])[]),((string,bytes)[],(string,bytes[])[]),((string,string)[],(string,string[])[])))")
+
+	// The names of the position events are indexed as the hashes of the strings, and the account is the topic1.
+	EventNameHashPositionIncrease = crypto.Keccak256Hash([]byte(EventNamePositionIncrease))
+	EventNameHashPositionDecrease = crypto.Keccak256Hash([]byte(EventNamePositionDecrease))
+)
+
+const (
+	EventNamePositionIncrease = "PositionIncrease"
+	EventNamePositionDecrease = "PositionDecrease"
+)
+
+// OrderType https://github.com/gmx-io/gmx-synthetics/blob/main/contracts/order/Order.sol
+const (
+	OrderTypeMarketSwap uint64 = iota
+	OrderTypeLimitSwap
+	OrderTypeMarketIncrease
+	OrderTypeLimitIncrease
+	OrderTypeMarketDecrease
+	OrderTypeLimitDecrease
+	OrderTypeStopLossDecrease
+	OrderTypeLiquidation
+)
+
+// USDDecimals is the decimals of the USD values and the prices of GMX v2, the prices of tokens are
+// scaled by 10^(USDDecimals - token decimals) so that the token amount multiplied by the price is the USD value.
+const USDDecimals = 30
+
+// Markets are the names of the known markets, which are the GM tokens of GMX v2.
+var Markets = map[common.Address]string{
+	// Arbitrum
+	common.HexToAddress("0x70d95587d40A2caf56bd97485aB3Eec10Bee6336"): "ETH/USD",
+	common.HexToAddress("0x47c031236e19d024b42f8AE6780E44A573170703"): "BTC/USD",
+	common.HexToAddress("0xC25cEf6061Cf5dE5eb761b50E4743c1F5D7E5407"): "ARB/USD",
+	common.HexToAddress("0x09400D9DB990D5ed3f35D7be61DfAEB900Af03C9"): "SOL/USD",
+	common.HexToAddress("0x7f1fa204bb700853D36994DA19F830b6Ad18455C"): "LINK/USD",
+	// Avalanche
+	common.HexToAddress("0x913C1F46b48b3eD35E7dc3Cf754d4ae8499F31CF"): "AVAX/USD",
+	common.HexToAddress("0xB7e69749E3d2EDd90ea59A4932EFEa2D41E245d7"): "ETH/USD",
+	common.HexToAddress("0xFb02132333A79C8B5Bd0b64E3AbccA5f7fAf2937"): "BTC/USD",
+}
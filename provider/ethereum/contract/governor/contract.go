@@ -0,0 +1,59 @@
+package governor
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+	"github.com/rss3-network/node/provider/ethereum/contract/nouns"
+	"github.com/rss3-network/protocol-go/schema/network"
+)
+
+// Governor https://github.com/OpenZeppelin/openzeppelin-contracts/blob/v5.0.2/contracts/governance/IGovernor.sol
+// The events of ProposalCreated, VoteCast, ProposalQueued and ProposalExecuted are the same in GovernorBravo and NounsDAO.
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/Governor.abi --pkg governor --type Governor --out governor.go
+
+var (
+	// Uniswap GovernorBravo https://etherscan.io/address/0x408ED6354d4973f66138C91495F2f2FCbd8724C3
+	AddressUniswap = common.HexToAddress("0x408ED6354d4973f66138C91495F2f2FCbd8724C3")
+	// ENS Governor https://etherscan.io/address/0x323A76393544d5ecca80cd6ef2A560C6a395b7E3
+	AddressENS = common.HexToAddress("0x323A76393544d5ecca80cd6ef2A560C6a395b7E3")
+	// Compound GovernorBravo https://etherscan.io/address/0xc0Da02939E1441F497fd74F78cE7Decb17B66529
+	AddressCompound = common.HexToAddress("0xc0Da02939E1441F497fd74F78cE7Decb17B66529")
+	// Gitcoin GovernorBravo https://etherscan.io/address/0x9D4C63565D5618310271bF3F3c01b2954C1D1639
+	AddressGitcoin = common.HexToAddress("0x9D4C63565D5618310271bF3F3c01b2954C1D1639")
+	// Arbitrum Core Governor https://arbiscan.io/address/0xf07DeD9dC292157749B6Fd268E37DF6EA38395B9
+	AddressArbitrumCore = common.HexToAddress("0xf07DeD9dC292157749B6Fd268E37DF6EA38395B9")
+	// Arbitrum Treasury Governor https://arbiscan.io/address/0x789fC99093B09aD01C34DC7251D0C89ce743e5a4
+	AddressArbitrumTreasury = common.HexToAddress("0x789fC99093B09aD01C34DC7251D0C89ce743e5a4")
+	// Optimism Governor https://optimistic.etherscan.io/address/0xcDF27F107725988f2261Ce2256bDfCdE8B382B10
+	AddressOptimism = common.HexToAddress("0xcDF27F107725988f2261Ce2256bDfCdE8B382B10")
+
+	EventProposalCreated    = contract.EventHash("ProposalCreated(uint256,address,address[],uint256[],string[],bytes[],uint256,uint256,string)")
+	EventProposalQueued     = contract.EventHash("ProposalQueued(uint256,uint256)")
+	EventProposalExecuted   = contract.EventHash("ProposalExecuted(uint256)")
+	EventVoteCast           = contract.EventHash("VoteCast(address,uint256,uint8,uint256,string)")
+	EventVoteCastWithParams = contract.EventHash("VoteCastWithParams(address,uint256,uint8,uint256,string,bytes)")
+)
+
+// DAO is the DAO of a governor, the votes are counted by the voting token of the decimals.
+type DAO struct {
+	Name         string
+	VoteDecimals uint8
+}
+
+// DAOs is the DAOs of the known governors on the networks.
+var DAOs = map[network.Network]map[common.Address]DAO{
+	network.Ethereum: {
+		AddressUniswap:        {Name: "Uniswap", VoteDecimals: 18},
+		AddressENS:            {Name: "ENS", VoteDecimals: 18},
+		AddressCompound:       {Name: "Compound", VoteDecimals: 18},
+		AddressGitcoin:        {Name: "Gitcoin", VoteDecimals: 18},
+		nouns.AddressNounsDAO: {Name: "Nouns", VoteDecimals: 0},
+	},
+	network.Arbitrum: {
+		AddressArbitrumCore:     {Name: "Arbitrum", VoteDecimals: 18},
+		AddressArbitrumTreasury: {Name: "Arbitrum", VoteDecimals: 18},
+	},
+	network.Optimism: {
+		AddressOptimism: {Name: "Optimism", VoteDecimals: 18},
+	},
+}
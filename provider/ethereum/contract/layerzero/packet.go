@@ -0,0 +1,100 @@
+package layerzero
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+	"github.com/samber/lo"
+)
+
+var (
+	EventHashUltraLightNodePacket         = contract.EventHash("Packet(bytes)")
+	EventHashUltraLightNodePacketReceived = contract.EventHash("PacketReceived(uint16,bytes,address,uint64,bytes32)")
+
+	packetArguments = abi.Arguments{
+		{Type: lo.Must(abi.NewType("bytes", "", nil))},
+	}
+
+	packetReceivedArguments = abi.Arguments{
+		{Type: lo.Must(abi.NewType("bytes", "", nil))},
+		{Type: lo.Must(abi.NewType("uint64", "", nil))},
+		{Type: lo.Must(abi.NewType("bytes32", "", nil))},
+	}
+)
+
+// packetHeaderLength is the length of the nonce, the source chain ID, the source address and the destination chain ID
+// at the beginning of an encoded packet.
+const packetHeaderLength = 8 + 2 + common.AddressLength + 2
+
+// Packet is a message sent through the UltraLightNode. The nonce is ordered by the source address and the destination chain,
+// so a packet is identified by the same fields on both the source and the destination chains.
+type Packet struct {
+	Nonce              uint64
+	SourceChainID      uint16
+	SourceAddress      common.Address
+	DestinationChainID uint16
+}
+
+// Key returns the identifier of the packet.
+func (p Packet) Key() string {
+	return fmt.Sprintf("%d:%s:%d:%d", p.SourceChainID, p.SourceAddress, p.DestinationChainID, p.Nonce)
+}
+
+// DecodePacket decodes a Packet event emitted by the UltraLightNode on the source chain,
+// the payload is packed by nonce, source chain ID, source address, destination chain ID, destination address and payload.
+func DecodePacket(log *ethereum.Log) (*Packet, error) {
+	values, err := packetArguments.Unpack(log.Data)
+	if err != nil {
+		return nil, fmt.Errorf("unpack Packet event: %w", err)
+	}
+
+	payload, ok := values[0].([]byte)
+	if !ok || len(payload) < packetHeaderLength {
+		return nil, fmt.Errorf("invalid packet payload")
+	}
+
+	packet := Packet{
+		Nonce:              binary.BigEndian.Uint64(payload[0:8]),
+		SourceChainID:      binary.BigEndian.Uint16(payload[8:10]),
+		SourceAddress:      common.BytesToAddress(payload[10 : 10+common.AddressLength]),
+		DestinationChainID: binary.BigEndian.Uint16(payload[10+common.AddressLength : packetHeaderLength]),
+	}
+
+	return &packet, nil
+}
+
+// DecodePacketReceived decodes a PacketReceived event emitted by the UltraLightNode on the destination chain,
+// the source address is the path of the packet, which starts with the address of the sender.
+func DecodePacketReceived(log *ethereum.Log, destinationChainID uint16) (*Packet, error) {
+	if len(log.Topics) != 3 {
+		return nil, fmt.Errorf("invalid PacketReceived event topics")
+	}
+
+	values, err := packetReceivedArguments.Unpack(log.Data)
+	if err != nil {
+		return nil, fmt.Errorf("unpack PacketReceived event: %w", err)
+	}
+
+	path, ok := values[0].([]byte)
+	if !ok || len(path) < common.AddressLength {
+		return nil, fmt.Errorf("invalid packet path")
+	}
+
+	nonce, ok := values[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid packet nonce")
+	}
+
+	packet := Packet{
+		Nonce:              nonce,
+		SourceChainID:      binary.BigEndian.Uint16(log.Topics[1][common.HashLength-2:]),
+		SourceAddress:      common.BytesToAddress(path[:common.AddressLength]),
+		DestinationChainID: destinationChainID,
+	}
+
+	return &packet, nil
+}
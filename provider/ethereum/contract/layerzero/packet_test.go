@@ -0,0 +1,86 @@
+package layerzero_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/rss3-network/node/provider/ethereum/contract/layerzero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacket(t *testing.T) {
+	t.Parallel()
+
+	type arguments struct {
+		packetLog         *ethereum.Log
+		packetReceivedLog *ethereum.Log
+		chainID           uint16
+	}
+
+	testcases := []struct {
+		name      string
+		arguments arguments
+		want      layerzero.Packet
+	}{
+		{
+			name: "Stargate from Ethereum to Arbitrum",
+			arguments: arguments{
+				// The payload is packed by nonce 289, source chain ID 101, source address, destination chain ID 110, destination address and a two-byte payload.
+				packetLog: &ethereum.Log{
+					Address: layerzero.AddressUltraLightNodeMainnet,
+					Topics: []common.Hash{
+						layerzero.EventHashUltraLightNodePacket,
+					},
+					Data: hexutil.MustDecode("0x" +
+						"0000000000000000000000000000000000000000000000000000000000000020" +
+						"0000000000000000000000000000000000000000000000000000000000000036" +
+						"0000000000000121" + "0065" + "296f55f8fb28e498b858d0bcda06d955b2cb3f97" + "006e" + "352d8275aae3e0c2404d9f68f6cee084b5beb3dd" +
+						"0000" + "00000000000000000000"),
+				},
+				packetReceivedLog: &ethereum.Log{
+					Address: layerzero.AddressUltraLightNodeArbitrumOne,
+					Topics: []common.Hash{
+						layerzero.EventHashUltraLightNodePacketReceived,
+						common.HexToHash("0x65"),
+						common.HexToHash("0x352d8275aae3e0c2404d9f68f6cee084b5beb3dd"),
+					},
+					// The source address is the path of the packet, which is packed by the source and destination addresses.
+					Data: hexutil.MustDecode("0x" +
+						"0000000000000000000000000000000000000000000000000000000000000060" +
+						"0000000000000000000000000000000000000000000000000000000000000121" +
+						"1111111111111111111111111111111111111111111111111111111111111111" +
+						"0000000000000000000000000000000000000000000000000000000000000028" +
+						"296f55f8fb28e498b858d0bcda06d955b2cb3f97352d8275aae3e0c2404d9f68" +
+						"f6cee084b5beb3dd000000000000000000000000000000000000000000000000"),
+				},
+				chainID: 110,
+			},
+			want: layerzero.Packet{
+				Nonce:              289,
+				SourceChainID:      101,
+				SourceAddress:      common.HexToAddress("0x296F55F8Fb28E498B858d0BcDA06D955B2Cb3f97"),
+				DestinationChainID: 110,
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			packet, err := layerzero.DecodePacket(testcase.arguments.packetLog)
+			require.NoError(t, err)
+			require.Equal(t, testcase.want, *packet)
+
+			packetReceived, err := layerzero.DecodePacketReceived(testcase.arguments.packetReceivedLog, testcase.arguments.chainID)
+			require.NoError(t, err)
+			require.Equal(t, testcase.want, *packetReceived)
+
+			require.Equal(t, packet.Key(), packetReceived.Key())
+		})
+	}
+}
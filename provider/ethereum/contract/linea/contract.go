@@ -19,11 +19,17 @@ import (
 // https://etherscan.io/address/0x504A330327A089d8364C4ab3811Ee26976d388ce
 //go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/L1USDCBridge.abi --pkg linea --type L1USDCBridge --out contract_l1_usdc_bridge.go
 
+// L2MessageService and L2TokenBridge share the ABIs of ZkEvmV2 and TokenBridge
+// https://lineascan.build/address/0x508Ca82Df566dCD1B0DE8296e70a96332cD644ec
+// https://lineascan.build/address/0x353012dc4a9A6cF55c941bADC267f82004A8ceB9
+
 var (
-	AddressZKEVMV2      = common.HexToAddress("0xd19d4B5d358258f05D7B411E21A1460D11B0876F")
-	AddressTokenBridge  = common.HexToAddress("0x051F1D88f0aF5763fB888eC4378b4D8B29ea3319")
-	AddressL1USDCBridge = common.HexToAddress("0x504A330327A089d8364C4ab3811Ee26976d388ce")
-	AddressUSDC         = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	AddressZKEVMV2          = common.HexToAddress("0xd19d4B5d358258f05D7B411E21A1460D11B0876F")
+	AddressTokenBridge      = common.HexToAddress("0x051F1D88f0aF5763fB888eC4378b4D8B29ea3319")
+	AddressL1USDCBridge     = common.HexToAddress("0x504A330327A089d8364C4ab3811Ee26976d388ce")
+	AddressUSDC             = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	AddressL2MessageService = common.HexToAddress("0x508Ca82Df566dCD1B0DE8296e70a96332cD644ec")
+	AddressL2TokenBridge    = common.HexToAddress("0x353012dc4a9A6cF55c941bADC267f82004A8ceB9")
 
 	EventHashZKEVMV2MessageSent                 = contract.EventHash("MessageSent(address,address,uint256,uint256,uint256,bytes,bytes32)")
 	EventHashZKEVMV2MessageClaimed              = contract.EventHash("MessageClaimed(bytes32)")
@@ -21,6 +21,7 @@ var (
 	AddressSeaportV1Dot3    = common.HexToAddress("0x0000000000000aD24e80fd803C6ac37206a45f15")
 	AddressSeaportV1Dot4    = common.HexToAddress("0x00000000000001ad428e4906aE43D8F9852d0dD6")
 	AddressSeaportV1Dot5    = common.HexToAddress("0x00000000000000ADc04C56Bf30aC9d3c0aAF14dC")
+	AddressSeaportV1Dot6    = common.HexToAddress("0x0000000000000068F116a894984e2DB1123eB395")
 
 	EventHashWyvernExchangeV1OrdersMatched = contract.EventHash("OrdersMatched(bytes32,bytes32,address,address,uint256,bytes32)")
 	EventHashWyvernExchangeV2OrdersMatched = EventHashWyvernExchangeV1OrdersMatched
@@ -9,6 +9,8 @@ import (
 //go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/L1StandardBridge.abi --pkg optimism --type L1StandardBridge --out contract_l1_standard_bridge.go
 // L2StandardBridge https://optimistic.etherscan.io/address/0x4200000000000000000000000000000000000010
 //go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/L2StandardBridge.abi --pkg optimism --type L2StandardBridge --out contract_l2_standard_bridge.go
+// CrossDomainMessenger https://etherscan.io/address/0x25ace71c97B33Cc4729CF772ae268934F7ab5fA1
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/CrossDomainMessenger.abi --pkg optimism --type CrossDomainMessenger --out contract_cross_domain_messenger.go
 
 var (
 	AddressL1ETH            = common.HexToAddress("0xDeadDeAddeAddEAddeadDEaDDEAdDeaDDeAD0000")
@@ -17,10 +19,17 @@ var (
 	AddressL2StandardBridge = common.HexToAddress("0x4200000000000000000000000000000000000010")
 	AddressL1OptimismPortal = common.HexToAddress("0xbEb5Fc579115071764c7423A4f12eDde41f106Ed")
 
+	AddressL1CrossDomainMessenger = common.HexToAddress("0x25ace71c97B33Cc4729CF772ae268934F7ab5fA1")
+	AddressL2CrossDomainMessenger = common.HexToAddress("0x4200000000000000000000000000000000000007")
+
 	EventHashAddressL1StandardBridgeETHDepositInitiated      = contract.EventHash("ETHDepositInitiated(address,address,uint256,bytes)")
 	EventHashAddressL1StandardBridgeERC20DepositInitiated    = contract.EventHash("ERC20DepositInitiated(address,address,address,address,uint256,bytes)")
 	EventHashAddressL1StandardBridgeETHWithdrawalFinalized   = contract.EventHash("ETHWithdrawalFinalized(address,address,uint256,bytes)")
 	EventHashAddressL1StandardBridgeERC20WithdrawalFinalized = contract.EventHash("ERC20WithdrawalFinalized(address,address,address,address,uint256,bytes)")
 	EventHashAddressL2StandardBridgeWithdrawalInitiated      = contract.EventHash("WithdrawalInitiated(address,address,address,address,uint256,bytes)")
 	EventHashAddressL2StandardBridgeDepositFinalized         = contract.EventHash("DepositFinalized(address,address,address,address,uint256,bytes)")
+
+	EventHashCrossDomainMessengerSentMessage           = contract.EventHash("SentMessage(address,address,bytes,uint256,uint256)")
+	EventHashCrossDomainMessengerSentMessageExtension1 = contract.EventHash("SentMessageExtension1(address,uint256)")
+	EventHashCrossDomainMessengerRelayedMessage        = contract.EventHash("RelayedMessage(bytes32)")
 )
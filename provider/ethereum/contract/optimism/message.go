@@ -0,0 +1,85 @@
+package optimism
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rss3-network/node/provider/ethereum"
+	"github.com/samber/lo"
+)
+
+// MessageVersion is the version of the messages sent by the CrossDomainMessenger since Bedrock,
+// which is encoded in the first two bytes of the message nonce.
+const MessageVersion = 1
+
+var crossDomainMessengerFilterer = lo.Must(NewCrossDomainMessengerFilterer(ethereum.AddressGenesis, nil))
+
+// HashCrossDomainMessage returns the hash of a message, which is the hash of the relayMessage call on the other chain.
+// https://github.com/ethereum-optimism/optimism/blob/develop/packages/contracts-bedrock/src/libraries/Hashing.sol
+func HashCrossDomainMessage(nonce *big.Int, sender, target common.Address, value, gasLimit *big.Int, message []byte) (common.Hash, error) {
+	if version := new(big.Int).Rsh(nonce, 240); version.Cmp(big.NewInt(MessageVersion)) != 0 {
+		return common.Hash{}, fmt.Errorf("unsupported message version %d", version)
+	}
+
+	abi, err := CrossDomainMessengerMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("load CrossDomainMessenger ABI: %w", err)
+	}
+
+	data, err := abi.Pack("relayMessage", nonce, sender, target, value, gasLimit, message)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("pack relayMessage: %w", err)
+	}
+
+	return crypto.Keccak256Hash(data), nil
+}
+
+// CrossDomainMessageHashes returns the hashes of the messages sent or relayed by the messenger in the logs,
+// which are shared by the transactions on both chains of a bridge transfer.
+func CrossDomainMessageHashes(messenger common.Address, logs []*ethereum.Log) ([]common.Hash, error) {
+	hashes := make([]common.Hash, 0)
+
+	for index, log := range logs {
+		if log.Address != messenger || len(log.Topics) == 0 {
+			continue
+		}
+
+		switch log.Topics[0] {
+		case EventHashCrossDomainMessengerSentMessage:
+			event, err := crossDomainMessengerFilterer.ParseSentMessage(log.Export())
+			if err != nil {
+				return nil, fmt.Errorf("parse SentMessage event: %w", err)
+			}
+
+			// The value of the message is emitted by the following SentMessageExtension1 event.
+			value := big.NewInt(0)
+
+			if index+1 < len(logs) && logs[index+1].Address == messenger && len(logs[index+1].Topics) > 0 && logs[index+1].Topics[0] == EventHashCrossDomainMessengerSentMessageExtension1 {
+				extension, err := crossDomainMessengerFilterer.ParseSentMessageExtension1(logs[index+1].Export())
+				if err != nil {
+					return nil, fmt.Errorf("parse SentMessageExtension1 event: %w", err)
+				}
+
+				value = extension.Value
+			}
+
+			hash, err := HashCrossDomainMessage(event.MessageNonce, event.Sender, event.Target, value, event.GasLimit, event.Message)
+			if err != nil {
+				return nil, fmt.Errorf("hash message %d: %w", event.MessageNonce, err)
+			}
+
+			hashes = append(hashes, hash)
+		case EventHashCrossDomainMessengerRelayedMessage:
+			event, err := crossDomainMessengerFilterer.ParseRelayedMessage(log.Export())
+			if err != nil {
+				return nil, fmt.Errorf("parse RelayedMessage event: %w", err)
+			}
+
+			hashes = append(hashes, event.MsgHash)
+		}
+	}
+
+	return hashes, nil
+}
@@ -0,0 +1,21 @@
+package pancakeswap
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+)
+
+// V3Pool
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/V3Pool.abi --pkg pancakeswap --type V3Pool --out contract_v3_pool.go
+// V3Factory https://bscscan.com/address/0x0BFbCF9fa4f9C56B0F40a671Ad40E0805A091865
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/V3Factory.abi --pkg pancakeswap --type V3Factory --out contract_v3_factory.go
+
+// PancakeSwap v3 is deployed at the same addresses on all networks.
+// https://developer.pancakeswap.finance/contracts/v3/addresses
+var (
+	AddressV3Factory                  = common.HexToAddress("0x0BFbCF9fa4f9C56B0F40a671Ad40E0805A091865")
+	AddressV3SmartRouter              = common.HexToAddress("0x13f4EA83D0bd40E75C8222255bc855a974568Dd4")
+	AddressNonfungiblePositionManager = common.HexToAddress("0x46A15B0b27311cedF172AB29E4f4766fbE7F4364")
+
+	EventHashV3PoolSwap = contract.EventHash("Swap(address,address,int256,int256,uint160,uint128,int24,uint128,uint128)")
+)
@@ -0,0 +1,53 @@
+package safe
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/node/provider/ethereum/contract"
+)
+
+// Safe v1.4.1 https://github.com/safe-global/safe-smart-account/blob/v1.4.1/contracts/Safe.sol
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/Safe.abi --pkg safe --type Safe --out safe.go
+
+// Safe v1.3.0 https://github.com/safe-global/safe-smart-account/blob/v1.3.0/contracts/GnosisSafe.sol
+// The owner and execution events of v1.3.0 have no indexed arguments.
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/SafeV130.abi --pkg safe --type SafeV130 --out safe_v130.go
+
+// MultiSend https://github.com/safe-global/safe-smart-account/blob/v1.4.1/contracts/libraries/MultiSend.sol
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/MultiSend.abi --pkg safe --type MultiSend --out multi_send.go
+
+var (
+	EventSafeSetup        = contract.EventHash("SafeSetup(address,address[],uint256,address,address)")
+	EventAddedOwner       = contract.EventHash("AddedOwner(address)")
+	EventRemovedOwner     = contract.EventHash("RemovedOwner(address)")
+	EventChangedThreshold = contract.EventHash("ChangedThreshold(uint256)")
+	EventExecutionSuccess = contract.EventHash("ExecutionSuccess(bytes32,uint256)")
+	EventExecutionFailure = contract.EventHash("ExecutionFailure(bytes32,uint256)")
+
+	MethodIDExecTransaction = contract.MethodID("execTransaction(address,uint256,bytes,uint8,uint256,uint256,uint256,address,address,bytes)")
+	MethodIDMultiSend       = contract.MethodID("multiSend(bytes)")
+)
+
+// The operations of the Safe transactions.
+const (
+	OperationCall         uint8 = 0
+	OperationDelegateCall uint8 = 1
+)
+
+type ExecTransactionInput struct {
+	To             common.Address
+	Value          *big.Int
+	Data           []byte
+	Operation      uint8
+	SafeTxGas      *big.Int
+	BaseGas        *big.Int
+	GasPrice       *big.Int
+	GasToken       common.Address
+	RefundReceiver common.Address
+	Signatures     []byte
+}
+
+type MultiSendInput struct {
+	Transactions []byte
+}
@@ -98,6 +98,32 @@ func EthereumChain(chainID uint16) (network.Network, bool) {
 	return chain, chain != 0
 }
 
+// ChainID returns the LayerZero chain ID of the network, which is the inverse of EthereumChain.
+func ChainID(n network.Network) (uint16, bool) {
+	var chainID uint16
+
+	switch n {
+	case network.Ethereum:
+		chainID = 101
+	case network.BinanceSmartChain:
+		chainID = 102
+	case network.Avalanche:
+		chainID = 106
+	case network.Polygon:
+		chainID = 109
+	case network.Arbitrum:
+		chainID = 110
+	case network.Optimism:
+		chainID = 111
+	case network.Base:
+		chainID = 184
+	case network.Linea:
+		chainID = 183
+	}
+
+	return chainID, chainID != 0
+}
+
 func FactoryAddress(n network.Network) (common.Address, bool) {
 	var address common.Address
 
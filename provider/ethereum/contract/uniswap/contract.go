@@ -19,10 +19,15 @@ import (
 //go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/V3Pool.abi --pkg uniswap --type V3Pool --out contract_v3_pool.go
 // NonfungiblePositionManager https://etherscan.io/address/0xc36442b4a4522e871399cd717abdd847ab11fe88#code
 //go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/NonfungiblePositionManager.abi --pkg uniswap --type NonfungiblePositionManager --out contract_nonfungible_position_manager.go
+// V4PoolManager https://etherscan.io/address/0x000000000004444c5dc75cB358380D2e3dE08A90
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/V4PoolManager.abi --pkg uniswap --type V4PoolManager --out contract_v4_pool_manager.go
+// V4PositionManager https://etherscan.io/address/0xbD216513d74C8cf14cf4747E6AaA6420FF64ee9e
+//go:generate go run --mod=mod github.com/ethereum/go-ethereum/cmd/abigen --abi ./abi/V4PositionManager.abi --pkg uniswap --type V4PositionManager --out contract_v4_position_manager.go
 
 // https://docs.uniswap.org/
 // https://github.com/Uniswap/universal-router/blob/main/deploy-addresses/mainnet.json
 // https://docs.uniswap.org/contracts/v3/reference/deployments/
+// https://docs.uniswap.org/contracts/v4/deployments
 var (
 	AddressV1Factory                                   = common.HexToAddress("0xc0a47dFe034B400B47bDaD5FecDa2621de6c4d95")
 	AddressV2Migrator                                  = common.HexToAddress("0x16D4F26C15f3658ec65B1126ff27DD3dF2a2996b")
@@ -71,6 +76,27 @@ var (
 	AddressNonfungiblePositionManagerAvalanche         = common.HexToAddress("0x655C406EBFa14EE2006250925e54ec43AD184f8B")
 	AddressNonfungiblePositionManagerLinea             = common.HexToAddress("0x4615C383F85D0a2BbED973d83ccecf5CB7121463")
 	AddressV2SwapRouterSAVM                            = common.HexToAddress("0xC7c934E224e8567df50058A907904b451bD1c57D")
+	AddressV4PoolManager                               = common.HexToAddress("0x000000000004444c5dc75cB358380D2e3dE08A90")
+	AddressV4PoolManagerArbitrum                       = common.HexToAddress("0x360E68faCcca8cA495c1B759Fd9EEe466db9FB32")
+	AddressV4PoolManagerOptimism                       = common.HexToAddress("0x9a13F98Cb987694C9F086b1F5eB990EeA8264Ec3")
+	AddressV4PoolManagerPolygon                        = common.HexToAddress("0x67366782805870060151383F4BbFF9daB53e5cD6")
+	AddressV4PoolManagerBase                           = common.HexToAddress("0x498581fF718922c3f8e6A244956aF099B2652b2b")
+	AddressV4PoolManagerBinanceSmartChain              = common.HexToAddress("0x28e2Ea090877bF75740558f6BFB36A5ffeE9e9dF")
+	AddressV4PoolManagerAvalanche                      = common.HexToAddress("0x06380C0e0912312B5150364B9DC4542BA0DbBc85")
+	AddressV4PositionManager                           = common.HexToAddress("0xbD216513d74C8cf14cf4747E6AaA6420FF64ee9e")
+	AddressV4PositionManagerArbitrum                   = common.HexToAddress("0xd88F38F930b7952f2DB2432Cb002E7abbF3dD869")
+	AddressV4PositionManagerOptimism                   = common.HexToAddress("0x3C3Ea4B57a46241e54610e5f022E5c45859A1017")
+	AddressV4PositionManagerPolygon                    = common.HexToAddress("0x1Ec2eBf4F37E7363FDfe3551602425af0B3ceef9")
+	AddressV4PositionManagerBase                       = common.HexToAddress("0x7C5f5A4bBd8fD63184577525326123B519429bDc")
+	AddressV4PositionManagerBinanceSmartChain          = common.HexToAddress("0x7A4a5c919aE2541AeD11041A1AEeE68f1287f95b")
+	AddressV4PositionManagerAvalanche                  = common.HexToAddress("0xB74b1F14d2754AcfcbBe1a221023a5cf50Ab8ACD")
+	AddressUniversalRouterV4                           = common.HexToAddress("0x66a9893cC07D91D95644AEDD05D03f95e1dBA8Af")
+	AddressUniversalRouterV4Arbitrum                   = common.HexToAddress("0xA51afAFe0263b40EdaEf0Df8781eA9aa03E381a3")
+	AddressUniversalRouterV4Optimism                   = common.HexToAddress("0x851116D9223fabED8E56C0E6b8Ad0c31d98B3507")
+	AddressUniversalRouterV4Polygon                    = common.HexToAddress("0x1095692A6237d83C6a72F3F5eFEdb9A670C49223")
+	AddressUniversalRouterV4Base                       = common.HexToAddress("0x6fF5693b99212Da76ad316178A184AB56D299b43")
+	AddressUniversalRouterV4BinanceSmartChain          = common.HexToAddress("0x1906c1d672b88cD1B9aC7593301cA990F94Eae07")
+	AddressUniversalRouterV4Avalanche                  = common.HexToAddress("0x94b75331AE8d42C1b61065089B7d48FE14aA73b7")
 
 	EventHashV1ExchangeTokenPurchase                     = contract.EventHash("TokenPurchase(address,uint256,uint256)")
 	EventHashV1ExchangeEthPurchase                       = contract.EventHash("EthPurchase(address,uint256,uint256)")
@@ -85,4 +111,7 @@ var (
 	EventHashNonfungiblePositionManagerCollect           = contract.EventHash("Collect(uint256,address,uint256,uint256)")
 	EventHashNonfungiblePositionManagerDecreaseLiquidity = contract.EventHash("DecreaseLiquidity(uint256,uint128,uint256,uint256)")
 	EventHashNonfungiblePositionManagerIncreaseLiquidity = contract.EventHash("IncreaseLiquidity(uint256,uint128,uint256,uint256)")
+	EventHashV4PoolManagerInitialize                     = contract.EventHash("Initialize(bytes32,address,address,uint24,int24,address,uint160,int24)")
+	EventHashV4PoolManagerModifyLiquidity                = contract.EventHash("ModifyLiquidity(bytes32,address,int24,int24,int256,bytes32)")
+	EventHashV4PoolManagerSwap                           = contract.EventHash("Swap(bytes32,address,int128,int128,uint160,uint128,int24,uint24)")
 )
@@ -98,9 +98,10 @@ type ReactionBody struct {
 }
 
 type LinkBody struct {
-	Type             string `json:"type"`
-	DisplayTimestamp uint32 `json:"displayTimestamp"`
-	TargetFid        uint64 `json:"targetFid"`
+	Type             string         `json:"type"`
+	DisplayTimestamp uint32         `json:"displayTimestamp"`
+	TargetFid        uint64         `json:"targetFid"`
+	TargetProfile    *model.Profile `json:"targetProfile,omitempty"`
 }
 
 type VerificationAddEthAddressBody struct {
@@ -38,6 +38,9 @@ const (
 	ReactionTypeRecast ReactionType = 2 // Share target cast to the user's audience
 )
 
+// LinkTypeFollow is the type of links following the target
+const LinkTypeFollow = "follow"
+
 //go:generate go run --mod=mod github.com/dmarkham/enumer --values --type=HubEventType --output type_event.go --transform=snake-upper
 type HubEventType int
 
@@ -3,7 +3,9 @@ package near
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"math/big"
 	"time"
 
@@ -18,6 +20,7 @@ type Client interface {
 	ChunkByHeight(ctx context.Context, blockHeight *big.Int, shardID int) (*Chunk, error)
 	TransactionByHash(ctx context.Context, txHash string, senderAccountID string) (*Transaction, error)
 	GetBlockHeight(ctx context.Context) (int64, error)
+	CallFunction(ctx context.Context, accountID string, methodName string, args any) ([]byte, error)
 }
 
 var _ Client = (*client)(nil)
@@ -138,3 +141,30 @@ func (c *client) GetBlockHeight(ctx context.Context) (int64, error) {
 
 	return int64(result.Header.Height), nil
 }
+
+// CallFunction calls a view method of the contract at the final block and returns the raw result.
+func (c *client) CallFunction(ctx context.Context, accountID string, methodName string, args any) ([]byte, error) {
+	argsBytes, err := json.Marshal(lo.Ternary(args == nil, any(map[string]any{}), args))
+	if err != nil {
+		return nil, fmt.Errorf("marshal args: %w", err)
+	}
+
+	var result CallFunctionResult
+
+	err = c.rpcCall(ctx, "query", map[string]interface{}{
+		"request_type": "call_function",
+		"finality":     "final",
+		"account_id":   accountID,
+		"method_name":  methodName,
+		"args_base64":  base64.StdEncoding.EncodeToString(argsBytes),
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Error != "" {
+		return nil, fmt.Errorf("call function %s of %s: %s", methodName, accountID, result.Error)
+	}
+
+	return result.Bytes(), nil
+}
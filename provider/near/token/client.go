@@ -0,0 +1,185 @@
+package token
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/rueidis"
+	"github.com/rss3-network/node/provider/near"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultCacheDuration = 7 * 24 * time.Hour
+)
+
+const (
+	methodFungibleTokenMetadata    = "ft_metadata"
+	methodNonFungibleTokenMetadata = "nft_metadata"
+)
+
+// Client is a client to look up NEAR token metadata.
+type Client interface {
+	// LookupFungibleToken looks up the NEP-141 token metadata of the contract.
+	LookupFungibleToken(ctx context.Context, contractID string) (*metadata.Token, error)
+	// LookupNonFungibleToken looks up the NEP-171 collection metadata of the contract.
+	LookupNonFungibleToken(ctx context.Context, contractID string) (*metadata.Token, error)
+}
+
+// FungibleTokenMetadata is the result of the ft_metadata view method.
+type FungibleTokenMetadata struct {
+	Spec     string `json:"spec"`
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	Icon     string `json:"icon"`
+	Decimals uint8  `json:"decimals"`
+}
+
+// NonFungibleTokenMetadata is the result of the nft_metadata view method.
+type NonFungibleTokenMetadata struct {
+	Spec    string `json:"spec"`
+	Name    string `json:"name"`
+	Symbol  string `json:"symbol"`
+	Icon    string `json:"icon"`
+	BaseURI string `json:"base_uri"`
+}
+
+var _ Client = (*client)(nil)
+
+// client is a client to look up NEAR token metadata.
+type client struct {
+	nearClient    near.Client
+	rueidisClient rueidis.Client
+}
+
+type Option func(*client) error
+
+// WithRueidisClient sets Redis client and enables caching.
+func WithRueidisClient(rueidisClient rueidis.Client) Option {
+	return func(c *client) error {
+		c.rueidisClient = rueidisClient
+
+		return nil
+	}
+}
+
+// LookupFungibleToken looks up the NEP-141 token metadata by the ft_metadata view method.
+func (c *client) LookupFungibleToken(ctx context.Context, contractID string) (*metadata.Token, error) {
+	cacheKey := c.buildCacheKey(methodFungibleTokenMetadata, contractID)
+
+	if tokenMetadata, err := c.lookupByRedis(ctx, cacheKey); err == nil {
+		return tokenMetadata, nil
+	}
+
+	result, err := c.nearClient.CallFunction(ctx, contractID, methodFungibleTokenMetadata, nil)
+	if err != nil {
+		return nil, fmt.Errorf("call %s: %w", methodFungibleTokenMetadata, err)
+	}
+
+	var value FungibleTokenMetadata
+	if err := json.Unmarshal(result, &value); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", methodFungibleTokenMetadata, err)
+	}
+
+	tokenMetadata := metadata.Token{
+		Address:  lo.ToPtr(contractID),
+		Name:     value.Name,
+		Symbol:   value.Symbol,
+		Decimals: value.Decimals,
+		Standard: metadata.StandardNEP141,
+	}
+
+	c.cacheTokenMetadata(ctx, cacheKey, &tokenMetadata)
+
+	return &tokenMetadata, nil
+}
+
+// LookupNonFungibleToken looks up the NEP-171 collection metadata by the nft_metadata view method.
+// The metadata has no standard as NEP-171 is not defined by the schema.
+func (c *client) LookupNonFungibleToken(ctx context.Context, contractID string) (*metadata.Token, error) {
+	cacheKey := c.buildCacheKey(methodNonFungibleTokenMetadata, contractID)
+
+	if tokenMetadata, err := c.lookupByRedis(ctx, cacheKey); err == nil {
+		return tokenMetadata, nil
+	}
+
+	result, err := c.nearClient.CallFunction(ctx, contractID, methodNonFungibleTokenMetadata, nil)
+	if err != nil {
+		return nil, fmt.Errorf("call %s: %w", methodNonFungibleTokenMetadata, err)
+	}
+
+	var value NonFungibleTokenMetadata
+	if err := json.Unmarshal(result, &value); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", methodNonFungibleTokenMetadata, err)
+	}
+
+	tokenMetadata := metadata.Token{
+		Address: lo.ToPtr(contractID),
+		Name:    value.Name,
+		Symbol:  value.Symbol,
+		URI:     value.BaseURI,
+	}
+
+	c.cacheTokenMetadata(ctx, cacheKey, &tokenMetadata)
+
+	return &tokenMetadata, nil
+}
+
+// lookupByRedis looks up token metadata by Redis when it's enabled to reduce RPC calls.
+func (c *client) lookupByRedis(ctx context.Context, key string) (*metadata.Token, error) {
+	if c.rueidisClient == nil {
+		return nil, fmt.Errorf("redis is disabled")
+	}
+
+	var tokenMetadata metadata.Token
+
+	command := c.rueidisClient.B().Get().Key(key).Build()
+
+	if err := c.rueidisClient.Do(ctx, command).DecodeJSON(&tokenMetadata); err != nil {
+		return nil, fmt.Errorf("lookup token metadata from redis: %w", err)
+	}
+
+	return &tokenMetadata, nil
+}
+
+// cacheTokenMetadata caches the token metadata to Redis, failures are ignored as the cache is optional.
+func (c *client) cacheTokenMetadata(ctx context.Context, key string, tokenMetadata *metadata.Token) {
+	if c.rueidisClient == nil {
+		return
+	}
+
+	value, err := json.Marshal(tokenMetadata)
+	if err != nil {
+		return
+	}
+
+	command := c.rueidisClient.B().Setex().
+		Key(key).
+		Seconds(int64(defaultCacheDuration.Seconds())).
+		Value(string(value)).
+		Build()
+
+	c.rueidisClient.Do(ctx, command)
+}
+
+func (c *client) buildCacheKey(method, contractID string) string {
+	return fmt.Sprintf("tokens:near:%s:%s", method, contractID)
+}
+
+// NewClient returns a client to look up NEAR token metadata.
+func NewClient(nearClient near.Client, options ...Option) Client {
+	instance := client{
+		nearClient: nearClient,
+	}
+
+	for _, option := range options {
+		if err := option(&instance); err != nil {
+			return nil
+		}
+	}
+
+	return &instance
+}
@@ -0,0 +1,86 @@
+package near
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// EventLogPrefix is the prefix of the NEP-297 event logs.
+const EventLogPrefix = "EVENT_JSON:"
+
+const (
+	StandardNEP141 = "nep141"
+	StandardNEP171 = "nep171"
+)
+
+const (
+	EventFTMint      = "ft_mint"
+	EventFTTransfer  = "ft_transfer"
+	EventFTBurn      = "ft_burn"
+	EventNFTMint     = "nft_mint"
+	EventNFTTransfer = "nft_transfer"
+	EventNFTBurn     = "nft_burn"
+)
+
+// Event is a NEP-297 event emitted in the logs of a receipt.
+type Event struct {
+	Standard string          `json:"standard"`
+	Version  string          `json:"version"`
+	Event    string          `json:"event"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// FungibleTokenEventData is an item of the NEP-141 event data, ft_mint and ft_burn only have the owner.
+type FungibleTokenEventData struct {
+	OwnerID    string `json:"owner_id"`
+	OldOwnerID string `json:"old_owner_id"`
+	NewOwnerID string `json:"new_owner_id"`
+	Amount     string `json:"amount"`
+	Memo       string `json:"memo"`
+}
+
+// NonFungibleTokenEventData is an item of the NEP-171 event data, nft_mint and nft_burn only have the owner.
+type NonFungibleTokenEventData struct {
+	OwnerID      string   `json:"owner_id"`
+	OldOwnerID   string   `json:"old_owner_id"`
+	NewOwnerID   string   `json:"new_owner_id"`
+	AuthorizedID string   `json:"authorized_id"`
+	TokenIDs     []string `json:"token_ids"`
+	Memo         string   `json:"memo"`
+}
+
+// ParseEvent parses a NEP-297 event from a log, it returns nil if the log is not an event.
+func ParseEvent(log string) (*Event, error) {
+	value, found := strings.CutPrefix(log, EventLogPrefix)
+	if !found {
+		return nil, nil
+	}
+
+	var event Event
+	if err := json.Unmarshal([]byte(value), &event); err != nil {
+		return nil, fmt.Errorf("unmarshal event: %w", err)
+	}
+
+	return &event, nil
+}
+
+// FungibleTokenData returns the data of a NEP-141 event.
+func (e *Event) FungibleTokenData() ([]FungibleTokenEventData, error) {
+	var data []FungibleTokenEventData
+	if err := json.Unmarshal(e.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal %s data: %w", e.Event, err)
+	}
+
+	return data, nil
+}
+
+// NonFungibleTokenData returns the data of a NEP-171 event.
+func (e *Event) NonFungibleTokenData() ([]NonFungibleTokenEventData, error) {
+	var data []NonFungibleTokenEventData
+	if err := json.Unmarshal(e.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal %s data: %w", e.Event, err)
+	}
+
+	return data, nil
+}
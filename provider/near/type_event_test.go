@@ -0,0 +1,98 @@
+package near_test
+
+import (
+	"testing"
+
+	"github.com/rss3-network/node/provider/near"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEvent(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		log       string
+		want      *near.Event
+		wantData  any
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name: "NEP-141 Transfer",
+			log:  `EVENT_JSON:{"standard":"nep141","version":"1.0.0","event":"ft_transfer","data":[{"old_owner_id":"alice.near","new_owner_id":"bob.near","amount":"25000000","memo":"tip"}]}`,
+			want: &near.Event{
+				Standard: near.StandardNEP141,
+				Version:  "1.0.0",
+				Event:    near.EventFTTransfer,
+			},
+			wantData: []near.FungibleTokenEventData{
+				{
+					OldOwnerID: "alice.near",
+					NewOwnerID: "bob.near",
+					Amount:     "25000000",
+					Memo:       "tip",
+				},
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "NEP-171 Mint",
+			log:  `EVENT_JSON:{"standard":"nep171","version":"1.2.0","event":"nft_mint","data":[{"owner_id":"alice.near","token_ids":["1","2"]}]}`,
+			want: &near.Event{
+				Standard: near.StandardNEP171,
+				Version:  "1.2.0",
+				Event:    near.EventNFTMint,
+			},
+			wantData: []near.NonFungibleTokenEventData{
+				{
+					OwnerID:  "alice.near",
+					TokenIDs: []string{"1", "2"},
+				},
+			},
+			wantError: require.NoError,
+		},
+		{
+			name:      "Plain Log",
+			log:       "Transfer 25000000 from alice.near to bob.near",
+			wantError: require.NoError,
+		},
+		{
+			name:      "Invalid Event",
+			log:       "EVENT_JSON:{",
+			wantError: require.Error,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			event, err := near.ParseEvent(testcase.log)
+			testcase.wantError(t, err)
+
+			if testcase.want == nil {
+				require.Nil(t, event)
+
+				return
+			}
+
+			require.Equal(t, testcase.want.Standard, event.Standard)
+			require.Equal(t, testcase.want.Version, event.Version)
+			require.Equal(t, testcase.want.Event, event.Event)
+
+			var data any
+
+			switch event.Standard {
+			case near.StandardNEP141:
+				data, err = event.FungibleTokenData()
+			case near.StandardNEP171:
+				data, err = event.NonFungibleTokenData()
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testcase.wantData, data)
+		})
+	}
+}
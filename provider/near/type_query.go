@@ -0,0 +1,22 @@
+package near
+
+// CallFunctionResult is the result of a view method call.
+type CallFunctionResult struct {
+	// Result is the returned bytes of the method, encoded as an array of numbers.
+	Result      []int    `json:"result"`
+	Logs        []string `json:"logs"`
+	BlockHeight int64    `json:"block_height"`
+	BlockHash   string   `json:"block_hash"`
+	Error       string   `json:"error"`
+}
+
+// Bytes returns the returned bytes of the method.
+func (r *CallFunctionResult) Bytes() []byte {
+	result := make([]byte, len(r.Result))
+
+	for index, value := range r.Result {
+		result[index] = byte(value)
+	}
+
+	return result
+}
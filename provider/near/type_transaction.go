@@ -1,5 +1,7 @@
 package near
 
+import "encoding/json"
+
 type Transaction struct {
 	Status               TransactionStatus  `json:"status"`
 	Transaction          TransactionDetails `json:"transaction"`
@@ -9,7 +11,8 @@ type Transaction struct {
 }
 
 type TransactionStatus struct {
-	SuccessValue string `json:"SuccessValue"`
+	SuccessValue string          `json:"SuccessValue"`
+	Failure      json.RawMessage `json:"Failure,omitempty"`
 }
 
 type TransactionOutcome struct {
@@ -35,7 +38,9 @@ type TransactionOutcomeProof struct {
 }
 
 type TransactionOutcomeStatus struct {
-	SuccessReceiptID string `json:"SuccessReceiptId"`
+	SuccessReceiptID string          `json:"SuccessReceiptId"`
+	SuccessValue     *string         `json:"SuccessValue,omitempty"`
+	Failure          json.RawMessage `json:"Failure,omitempty"`
 }
 
 type TransactionOutcomeMetadata struct {
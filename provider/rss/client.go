@@ -0,0 +1,124 @@
+package rss
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rss3-network/node/internal/constant"
+	"github.com/samber/lo"
+)
+
+const (
+	DefaultTimeout = 30 * time.Second
+
+	// maxFeedSize limits the size of feeds to be read, feeds are rarely larger than a few megabytes.
+	maxFeedSize = 16 << 20
+)
+
+// Client fetches feeds with conditional requests.
+type Client interface {
+	// Fetch fetches and parses the feed, the feed is not modified if the response has no feed.
+	Fetch(ctx context.Context, feedURL string, validator Validator) (*Response, error)
+}
+
+// Validator is the cache validator of a feed returned by its server.
+type Validator struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+type Response struct {
+	// Feed is nil if the feed is not modified since the validator.
+	Feed      *Feed
+	Validator Validator
+}
+
+var _ Client = (*client)(nil)
+
+type client struct {
+	httpClient *http.Client
+}
+
+func (c *client) Fetch(ctx context.Context, feedURL string, validator Validator) (*Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, feedURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("new request: %w", err)
+	}
+
+	request.Header.Set("Accept", "application/rss+xml, application/atom+xml, application/feed+json, application/xml;q=0.9, application/json;q=0.9, */*;q=0.8")
+	request.Header.Set("User-Agent", fmt.Sprintf("RSS3 %s/%s", constant.Name, constant.BuildVersion()))
+
+	if validator.ETag != "" {
+		request.Header.Set("If-None-Match", validator.ETag)
+	}
+
+	if validator.LastModified != "" {
+		request.Header.Set("If-Modified-Since", validator.LastModified)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+
+	defer lo.Try(response.Body.Close)
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		return &Response{
+			Validator: validator,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxFeedSize))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	feed, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse feed: %w", err)
+	}
+
+	return &Response{
+		Feed: feed,
+		Validator: Validator{
+			ETag:         response.Header.Get("ETag"),
+			LastModified: response.Header.Get("Last-Modified"),
+		},
+	}, nil
+}
+
+type Option func(*client) error
+
+// WithHTTPClient sets the HTTP client to fetch feeds.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *client) error {
+		c.httpClient = httpClient
+
+		return nil
+	}
+}
+
+// NewClient returns a client to fetch feeds.
+func NewClient(options ...Option) (Client, error) {
+	instance := client{
+		httpClient: &http.Client{
+			Timeout: DefaultTimeout,
+		},
+	}
+
+	for _, option := range options {
+		if err := option(&instance); err != nil {
+			return nil, fmt.Errorf("apply option: %w", err)
+		}
+	}
+
+	return &instance, nil
+}
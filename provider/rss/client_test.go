@@ -0,0 +1,84 @@
+package rss_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rss3-network/node/provider/rss"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Fetch(t *testing.T) {
+	t.Parallel()
+
+	const (
+		etag         = `"v1"`
+		lastModified = "Mon, 02 Sep 2024 10:00:00 GMT"
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Header.Get("If-None-Match") == etag || request.Header.Get("If-Modified-Since") == lastModified {
+			writer.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		writer.Header().Set("ETag", etag)
+		writer.Header().Set("Last-Modified", lastModified)
+		writer.Header().Set("Content-Type", "application/feed+json")
+
+		_, _ = writer.Write([]byte(`{"version": "https://jsonfeed.org/version/1.1", "title": "Example", "items": [{"id": "1", "url": "https://example.org/1"}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := rss.NewClient()
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name      string
+		validator rss.Validator
+		modified  bool
+	}{
+		{
+			name:     "Without validator",
+			modified: true,
+		},
+		{
+			name:      "Not modified by ETag",
+			validator: rss.Validator{ETag: etag},
+		},
+		{
+			name:      "Not modified by Last-Modified",
+			validator: rss.Validator{LastModified: lastModified},
+		},
+		{
+			name:      "Modified",
+			validator: rss.Validator{ETag: `"v0"`},
+			modified:  true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			response, err := client.Fetch(context.Background(), server.URL, testcase.validator)
+			require.NoError(t, err)
+
+			if !testcase.modified {
+				require.Nil(t, response.Feed)
+				require.Equal(t, testcase.validator, response.Validator)
+
+				return
+			}
+
+			require.NotNil(t, response.Feed)
+			require.Len(t, response.Feed.Items, 1)
+			require.Equal(t, rss.Validator{ETag: etag, LastModified: lastModified}, response.Validator)
+		})
+	}
+}
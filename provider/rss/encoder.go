@@ -0,0 +1,366 @@
+package rss
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	namespaceDublinCore = "http://purl.org/dc/elements/1.1/"
+	namespaceContent    = "http://purl.org/rss/1.0/modules/content/"
+
+	versionJSONFeed = "https://jsonfeed.org/version/1.1"
+)
+
+// ContentType returns the media type of the format.
+func ContentType(format string) string {
+	switch format {
+	case FormatAtom:
+		return "application/atom+xml; charset=utf-8"
+	case FormatJSON:
+		return "application/feed+json; charset=utf-8"
+	default:
+		return "application/rss+xml; charset=utf-8"
+	}
+}
+
+// Encode encodes the feed as an RSS 2.0, Atom or JSON Feed document by the format of the feed.
+func Encode(feed *Feed) ([]byte, error) {
+	switch feed.Format {
+	case FormatRSS:
+		return encodeXML(newRSSOutput(feed))
+	case FormatAtom:
+		return encodeXML(newAtomOutput(feed))
+	case FormatJSON:
+		data, err := json.MarshalIndent(newJSONOutput(feed), "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("encode json feed: %w", err)
+		}
+
+		return data, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrorUnsupportedFormat, feed.Format)
+	}
+}
+
+func encodeXML(document any) ([]byte, error) {
+	data, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode xml: %w", err)
+	}
+
+	return append([]byte(xml.Header), data...), nil
+}
+
+// updatedAt returns the time of the latest item, or now if the feed has no dated items.
+func (f *Feed) updatedAt() time.Time {
+	var updatedAt time.Time
+
+	for _, item := range f.Items {
+		if timestamp := item.Timestamp(); timestamp.After(updatedAt) {
+			updatedAt = timestamp
+		}
+	}
+
+	if updatedAt.IsZero() {
+		return time.Now().UTC()
+	}
+
+	return updatedAt
+}
+
+type rssOutput struct {
+	XMLName          xml.Name         `xml:"rss"`
+	Version          string           `xml:"version,attr"`
+	NamespaceDC      string           `xml:"xmlns:dc,attr"`
+	NamespaceContent string           `xml:"xmlns:content,attr"`
+	Channel          rssOutputChannel `xml:"channel"`
+}
+
+type rssOutputChannel struct {
+	Title         string          `xml:"title"`
+	Link          string          `xml:"link"`
+	Description   string          `xml:"description"`
+	LastBuildDate string          `xml:"lastBuildDate"`
+	Items         []rssOutputItem `xml:"item"`
+}
+
+type rssOutputItem struct {
+	GUID        rssOutputGUID        `xml:"guid"`
+	Title       string               `xml:"title,omitempty"`
+	Link        string               `xml:"link,omitempty"`
+	Description string               `xml:"description,omitempty"`
+	Content     *rssOutputCDATA      `xml:"content:encoded,omitempty"`
+	Author      string               `xml:"author,omitempty"`
+	Creators    []string             `xml:"dc:creator,omitempty"`
+	Categories  []string             `xml:"category,omitempty"`
+	Enclosures  []rssOutputEnclosure `xml:"enclosure,omitempty"`
+	PubDate     string               `xml:"pubDate,omitempty"`
+	Date        string               `xml:"dc:date,omitempty"`
+}
+
+type rssOutputGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssOutputCDATA struct {
+	Value string `xml:",cdata"`
+}
+
+// rssOutputEnclosure is an enclosure of unknown length, the length is required by RSS 2.0.
+type rssOutputEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int    `xml:"length,attr"`
+	Type   string `xml:"type,attr,omitempty"`
+}
+
+func newRSSOutput(feed *Feed) *rssOutput {
+	output := rssOutput{
+		Version:          "2.0",
+		NamespaceDC:      namespaceDublinCore,
+		NamespaceContent: namespaceContent,
+		Channel: rssOutputChannel{
+			Title:         feed.Title,
+			Link:          feed.Link,
+			Description:   feed.Description,
+			LastBuildDate: feed.updatedAt().Format(time.RFC1123Z),
+		},
+	}
+
+	for _, item := range feed.Items {
+		value := rssOutputItem{
+			GUID: rssOutputGUID{
+				Value: item.ID,
+			},
+			Title:       item.Title,
+			Link:        item.Link,
+			Description: lo.Ternary(item.Summary != "", item.Summary, item.Content),
+			Categories:  item.Categories,
+		}
+
+		if item.Content != "" && item.Summary != "" {
+			value.Content = &rssOutputCDATA{Value: item.Content}
+		}
+
+		// The author of RSS is an email, authors without an email are creators of Dublin Core.
+		for _, author := range item.Authors {
+			switch {
+			case author.Email != "" && value.Author == "":
+				value.Author = lo.Ternary(author.Name != "", fmt.Sprintf("%s (%s)", author.Email, author.Name), author.Email)
+			case author.Name != "":
+				value.Creators = append(value.Creators, author.Name)
+			}
+		}
+
+		for _, enclosure := range item.Enclosures {
+			value.Enclosures = append(value.Enclosures, rssOutputEnclosure{URL: enclosure.URL, Type: enclosure.MimeType})
+		}
+
+		if timestamp := item.Timestamp(); !timestamp.IsZero() {
+			value.PubDate = timestamp.Format(time.RFC1123Z)
+		}
+
+		if !item.UpdatedAt.IsZero() {
+			value.Date = item.UpdatedAt.Format(time.RFC3339)
+		}
+
+		output.Channel.Items = append(output.Channel.Items, value)
+	}
+
+	return &output
+}
+
+type atomOutput struct {
+	XMLName  xml.Name          `xml:"http://www.w3.org/2005/Atom feed"`
+	ID       string            `xml:"id"`
+	Title    string            `xml:"title"`
+	Subtitle string            `xml:"subtitle,omitempty"`
+	Links    []atomOutputLink  `xml:"link"`
+	Updated  string            `xml:"updated"`
+	Author   *atomOutputPerson `xml:"author,omitempty"`
+	Entries  []atomOutputEntry `xml:"entry"`
+}
+
+type atomOutputEntry struct {
+	ID         string             `xml:"id"`
+	Title      string             `xml:"title"`
+	Links      []atomOutputLink   `xml:"link,omitempty"`
+	Summary    *atomOutputText    `xml:"summary,omitempty"`
+	Content    *atomOutputText    `xml:"content,omitempty"`
+	Authors    []atomOutputPerson `xml:"author,omitempty"`
+	Categories []atomCategory     `xml:"category,omitempty"`
+	Published  string             `xml:"published,omitempty"`
+	Updated    string             `xml:"updated"`
+}
+
+type atomOutputLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomOutputText struct {
+	Type string `xml:"type,attr"`
+	Text string `xml:",chardata"`
+}
+
+type atomOutputPerson struct {
+	Name  string `xml:"name"`
+	Email string `xml:"email,omitempty"`
+	URI   string `xml:"uri,omitempty"`
+}
+
+func newAtomOutput(feed *Feed) *atomOutput {
+	output := atomOutput{
+		ID:       feed.Link,
+		Title:    feed.Title,
+		Subtitle: feed.Description,
+		Links:    []atomOutputLink{{Href: feed.Link, Rel: "alternate"}},
+		Updated:  feed.updatedAt().Format(time.RFC3339),
+	}
+
+	if feed.Author != nil {
+		output.Author = newAtomOutputPerson(*feed.Author)
+	}
+
+	for _, item := range feed.Items {
+		// The updated time is required by Atom.
+		updatedAt := lo.Ternary(item.UpdatedAt.IsZero(), item.Timestamp(), item.UpdatedAt)
+
+		entry := atomOutputEntry{
+			ID:      item.ID,
+			Title:   item.Title,
+			Updated: lo.Ternary(updatedAt.IsZero(), feed.updatedAt(), updatedAt).Format(time.RFC3339),
+		}
+
+		if item.Link != "" {
+			entry.Links = append(entry.Links, atomOutputLink{Href: item.Link, Rel: "alternate"})
+		}
+
+		for _, enclosure := range item.Enclosures {
+			entry.Links = append(entry.Links, atomOutputLink{Href: enclosure.URL, Rel: "enclosure", Type: enclosure.MimeType})
+		}
+
+		if item.Summary != "" {
+			entry.Summary = &atomOutputText{Type: "html", Text: item.Summary}
+		}
+
+		if item.Content != "" {
+			entry.Content = &atomOutputText{Type: "html", Text: item.Content}
+		}
+
+		for _, author := range item.Authors {
+			entry.Authors = append(entry.Authors, *newAtomOutputPerson(author))
+		}
+
+		for _, category := range item.Categories {
+			entry.Categories = append(entry.Categories, atomCategory{Term: category})
+		}
+
+		if !item.PublishedAt.IsZero() {
+			entry.Published = item.PublishedAt.Format(time.RFC3339)
+		}
+
+		output.Entries = append(output.Entries, entry)
+	}
+
+	return &output
+}
+
+// newAtomOutputPerson returns the person of the author, the name is required by Atom.
+func newAtomOutputPerson(author Author) *atomOutputPerson {
+	return &atomOutputPerson{
+		Name:  firstText(author.Name, author.Email, author.URL),
+		Email: author.Email,
+		URI:   author.URL,
+	}
+}
+
+type jsonOutput struct {
+	Version     string             `json:"version"`
+	Title       string             `json:"title"`
+	HomePageURL string             `json:"home_page_url,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Authors     []jsonOutputAuthor `json:"authors,omitempty"`
+	Items       []jsonOutputItem   `json:"items"`
+}
+
+type jsonOutputItem struct {
+	ID            string                 `json:"id"`
+	URL           string                 `json:"url,omitempty"`
+	Title         string                 `json:"title,omitempty"`
+	ContentHTML   string                 `json:"content_html,omitempty"`
+	ContentText   string                 `json:"content_text,omitempty"`
+	Summary       string                 `json:"summary,omitempty"`
+	DatePublished string                 `json:"date_published,omitempty"`
+	DateModified  string                 `json:"date_modified,omitempty"`
+	Authors       []jsonOutputAuthor     `json:"authors,omitempty"`
+	Tags          []string               `json:"tags,omitempty"`
+	Attachments   []jsonOutputAttachment `json:"attachments,omitempty"`
+}
+
+type jsonOutputAuthor struct {
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+type jsonOutputAttachment struct {
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type,omitempty"`
+}
+
+func newJSONOutput(feed *Feed) *jsonOutput {
+	output := jsonOutput{
+		Version:     versionJSONFeed,
+		Title:       feed.Title,
+		HomePageURL: feed.Link,
+		Description: feed.Description,
+		Items:       make([]jsonOutputItem, 0, len(feed.Items)),
+	}
+
+	if feed.Author != nil {
+		output.Authors = append(output.Authors, jsonOutputAuthor{Name: feed.Author.Name, URL: feed.Author.URL})
+	}
+
+	for _, item := range feed.Items {
+		value := jsonOutputItem{
+			ID:      item.ID,
+			URL:     item.Link,
+			Title:   item.Title,
+			Summary: item.Summary,
+			Tags:    item.Categories,
+		}
+
+		// The content is required by JSON Feed, the summary is the content of an item without one.
+		if item.Content != "" {
+			value.ContentHTML = item.Content
+		} else {
+			value.ContentText = firstText(item.Summary, item.Title)
+		}
+
+		if !item.PublishedAt.IsZero() {
+			value.DatePublished = item.PublishedAt.Format(time.RFC3339)
+		}
+
+		if !item.UpdatedAt.IsZero() {
+			value.DateModified = item.UpdatedAt.Format(time.RFC3339)
+		}
+
+		for _, author := range item.Authors {
+			value.Authors = append(value.Authors, jsonOutputAuthor{Name: firstText(author.Name, author.Email), URL: author.URL})
+		}
+
+		for _, enclosure := range item.Enclosures {
+			value.Attachments = append(value.Attachments, jsonOutputAttachment{URL: enclosure.URL, MimeType: enclosure.MimeType})
+		}
+
+		output.Items = append(output.Items, value)
+	}
+
+	return &output
+}
@@ -0,0 +1,77 @@
+package rss_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rss3-network/node/provider/rss"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+
+	item := rss.Item{
+		ID:          "urn:rss3:ethereum:0x1",
+		Title:       "Swapped 1.2 ETH for 3,000 USDC on Uniswap",
+		Link:        "https://etherscan.io/tx/0x1",
+		Summary:     "Swapped 1.2 ETH for 3,000 USDC on Uniswap",
+		Content:     "<p>Swapped 1.2 ETH for 3,000 USDC on Uniswap</p>",
+		Authors:     []rss.Author{{Name: "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"}},
+		Categories:  []string{"exchange", "swap"},
+		Enclosures:  []rss.Enclosure{{URL: "https://rss3.io/swap.png", MimeType: "image/png"}},
+		PublishedAt: time.Date(2024, time.September, 2, 10, 0, 0, 0, time.UTC),
+		UpdatedAt:   time.Date(2024, time.September, 3, 10, 0, 0, 0, time.UTC),
+	}
+
+	testcases := []struct {
+		name   string
+		format string
+	}{
+		{
+			name:   "RSS 2.0",
+			format: rss.FormatRSS,
+		},
+		{
+			name:   "Atom",
+			format: rss.FormatAtom,
+		},
+		{
+			name:   "JSON Feed",
+			format: rss.FormatJSON,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			item := item
+
+			feed := rss.Feed{
+				Format:      testcase.format,
+				Title:       "Activities of 0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+				Link:        "https://node.rss3.io/decentralized/0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045",
+				Description: "Activities indexed by RSS3 Node",
+				Items:       []*rss.Item{&item},
+			}
+
+			data, err := rss.Encode(&feed)
+			require.NoError(t, err)
+
+			// The encoded feed is parsed back to the same feed.
+			result, err := rss.Parse(data)
+			require.NoError(t, err)
+			require.Equal(t, &feed, result)
+		})
+	}
+
+	t.Run("Unsupported format", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := rss.Encode(&rss.Feed{Format: "html"})
+		require.ErrorIs(t, err, rss.ErrorUnsupportedFormat)
+	})
+}
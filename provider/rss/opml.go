@@ -0,0 +1,46 @@
+package rss
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+type opmlDocument struct {
+	Outlines []opmlOutline `xml:"body>outline"`
+}
+
+type opmlOutline struct {
+	XMLURL   string        `xml:"xmlUrl,attr"`
+	Outlines []opmlOutline `xml:"outline"`
+}
+
+// ParseOPML returns the feed URLs of the subscription list, outlines nested in categories included.
+func ParseOPML(data []byte) ([]string, error) {
+	var document opmlDocument
+
+	if err := newDecoder(bytes.NewReader(data)).Decode(&document); err != nil {
+		return nil, fmt.Errorf("decode opml: %w", err)
+	}
+
+	var (
+		feeds []string
+		visit func(outlines []opmlOutline)
+	)
+
+	visit = func(outlines []opmlOutline) {
+		for _, outline := range outlines {
+			if feedURL := strings.TrimSpace(outline.XMLURL); feedURL != "" {
+				feeds = append(feeds, feedURL)
+			}
+
+			visit(outline.Outlines)
+		}
+	}
+
+	visit(document.Outlines)
+
+	return lo.Uniq(feeds), nil
+}
@@ -0,0 +1,484 @@
+package rss
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html/charset"
+)
+
+var ErrorUnsupportedFormat = errors.New("unsupported feed format")
+
+const (
+	namespaceAtom = "http://www.w3.org/2005/Atom"
+)
+
+// dateLayouts are the layouts of dates found in feeds, RSS uses RFC 822 while Atom and JSON Feed use RFC 3339.
+var dateLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC822Z,
+	time.RFC822,
+	time.RFC3339,
+	time.RFC3339Nano,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"Mon, 2 Jan 2006 15:04 -0700",
+	"2 Jan 2006 15:04:05 -0700",
+	"2 Jan 2006 15:04:05 MST",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// Parse parses an RSS 2.0, RSS 1.0, Atom or JSON Feed document, items without an id or a link are dropped.
+func Parse(data []byte) (*Feed, error) {
+	data = bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")))
+
+	if bytes.HasPrefix(data, []byte("{")) {
+		return parseJSONFeed(data)
+	}
+
+	decoder := newDecoder(bytes.NewReader(data))
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, ErrorUnsupportedFormat
+			}
+
+			return nil, fmt.Errorf("decode token: %w", err)
+		}
+
+		element, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch element.Name.Local {
+		case "rss", "RDF":
+			return parseRSS(decoder, &element)
+		case "feed":
+			return parseAtom(decoder, &element)
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrorUnsupportedFormat, element.Name.Local)
+		}
+	}
+}
+
+type rssDocument struct {
+	Channel rssChannel `xml:"channel"`
+	// Items are siblings of the channel in RSS 1.0.
+	Items []rssItem `xml:"item"`
+}
+
+type rssChannel struct {
+	Title          string    `xml:"title"`
+	Links          []string  `xml:"link"`
+	Description    string    `xml:"description"`
+	ManagingEditor string    `xml:"managingEditor"`
+	Items          []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	GUID        string         `xml:"guid"`
+	Title       string         `xml:"title"`
+	Links       []string       `xml:"link"`
+	Description string         `xml:"description"`
+	Content     string         `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	Author      string         `xml:"author"`
+	Creators    []string       `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	Categories  []string       `xml:"category"`
+	Enclosures  []rssEnclosure `xml:"enclosure"`
+	PubDate     string         `xml:"pubDate"`
+	Date        string         `xml:"http://purl.org/dc/elements/1.1/ date"`
+	About       string         `xml:"about,attr"`
+}
+
+type rssEnclosure struct {
+	URL  string `xml:"url,attr"`
+	Type string `xml:"type,attr"`
+}
+
+func parseRSS(decoder *xml.Decoder, element *xml.StartElement) (*Feed, error) {
+	var document rssDocument
+
+	if err := decoder.DecodeElement(&document, element); err != nil {
+		return nil, fmt.Errorf("decode rss: %w", err)
+	}
+
+	feed := Feed{
+		Format:      FormatRSS,
+		Title:       strings.TrimSpace(document.Channel.Title),
+		Link:        firstText(document.Channel.Links...),
+		Description: strings.TrimSpace(document.Channel.Description),
+	}
+
+	if editor := strings.TrimSpace(document.Channel.ManagingEditor); editor != "" {
+		feed.Author = parseRSSAuthor(editor)
+	}
+
+	for _, value := range append(document.Channel.Items, document.Items...) {
+		item := Item{
+			ID:          strings.TrimSpace(value.GUID),
+			Title:       strings.TrimSpace(value.Title),
+			Link:        firstText(value.Links...),
+			Summary:     strings.TrimSpace(value.Description),
+			Content:     strings.TrimSpace(value.Content),
+			Categories:  trimTexts(value.Categories),
+			PublishedAt: parseDate(value.PubDate),
+			UpdatedAt:   parseDate(value.Date),
+		}
+
+		if item.ID == "" {
+			item.ID = firstText(strings.TrimSpace(value.About), item.Link)
+		}
+
+		if author := strings.TrimSpace(value.Author); author != "" {
+			item.Authors = append(item.Authors, *parseRSSAuthor(author))
+		}
+
+		for _, creator := range trimTexts(value.Creators) {
+			item.Authors = append(item.Authors, Author{Name: creator})
+		}
+
+		for _, enclosure := range value.Enclosures {
+			if enclosure.URL != "" {
+				item.Enclosures = append(item.Enclosures, Enclosure{URL: enclosure.URL, MimeType: enclosure.Type})
+			}
+		}
+
+		feed.appendItem(&item)
+	}
+
+	return &feed, nil
+}
+
+// parseRSSAuthor parses the author of RSS in the form of `email (name)`.
+func parseRSSAuthor(value string) *Author {
+	if email, name, found := strings.Cut(value, " ("); found && strings.HasSuffix(name, ")") {
+		return &Author{
+			Name:  strings.TrimSuffix(name, ")"),
+			Email: strings.TrimSpace(email),
+		}
+	}
+
+	if strings.Contains(value, "@") && !strings.Contains(value, " ") {
+		return &Author{Email: value}
+	}
+
+	return &Author{Name: value}
+}
+
+type atomFeed struct {
+	Title    atomText     `xml:"http://www.w3.org/2005/Atom title"`
+	Subtitle atomText     `xml:"http://www.w3.org/2005/Atom subtitle"`
+	Links    []atomLink   `xml:"http://www.w3.org/2005/Atom link"`
+	Authors  []atomPerson `xml:"http://www.w3.org/2005/Atom author"`
+	Entries  []atomEntry  `xml:"http://www.w3.org/2005/Atom entry"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"http://www.w3.org/2005/Atom id"`
+	Title      atomText       `xml:"http://www.w3.org/2005/Atom title"`
+	Links      []atomLink     `xml:"http://www.w3.org/2005/Atom link"`
+	Summary    atomText       `xml:"http://www.w3.org/2005/Atom summary"`
+	Content    atomText       `xml:"http://www.w3.org/2005/Atom content"`
+	Authors    []atomPerson   `xml:"http://www.w3.org/2005/Atom author"`
+	Categories []atomCategory `xml:"http://www.w3.org/2005/Atom category"`
+	Published  string         `xml:"http://www.w3.org/2005/Atom published"`
+	Updated    string         `xml:"http://www.w3.org/2005/Atom updated"`
+}
+
+// atomText is a text construct, the XHTML content is kept as markup.
+type atomText struct {
+	Type     string `xml:"type,attr"`
+	Text     string `xml:",chardata"`
+	InnerXML string `xml:",innerxml"`
+}
+
+func (t atomText) String() string {
+	if t.Type == "xhtml" {
+		return strings.TrimSpace(t.InnerXML)
+	}
+
+	return strings.TrimSpace(t.Text)
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type atomPerson struct {
+	Name  string `xml:"http://www.w3.org/2005/Atom name"`
+	Email string `xml:"http://www.w3.org/2005/Atom email"`
+	URI   string `xml:"http://www.w3.org/2005/Atom uri"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+func parseAtom(decoder *xml.Decoder, element *xml.StartElement) (*Feed, error) {
+	if element.Name.Space != namespaceAtom {
+		return nil, fmt.Errorf("%w: feed in namespace %s", ErrorUnsupportedFormat, element.Name.Space)
+	}
+
+	var document atomFeed
+
+	if err := decoder.DecodeElement(&document, element); err != nil {
+		return nil, fmt.Errorf("decode atom: %w", err)
+	}
+
+	feed := Feed{
+		Format:      FormatAtom,
+		Title:       document.Title.String(),
+		Link:        alternateLink(document.Links),
+		Description: document.Subtitle.String(),
+	}
+
+	if len(document.Authors) > 0 {
+		feed.Author = document.Authors[0].author()
+	}
+
+	for _, entry := range document.Entries {
+		item := Item{
+			ID:          strings.TrimSpace(entry.ID),
+			Title:       entry.Title.String(),
+			Link:        alternateLink(entry.Links),
+			Summary:     entry.Summary.String(),
+			Content:     entry.Content.String(),
+			PublishedAt: parseDate(entry.Published),
+			UpdatedAt:   parseDate(entry.Updated),
+		}
+
+		for _, person := range entry.Authors {
+			item.Authors = append(item.Authors, *person.author())
+		}
+
+		for _, category := range entry.Categories {
+			if term := strings.TrimSpace(category.Term); term != "" {
+				item.Categories = append(item.Categories, term)
+			}
+		}
+
+		for _, link := range entry.Links {
+			if link.Rel == "enclosure" && link.Href != "" {
+				item.Enclosures = append(item.Enclosures, Enclosure{URL: link.Href, MimeType: link.Type})
+			}
+		}
+
+		feed.appendItem(&item)
+	}
+
+	return &feed, nil
+}
+
+func (p atomPerson) author() *Author {
+	return &Author{
+		Name:  strings.TrimSpace(p.Name),
+		Email: strings.TrimSpace(p.Email),
+		URL:   strings.TrimSpace(p.URI),
+	}
+}
+
+// alternateLink returns the link to the HTML page, a link without a relation is an alternate link.
+func alternateLink(links []atomLink) string {
+	for _, link := range links {
+		if link.Rel == "" || link.Rel == "alternate" {
+			return strings.TrimSpace(link.Href)
+		}
+	}
+
+	return ""
+}
+
+// jsonFeed is a JSON Feed of version 1 or 1.1, https://www.jsonfeed.org/version/1.1/.
+type jsonFeed struct {
+	Version     string       `json:"version"`
+	Title       string       `json:"title"`
+	HomePageURL string       `json:"home_page_url"`
+	Description string       `json:"description"`
+	Author      *jsonAuthor  `json:"author"`
+	Authors     []jsonAuthor `json:"authors"`
+	Items       []jsonItem   `json:"items"`
+}
+
+type jsonItem struct {
+	// ID is a string by the specification, but some publishers use numbers.
+	ID            json.RawMessage  `json:"id"`
+	URL           string           `json:"url"`
+	ExternalURL   string           `json:"external_url"`
+	Title         string           `json:"title"`
+	ContentHTML   string           `json:"content_html"`
+	ContentText   string           `json:"content_text"`
+	Summary       string           `json:"summary"`
+	DatePublished string           `json:"date_published"`
+	DateModified  string           `json:"date_modified"`
+	Author        *jsonAuthor      `json:"author"`
+	Authors       []jsonAuthor     `json:"authors"`
+	Tags          []string         `json:"tags"`
+	Attachments   []jsonAttachment `json:"attachments"`
+}
+
+type jsonAuthor struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type jsonAttachment struct {
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type"`
+}
+
+func parseJSONFeed(data []byte) (*Feed, error) {
+	var document jsonFeed
+
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("decode json feed: %w", err)
+	}
+
+	if !strings.HasPrefix(document.Version, "https://jsonfeed.org/version/") {
+		return nil, fmt.Errorf("%w: json feed version %q", ErrorUnsupportedFormat, document.Version)
+	}
+
+	feed := Feed{
+		Format:      FormatJSON,
+		Title:       strings.TrimSpace(document.Title),
+		Link:        strings.TrimSpace(document.HomePageURL),
+		Description: strings.TrimSpace(document.Description),
+	}
+
+	// The author is deprecated by the authors in version 1.1.
+	if authors := jsonAuthors(document.Author, document.Authors); len(authors) > 0 {
+		feed.Author = &authors[0]
+	}
+
+	for _, value := range document.Items {
+		item := Item{
+			ID:          jsonItemID(value.ID),
+			Title:       strings.TrimSpace(value.Title),
+			Link:        firstText(strings.TrimSpace(value.URL), strings.TrimSpace(value.ExternalURL)),
+			Summary:     strings.TrimSpace(value.Summary),
+			Content:     firstText(strings.TrimSpace(value.ContentHTML), strings.TrimSpace(value.ContentText)),
+			Authors:     jsonAuthors(value.Author, value.Authors),
+			Categories:  trimTexts(value.Tags),
+			PublishedAt: parseDate(value.DatePublished),
+			UpdatedAt:   parseDate(value.DateModified),
+		}
+
+		for _, attachment := range value.Attachments {
+			if attachment.URL != "" {
+				item.Enclosures = append(item.Enclosures, Enclosure{URL: attachment.URL, MimeType: attachment.MimeType})
+			}
+		}
+
+		feed.appendItem(&item)
+	}
+
+	return &feed, nil
+}
+
+func jsonItemID(value json.RawMessage) string {
+	var id string
+
+	if err := json.Unmarshal(value, &id); err == nil {
+		return strings.TrimSpace(id)
+	}
+
+	var number json.Number
+
+	if err := json.Unmarshal(value, &number); err == nil {
+		return number.String()
+	}
+
+	return ""
+}
+
+func jsonAuthors(author *jsonAuthor, authors []jsonAuthor) []Author {
+	if author != nil {
+		authors = append([]jsonAuthor{*author}, authors...)
+	}
+
+	var result []Author
+
+	for _, value := range authors {
+		result = append(result, Author{
+			Name: strings.TrimSpace(value.Name),
+			URL:  strings.TrimSpace(value.URL),
+		})
+	}
+
+	return result
+}
+
+// appendItem appends the item to the feed, the link is the id of an item without one.
+func (f *Feed) appendItem(item *Item) {
+	if item.ID == "" {
+		item.ID = item.Link
+	}
+
+	if item.ID == "" {
+		return
+	}
+
+	f.Items = append(f.Items, item)
+}
+
+// parseDate parses the date with the known layouts, it returns the zero time if the date is invalid.
+func parseDate(value string) time.Time {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}
+	}
+
+	for _, layout := range dateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date.UTC()
+		}
+	}
+
+	return time.Time{}
+}
+
+func newDecoder(reader io.Reader) *xml.Decoder {
+	decoder := xml.NewDecoder(reader)
+	decoder.CharsetReader = charset.NewReaderLabel
+	// Feeds in the wild often contain HTML entities and unescaped ampersands.
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+
+	return decoder
+}
+
+// firstText returns the first non-empty value.
+func firstText(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func trimTexts(values []string) []string {
+	var result []string
+
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
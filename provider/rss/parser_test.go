@@ -0,0 +1,245 @@
+package rss_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rss3-network/node/provider/rss"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		data      string
+		want      *rss.Feed
+		wantError require.ErrorAssertionFunc
+	}{
+		{
+			name: "RSS 2.0",
+			data: `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom" xmlns:content="http://purl.org/rss/1.0/modules/content/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel>
+    <title>RSS3 Blog</title>
+    <link>https://rss3.io/blog</link>
+    <atom:link href="https://rss3.io/blog/rss.xml" rel="self" type="application/rss+xml"/>
+    <description>News of RSS3</description>
+    <managingEditor>editor@rss3.io (RSS3 Editor)</managingEditor>
+    <item>
+      <title>Hello &amp; welcome</title>
+      <link>https://rss3.io/blog/hello</link>
+      <guid isPermaLink="false">hello-1</guid>
+      <description>A short summary</description>
+      <content:encoded><![CDATA[<p>The full content</p>]]></content:encoded>
+      <dc:creator>Alice</dc:creator>
+      <category>news</category>
+      <category>rss3</category>
+      <enclosure url="https://rss3.io/hello.png" type="image/png" length="1024"/>
+      <pubDate>Mon, 02 Sep 2024 10:00:00 +0000</pubDate>
+    </item>
+    <item>
+      <title>Without a guid</title>
+      <link>https://rss3.io/blog/no-guid</link>
+      <pubDate>Sun, 01 Sep 2024 10:00:00 GMT</pubDate>
+    </item>
+    <item>
+      <title>Without a guid and a link</title>
+    </item>
+  </channel>
+</rss>`,
+			want: &rss.Feed{
+				Format:      rss.FormatRSS,
+				Title:       "RSS3 Blog",
+				Link:        "https://rss3.io/blog",
+				Description: "News of RSS3",
+				Author:      &rss.Author{Name: "RSS3 Editor", Email: "editor@rss3.io"},
+				Items: []*rss.Item{
+					{
+						ID:          "hello-1",
+						Title:       "Hello & welcome",
+						Link:        "https://rss3.io/blog/hello",
+						Summary:     "A short summary",
+						Content:     "<p>The full content</p>",
+						Authors:     []rss.Author{{Name: "Alice"}},
+						Categories:  []string{"news", "rss3"},
+						Enclosures:  []rss.Enclosure{{URL: "https://rss3.io/hello.png", MimeType: "image/png"}},
+						PublishedAt: time.Date(2024, 9, 2, 10, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:          "https://rss3.io/blog/no-guid",
+						Title:       "Without a guid",
+						Link:        "https://rss3.io/blog/no-guid",
+						PublishedAt: time.Date(2024, 9, 1, 10, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "RSS 1.0",
+			data: `<?xml version="1.0"?>
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#" xmlns="http://purl.org/rss/1.0/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel rdf:about="https://example.com/">
+    <title>Example</title>
+    <link>https://example.com/</link>
+  </channel>
+  <item rdf:about="https://example.com/1">
+    <title>First</title>
+    <link>https://example.com/1</link>
+    <dc:date>2024-09-02T10:00:00Z</dc:date>
+  </item>
+</rdf:RDF>`,
+			want: &rss.Feed{
+				Format: rss.FormatRSS,
+				Title:  "Example",
+				Link:   "https://example.com/",
+				Items: []*rss.Item{
+					{
+						ID:        "https://example.com/1",
+						Title:     "First",
+						Link:      "https://example.com/1",
+						UpdatedAt: time.Date(2024, 9, 2, 10, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "Atom",
+			data: `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Example Atom</title>
+  <subtitle type="html">A &lt;b&gt;subtitle&lt;/b&gt;</subtitle>
+  <link href="https://example.org/feed.xml" rel="self"/>
+  <link href="https://example.org/"/>
+  <author><name>Bob</name><uri>https://example.org/bob</uri></author>
+  <entry>
+    <id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+    <title>Atom-Powered Robots Run Amok</title>
+    <link rel="alternate" href="https://example.org/2003/12/13/atom03"/>
+    <link rel="enclosure" href="https://example.org/audio.mp3" type="audio/mpeg"/>
+    <published>2003-12-13T08:29:29-04:00</published>
+    <updated>2003-12-13T18:30:02Z</updated>
+    <summary>Some text.</summary>
+    <content type="xhtml"><div xmlns="http://www.w3.org/1999/xhtml"><p>Hello</p></div></content>
+    <author><name>Carol</name><email>carol@example.org</email></author>
+    <category term="robots"/>
+  </entry>
+</feed>`,
+			want: &rss.Feed{
+				Format:      rss.FormatAtom,
+				Title:       "Example Atom",
+				Link:        "https://example.org/",
+				Description: "A <b>subtitle</b>",
+				Author:      &rss.Author{Name: "Bob", URL: "https://example.org/bob"},
+				Items: []*rss.Item{
+					{
+						ID:          "urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a",
+						Title:       "Atom-Powered Robots Run Amok",
+						Link:        "https://example.org/2003/12/13/atom03",
+						Summary:     "Some text.",
+						Content:     `<div xmlns="http://www.w3.org/1999/xhtml"><p>Hello</p></div>`,
+						Authors:     []rss.Author{{Name: "Carol", Email: "carol@example.org"}},
+						Categories:  []string{"robots"},
+						Enclosures:  []rss.Enclosure{{URL: "https://example.org/audio.mp3", MimeType: "audio/mpeg"}},
+						PublishedAt: time.Date(2003, 12, 13, 12, 29, 29, 0, time.UTC),
+						UpdatedAt:   time.Date(2003, 12, 13, 18, 30, 2, 0, time.UTC),
+					},
+				},
+			},
+			wantError: require.NoError,
+		},
+		{
+			name: "JSON Feed",
+			data: `{
+  "version": "https://jsonfeed.org/version/1.1",
+  "title": "My Example Feed",
+  "home_page_url": "https://example.org/",
+  "authors": [{"name": "Dave", "url": "https://example.org/dave"}],
+  "items": [
+    {
+      "id": "2",
+      "content_text": "This is a second item.",
+      "url": "https://example.org/second-item",
+      "date_published": "2024-09-02T10:00:00Z",
+      "tags": ["second"],
+      "attachments": [{"url": "https://example.org/second.png", "mime_type": "image/png"}]
+    },
+    {
+      "id": 1,
+      "content_html": "<p>Hello, world!</p>",
+      "url": "https://example.org/initial-post",
+      "author": {"name": "Erin"}
+    }
+  ]
+}`,
+			want: &rss.Feed{
+				Format: rss.FormatJSON,
+				Title:  "My Example Feed",
+				Link:   "https://example.org/",
+				Author: &rss.Author{Name: "Dave", URL: "https://example.org/dave"},
+				Items: []*rss.Item{
+					{
+						ID:          "2",
+						Link:        "https://example.org/second-item",
+						Content:     "This is a second item.",
+						Categories:  []string{"second"},
+						Enclosures:  []rss.Enclosure{{URL: "https://example.org/second.png", MimeType: "image/png"}},
+						PublishedAt: time.Date(2024, 9, 2, 10, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:      "1",
+						Link:    "https://example.org/initial-post",
+						Content: "<p>Hello, world!</p>",
+						Authors: []rss.Author{{Name: "Erin"}},
+					},
+				},
+			},
+			wantError: require.NoError,
+		},
+		{
+			name:      "Unsupported JSON",
+			data:      `{"title": "Not a feed"}`,
+			wantError: require.Error,
+		},
+		{
+			name:      "Unsupported XML",
+			data:      `<html><body>Not a feed</body></html>`,
+			wantError: require.Error,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			feed, err := rss.Parse([]byte(testcase.data))
+			testcase.wantError(t, err)
+			require.Equal(t, testcase.want, feed)
+		})
+	}
+}
+
+func TestParseOPML(t *testing.T) {
+	t.Parallel()
+
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <head><title>Subscriptions</title></head>
+  <body>
+    <outline text="RSS3 Blog" type="rss" xmlUrl="https://rss3.io/blog/rss.xml"/>
+    <outline text="News">
+      <outline text="Example" type="rss" xmlUrl="https://example.org/feed.xml"/>
+      <outline text="Duplicated" type="rss" xmlUrl="https://rss3.io/blog/rss.xml"/>
+    </outline>
+  </body>
+</opml>`
+
+	feeds, err := rss.ParseOPML([]byte(data))
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://rss3.io/blog/rss.xml", "https://example.org/feed.xml"}, feeds)
+}
@@ -0,0 +1,56 @@
+package rss
+
+import (
+	"time"
+)
+
+const (
+	FormatRSS  = "rss"
+	FormatAtom = "atom"
+	FormatJSON = "json"
+)
+
+// Feed is an RSS 2.0, RSS 1.0, Atom or JSON Feed document.
+type Feed struct {
+	Format      string  `json:"format"`
+	Title       string  `json:"title,omitempty"`
+	Link        string  `json:"link,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Author      *Author `json:"author,omitempty"`
+	Items       []*Item `json:"items,omitempty"`
+}
+
+// Item is an item of RSS, an entry of Atom or an item of JSON Feed.
+type Item struct {
+	// ID is the guid, id or link of the item, it is unique within the feed.
+	ID          string      `json:"id"`
+	Title       string      `json:"title,omitempty"`
+	Link        string      `json:"link,omitempty"`
+	Summary     string      `json:"summary,omitempty"`
+	Content     string      `json:"content,omitempty"`
+	Authors     []Author    `json:"authors,omitempty"`
+	Categories  []string    `json:"categories,omitempty"`
+	Enclosures  []Enclosure `json:"enclosures,omitempty"`
+	PublishedAt time.Time   `json:"published_at,omitempty"`
+	UpdatedAt   time.Time   `json:"updated_at,omitempty"`
+}
+
+// Timestamp returns the published time of the item, or the updated time if it is not published.
+func (i *Item) Timestamp() time.Time {
+	if !i.PublishedAt.IsZero() {
+		return i.PublishedAt
+	}
+
+	return i.UpdatedAt
+}
+
+type Author struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+	URL   string `json:"url,omitempty"`
+}
+
+type Enclosure struct {
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type,omitempty"`
+}
@@ -0,0 +1,30 @@
+package metadata
+
+import (
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/shopspring/decimal"
+)
+
+// PositionSide is the side of a perpetual position.
+type PositionSide string
+
+const (
+	PositionSideLong  PositionSide = "long"
+	PositionSideShort PositionSide = "short"
+)
+
+// ExchangePosition is the metadata of a change of a perpetual position, which is a loan of the size of the position
+// against the collateral. The collateral is the collateral token of the change, and the amount is the size of the change in USD.
+type ExchangePosition struct {
+	metadata.ExchangeLoan
+
+	// Market is the name of the market of the position, which is the address of the market for unknown markets.
+	Market string       `json:"market"`
+	Side   PositionSide `json:"side"`
+	// Size is the size of the position in USD after the change.
+	Size decimal.Decimal `json:"size"`
+	// Leverage is the leverage of the position after the change, which is empty for a closed position.
+	Leverage *decimal.Decimal `json:"leverage,omitempty"`
+	// PnL is the realized PnL of the change in USD, which is empty for an increased position.
+	PnL *decimal.Decimal `json:"pnl,omitempty"`
+}
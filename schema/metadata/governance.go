@@ -0,0 +1,24 @@
+package metadata
+
+import (
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/shopspring/decimal"
+)
+
+// GovernanceProposal is the metadata of a proposal with the DAO of the governor.
+type GovernanceProposal struct {
+	metadata.GovernanceProposal
+
+	// DAO is the name of the DAO of the governor, which is empty for unknown governors.
+	DAO string `json:"dao,omitempty"`
+}
+
+// GovernanceVote is the metadata of a vote with the DAO of the governor and the exact weight of the vote.
+type GovernanceVote struct {
+	metadata.GovernanceVote
+
+	// DAO is the name of the DAO of the governor, which is empty for unknown governors.
+	DAO string `json:"dao,omitempty"`
+	// Weight is the weight of the vote in the voting token, the count is the weight rounded down to whole tokens.
+	Weight decimal.Decimal `json:"weight"`
+}
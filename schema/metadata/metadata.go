@@ -0,0 +1,42 @@
+package metadata
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rss3-network/node/schema/worker/decentralized"
+	"github.com/rss3-network/protocol-go/schema"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/typex"
+)
+
+// extensions are the metadata of the platforms which extend the metadata of the protocol with fields of their own.
+var extensions = map[string]map[schema.Type]func() metadata.Metadata{
+	decentralized.PlatformGMX.String(): {
+		typex.ExchangeLoan: func() metadata.Metadata { return new(ExchangePosition) },
+	},
+	decentralized.PlatformGovernor.String(): {
+		typex.GovernanceProposal: func() metadata.Metadata { return new(GovernanceProposal) },
+		typex.GovernanceVote:     func() metadata.Metadata { return new(GovernanceVote) },
+	},
+	decentralized.PlatformSafe.String(): {
+		typex.TransactionApproval: func() metadata.Metadata { return new(SafeApproval) },
+	},
+}
+
+// Unmarshal unmarshals the metadata of an action of the platform,
+// the metadata extended by the platform keeps its own fields, and any other metadata is unmarshalled by the protocol.
+func Unmarshal(platform string, metadataType schema.Type, data json.RawMessage) (metadata.Metadata, error) {
+	newMetadata, found := extensions[platform][metadataType]
+	if !found {
+		return metadata.Unmarshal(metadataType, data)
+	}
+
+	result := newMetadata()
+
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, fmt.Errorf("invalid metadata: %w", err)
+	}
+
+	return result, nil
+}
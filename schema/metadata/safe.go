@@ -0,0 +1,25 @@
+package metadata
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rss3-network/protocol-go/schema"
+	"github.com/rss3-network/protocol-go/schema/metadata"
+	"github.com/rss3-network/protocol-go/schema/typex"
+)
+
+var _ metadata.Metadata = (*SafeApproval)(nil)
+
+// SafeApproval is the metadata of a change of the owners approving the transactions of a Safe,
+// or of the threshold of the approvals required by a transaction.
+type SafeApproval struct {
+	Action metadata.TransactionApprovalAction `json:"action"`
+
+	// Owner is the owner approved or revoked by the Safe, which is empty for the threshold changes.
+	Owner *common.Address `json:"owner,omitempty"`
+	// Threshold is the number of approvals required by a transaction, which is empty for the owner changes.
+	Threshold *uint64 `json:"threshold,omitempty"`
+}
+
+func (s SafeApproval) Type() schema.Type {
+	return typex.TransactionApproval
+}
@@ -7,42 +7,51 @@ import "github.com/labstack/echo/v4"
 type Platform uint64
 
 const (
-	PlatformUnknown    Platform = iota // Unknown
-	Platform1Inch                      // 1inch
-	PlatformAAVE                       // AAVE
-	PlatformAavegotchi                 // Aavegotchi
-	PlatformArbitrum                   // Arbitrum
-	PlatformBase                       // Base
-	PlatformBendDAO                    // BendDAO
-	PlatformCow                        // Cow
-	PlatformCrossbell                  // Crossbell
-	PlatformCurve                      // Curve
-	PlatformENS                        // ENS
-	PlatformFarcaster                  // Farcaster
-	PlatformHighlight                  // Highlight
-	PlatformIQWiki                     // IQWiki
-	PlatformKiwiStand                  // KiwiStand
-	PlatformLens                       // Lens
-	PlatformLido                       // Lido
-	PlatformLinea                      // Linea
-	PlatformLiNEAR                     // LiNEAR
-	PlatformLooksRare                  // LooksRare
-	PlatformMatters                    // Matters
-	PlatformMirror                     // Mirror
-	PlatformNearSocial                 // NearSocial
-	PlatformNouns                      // Nouns
-	PlatformOpenSea                    // OpenSea
-	PlatformOptimism                   // Optimism
-	PlatformParagraph                  // Paragraph
-	PlatformParaswap                   // Paraswap
-	PlatformPolymarket                 // Polymarket
-	PlatformRSS3                       // RSS3
-	PlatformRainbow                    // Rainbow
-	PlatformSAVM                       // SAVM
-	PlatformStargate                   // Stargate
-	PlatformUniswap                    // Uniswap
-	PlatformVSL                        // VSL
-	PlatformZerion                     // Zerion
+	PlatformUnknown     Platform = iota // Unknown
+	Platform1Inch                       // 1inch
+	PlatformAAVE                        // AAVE
+	PlatformAavegotchi                  // Aavegotchi
+	PlatformAerodrome                   // Aerodrome
+	PlatformArbitrum                    // Arbitrum
+	PlatformBase                        // Base
+	PlatformBendDAO                     // BendDAO
+	PlatformBlur                        // Blur
+	PlatformCow                         // Cow
+	PlatformCrossbell                   // Crossbell
+	PlatformCurve                       // Curve
+	PlatformEAS                         // EAS
+	PlatformEigenLayer                  // EigenLayer
+	PlatformENS                         // ENS
+	PlatformFarcaster                   // Farcaster
+	PlatformGMX                         // GMX
+	PlatformGovernor                    // Governor
+	PlatformHighlight                   // Highlight
+	PlatformIQWiki                      // IQWiki
+	PlatformKiwiStand                   // KiwiStand
+	PlatformLens                        // Lens
+	PlatformLido                        // Lido
+	PlatformLinea                       // Linea
+	PlatformLiNEAR                      // LiNEAR
+	PlatformLooksRare                   // LooksRare
+	PlatformMatters                     // Matters
+	PlatformMirror                      // Mirror
+	PlatformNearSocial                  // NearSocial
+	PlatformNouns                       // Nouns
+	PlatformOpenSea                     // OpenSea
+	PlatformOptimism                    // Optimism
+	PlatformPancakeSwap                 // PancakeSwap
+	PlatformParagraph                   // Paragraph
+	PlatformParaswap                    // Paraswap
+	PlatformPolymarket                  // Polymarket
+	PlatformRSS3                        // RSS3
+	PlatformRainbow                     // Rainbow
+	PlatformSafe                        // Safe
+	PlatformSAVM                        // SAVM
+	PlatformStargate                    // Stargate
+	PlatformUniswap                     // Uniswap
+	PlatformVelodrome                   // Velodrome
+	PlatformVSL                         // VSL
+	PlatformZerion                      // Zerion
 )
 
 var _ echo.BindUnmarshaler = (*Platform)(nil)
@@ -65,10 +74,16 @@ var ToPlatformMap = map[Worker]Platform{
 	Arbitrum:   PlatformArbitrum,
 	Base:       PlatformBase,
 	BendDAO:    PlatformBendDAO,
+	Blur:       PlatformBlur,
 	Cow:        PlatformCow,
 	Crossbell:  PlatformCrossbell,
 	Curve:      PlatformCurve,
+	EAS:        PlatformEAS,
+	EigenLayer: PlatformEigenLayer,
 	ENS:        PlatformENS,
+	Farcaster:  PlatformFarcaster,
+	GMX:        PlatformGMX,
+	Governor:   PlatformGovernor,
 	Highlight:  PlatformHighlight,
 	IQWiki:     PlatformIQWiki,
 	KiwiStand:  PlatformKiwiStand,
@@ -90,9 +105,15 @@ var ToPlatformMap = map[Worker]Platform{
 	Polymarket: PlatformPolymarket,
 	Rainbow:    PlatformRainbow,
 	RSS3:       PlatformRSS3,
+	Safe:       PlatformSafe,
 	SAVM:       PlatformSAVM,
 	Stargate:   PlatformStargate,
 	Uniswap:    PlatformUniswap,
 	VSL:        PlatformVSL,
 	Zerion:     PlatformZerion,
 }
+
+// ToForkPlatformsMap is a map of worker to the platforms of the forks indexed by the worker besides its own platform
+var ToForkPlatformsMap = map[Worker][]Platform{
+	Uniswap: {PlatformAerodrome, PlatformPancakeSwap, PlatformVelodrome},
+}
@@ -9,11 +9,11 @@ import (
 	"strings"
 )
 
-const _PlatformName = "Unknown1inchAAVEAavegotchiArbitrumBaseBendDAOCowCrossbellCurveENSFarcasterHighlightIQWikiKiwiStandLensLidoLineaLiNEARLooksRareMattersMirrorNearSocialNounsOpenSeaOptimismParagraphParaswapPolymarketRSS3RainbowSAVMStargateUniswapVSLZerion"
+const _PlatformName = "Unknown1inchAAVEAavegotchiAerodromeArbitrumBaseBendDAOBlurCowCrossbellCurveEASEigenLayerENSFarcasterGMXGovernorHighlightIQWikiKiwiStandLensLidoLineaLiNEARLooksRareMattersMirrorNearSocialNounsOpenSeaOptimismPancakeSwapParagraphParaswapPolymarketRSS3RainbowSafeSAVMStargateUniswapVelodromeVSLZerion"
 
-var _PlatformIndex = [...]uint8{0, 7, 12, 16, 26, 34, 38, 45, 48, 57, 62, 65, 74, 83, 89, 98, 102, 106, 111, 117, 126, 133, 139, 149, 154, 161, 169, 178, 186, 196, 200, 207, 211, 219, 226, 229, 235}
+var _PlatformIndex = [...]uint16{0, 7, 12, 16, 26, 35, 43, 47, 54, 58, 61, 70, 75, 78, 88, 91, 100, 103, 111, 120, 126, 135, 139, 143, 148, 154, 163, 170, 176, 186, 191, 198, 206, 217, 226, 234, 244, 248, 255, 259, 263, 271, 278, 287, 290, 296}
 
-const _PlatformLowerName = "unknown1inchaaveaavegotchiarbitrumbasebenddaocowcrossbellcurveensfarcasterhighlightiqwikikiwistandlenslidolinealinearlooksraremattersmirrornearsocialnounsopenseaoptimismparagraphparaswappolymarketrss3rainbowsavmstargateuniswapvslzerion"
+const _PlatformLowerName = "unknown1inchaaveaavegotchiaerodromearbitrumbasebenddaoblurcowcrossbellcurveeaseigenlayerensfarcastergmxgovernorhighlightiqwikikiwistandlenslidolinealinearlooksraremattersmirrornearsocialnounsopenseaoptimismpancakeswapparagraphparaswappolymarketrss3rainbowsafesavmstargateuniswapvelodromevslzerion"
 
 func (i Platform) String() string {
 	if i >= Platform(len(_PlatformIndex)-1) {
@@ -34,41 +34,50 @@ func _PlatformNoOp() {
 	_ = x[Platform1Inch-(1)]
 	_ = x[PlatformAAVE-(2)]
 	_ = x[PlatformAavegotchi-(3)]
-	_ = x[PlatformArbitrum-(4)]
-	_ = x[PlatformBase-(5)]
-	_ = x[PlatformBendDAO-(6)]
-	_ = x[PlatformCow-(7)]
-	_ = x[PlatformCrossbell-(8)]
-	_ = x[PlatformCurve-(9)]
-	_ = x[PlatformENS-(10)]
-	_ = x[PlatformFarcaster-(11)]
-	_ = x[PlatformHighlight-(12)]
-	_ = x[PlatformIQWiki-(13)]
-	_ = x[PlatformKiwiStand-(14)]
-	_ = x[PlatformLens-(15)]
-	_ = x[PlatformLido-(16)]
-	_ = x[PlatformLinea-(17)]
-	_ = x[PlatformLiNEAR-(18)]
-	_ = x[PlatformLooksRare-(19)]
-	_ = x[PlatformMatters-(20)]
-	_ = x[PlatformMirror-(21)]
-	_ = x[PlatformNearSocial-(22)]
-	_ = x[PlatformNouns-(23)]
-	_ = x[PlatformOpenSea-(24)]
-	_ = x[PlatformOptimism-(25)]
-	_ = x[PlatformParagraph-(26)]
-	_ = x[PlatformParaswap-(27)]
-	_ = x[PlatformPolymarket-(28)]
-	_ = x[PlatformRSS3-(29)]
-	_ = x[PlatformRainbow-(30)]
-	_ = x[PlatformSAVM-(31)]
-	_ = x[PlatformStargate-(32)]
-	_ = x[PlatformUniswap-(33)]
-	_ = x[PlatformVSL-(34)]
-	_ = x[PlatformZerion-(35)]
+	_ = x[PlatformAerodrome-(4)]
+	_ = x[PlatformArbitrum-(5)]
+	_ = x[PlatformBase-(6)]
+	_ = x[PlatformBendDAO-(7)]
+	_ = x[PlatformBlur-(8)]
+	_ = x[PlatformCow-(9)]
+	_ = x[PlatformCrossbell-(10)]
+	_ = x[PlatformCurve-(11)]
+	_ = x[PlatformEAS-(12)]
+	_ = x[PlatformEigenLayer-(13)]
+	_ = x[PlatformENS-(14)]
+	_ = x[PlatformFarcaster-(15)]
+	_ = x[PlatformGMX-(16)]
+	_ = x[PlatformGovernor-(17)]
+	_ = x[PlatformHighlight-(18)]
+	_ = x[PlatformIQWiki-(19)]
+	_ = x[PlatformKiwiStand-(20)]
+	_ = x[PlatformLens-(21)]
+	_ = x[PlatformLido-(22)]
+	_ = x[PlatformLinea-(23)]
+	_ = x[PlatformLiNEAR-(24)]
+	_ = x[PlatformLooksRare-(25)]
+	_ = x[PlatformMatters-(26)]
+	_ = x[PlatformMirror-(27)]
+	_ = x[PlatformNearSocial-(28)]
+	_ = x[PlatformNouns-(29)]
+	_ = x[PlatformOpenSea-(30)]
+	_ = x[PlatformOptimism-(31)]
+	_ = x[PlatformPancakeSwap-(32)]
+	_ = x[PlatformParagraph-(33)]
+	_ = x[PlatformParaswap-(34)]
+	_ = x[PlatformPolymarket-(35)]
+	_ = x[PlatformRSS3-(36)]
+	_ = x[PlatformRainbow-(37)]
+	_ = x[PlatformSafe-(38)]
+	_ = x[PlatformSAVM-(39)]
+	_ = x[PlatformStargate-(40)]
+	_ = x[PlatformUniswap-(41)]
+	_ = x[PlatformVelodrome-(42)]
+	_ = x[PlatformVSL-(43)]
+	_ = x[PlatformZerion-(44)]
 }
 
-var _PlatformValues = []Platform{PlatformUnknown, Platform1Inch, PlatformAAVE, PlatformAavegotchi, PlatformArbitrum, PlatformBase, PlatformBendDAO, PlatformCow, PlatformCrossbell, PlatformCurve, PlatformENS, PlatformFarcaster, PlatformHighlight, PlatformIQWiki, PlatformKiwiStand, PlatformLens, PlatformLido, PlatformLinea, PlatformLiNEAR, PlatformLooksRare, PlatformMatters, PlatformMirror, PlatformNearSocial, PlatformNouns, PlatformOpenSea, PlatformOptimism, PlatformParagraph, PlatformParaswap, PlatformPolymarket, PlatformRSS3, PlatformRainbow, PlatformSAVM, PlatformStargate, PlatformUniswap, PlatformVSL, PlatformZerion}
+var _PlatformValues = []Platform{PlatformUnknown, Platform1Inch, PlatformAAVE, PlatformAavegotchi, PlatformAerodrome, PlatformArbitrum, PlatformBase, PlatformBendDAO, PlatformBlur, PlatformCow, PlatformCrossbell, PlatformCurve, PlatformEAS, PlatformEigenLayer, PlatformENS, PlatformFarcaster, PlatformGMX, PlatformGovernor, PlatformHighlight, PlatformIQWiki, PlatformKiwiStand, PlatformLens, PlatformLido, PlatformLinea, PlatformLiNEAR, PlatformLooksRare, PlatformMatters, PlatformMirror, PlatformNearSocial, PlatformNouns, PlatformOpenSea, PlatformOptimism, PlatformPancakeSwap, PlatformParagraph, PlatformParaswap, PlatformPolymarket, PlatformRSS3, PlatformRainbow, PlatformSafe, PlatformSAVM, PlatformStargate, PlatformUniswap, PlatformVelodrome, PlatformVSL, PlatformZerion}
 
 var _PlatformNameToValueMap = map[string]Platform{
 	_PlatformName[0:7]:          PlatformUnknown,
@@ -79,70 +88,88 @@ var _PlatformNameToValueMap = map[string]Platform{
 	_PlatformLowerName[12:16]:   PlatformAAVE,
 	_PlatformName[16:26]:        PlatformAavegotchi,
 	_PlatformLowerName[16:26]:   PlatformAavegotchi,
-	_PlatformName[26:34]:        PlatformArbitrum,
-	_PlatformLowerName[26:34]:   PlatformArbitrum,
-	_PlatformName[34:38]:        PlatformBase,
-	_PlatformLowerName[34:38]:   PlatformBase,
-	_PlatformName[38:45]:        PlatformBendDAO,
-	_PlatformLowerName[38:45]:   PlatformBendDAO,
-	_PlatformName[45:48]:        PlatformCow,
-	_PlatformLowerName[45:48]:   PlatformCow,
-	_PlatformName[48:57]:        PlatformCrossbell,
-	_PlatformLowerName[48:57]:   PlatformCrossbell,
-	_PlatformName[57:62]:        PlatformCurve,
-	_PlatformLowerName[57:62]:   PlatformCurve,
-	_PlatformName[62:65]:        PlatformENS,
-	_PlatformLowerName[62:65]:   PlatformENS,
-	_PlatformName[65:74]:        PlatformFarcaster,
-	_PlatformLowerName[65:74]:   PlatformFarcaster,
-	_PlatformName[74:83]:        PlatformHighlight,
-	_PlatformLowerName[74:83]:   PlatformHighlight,
-	_PlatformName[83:89]:        PlatformIQWiki,
-	_PlatformLowerName[83:89]:   PlatformIQWiki,
-	_PlatformName[89:98]:        PlatformKiwiStand,
-	_PlatformLowerName[89:98]:   PlatformKiwiStand,
-	_PlatformName[98:102]:       PlatformLens,
-	_PlatformLowerName[98:102]:  PlatformLens,
-	_PlatformName[102:106]:      PlatformLido,
-	_PlatformLowerName[102:106]: PlatformLido,
-	_PlatformName[106:111]:      PlatformLinea,
-	_PlatformLowerName[106:111]: PlatformLinea,
-	_PlatformName[111:117]:      PlatformLiNEAR,
-	_PlatformLowerName[111:117]: PlatformLiNEAR,
-	_PlatformName[117:126]:      PlatformLooksRare,
-	_PlatformLowerName[117:126]: PlatformLooksRare,
-	_PlatformName[126:133]:      PlatformMatters,
-	_PlatformLowerName[126:133]: PlatformMatters,
-	_PlatformName[133:139]:      PlatformMirror,
-	_PlatformLowerName[133:139]: PlatformMirror,
-	_PlatformName[139:149]:      PlatformNearSocial,
-	_PlatformLowerName[139:149]: PlatformNearSocial,
-	_PlatformName[149:154]:      PlatformNouns,
-	_PlatformLowerName[149:154]: PlatformNouns,
-	_PlatformName[154:161]:      PlatformOpenSea,
-	_PlatformLowerName[154:161]: PlatformOpenSea,
-	_PlatformName[161:169]:      PlatformOptimism,
-	_PlatformLowerName[161:169]: PlatformOptimism,
-	_PlatformName[169:178]:      PlatformParagraph,
-	_PlatformLowerName[169:178]: PlatformParagraph,
-	_PlatformName[178:186]:      PlatformParaswap,
-	_PlatformLowerName[178:186]: PlatformParaswap,
-	_PlatformName[186:196]:      PlatformPolymarket,
-	_PlatformLowerName[186:196]: PlatformPolymarket,
-	_PlatformName[196:200]:      PlatformRSS3,
-	_PlatformLowerName[196:200]: PlatformRSS3,
-	_PlatformName[200:207]:      PlatformRainbow,
-	_PlatformLowerName[200:207]: PlatformRainbow,
-	_PlatformName[207:211]:      PlatformSAVM,
-	_PlatformLowerName[207:211]: PlatformSAVM,
-	_PlatformName[211:219]:      PlatformStargate,
-	_PlatformLowerName[211:219]: PlatformStargate,
-	_PlatformName[219:226]:      PlatformUniswap,
-	_PlatformLowerName[219:226]: PlatformUniswap,
-	_PlatformName[226:229]:      PlatformVSL,
-	_PlatformLowerName[226:229]: PlatformVSL,
-	_PlatformName[229:235]:      PlatformZerion,
-	_PlatformLowerName[229:235]: PlatformZerion,
+	_PlatformName[26:35]:        PlatformAerodrome,
+	_PlatformLowerName[26:35]:   PlatformAerodrome,
+	_PlatformName[35:43]:        PlatformArbitrum,
+	_PlatformLowerName[35:43]:   PlatformArbitrum,
+	_PlatformName[43:47]:        PlatformBase,
+	_PlatformLowerName[43:47]:   PlatformBase,
+	_PlatformName[47:54]:        PlatformBendDAO,
+	_PlatformLowerName[47:54]:   PlatformBendDAO,
+	_PlatformName[54:58]:        PlatformBlur,
+	_PlatformLowerName[54:58]:   PlatformBlur,
+	_PlatformName[58:61]:        PlatformCow,
+	_PlatformLowerName[58:61]:   PlatformCow,
+	_PlatformName[61:70]:        PlatformCrossbell,
+	_PlatformLowerName[61:70]:   PlatformCrossbell,
+	_PlatformName[70:75]:        PlatformCurve,
+	_PlatformLowerName[70:75]:   PlatformCurve,
+	_PlatformName[75:78]:        PlatformEAS,
+	_PlatformLowerName[75:78]:   PlatformEAS,
+	_PlatformName[78:88]:        PlatformEigenLayer,
+	_PlatformLowerName[78:88]:   PlatformEigenLayer,
+	_PlatformName[88:91]:        PlatformENS,
+	_PlatformLowerName[88:91]:   PlatformENS,
+	_PlatformName[91:100]:       PlatformFarcaster,
+	_PlatformLowerName[91:100]:  PlatformFarcaster,
+	_PlatformName[100:103]:      PlatformGMX,
+	_PlatformLowerName[100:103]: PlatformGMX,
+	_PlatformName[103:111]:      PlatformGovernor,
+	_PlatformLowerName[103:111]: PlatformGovernor,
+	_PlatformName[111:120]:      PlatformHighlight,
+	_PlatformLowerName[111:120]: PlatformHighlight,
+	_PlatformName[120:126]:      PlatformIQWiki,
+	_PlatformLowerName[120:126]: PlatformIQWiki,
+	_PlatformName[126:135]:      PlatformKiwiStand,
+	_PlatformLowerName[126:135]: PlatformKiwiStand,
+	_PlatformName[135:139]:      PlatformLens,
+	_PlatformLowerName[135:139]: PlatformLens,
+	_PlatformName[139:143]:      PlatformLido,
+	_PlatformLowerName[139:143]: PlatformLido,
+	_PlatformName[143:148]:      PlatformLinea,
+	_PlatformLowerName[143:148]: PlatformLinea,
+	_PlatformName[148:154]:      PlatformLiNEAR,
+	_PlatformLowerName[148:154]: PlatformLiNEAR,
+	_PlatformName[154:163]:      PlatformLooksRare,
+	_PlatformLowerName[154:163]: PlatformLooksRare,
+	_PlatformName[163:170]:      PlatformMatters,
+	_PlatformLowerName[163:170]: PlatformMatters,
+	_PlatformName[170:176]:      PlatformMirror,
+	_PlatformLowerName[170:176]: PlatformMirror,
+	_PlatformName[176:186]:      PlatformNearSocial,
+	_PlatformLowerName[176:186]: PlatformNearSocial,
+	_PlatformName[186:191]:      PlatformNouns,
+	_PlatformLowerName[186:191]: PlatformNouns,
+	_PlatformName[191:198]:      PlatformOpenSea,
+	_PlatformLowerName[191:198]: PlatformOpenSea,
+	_PlatformName[198:206]:      PlatformOptimism,
+	_PlatformLowerName[198:206]: PlatformOptimism,
+	_PlatformName[206:217]:      PlatformPancakeSwap,
+	_PlatformLowerName[206:217]: PlatformPancakeSwap,
+	_PlatformName[217:226]:      PlatformParagraph,
+	_PlatformLowerName[217:226]: PlatformParagraph,
+	_PlatformName[226:234]:      PlatformParaswap,
+	_PlatformLowerName[226:234]: PlatformParaswap,
+	_PlatformName[234:244]:      PlatformPolymarket,
+	_PlatformLowerName[234:244]: PlatformPolymarket,
+	_PlatformName[244:248]:      PlatformRSS3,
+	_PlatformLowerName[244:248]: PlatformRSS3,
+	_PlatformName[248:255]:      PlatformRainbow,
+	_PlatformLowerName[248:255]: PlatformRainbow,
+	_PlatformName[255:259]:      PlatformSafe,
+	_PlatformLowerName[255:259]: PlatformSafe,
+	_PlatformName[259:263]:      PlatformSAVM,
+	_PlatformLowerName[259:263]: PlatformSAVM,
+	_PlatformName[263:271]:      PlatformStargate,
+	_PlatformLowerName[263:271]: PlatformStargate,
+	_PlatformName[271:278]:      PlatformUniswap,
+	_PlatformLowerName[271:278]: PlatformUniswap,
+	_PlatformName[278:287]:      PlatformVelodrome,
+	_PlatformLowerName[278:287]: PlatformVelodrome,
+	_PlatformName[287:290]:      PlatformVSL,
+	_PlatformLowerName[287:290]: PlatformVSL,
+	_PlatformName[290:296]:      PlatformZerion,
+	_PlatformLowerName[290:296]: PlatformZerion,
 }
 
 var _PlatformNames = []string{
@@ -150,38 +177,47 @@ var _PlatformNames = []string{
 	_PlatformName[7:12],
 	_PlatformName[12:16],
 	_PlatformName[16:26],
-	_PlatformName[26:34],
-	_PlatformName[34:38],
-	_PlatformName[38:45],
-	_PlatformName[45:48],
-	_PlatformName[48:57],
-	_PlatformName[57:62],
-	_PlatformName[62:65],
-	_PlatformName[65:74],
-	_PlatformName[74:83],
-	_PlatformName[83:89],
-	_PlatformName[89:98],
-	_PlatformName[98:102],
-	_PlatformName[102:106],
-	_PlatformName[106:111],
-	_PlatformName[111:117],
-	_PlatformName[117:126],
-	_PlatformName[126:133],
-	_PlatformName[133:139],
-	_PlatformName[139:149],
-	_PlatformName[149:154],
-	_PlatformName[154:161],
-	_PlatformName[161:169],
-	_PlatformName[169:178],
-	_PlatformName[178:186],
-	_PlatformName[186:196],
-	_PlatformName[196:200],
-	_PlatformName[200:207],
-	_PlatformName[207:211],
-	_PlatformName[211:219],
-	_PlatformName[219:226],
-	_PlatformName[226:229],
-	_PlatformName[229:235],
+	_PlatformName[26:35],
+	_PlatformName[35:43],
+	_PlatformName[43:47],
+	_PlatformName[47:54],
+	_PlatformName[54:58],
+	_PlatformName[58:61],
+	_PlatformName[61:70],
+	_PlatformName[70:75],
+	_PlatformName[75:78],
+	_PlatformName[78:88],
+	_PlatformName[88:91],
+	_PlatformName[91:100],
+	_PlatformName[100:103],
+	_PlatformName[103:111],
+	_PlatformName[111:120],
+	_PlatformName[120:126],
+	_PlatformName[126:135],
+	_PlatformName[135:139],
+	_PlatformName[139:143],
+	_PlatformName[143:148],
+	_PlatformName[148:154],
+	_PlatformName[154:163],
+	_PlatformName[163:170],
+	_PlatformName[170:176],
+	_PlatformName[176:186],
+	_PlatformName[186:191],
+	_PlatformName[191:198],
+	_PlatformName[198:206],
+	_PlatformName[206:217],
+	_PlatformName[217:226],
+	_PlatformName[226:234],
+	_PlatformName[234:244],
+	_PlatformName[244:248],
+	_PlatformName[248:255],
+	_PlatformName[255:259],
+	_PlatformName[259:263],
+	_PlatformName[263:271],
+	_PlatformName[271:278],
+	_PlatformName[278:287],
+	_PlatformName[287:290],
+	_PlatformName[290:296],
 }
 
 // PlatformString retrieves an enum value from the enum constants string name.
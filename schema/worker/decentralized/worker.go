@@ -15,11 +15,17 @@ const (
 	Arbitrum                     // arbitrum
 	Base                         // base
 	BendDAO                      // benddao
+	Blur                         // blur
 	Core                         // core
 	Cow                          // cow
 	Crossbell                    // crossbell
 	Curve                        // curve
+	EAS                          // eas
+	EigenLayer                   // eigenlayer
 	ENS                          // ens
+	Farcaster                    // farcaster
+	GMX                          // gmx
+	Governor                     // governor
 	Highlight                    // highlight
 	IQWiki                       // iqwiki
 	KiwiStand                    // kiwistand
@@ -41,6 +47,7 @@ const (
 	Polymarket                   // polymarket
 	Rainbow                      // rainbow
 	RSS3                         // rss3
+	Safe                         // safe
 	SAVM                         // savm
 	Stargate                     // stargate
 	Uniswap                      // uniswap
@@ -79,11 +86,17 @@ var ToTagsMap = map[Worker][]tag.Tag{
 	Aavegotchi: {tag.Metaverse},
 	Arbitrum:   {tag.Transaction},
 	BendDAO:    {tag.Collectible, tag.Exchange},
+	Blur:       {tag.Collectible, tag.Exchange},
 	Core:       {tag.Collectible, tag.Transaction},
 	Cow:        {tag.Exchange},
 	Crossbell:  {tag.Social},
 	Curve:      {tag.Exchange, tag.Transaction},
+	EAS:        {tag.Social},
+	EigenLayer: {tag.Exchange, tag.Transaction},
 	ENS:        {tag.Social, tag.Collectible},
+	Farcaster:  {tag.Social},
+	GMX:        {tag.Exchange},
+	Governor:   {tag.Governance},
 	Highlight:  {tag.Collectible, tag.Transaction},
 	IQWiki:     {tag.Social},
 	KiwiStand:  {tag.Collectible, tag.Transaction, tag.Social},
@@ -105,6 +118,7 @@ var ToTagsMap = map[Worker][]tag.Tag{
 	Polymarket: {tag.Exchange},
 	Rainbow:    {tag.Exchange, tag.Transaction},
 	RSS3:       {tag.Exchange, tag.Collectible},
+	Safe:       {tag.Transaction},
 	SAVM:       {tag.Transaction},
 	Stargate:   {tag.Transaction},
 	Uniswap:    {tag.Exchange, tag.Transaction},
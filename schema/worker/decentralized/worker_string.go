@@ -9,11 +9,11 @@ import (
 	"strings"
 )
 
-const _WorkerName = "aaveaavegotchiarbitrumbasebenddaocorecowcrossbellcurveenshighlightiqwikikiwistandlenslidolinealinearlooksraremattersmirrormomokanearsocialnouns1inchopenseaoptimismparagraphparaswappolymarketrainbowrss3savmstargateuniswapvslzerion"
+const _WorkerName = "aaveaavegotchiarbitrumbasebenddaoblurcorecowcrossbellcurveeaseigenlayerensfarcastergmxgovernorhighlightiqwikikiwistandlenslidolinealinearlooksraremattersmirrormomokanearsocialnouns1inchopenseaoptimismparagraphparaswappolymarketrainbowrss3safesavmstargateuniswapvslzerion"
 
-var _WorkerIndex = [...]uint8{0, 4, 14, 22, 26, 33, 37, 40, 49, 54, 57, 66, 72, 81, 85, 89, 94, 100, 109, 116, 122, 128, 138, 143, 148, 155, 163, 172, 180, 190, 197, 201, 205, 213, 220, 223, 229}
+var _WorkerIndex = [...]uint16{0, 4, 14, 22, 26, 33, 37, 41, 44, 53, 58, 61, 71, 74, 83, 86, 94, 103, 109, 118, 122, 126, 131, 137, 146, 153, 159, 165, 175, 180, 185, 192, 200, 209, 217, 227, 234, 238, 242, 246, 254, 261, 264, 270}
 
-const _WorkerLowerName = "aaveaavegotchiarbitrumbasebenddaocorecowcrossbellcurveenshighlightiqwikikiwistandlenslidolinealinearlooksraremattersmirrormomokanearsocialnouns1inchopenseaoptimismparagraphparaswappolymarketrainbowrss3savmstargateuniswapvslzerion"
+const _WorkerLowerName = "aaveaavegotchiarbitrumbasebenddaoblurcorecowcrossbellcurveeaseigenlayerensfarcastergmxgovernorhighlightiqwikikiwistandlenslidolinealinearlooksraremattersmirrormomokanearsocialnouns1inchopenseaoptimismparagraphparaswappolymarketrainbowrss3safesavmstargateuniswapvslzerion"
 
 func (i Worker) String() string {
 	i -= 1
@@ -36,40 +36,47 @@ func _WorkerNoOp() {
 	_ = x[Arbitrum-(3)]
 	_ = x[Base-(4)]
 	_ = x[BendDAO-(5)]
-	_ = x[Core-(6)]
-	_ = x[Cow-(7)]
-	_ = x[Crossbell-(8)]
-	_ = x[Curve-(9)]
-	_ = x[ENS-(10)]
-	_ = x[Highlight-(11)]
-	_ = x[IQWiki-(12)]
-	_ = x[KiwiStand-(13)]
-	_ = x[Lens-(14)]
-	_ = x[Lido-(15)]
-	_ = x[Linea-(16)]
-	_ = x[LiNEAR-(17)]
-	_ = x[Looksrare-(18)]
-	_ = x[Matters-(19)]
-	_ = x[Mirror-(20)]
-	_ = x[Momoka-(21)]
-	_ = x[NearSocial-(22)]
-	_ = x[Nouns-(23)]
-	_ = x[Oneinch-(24)]
-	_ = x[OpenSea-(25)]
-	_ = x[Optimism-(26)]
-	_ = x[Paragraph-(27)]
-	_ = x[Paraswap-(28)]
-	_ = x[Polymarket-(29)]
-	_ = x[Rainbow-(30)]
-	_ = x[RSS3-(31)]
-	_ = x[SAVM-(32)]
-	_ = x[Stargate-(33)]
-	_ = x[Uniswap-(34)]
-	_ = x[VSL-(35)]
-	_ = x[Zerion-(36)]
+	_ = x[Blur-(6)]
+	_ = x[Core-(7)]
+	_ = x[Cow-(8)]
+	_ = x[Crossbell-(9)]
+	_ = x[Curve-(10)]
+	_ = x[EAS-(11)]
+	_ = x[EigenLayer-(12)]
+	_ = x[ENS-(13)]
+	_ = x[Farcaster-(14)]
+	_ = x[GMX-(15)]
+	_ = x[Governor-(16)]
+	_ = x[Highlight-(17)]
+	_ = x[IQWiki-(18)]
+	_ = x[KiwiStand-(19)]
+	_ = x[Lens-(20)]
+	_ = x[Lido-(21)]
+	_ = x[Linea-(22)]
+	_ = x[LiNEAR-(23)]
+	_ = x[Looksrare-(24)]
+	_ = x[Matters-(25)]
+	_ = x[Mirror-(26)]
+	_ = x[Momoka-(27)]
+	_ = x[NearSocial-(28)]
+	_ = x[Nouns-(29)]
+	_ = x[Oneinch-(30)]
+	_ = x[OpenSea-(31)]
+	_ = x[Optimism-(32)]
+	_ = x[Paragraph-(33)]
+	_ = x[Paraswap-(34)]
+	_ = x[Polymarket-(35)]
+	_ = x[Rainbow-(36)]
+	_ = x[RSS3-(37)]
+	_ = x[Safe-(38)]
+	_ = x[SAVM-(39)]
+	_ = x[Stargate-(40)]
+	_ = x[Uniswap-(41)]
+	_ = x[VSL-(42)]
+	_ = x[Zerion-(43)]
 }
 
-var _WorkerValues = []Worker{Aave, Aavegotchi, Arbitrum, Base, BendDAO, Core, Cow, Crossbell, Curve, ENS, Highlight, IQWiki, KiwiStand, Lens, Lido, Linea, LiNEAR, Looksrare, Matters, Mirror, Momoka, NearSocial, Nouns, Oneinch, OpenSea, Optimism, Paragraph, Paraswap, Polymarket, Rainbow, RSS3, SAVM, Stargate, Uniswap, VSL, Zerion}
+var _WorkerValues = []Worker{Aave, Aavegotchi, Arbitrum, Base, BendDAO, Blur, Core, Cow, Crossbell, Curve, EAS, EigenLayer, ENS, Farcaster, GMX, Governor, Highlight, IQWiki, KiwiStand, Lens, Lido, Linea, LiNEAR, Looksrare, Matters, Mirror, Momoka, NearSocial, Nouns, Oneinch, OpenSea, Optimism, Paragraph, Paraswap, Polymarket, Rainbow, RSS3, Safe, SAVM, Stargate, Uniswap, VSL, Zerion}
 
 var _WorkerNameToValueMap = map[string]Worker{
 	_WorkerName[0:4]:          Aave,
@@ -82,68 +89,82 @@ var _WorkerNameToValueMap = map[string]Worker{
 	_WorkerLowerName[22:26]:   Base,
 	_WorkerName[26:33]:        BendDAO,
 	_WorkerLowerName[26:33]:   BendDAO,
-	_WorkerName[33:37]:        Core,
-	_WorkerLowerName[33:37]:   Core,
-	_WorkerName[37:40]:        Cow,
-	_WorkerLowerName[37:40]:   Cow,
-	_WorkerName[40:49]:        Crossbell,
-	_WorkerLowerName[40:49]:   Crossbell,
-	_WorkerName[49:54]:        Curve,
-	_WorkerLowerName[49:54]:   Curve,
-	_WorkerName[54:57]:        ENS,
-	_WorkerLowerName[54:57]:   ENS,
-	_WorkerName[57:66]:        Highlight,
-	_WorkerLowerName[57:66]:   Highlight,
-	_WorkerName[66:72]:        IQWiki,
-	_WorkerLowerName[66:72]:   IQWiki,
-	_WorkerName[72:81]:        KiwiStand,
-	_WorkerLowerName[72:81]:   KiwiStand,
-	_WorkerName[81:85]:        Lens,
-	_WorkerLowerName[81:85]:   Lens,
-	_WorkerName[85:89]:        Lido,
-	_WorkerLowerName[85:89]:   Lido,
-	_WorkerName[89:94]:        Linea,
-	_WorkerLowerName[89:94]:   Linea,
-	_WorkerName[94:100]:       LiNEAR,
-	_WorkerLowerName[94:100]:  LiNEAR,
-	_WorkerName[100:109]:      Looksrare,
-	_WorkerLowerName[100:109]: Looksrare,
-	_WorkerName[109:116]:      Matters,
-	_WorkerLowerName[109:116]: Matters,
-	_WorkerName[116:122]:      Mirror,
-	_WorkerLowerName[116:122]: Mirror,
-	_WorkerName[122:128]:      Momoka,
-	_WorkerLowerName[122:128]: Momoka,
-	_WorkerName[128:138]:      NearSocial,
-	_WorkerLowerName[128:138]: NearSocial,
-	_WorkerName[138:143]:      Nouns,
-	_WorkerLowerName[138:143]: Nouns,
-	_WorkerName[143:148]:      Oneinch,
-	_WorkerLowerName[143:148]: Oneinch,
-	_WorkerName[148:155]:      OpenSea,
-	_WorkerLowerName[148:155]: OpenSea,
-	_WorkerName[155:163]:      Optimism,
-	_WorkerLowerName[155:163]: Optimism,
-	_WorkerName[163:172]:      Paragraph,
-	_WorkerLowerName[163:172]: Paragraph,
-	_WorkerName[172:180]:      Paraswap,
-	_WorkerLowerName[172:180]: Paraswap,
-	_WorkerName[180:190]:      Polymarket,
-	_WorkerLowerName[180:190]: Polymarket,
-	_WorkerName[190:197]:      Rainbow,
-	_WorkerLowerName[190:197]: Rainbow,
-	_WorkerName[197:201]:      RSS3,
-	_WorkerLowerName[197:201]: RSS3,
-	_WorkerName[201:205]:      SAVM,
-	_WorkerLowerName[201:205]: SAVM,
-	_WorkerName[205:213]:      Stargate,
-	_WorkerLowerName[205:213]: Stargate,
-	_WorkerName[213:220]:      Uniswap,
-	_WorkerLowerName[213:220]: Uniswap,
-	_WorkerName[220:223]:      VSL,
-	_WorkerLowerName[220:223]: VSL,
-	_WorkerName[223:229]:      Zerion,
-	_WorkerLowerName[223:229]: Zerion,
+	_WorkerName[33:37]:        Blur,
+	_WorkerLowerName[33:37]:   Blur,
+	_WorkerName[37:41]:        Core,
+	_WorkerLowerName[37:41]:   Core,
+	_WorkerName[41:44]:        Cow,
+	_WorkerLowerName[41:44]:   Cow,
+	_WorkerName[44:53]:        Crossbell,
+	_WorkerLowerName[44:53]:   Crossbell,
+	_WorkerName[53:58]:        Curve,
+	_WorkerLowerName[53:58]:   Curve,
+	_WorkerName[58:61]:        EAS,
+	_WorkerLowerName[58:61]:   EAS,
+	_WorkerName[61:71]:        EigenLayer,
+	_WorkerLowerName[61:71]:   EigenLayer,
+	_WorkerName[71:74]:        ENS,
+	_WorkerLowerName[71:74]:   ENS,
+	_WorkerName[74:83]:        Farcaster,
+	_WorkerLowerName[74:83]:   Farcaster,
+	_WorkerName[83:86]:        GMX,
+	_WorkerLowerName[83:86]:   GMX,
+	_WorkerName[86:94]:        Governor,
+	_WorkerLowerName[86:94]:   Governor,
+	_WorkerName[94:103]:       Highlight,
+	_WorkerLowerName[94:103]:  Highlight,
+	_WorkerName[103:109]:      IQWiki,
+	_WorkerLowerName[103:109]: IQWiki,
+	_WorkerName[109:118]:      KiwiStand,
+	_WorkerLowerName[109:118]: KiwiStand,
+	_WorkerName[118:122]:      Lens,
+	_WorkerLowerName[118:122]: Lens,
+	_WorkerName[122:126]:      Lido,
+	_WorkerLowerName[122:126]: Lido,
+	_WorkerName[126:131]:      Linea,
+	_WorkerLowerName[126:131]: Linea,
+	_WorkerName[131:137]:      LiNEAR,
+	_WorkerLowerName[131:137]: LiNEAR,
+	_WorkerName[137:146]:      Looksrare,
+	_WorkerLowerName[137:146]: Looksrare,
+	_WorkerName[146:153]:      Matters,
+	_WorkerLowerName[146:153]: Matters,
+	_WorkerName[153:159]:      Mirror,
+	_WorkerLowerName[153:159]: Mirror,
+	_WorkerName[159:165]:      Momoka,
+	_WorkerLowerName[159:165]: Momoka,
+	_WorkerName[165:175]:      NearSocial,
+	_WorkerLowerName[165:175]: NearSocial,
+	_WorkerName[175:180]:      Nouns,
+	_WorkerLowerName[175:180]: Nouns,
+	_WorkerName[180:185]:      Oneinch,
+	_WorkerLowerName[180:185]: Oneinch,
+	_WorkerName[185:192]:      OpenSea,
+	_WorkerLowerName[185:192]: OpenSea,
+	_WorkerName[192:200]:      Optimism,
+	_WorkerLowerName[192:200]: Optimism,
+	_WorkerName[200:209]:      Paragraph,
+	_WorkerLowerName[200:209]: Paragraph,
+	_WorkerName[209:217]:      Paraswap,
+	_WorkerLowerName[209:217]: Paraswap,
+	_WorkerName[217:227]:      Polymarket,
+	_WorkerLowerName[217:227]: Polymarket,
+	_WorkerName[227:234]:      Rainbow,
+	_WorkerLowerName[227:234]: Rainbow,
+	_WorkerName[234:238]:      RSS3,
+	_WorkerLowerName[234:238]: RSS3,
+	_WorkerName[238:242]:      Safe,
+	_WorkerLowerName[238:242]: Safe,
+	_WorkerName[242:246]:      SAVM,
+	_WorkerLowerName[242:246]: SAVM,
+	_WorkerName[246:254]:      Stargate,
+	_WorkerLowerName[246:254]: Stargate,
+	_WorkerName[254:261]:      Uniswap,
+	_WorkerLowerName[254:261]: Uniswap,
+	_WorkerName[261:264]:      VSL,
+	_WorkerLowerName[261:264]: VSL,
+	_WorkerName[264:270]:      Zerion,
+	_WorkerLowerName[264:270]: Zerion,
 }
 
 var _WorkerNames = []string{
@@ -153,36 +174,43 @@ var _WorkerNames = []string{
 	_WorkerName[22:26],
 	_WorkerName[26:33],
 	_WorkerName[33:37],
-	_WorkerName[37:40],
-	_WorkerName[40:49],
-	_WorkerName[49:54],
-	_WorkerName[54:57],
-	_WorkerName[57:66],
-	_WorkerName[66:72],
-	_WorkerName[72:81],
-	_WorkerName[81:85],
-	_WorkerName[85:89],
-	_WorkerName[89:94],
-	_WorkerName[94:100],
-	_WorkerName[100:109],
-	_WorkerName[109:116],
-	_WorkerName[116:122],
-	_WorkerName[122:128],
-	_WorkerName[128:138],
-	_WorkerName[138:143],
-	_WorkerName[143:148],
-	_WorkerName[148:155],
-	_WorkerName[155:163],
-	_WorkerName[163:172],
-	_WorkerName[172:180],
-	_WorkerName[180:190],
-	_WorkerName[190:197],
-	_WorkerName[197:201],
-	_WorkerName[201:205],
-	_WorkerName[205:213],
-	_WorkerName[213:220],
-	_WorkerName[220:223],
-	_WorkerName[223:229],
+	_WorkerName[37:41],
+	_WorkerName[41:44],
+	_WorkerName[44:53],
+	_WorkerName[53:58],
+	_WorkerName[58:61],
+	_WorkerName[61:71],
+	_WorkerName[71:74],
+	_WorkerName[74:83],
+	_WorkerName[83:86],
+	_WorkerName[86:94],
+	_WorkerName[94:103],
+	_WorkerName[103:109],
+	_WorkerName[109:118],
+	_WorkerName[118:122],
+	_WorkerName[122:126],
+	_WorkerName[126:131],
+	_WorkerName[131:137],
+	_WorkerName[137:146],
+	_WorkerName[146:153],
+	_WorkerName[153:159],
+	_WorkerName[159:165],
+	_WorkerName[165:175],
+	_WorkerName[175:180],
+	_WorkerName[180:185],
+	_WorkerName[185:192],
+	_WorkerName[192:200],
+	_WorkerName[200:209],
+	_WorkerName[209:217],
+	_WorkerName[217:227],
+	_WorkerName[227:234],
+	_WorkerName[234:238],
+	_WorkerName[238:242],
+	_WorkerName[242:246],
+	_WorkerName[246:254],
+	_WorkerName[254:261],
+	_WorkerName[261:264],
+	_WorkerName[264:270],
 }
 
 // WorkerString retrieves an enum value from the enum constants string name.
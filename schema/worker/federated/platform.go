@@ -10,6 +10,11 @@ const (
 	PlatformUnknown  Platform = iota // Unknown
 	PlatformMastodon                 // Mastodon
 	PlatformBluesky                  // Bluesky
+	PlatformMisskey                  // Misskey
+	PlatformPleroma                  // Pleroma
+	PlatformPixelfed                 // Pixelfed
+	PlatformLemmy                    // Lemmy
+	PlatformPeerTube                 // PeerTube
 )
 
 var _ echo.BindUnmarshaler = (*Platform)(nil)
@@ -9,11 +9,11 @@ import (
 	"strings"
 )
 
-const _PlatformName = "UnknownMastodonBluesky"
+const _PlatformName = "UnknownMastodonBlueskyMisskeyPleromaPixelfedLemmyPeerTube"
 
-var _PlatformIndex = [...]uint8{0, 7, 15, 22}
+var _PlatformIndex = [...]uint8{0, 7, 15, 22, 29, 36, 44, 49, 57}
 
-const _PlatformLowerName = "unknownmastodonbluesky"
+const _PlatformLowerName = "unknownmastodonblueskymisskeypleromapixelfedlemmypeertube"
 
 func (i Platform) String() string {
 	if i >= Platform(len(_PlatformIndex)-1) {
@@ -33,9 +33,14 @@ func _PlatformNoOp() {
 	_ = x[PlatformUnknown-(0)]
 	_ = x[PlatformMastodon-(1)]
 	_ = x[PlatformBluesky-(2)]
+	_ = x[PlatformMisskey-(3)]
+	_ = x[PlatformPleroma-(4)]
+	_ = x[PlatformPixelfed-(5)]
+	_ = x[PlatformLemmy-(6)]
+	_ = x[PlatformPeerTube-(7)]
 }
 
-var _PlatformValues = []Platform{PlatformUnknown, PlatformMastodon, PlatformBluesky}
+var _PlatformValues = []Platform{PlatformUnknown, PlatformMastodon, PlatformBluesky, PlatformMisskey, PlatformPleroma, PlatformPixelfed, PlatformLemmy, PlatformPeerTube}
 
 var _PlatformNameToValueMap = map[string]Platform{
 	_PlatformName[0:7]:        PlatformUnknown,
@@ -44,12 +49,27 @@ var _PlatformNameToValueMap = map[string]Platform{
 	_PlatformLowerName[7:15]:  PlatformMastodon,
 	_PlatformName[15:22]:      PlatformBluesky,
 	_PlatformLowerName[15:22]: PlatformBluesky,
+	_PlatformName[22:29]:      PlatformMisskey,
+	_PlatformLowerName[22:29]: PlatformMisskey,
+	_PlatformName[29:36]:      PlatformPleroma,
+	_PlatformLowerName[29:36]: PlatformPleroma,
+	_PlatformName[36:44]:      PlatformPixelfed,
+	_PlatformLowerName[36:44]: PlatformPixelfed,
+	_PlatformName[44:49]:      PlatformLemmy,
+	_PlatformLowerName[44:49]: PlatformLemmy,
+	_PlatformName[49:57]:      PlatformPeerTube,
+	_PlatformLowerName[49:57]: PlatformPeerTube,
 }
 
 var _PlatformNames = []string{
 	_PlatformName[0:7],
 	_PlatformName[7:15],
 	_PlatformName[15:22],
+	_PlatformName[22:29],
+	_PlatformName[29:36],
+	_PlatformName[36:44],
+	_PlatformName[44:49],
+	_PlatformName[49:57],
 }
 
 // PlatformString retrieves an enum value from the enum constants string name.
@@ -9,6 +9,7 @@ type Platform uint64
 const (
 	PlatformUnknown Platform = iota // Unknown
 	PlatformRSSHub                  // RSSHub
+	PlatformRSS                     // RSS
 )
 
 var _ echo.BindUnmarshaler = (*Platform)(nil)
@@ -27,4 +28,5 @@ func (p *Platform) UnmarshalParam(param string) error {
 // ToPlatformMap is a map of worker to platform
 var ToPlatformMap = map[Worker]Platform{
 	Core: PlatformRSSHub,
+	Feed: PlatformRSS,
 }
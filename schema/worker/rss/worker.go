@@ -11,6 +11,7 @@ type Worker int
 
 const (
 	Core Worker = iota + 1 // core
+	Feed                   // feed
 )
 
 func (w Worker) Component() string {
@@ -41,4 +42,5 @@ func GetValueByWorkerStr(workerStr string) Worker {
 // ToTagsMap is a map of worker to tags
 var ToTagsMap = map[Worker][]tag.Tag{
 	Core: {tag.RSS},
+	Feed: {tag.Social},
 }
@@ -9,11 +9,11 @@ import (
 	"strings"
 )
 
-const _WorkerName = "core"
+const _WorkerName = "corefeed"
 
-var _WorkerIndex = [...]uint8{0, 4}
+var _WorkerIndex = [...]uint8{0, 4, 8}
 
-const _WorkerLowerName = "core"
+const _WorkerLowerName = "corefeed"
 
 func (i Worker) String() string {
 	i -= 1
@@ -32,17 +32,21 @@ func (Worker) Values() []string {
 func _WorkerNoOp() {
 	var x [1]struct{}
 	_ = x[Core-(1)]
+	_ = x[Feed-(2)]
 }
 
-var _WorkerValues = []Worker{Core}
+var _WorkerValues = []Worker{Core, Feed}
 
 var _WorkerNameToValueMap = map[string]Worker{
 	_WorkerName[0:4]:      Core,
 	_WorkerLowerName[0:4]: Core,
+	_WorkerName[4:8]:      Feed,
+	_WorkerLowerName[4:8]: Feed,
 }
 
 var _WorkerNames = []string{
 	_WorkerName[0:4],
+	_WorkerName[4:8],
 }
 
 // WorkerString retrieves an enum value from the enum constants string name.
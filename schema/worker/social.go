@@ -0,0 +1,19 @@
+package worker
+
+// Relations of the social graph. The protocol has no types of follows or blocks, so they are
+// social profile updates of the account, with the relation as the key and the other account as the value.
+const (
+	SocialRelationFollow   = "follow"
+	SocialRelationUnfollow = "unfollow"
+	SocialRelationBlock    = "block"
+	SocialRelationUnblock  = "unblock"
+	SocialRelationList     = "list"
+	SocialRelationUnlist   = "unlist"
+)
+
+// SocialRelationUndo maps the relations to the relations undoing them.
+var SocialRelationUndo = map[string]string{
+	SocialRelationFollow: SocialRelationUnfollow,
+	SocialRelationBlock:  SocialRelationUnblock,
+	SocialRelationList:   SocialRelationUnlist,
+}
@@ -8,4 +8,5 @@ const (
 	StatusIndexing                // Indexing
 	StatusReady                   // Ready
 	StatusUnhealthy               // Unhealthy
+	StatusPaused                  // Paused
 )
@@ -9,11 +9,11 @@ import (
 	"strings"
 )
 
-const _StatusName = "UnknownIndexingReadyUnhealthy"
+const _StatusName = "UnknownIndexingReadyUnhealthyPaused"
 
-var _StatusIndex = [...]uint8{0, 7, 15, 20, 29}
+var _StatusIndex = [...]uint8{0, 7, 15, 20, 29, 35}
 
-const _StatusLowerName = "unknownindexingreadyunhealthy"
+const _StatusLowerName = "unknownindexingreadyunhealthypaused"
 
 func (i Status) String() string {
 	if i >= Status(len(_StatusIndex)-1) {
@@ -34,9 +34,10 @@ func _StatusNoOp() {
 	_ = x[StatusIndexing-(1)]
 	_ = x[StatusReady-(2)]
 	_ = x[StatusUnhealthy-(3)]
+	_ = x[StatusPaused-(4)]
 }
 
-var _StatusValues = []Status{StatusUnknown, StatusIndexing, StatusReady, StatusUnhealthy}
+var _StatusValues = []Status{StatusUnknown, StatusIndexing, StatusReady, StatusUnhealthy, StatusPaused}
 
 var _StatusNameToValueMap = map[string]Status{
 	_StatusName[0:7]:        StatusUnknown,
@@ -47,6 +48,8 @@ var _StatusNameToValueMap = map[string]Status{
 	_StatusLowerName[15:20]: StatusReady,
 	_StatusName[20:29]:      StatusUnhealthy,
 	_StatusLowerName[20:29]: StatusUnhealthy,
+	_StatusName[29:35]:      StatusPaused,
+	_StatusLowerName[29:35]: StatusPaused,
 }
 
 var _StatusNames = []string{
@@ -54,6 +57,7 @@ var _StatusNames = []string{
 	_StatusName[7:15],
 	_StatusName[15:20],
 	_StatusName[20:29],
+	_StatusName[29:35],
 }
 
 // StatusString retrieves an enum value from the enum constants string name.